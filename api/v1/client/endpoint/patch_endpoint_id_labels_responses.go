@@ -66,14 +66,26 @@ func NewPatchEndpointIDLabelsOK() *PatchEndpointIDLabelsOK {
 Success
 */
 type PatchEndpointIDLabelsOK struct {
+	Payload *models.Identity
 }
 
 func (o *PatchEndpointIDLabelsOK) Error() string {
-	return fmt.Sprintf("[PATCH /endpoint/{id}/labels][%d] patchEndpointIdLabelsOK ", 200)
+	return fmt.Sprintf("[PATCH /endpoint/{id}/labels][%d] patchEndpointIdLabelsOK  %+v", 200, o.Payload)
+}
+
+func (o *PatchEndpointIDLabelsOK) GetPayload() *models.Identity {
+	return o.Payload
 }
 
 func (o *PatchEndpointIDLabelsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	o.Payload = new(models.Identity)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
 	return nil
 }
 
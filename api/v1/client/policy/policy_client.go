@@ -54,6 +54,8 @@ type ClientService interface {
 
 	GetPolicySelectors(params *GetPolicySelectorsParams) (*GetPolicySelectorsOK, error)
 
+	PostPolicyDryRun(params *PostPolicyDryRunParams) (*PostPolicyDryRunOK, error)
+
 	PutPolicy(params *PutPolicyParams) (*PutPolicyOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -495,6 +497,46 @@ func (a *Client) GetPolicySelectors(params *GetPolicySelectorsParams) (*GetPolic
 	panic(msg)
 }
 
+/*
+  PostPolicyDryRun evaluates recently observed flows against a candidate policy
+
+  Resolves the provided candidate rules against the current set of
+security identities and evaluates flows recently observed by Hubble
+against the result, without programming any datapath state. Returns
+the flows whose verdict would change under the candidate policy.
+
+*/
+func (a *Client) PostPolicyDryRun(params *PostPolicyDryRunParams) (*PostPolicyDryRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostPolicyDryRunParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostPolicyDryRun",
+		Method:             "POST",
+		PathPattern:        "/policy/dry-run",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostPolicyDryRunReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostPolicyDryRunOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PostPolicyDryRun: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   PutPolicy creates or update a policy sub tree
 */
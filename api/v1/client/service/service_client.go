@@ -32,12 +32,16 @@ type Client struct {
 type ClientService interface {
 	DeleteServiceID(params *DeleteServiceIDParams) (*DeleteServiceIDOK, error)
 
+	DeleteServiceIDAffinity(params *DeleteServiceIDAffinityParams) (*DeleteServiceIDAffinityOK, error)
+
 	GetLrp(params *GetLrpParams) (*GetLrpOK, error)
 
 	GetService(params *GetServiceParams) (*GetServiceOK, error)
 
 	GetServiceID(params *GetServiceIDParams) (*GetServiceIDOK, error)
 
+	GetServiceIDConnections(params *GetServiceIDConnectionsParams) (*GetServiceIDConnectionsOK, error)
+
 	PutServiceID(params *PutServiceIDParams) (*PutServiceIDOK, *PutServiceIDCreated, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -77,6 +81,45 @@ func (a *Client) DeleteServiceID(params *DeleteServiceIDParams) (*DeleteServiceI
 	panic(msg)
 }
 
+/*
+  DeleteServiceIDAffinity purges session affinity of a service
+
+  Deletes the session affinity entries of the given service, causing
+its clients to re-select a backend. Affinity entries of other
+services are left intact.
+
+*/
+func (a *Client) DeleteServiceIDAffinity(params *DeleteServiceIDAffinityParams) (*DeleteServiceIDAffinityOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteServiceIDAffinityParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteServiceIDAffinity",
+		Method:             "DELETE",
+		PathPattern:        "/service/{id}/affinity",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteServiceIDAffinityReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteServiceIDAffinityOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DeleteServiceIDAffinity: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   GetLrp retrieves list of all local redirect policies
 */
@@ -179,6 +222,45 @@ func (a *Client) GetServiceID(params *GetServiceIDParams) (*GetServiceIDOK, erro
 	panic(msg)
 }
 
+/*
+  GetServiceIDConnections retrieves per backend connection counts of a service
+
+  Returns the number of connections tracked in the datapath conntrack
+table for each backend of the given service. Backends which still
+have tracked connections but are no longer part of the service are
+reported as stale.
+*/
+func (a *Client) GetServiceIDConnections(params *GetServiceIDConnectionsParams) (*GetServiceIDConnectionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetServiceIDConnectionsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetServiceIDConnections",
+		Method:             "GET",
+		PathPattern:        "/service/{id}/connections",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetServiceIDConnectionsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetServiceIDConnectionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetServiceIDConnections: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   PutServiceID creates or update service
 */
@@ -24,11 +24,17 @@ type PathStatus struct {
 	// Connectivity status without policy applied
 	HTTP *ConnectivityStatus `json:"http,omitempty"`
 
+	// Connectivity status via service redirection
+	HTTPService *ConnectivityStatus `json:"http-service,omitempty"`
+
 	// Basic ping connectivity status to node IP
 	Icmp *ConnectivityStatus `json:"icmp,omitempty"`
 
 	// IP address queried for the connectivity status
 	IP string `json:"ip,omitempty"`
+
+	// Basic TCP connectivity status to node health port
+	TCP *ConnectivityStatus `json:"tcp,omitempty"`
 }
 
 // Validate validates this path status
@@ -39,10 +45,18 @@ func (m *PathStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHTTPService(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIcmp(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateTCP(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -67,6 +81,24 @@ func (m *PathStatus) validateHTTP(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PathStatus) validateHTTPService(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HTTPService) { // not required
+		return nil
+	}
+
+	if m.HTTPService != nil {
+		if err := m.HTTPService.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("http-service")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *PathStatus) validateIcmp(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Icmp) { // not required
@@ -85,6 +117,24 @@ func (m *PathStatus) validateIcmp(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PathStatus) validateTCP(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TCP) { // not required
+		return nil
+	}
+
+	if m.TCP != nil {
+		if err := m.TCP.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tcp")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *PathStatus) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -209,6 +209,10 @@ func init() {
           "description": "Connectivity status without policy applied",
           "$ref": "#/definitions/ConnectivityStatus"
         },
+        "http-service": {
+          "description": "Connectivity status via service redirection",
+          "$ref": "#/definitions/ConnectivityStatus"
+        },
         "icmp": {
           "description": "Basic ping connectivity status to node IP",
           "$ref": "#/definitions/ConnectivityStatus"
@@ -216,6 +220,10 @@ func init() {
         "ip": {
           "description": "IP address queried for the connectivity status",
           "type": "string"
+        },
+        "tcp": {
+          "description": "Basic TCP connectivity status to node health port",
+          "$ref": "#/definitions/ConnectivityStatus"
         }
       }
     },
@@ -438,6 +446,10 @@ func init() {
           "description": "Connectivity status without policy applied",
           "$ref": "#/definitions/ConnectivityStatus"
         },
+        "http-service": {
+          "description": "Connectivity status via service redirection",
+          "$ref": "#/definitions/ConnectivityStatus"
+        },
         "icmp": {
           "description": "Basic ping connectivity status to node IP",
           "$ref": "#/definitions/ConnectivityStatus"
@@ -445,6 +457,10 @@ func init() {
         "ip": {
           "description": "IP address queried for the connectivity status",
           "type": "string"
+        },
+        "tcp": {
+          "description": "Basic TCP connectivity status to node health port",
+          "$ref": "#/definitions/ConnectivityStatus"
         }
       }
     },
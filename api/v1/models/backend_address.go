@@ -29,6 +29,9 @@ type BackendAddress struct {
 
 	// Layer 4 port number
 	Port uint16 `json:"port,omitempty"`
+
+	// Indicator if this backend is quarantined due to failing health probes
+	Quarantined bool `json:"quarantined,omitempty"`
 }
 
 // Validate validates this backend address
@@ -24,6 +24,9 @@ import (
 // swagger:model EncryptionStatus
 type EncryptionStatus struct {
 
+	// Status of the IPsec datapath
+	Ipsec *IPsecStatus `json:"ipsec,omitempty"`
+
 	// mode
 	// Enum: [Disabled IPsec Wireguard]
 	Mode string `json:"mode,omitempty"`
@@ -39,6 +42,10 @@ type EncryptionStatus struct {
 func (m *EncryptionStatus) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateIpsec(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMode(formats); err != nil {
 		res = append(res, err)
 	}
@@ -53,6 +60,24 @@ func (m *EncryptionStatus) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *EncryptionStatus) validateIpsec(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Ipsec) { // not required
+		return nil
+	}
+
+	if m.Ipsec != nil {
+		if err := m.Ipsec.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ipsec")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var encryptionStatusTypeModePropEnum []interface{}
 
 func init() {
@@ -9,6 +9,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -71,6 +73,9 @@ type EndpointChangeRequest struct {
 	// Whether policy enforcement is enabled or not
 	PolicyEnabled bool `json:"policy-enabled,omitempty"`
 
+	// Additional network interfaces of the endpoint
+	SecondaryInterfaces []*EndpointSecondaryInterface `json:"secondary-interfaces"`
+
 	// Current state of endpoint
 	// Required: true
 	State EndpointState `json:"state"`
@@ -96,6 +101,10 @@ func (m *EndpointChangeRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSecondaryInterfaces(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateState(formats); err != nil {
 		res = append(res, err)
 	}
@@ -158,6 +167,31 @@ func (m *EndpointChangeRequest) validateLabels(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *EndpointChangeRequest) validateSecondaryInterfaces(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryInterfaces) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.SecondaryInterfaces); i++ {
+		if swag.IsZero(m.SecondaryInterfaces[i]) { // not required
+			continue
+		}
+
+		if m.SecondaryInterfaces[i] != nil {
+			if err := m.SecondaryInterfaces[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("secondary-interfaces" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *EndpointChangeRequest) validateState(formats strfmt.Registry) error {
 
 	if err := m.State.Validate(formats); err != nil {
@@ -38,6 +38,9 @@ type EndpointNetworking struct {
 
 	// MAC address
 	Mac string `json:"mac,omitempty"`
+
+	// Additional network interfaces of the endpoint
+	SecondaryInterfaces []*EndpointSecondaryInterface `json:"secondary-interfaces"`
 }
 
 // Validate validates this endpoint networking
@@ -52,6 +55,10 @@ func (m *EndpointNetworking) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSecondaryInterfaces(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -101,6 +108,31 @@ func (m *EndpointNetworking) validateHostAddressing(formats strfmt.Registry) err
 	return nil
 }
 
+func (m *EndpointNetworking) validateSecondaryInterfaces(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryInterfaces) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.SecondaryInterfaces); i++ {
+		if swag.IsZero(m.SecondaryInterfaces[i]) { // not required
+			continue
+		}
+
+		if m.SecondaryInterfaces[i] != nil {
+			if err := m.SecondaryInterfaces[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("secondary-interfaces" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *EndpointNetworking) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -9,6 +9,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -49,6 +51,11 @@ type EndpointPolicy struct {
 	// l4
 	L4 *L4Policy `json:"l4,omitempty"`
 
+	// The rule label provenance of each policy map entry. Only present when
+	// policy map provenance tracking is enabled in the agent.
+	//
+	PolicyMapProvenance []*PolicyMapEntryProvenance `json:"policy-map-provenance"`
+
 	// Whether policy enforcement is enabled (ingress, egress, both or none)
 	PolicyEnabled EndpointPolicyEnabled `json:"policy-enabled,omitempty"`
 
@@ -68,6 +75,10 @@ func (m *EndpointPolicy) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePolicyMapProvenance(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePolicyEnabled(formats); err != nil {
 		res = append(res, err)
 	}
@@ -114,6 +125,31 @@ func (m *EndpointPolicy) validateL4(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *EndpointPolicy) validatePolicyMapProvenance(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PolicyMapProvenance) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.PolicyMapProvenance); i++ {
+		if swag.IsZero(m.PolicyMapProvenance[i]) { // not required
+			continue
+		}
+
+		if m.PolicyMapProvenance[i] != nil {
+			if err := m.PolicyMapProvenance[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("policy-map-provenance" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *EndpointPolicy) validatePolicyEnabled(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.PolicyEnabled) { // not required
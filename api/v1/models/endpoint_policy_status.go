@@ -21,6 +21,11 @@ import (
 // swagger:model EndpointPolicyStatus
 type EndpointPolicyStatus struct {
 
+	// List of proxy redirects whose proxy listener is not yet confirmed
+	// listening and whose policy map entries are subject to the configured
+	// proxy redirect fallback
+	PendingRedirects []string `json:"pending-redirects"`
+
 	// The policy revision currently enforced in the proxy for this endpoint
 	ProxyPolicyRevision int64 `json:"proxy-policy-revision,omitempty"`
 
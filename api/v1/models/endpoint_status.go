@@ -9,6 +9,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -19,6 +21,9 @@ import (
 // swagger:model EndpointStatus
 type EndpointStatus struct {
 
+	// Bandwidth limits enforced for the endpoint
+	Bandwidth *EndpointBandwidth `json:"bandwidth,omitempty"`
+
 	// Status of internal controllers attached to this endpoint
 	Controllers ControllerStatuses `json:"controllers,omitempty"`
 
@@ -49,6 +54,9 @@ type EndpointStatus struct {
 	// The configuration in effect on this endpoint
 	Realized *EndpointConfigurationSpec `json:"realized,omitempty"`
 
+	// Duration breakdown of the most recent endpoint regenerations
+	RegenerationStatistics []*EndpointRegenerationStatistics `json:"regeneration-statistics"`
+
 	// Current state of endpoint
 	// Required: true
 	State EndpointState `json:"state"`
@@ -58,6 +66,10 @@ type EndpointStatus struct {
 func (m *EndpointStatus) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBandwidth(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateControllers(formats); err != nil {
 		res = append(res, err)
 	}
@@ -98,6 +110,10 @@ func (m *EndpointStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRegenerationStatistics(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateState(formats); err != nil {
 		res = append(res, err)
 	}
@@ -108,6 +124,24 @@ func (m *EndpointStatus) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *EndpointStatus) validateBandwidth(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Bandwidth) { // not required
+		return nil
+	}
+
+	if m.Bandwidth != nil {
+		if err := m.Bandwidth.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bandwidth")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *EndpointStatus) validateControllers(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Controllers) { // not required
@@ -282,6 +316,31 @@ func (m *EndpointStatus) validateRealized(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *EndpointStatus) validateRegenerationStatistics(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RegenerationStatistics) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.RegenerationStatistics); i++ {
+		if swag.IsZero(m.RegenerationStatistics[i]) { // not required
+			continue
+		}
+
+		if m.RegenerationStatistics[i] != nil {
+			if err := m.RegenerationStatistics[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("regeneration-statistics" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *EndpointStatus) validateState(formats strfmt.Registry) error {
 
 	if err := m.State.Validate(formats); err != nil {
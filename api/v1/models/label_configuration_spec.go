@@ -19,6 +19,12 @@ import (
 // swagger:model LabelConfigurationSpec
 type LabelConfigurationSpec struct {
 
+	// Labels to add to the endpoint, merged with the labels derived from the orchestration system. Labels retain their source prefix.
+	Add Labels `json:"add,omitempty"`
+
+	// Labels to delete from the endpoint
+	Delete Labels `json:"delete,omitempty"`
+
 	// Custom labels in addition to orchestration system labels.
 	User Labels `json:"user,omitempty"`
 }
@@ -27,6 +33,14 @@ type LabelConfigurationSpec struct {
 func (m *LabelConfigurationSpec) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAdd(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDelete(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUser(formats); err != nil {
 		res = append(res, err)
 	}
@@ -37,6 +51,38 @@ func (m *LabelConfigurationSpec) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *LabelConfigurationSpec) validateAdd(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Add) { // not required
+		return nil
+	}
+
+	if err := m.Add.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("add")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *LabelConfigurationSpec) validateDelete(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Delete) { // not required
+		return nil
+	}
+
+	if err := m.Delete.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("delete")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *LabelConfigurationSpec) validateUser(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.User) { // not required
@@ -22,6 +22,9 @@ import (
 // swagger:model RecorderFilter
 type RecorderFilter struct {
 
+	// Destination security identity, zero for any
+	DstIdentity int64 `json:"dst-identity,omitempty"`
+
 	// Layer 4 destination port, zero (or in future range)
 	DstPort string `json:"dst-port,omitempty"`
 
@@ -32,6 +35,9 @@ type RecorderFilter struct {
 	// Enum: [TCP UDP ANY]
 	Protocol string `json:"protocol,omitempty"`
 
+	// Source security identity, zero for any
+	SrcIdentity int64 `json:"src-identity,omitempty"`
+
 	// Layer 4 source port, zero (or in future range)
 	SrcPort string `json:"src-port,omitempty"`
 
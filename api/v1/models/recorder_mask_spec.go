@@ -18,6 +18,9 @@ import (
 // swagger:model RecorderMaskSpec
 type RecorderMaskSpec struct {
 
+	// Destination security identity mask
+	DstIdentityMask string `json:"dst-identity-mask,omitempty"`
+
 	// Layer 4 destination port mask
 	DstPortMask string `json:"dst-port-mask,omitempty"`
 
@@ -30,6 +33,9 @@ type RecorderMaskSpec struct {
 	// Layer 4 protocol mask
 	ProtocolMask string `json:"protocol-mask,omitempty"`
 
+	// Source security identity mask
+	SrcIdentityMask string `json:"src-identity-mask,omitempty"`
+
 	// Layer 4 source port mask
 	SrcPortMask string `json:"src-port-mask,omitempty"`
 
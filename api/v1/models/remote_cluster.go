@@ -9,6 +9,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -46,6 +48,9 @@ type RemoteCluster struct {
 
 	// Status of the control plane
 	Status string `json:"status,omitempty"`
+
+	// Synchronization status of the individual resource types from the cluster
+	Sync []*RemoteClusterSyncStatus `json:"sync"`
 }
 
 // Validate validates this remote cluster
@@ -56,6 +61,10 @@ func (m *RemoteCluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSync(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -75,6 +84,31 @@ func (m *RemoteCluster) validateLastFailure(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *RemoteCluster) validateSync(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Sync) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Sync); i++ {
+		if swag.IsZero(m.Sync[i]) { // not required
+			continue
+		}
+
+		if m.Sync[i] != nil {
+			if err := m.Sync[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sync" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *RemoteCluster) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -61,6 +61,9 @@ type StatusResponse struct {
 	// Status of Hubble server
 	Hubble *HubbleStatus `json:"hubble,omitempty"`
 
+	// Status of the lazy identity migration after a labels filter change
+	IdentityMigration *Status `json:"identity-migration,omitempty"`
+
 	// Status of IP address management
 	Ipam *IPAMStatus `json:"ipam,omitempty"`
 
@@ -134,6 +137,10 @@ func (m *StatusResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateIdentityMigration(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIpam(formats); err != nil {
 		res = append(res, err)
 	}
@@ -368,6 +375,24 @@ func (m *StatusResponse) validateHubble(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *StatusResponse) validateIdentityMigration(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.IdentityMigration) { // not required
+		return nil
+	}
+
+	if m.IdentityMigration != nil {
+		if err := m.IdentityMigration.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("identity-migration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *StatusResponse) validateIpam(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Ipam) { // not required
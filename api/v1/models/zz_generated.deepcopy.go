@@ -249,6 +249,11 @@ func (in *ControllerStatusStatus) DeepCopy() *ControllerStatusStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionStatus) DeepCopyInto(out *EncryptionStatus) {
 	*out = *in
+	if in.Ipsec != nil {
+		in, out := &in.Ipsec, &out.Ipsec
+		*out = new(IPsecStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Wireguard != nil {
 		in, out := &in.Wireguard, &out.Wireguard
 		*out = new(WireguardStatus)
@@ -300,6 +305,17 @@ func (in *EndpointPolicy) DeepCopyInto(out *EndpointPolicy) {
 		*out = new(L4Policy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PolicyMapProvenance != nil {
+		in, out := &in.PolicyMapProvenance, &out.PolicyMapProvenance
+		*out = make([]*PolicyMapEntryProvenance, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PolicyMapEntryProvenance)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	return
 }
 
@@ -405,6 +421,56 @@ func (in *IPAMStatus) DeepCopy() *IPAMStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPsecStateEntry) DeepCopyInto(out *IPsecStateEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPsecStateEntry.
+func (in *IPsecStateEntry) DeepCopy() *IPsecStateEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(IPsecStateEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPsecStatus) DeepCopyInto(out *IPsecStatus) {
+	*out = *in
+	if in.ErrorCounters != nil {
+		in, out := &in.ErrorCounters, &out.ErrorCounters
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make([]*IPsecStateEntry, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPsecStateEntry)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPsecStatus.
+func (in *IPsecStatus) DeepCopy() *IPsecStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPsecStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8sStatus) DeepCopyInto(out *K8sStatus) {
 	*out = *in
@@ -732,6 +798,33 @@ func (in *NodeElement) DeepCopy() *NodeElement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyMapEntryProvenance) DeepCopyInto(out *PolicyMapEntryProvenance) {
+	*out = *in
+	if in.DerivedFromRules != nil {
+		in, out := &in.DerivedFromRules, &out.DerivedFromRules
+		*out = make([][]string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyMapEntryProvenance.
+func (in *PolicyMapEntryProvenance) DeepCopy() *PolicyMapEntryProvenance {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyMapEntryProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyRule) DeepCopyInto(out *PolicyRule) {
 	*out = *in
@@ -811,6 +904,17 @@ func (in *ProxyStatus) DeepCopy() *ProxyStatus {
 func (in *RemoteCluster) DeepCopyInto(out *RemoteCluster) {
 	*out = *in
 	in.LastFailure.DeepCopyInto(&out.LastFailure)
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = make([]*RemoteClusterSyncStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RemoteClusterSyncStatus)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	return
 }
 
@@ -824,6 +928,23 @@ func (in *RemoteCluster) DeepCopy() *RemoteCluster {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteClusterSyncStatus) DeepCopyInto(out *RemoteClusterSyncStatus) {
+	*out = *in
+	in.LastSuccess.DeepCopyInto(&out.LastSuccess)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterSyncStatus.
+func (in *RemoteClusterSyncStatus) DeepCopy() *RemoteClusterSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequestResponseStatistics) DeepCopyInto(out *RequestResponseStatistics) {
 	*out = *in
@@ -914,6 +1035,11 @@ func (in *StatusResponse) DeepCopyInto(out *StatusResponse) {
 		*out = new(HubbleStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IdentityMigration != nil {
+		in, out := &in.IdentityMigration, &out.IdentityMigration
+		*out = new(Status)
+		**out = **in
+	}
 	if in.Ipam != nil {
 		in, out := &in.Ipam, &out.Ipam
 		*out = new(IPAMStatus)
@@ -467,7 +467,10 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Success"
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/Identity"
+            }
           },
           "404": {
             "description": "Endpoint not found"
@@ -1101,6 +1104,47 @@ func init() {
         }
       }
     },
+    "/policy/dry-run": {
+      "post": {
+        "description": "Resolves the provided candidate rules against the current set of\nsecurity identities and evaluates flows recently observed by Hubble\nagainst the result, without programming any datapath state. Returns\nthe flows whose verdict would change under the candidate policy.\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "Evaluate recently observed flows against a candidate policy",
+        "parameters": [
+          {
+            "name": "spec",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PolicyDryRunSpec"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/PolicyDryRunResult"
+            }
+          },
+          "400": {
+            "description": "Invalid policy",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "InvalidPolicy"
+          },
+          "500": {
+            "description": "Error while evaluating the candidate policy",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
+    },
     "/policy/resolve": {
       "get": {
         "tags": [
@@ -1455,6 +1499,91 @@ func init() {
           }
         }
       }
+    },
+    "/service/{id}/affinity": {
+      "delete": {
+        "description": "Deletes the session affinity entries of the given service, causing\nits clients to re-select a backend. Affinity entries of other\nservices are left intact.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "Purge session affinity of a service",
+        "parameters": [
+          {
+            "$ref": "#/parameters/service-id"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success"
+          },
+          "404": {
+            "description": "Service not found"
+          },
+          "500": {
+            "description": "Affinity purge failed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
+    },
+    "/service/{id}/connections": {
+      "get": {
+        "description": "Returns the number of connections tracked in the datapath conntrack\ntable for each backend of the given service. Backends which still\nhave tracked connections but are no longer part of the service are\nreported as stale.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "Retrieve per-backend connection counts of a service",
+        "parameters": [
+          {
+            "$ref": "#/parameters/service-id"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BackendConnections"
+              }
+            }
+          },
+          "404": {
+            "description": "Service not found"
+          },
+          "500": {
+            "description": "Connection count failed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
+    },
+    "/wireguard/rotate-key": {
+      "post": {
+        "description": "Generates a new Wireguard key pair for the local node, switches the\ndevice over to it and publishes the new public key to peers. Peers\nkeep the old key accepted for a grace window while they converge.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Rotate the Wireguard key of the local node",
+        "responses": {
+          "200": {
+            "description": "Success"
+          },
+          "500": {
+            "description": "Key rotation failed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
     }
   },
   "definitions": {
@@ -1598,6 +1727,31 @@ func init() {
           "description": "Layer 4 port number",
           "type": "integer",
           "format": "uint16"
+        },
+        "quarantined": {
+          "description": "Indicator if this backend is quarantined due to failing health probes",
+          "type": "boolean"
+        }
+      }
+    },
+    "BackendConnections": {
+      "description": "Number of datapath connections tracked towards a service backend",
+      "type": "object",
+      "properties": {
+        "backend-address": {
+          "$ref": "#/definitions/BackendAddress"
+        },
+        "backend-id": {
+          "description": "ID of the backend",
+          "type": "integer"
+        },
+        "connections": {
+          "description": "Number of connections tracked towards the backend",
+          "type": "integer"
+        },
+        "stale": {
+          "description": "The backend is no longer part of the service",
+          "type": "boolean"
         }
       }
     },
@@ -2038,6 +2192,10 @@ func init() {
     "EncryptionStatus": {
       "description": "Status of transparent encryption\n\n+k8s:deepcopy-gen=true",
       "properties": {
+        "ipsec": {
+          "description": "Status of the IPsec datapath",
+          "$ref": "#/definitions/IPsecStatus"
+        },
         "mode": {
           "type": "string",
           "enum": [
@@ -2074,6 +2232,20 @@ func init() {
         }
       }
     },
+    "EndpointBandwidth": {
+      "description": "Bandwidth limits enforced for the endpoint",
+      "type": "object",
+      "properties": {
+        "egress": {
+          "description": "Egress bandwidth limit in bytes per second, 0 if unlimited",
+          "type": "integer"
+        },
+        "ingress": {
+          "description": "Ingress bandwidth limit in bytes per second, 0 if unlimited",
+          "type": "integer"
+        }
+      }
+    },
     "EndpointChangeRequest": {
       "description": "Structure which contains the mutable elements of an Endpoint.\n",
       "type": "object",
@@ -2147,6 +2319,13 @@ func init() {
           "description": "Whether policy enforcement is enabled or not",
           "type": "boolean"
         },
+        "secondary-interfaces": {
+          "description": "Additional network interfaces of the endpoint",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EndpointSecondaryInterface"
+          }
+        },
         "state": {
           "description": "Current state of endpoint",
           "$ref": "#/definitions/EndpointState"
@@ -2314,6 +2493,13 @@ func init() {
         "mac": {
           "description": "MAC address",
           "type": "string"
+        },
+        "secondary-interfaces": {
+          "description": "Additional network interfaces of the endpoint",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EndpointSecondaryInterface"
+          }
         }
       }
     },
@@ -2390,6 +2576,13 @@ func init() {
       "description": "Policy information of an endpoint",
       "type": "object",
       "properties": {
+        "pending-redirects": {
+          "description": "List of proxy redirects whose proxy listener is not yet confirmed\nlistening and whose policy map entries are subject to the configured\nproxy redirect fallback",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "proxy-policy-revision": {
           "description": "The policy revision currently enforced in the proxy for this endpoint",
           "type": "integer"
@@ -2411,6 +2604,65 @@ func init() {
         }
       }
     },
+    "EndpointRegenerationStatistics": {
+      "description": "Duration breakdown of a single endpoint regeneration",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "Timestamp when the regeneration completed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "phase-durations": {
+          "description": "Duration in seconds of each regeneration phase",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number"
+          }
+        },
+        "reason": {
+          "description": "Reason which triggered the regeneration",
+          "type": "string"
+        },
+        "success": {
+          "description": "Whether the regeneration completed successfully",
+          "type": "boolean"
+        }
+      }
+    },
+    "EndpointSecondaryInterface": {
+      "description": "Secondary network interface attached to the endpoint, e.g. by a\nchained CNI plugin providing additional networks\n",
+      "type": "object",
+      "properties": {
+        "addressing": {
+          "description": "IP4/6 addresses assigned on this interface",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AddressPair"
+          }
+        },
+        "container-interface-name": {
+          "description": "Name of the network device in the container",
+          "type": "string"
+        },
+        "host-mac": {
+          "description": "MAC address",
+          "type": "string"
+        },
+        "interface-index": {
+          "description": "Index of the host-side network device",
+          "type": "integer"
+        },
+        "interface-name": {
+          "description": "Name of the host-side network device",
+          "type": "string"
+        },
+        "mac": {
+          "description": "MAC address",
+          "type": "string"
+        }
+      }
+    },
     "EndpointState": {
       "description": "State of endpoint",
       "type": "string",
@@ -2433,6 +2685,10 @@ func init() {
         "state"
       ],
       "properties": {
+        "bandwidth": {
+          "description": "Bandwidth limits enforced for the endpoint",
+          "$ref": "#/definitions/EndpointBandwidth"
+        },
         "controllers": {
           "description": "Status of internal controllers attached to this endpoint",
           "$ref": "#/definitions/ControllerStatuses"
@@ -2473,6 +2729,13 @@ func init() {
           "description": "The configuration in effect on this endpoint",
           "$ref": "#/definitions/EndpointConfigurationSpec"
         },
+        "regeneration-statistics": {
+          "description": "Duration breakdown of the most recent endpoint regenerations",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EndpointRegenerationStatistics"
+          }
+        },
         "state": {
           "description": "Current state of endpoint",
           "$ref": "#/definitions/EndpointState"
@@ -2761,6 +3024,70 @@ func init() {
         }
       }
     },
+    "IPsecStateEntry": {
+      "description": "XFRM state installed by Cilium\n\n+k8s:deepcopy-gen=true",
+      "properties": {
+        "bytes": {
+          "description": "Number of bytes processed by this state",
+          "type": "integer"
+        },
+        "destination": {
+          "description": "Destination address of the state",
+          "type": "string"
+        },
+        "direction": {
+          "description": "Direction of the state with respect to the local node",
+          "type": "string",
+          "enum": [
+            "in",
+            "out"
+          ]
+        },
+        "packets": {
+          "description": "Number of packets processed by this state",
+          "type": "integer"
+        },
+        "remote-node": {
+          "description": "Name of the remote node this state is associated with",
+          "type": "string"
+        },
+        "source": {
+          "description": "Source address of the state",
+          "type": "string"
+        },
+        "spi": {
+          "description": "Security parameter index of this state",
+          "type": "string"
+        },
+        "stale": {
+          "description": "True if the state does not match any known node",
+          "type": "boolean"
+        }
+      }
+    },
+    "IPsecStatus": {
+      "description": "Status of the IPsec datapath\n\n+k8s:deepcopy-gen=true",
+      "properties": {
+        "error-counters": {
+          "description": "Non-zero XFRM error counters of the kernel",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        },
+        "policies": {
+          "description": "Number of XFRM policies installed by Cilium",
+          "type": "integer"
+        },
+        "states": {
+          "description": "XFRM states installed by Cilium",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IPsecStateEntry"
+          }
+        }
+      }
+    },
     "Identity": {
       "description": "Security identity",
       "type": "object",
@@ -3074,6 +3401,14 @@ func init() {
       "description": "User desired Label configuration of an endpoint",
       "type": "object",
       "properties": {
+        "add": {
+          "description": "Labels to add to the endpoint, merged with the labels derived from the orchestration system. Labels retain their source prefix.",
+          "$ref": "#/definitions/Labels"
+        },
+        "delete": {
+          "description": "Labels to delete from the endpoint",
+          "$ref": "#/definitions/Labels"
+        },
         "user": {
           "description": "Custom labels in addition to orchestration system labels.",
           "$ref": "#/definitions/Labels"
@@ -3322,24 +3657,101 @@ func init() {
         }
       }
     },
-    "PolicyRule": {
-      "description": "A policy rule including the rule labels it derives from\n\n+k8s:deepcopy-gen=true",
+    "PolicyDryRunFlow": {
+      "description": "Aggregated observed flow whose verdict would change under a candidate policy",
+      "type": "object",
       "properties": {
-        "derived-from-rules": {
-          "description": "The policy rule labels identifying the policy rules this rule derives from",
-          "type": "array",
-          "items": {
-            "type": "array",
-            "items": {
-              "type": "string"
-            }
-          }
+        "count": {
+          "description": "Number of observed flows aggregated into this entry",
+          "type": "integer"
         },
-        "rule": {
-          "description": "The policy rule as json",
-          "type": "string"
-        }
-      }
+        "destination-labels": {
+          "description": "Labels of the destination security identity",
+          "$ref": "#/definitions/Labels"
+        },
+        "destination-port": {
+          "description": "Destination port of the flow, 0 if not applicable",
+          "type": "integer"
+        },
+        "dry-run-verdict": {
+          "description": "Verdict the flow would receive under the candidate policy",
+          "type": "string"
+        },
+        "protocol": {
+          "description": "L4 protocol of the flow",
+          "type": "string"
+        },
+        "source-labels": {
+          "description": "Labels of the source security identity",
+          "$ref": "#/definitions/Labels"
+        },
+        "traffic-direction": {
+          "description": "Direction of the flow from the perspective of the local endpoint (ingress or egress)",
+          "type": "string"
+        },
+        "verdict": {
+          "description": "Verdict observed for the flow",
+          "type": "string"
+        }
+      }
+    },
+    "PolicyDryRunResult": {
+      "description": "Result of evaluating observed flows against a candidate policy",
+      "type": "object",
+      "properties": {
+        "changed": {
+          "description": "Aggregated flows whose verdict would change under the candidate policy",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PolicyDryRunFlow"
+          }
+        },
+        "flows-changed": {
+          "description": "Number of evaluated flows whose verdict would change",
+          "type": "integer"
+        },
+        "flows-evaluated": {
+          "description": "Number of flows that were evaluated",
+          "type": "integer"
+        },
+        "truncated": {
+          "description": "True if the set of changed flows was truncated to bound memory usage",
+          "type": "boolean"
+        }
+      }
+    },
+    "PolicyDryRunSpec": {
+      "description": "Candidate policy and evaluation bounds for a policy dry-run",
+      "type": "object",
+      "properties": {
+        "flows": {
+          "description": "Maximum number of recent flows to evaluate. Defaults to 1000 and is\nbounded by the size of the Hubble ring buffer.\n",
+          "type": "integer"
+        },
+        "policy": {
+          "description": "Candidate policy rules as JSON, in the same format as the policy import API",
+          "type": "string"
+        }
+      }
+    },
+    "PolicyRule": {
+      "description": "A policy rule including the rule labels it derives from\n\n+k8s:deepcopy-gen=true",
+      "properties": {
+        "derived-from-rules": {
+          "description": "The policy rule labels identifying the policy rules this rule derives from",
+          "type": "array",
+          "items": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        },
+        "rule": {
+          "description": "The policy rule as json",
+          "type": "string"
+        }
+      }
     },
     "PolicyTraceResult": {
       "description": "Response to a policy resolution process",
@@ -3506,6 +3918,10 @@ func init() {
       "description": "n-tuple filter to match traffic to be recorded",
       "type": "object",
       "properties": {
+        "dst-identity": {
+          "description": "Destination security identity, zero for any",
+          "type": "integer"
+        },
         "dst-port": {
           "description": "Layer 4 destination port, zero (or in future range)",
           "type": "string"
@@ -3523,6 +3939,10 @@ func init() {
             "ANY"
           ]
         },
+        "src-identity": {
+          "description": "Source security identity, zero for any",
+          "type": "integer"
+        },
         "src-port": {
           "description": "Layer 4 source port, zero (or in future range)",
           "type": "string"
@@ -3546,6 +3966,10 @@ func init() {
       "description": "Configuration of a recorder mask",
       "type": "object",
       "properties": {
+        "dst-identity-mask": {
+          "description": "Destination security identity mask",
+          "type": "string"
+        },
         "dst-port-mask": {
           "description": "Layer 4 destination port mask",
           "type": "string"
@@ -3562,6 +3986,10 @@ func init() {
           "description": "Layer 4 protocol mask",
           "type": "string"
         },
+        "src-identity-mask": {
+          "description": "Source security identity mask",
+          "type": "string"
+        },
         "src-port-mask": {
           "description": "Layer 4 source port mask",
           "type": "string"
@@ -3867,6 +4295,10 @@ func init() {
           "description": "Status of Hubble server",
           "$ref": "#/definitions/HubbleStatus"
         },
+        "identity-migration": {
+          "description": "Status of the lazy identity migration after a labels filter change",
+          "$ref": "#/definitions/Status"
+        },
         "ipam": {
           "description": "Status of IP address management",
           "$ref": "#/definitions/IPAMStatus"
@@ -4678,7 +5110,10 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Success"
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/Identity"
+            }
           },
           "404": {
             "description": "Endpoint not found"
@@ -5377,6 +5812,47 @@ func init() {
         }
       }
     },
+    "/policy/dry-run": {
+      "post": {
+        "description": "Resolves the provided candidate rules against the current set of\nsecurity identities and evaluates flows recently observed by Hubble\nagainst the result, without programming any datapath state. Returns\nthe flows whose verdict would change under the candidate policy.\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "Evaluate recently observed flows against a candidate policy",
+        "parameters": [
+          {
+            "name": "spec",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PolicyDryRunSpec"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/PolicyDryRunResult"
+            }
+          },
+          "400": {
+            "description": "Invalid policy",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "InvalidPolicy"
+          },
+          "500": {
+            "description": "Error while evaluating the candidate policy",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
+    },
     "/policy/resolve": {
       "get": {
         "tags": [
@@ -5784,6 +6260,99 @@ func init() {
           }
         }
       }
+    },
+    "/service/{id}/affinity": {
+      "delete": {
+        "description": "Deletes the session affinity entries of the given service, causing\nits clients to re-select a backend. Affinity entries of other\nservices are left intact.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "Purge session affinity of a service",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "ID of service",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success"
+          },
+          "404": {
+            "description": "Service not found"
+          },
+          "500": {
+            "description": "Affinity purge failed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
+    },
+    "/service/{id}/connections": {
+      "get": {
+        "description": "Returns the number of connections tracked in the datapath conntrack\ntable for each backend of the given service. Backends which still\nhave tracked connections but are no longer part of the service are\nreported as stale.\n",
+        "tags": [
+          "service"
+        ],
+        "summary": "Retrieve per-backend connection counts of a service",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "ID of service",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BackendConnections"
+              }
+            }
+          },
+          "404": {
+            "description": "Service not found"
+          },
+          "500": {
+            "description": "Connection count failed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
+    },
+    "/wireguard/rotate-key": {
+      "post": {
+        "description": "Generates a new Wireguard key pair for the local node, switches the\ndevice over to it and publishes the new public key to peers. Peers\nkeep the old key accepted for a grace window while they converge.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Rotate the Wireguard key of the local node",
+        "responses": {
+          "200": {
+            "description": "Success"
+          },
+          "500": {
+            "description": "Key rotation failed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
     }
   },
   "definitions": {
@@ -5927,6 +6496,31 @@ func init() {
           "description": "Layer 4 port number",
           "type": "integer",
           "format": "uint16"
+        },
+        "quarantined": {
+          "description": "Indicator if this backend is quarantined due to failing health probes",
+          "type": "boolean"
+        }
+      }
+    },
+    "BackendConnections": {
+      "description": "Number of datapath connections tracked towards a service backend",
+      "type": "object",
+      "properties": {
+        "backend-address": {
+          "$ref": "#/definitions/BackendAddress"
+        },
+        "backend-id": {
+          "description": "ID of the backend",
+          "type": "integer"
+        },
+        "connections": {
+          "description": "Number of connections tracked towards the backend",
+          "type": "integer"
+        },
+        "stale": {
+          "description": "The backend is no longer part of the service",
+          "type": "boolean"
         }
       }
     },
@@ -6442,6 +7036,10 @@ func init() {
     "EncryptionStatus": {
       "description": "Status of transparent encryption\n\n+k8s:deepcopy-gen=true",
       "properties": {
+        "ipsec": {
+          "description": "Status of the IPsec datapath",
+          "$ref": "#/definitions/IPsecStatus"
+        },
         "mode": {
           "type": "string",
           "enum": [
@@ -6478,6 +7076,20 @@ func init() {
         }
       }
     },
+    "EndpointBandwidth": {
+      "description": "Bandwidth limits enforced for the endpoint",
+      "type": "object",
+      "properties": {
+        "egress": {
+          "description": "Egress bandwidth limit in bytes per second, 0 if unlimited",
+          "type": "integer"
+        },
+        "ingress": {
+          "description": "Ingress bandwidth limit in bytes per second, 0 if unlimited",
+          "type": "integer"
+        }
+      }
+    },
     "EndpointChangeRequest": {
       "description": "Structure which contains the mutable elements of an Endpoint.\n",
       "type": "object",
@@ -6551,6 +7163,13 @@ func init() {
           "description": "Whether policy enforcement is enabled or not",
           "type": "boolean"
         },
+        "secondary-interfaces": {
+          "description": "Additional network interfaces of the endpoint",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EndpointSecondaryInterface"
+          }
+        },
         "state": {
           "description": "Current state of endpoint",
           "$ref": "#/definitions/EndpointState"
@@ -6718,6 +7337,13 @@ func init() {
         "mac": {
           "description": "MAC address",
           "type": "string"
+        },
+        "secondary-interfaces": {
+          "description": "Additional network interfaces of the endpoint",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EndpointSecondaryInterface"
+          }
         }
       }
     },
@@ -6794,6 +7420,13 @@ func init() {
       "description": "Policy information of an endpoint",
       "type": "object",
       "properties": {
+        "pending-redirects": {
+          "description": "List of proxy redirects whose proxy listener is not yet confirmed\nlistening and whose policy map entries are subject to the configured\nproxy redirect fallback",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "proxy-policy-revision": {
           "description": "The policy revision currently enforced in the proxy for this endpoint",
           "type": "integer"
@@ -6815,6 +7448,65 @@ func init() {
         }
       }
     },
+    "EndpointRegenerationStatistics": {
+      "description": "Duration breakdown of a single endpoint regeneration",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "Timestamp when the regeneration completed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "phase-durations": {
+          "description": "Duration in seconds of each regeneration phase",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number"
+          }
+        },
+        "reason": {
+          "description": "Reason which triggered the regeneration",
+          "type": "string"
+        },
+        "success": {
+          "description": "Whether the regeneration completed successfully",
+          "type": "boolean"
+        }
+      }
+    },
+    "EndpointSecondaryInterface": {
+      "description": "Secondary network interface attached to the endpoint, e.g. by a\nchained CNI plugin providing additional networks\n",
+      "type": "object",
+      "properties": {
+        "addressing": {
+          "description": "IP4/6 addresses assigned on this interface",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AddressPair"
+          }
+        },
+        "container-interface-name": {
+          "description": "Name of the network device in the container",
+          "type": "string"
+        },
+        "host-mac": {
+          "description": "MAC address",
+          "type": "string"
+        },
+        "interface-index": {
+          "description": "Index of the host-side network device",
+          "type": "integer"
+        },
+        "interface-name": {
+          "description": "Name of the host-side network device",
+          "type": "string"
+        },
+        "mac": {
+          "description": "MAC address",
+          "type": "string"
+        }
+      }
+    },
     "EndpointState": {
       "description": "State of endpoint",
       "type": "string",
@@ -6837,6 +7529,10 @@ func init() {
         "state"
       ],
       "properties": {
+        "bandwidth": {
+          "description": "Bandwidth limits enforced for the endpoint",
+          "$ref": "#/definitions/EndpointBandwidth"
+        },
         "controllers": {
           "description": "Status of internal controllers attached to this endpoint",
           "$ref": "#/definitions/ControllerStatuses"
@@ -6877,6 +7573,13 @@ func init() {
           "description": "The configuration in effect on this endpoint",
           "$ref": "#/definitions/EndpointConfigurationSpec"
         },
+        "regeneration-statistics": {
+          "description": "Duration breakdown of the most recent endpoint regenerations",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EndpointRegenerationStatistics"
+          }
+        },
         "state": {
           "description": "Current state of endpoint",
           "$ref": "#/definitions/EndpointState"
@@ -7204,6 +7907,70 @@ func init() {
         }
       }
     },
+    "IPsecStateEntry": {
+      "description": "XFRM state installed by Cilium\n\n+k8s:deepcopy-gen=true",
+      "properties": {
+        "bytes": {
+          "description": "Number of bytes processed by this state",
+          "type": "integer"
+        },
+        "destination": {
+          "description": "Destination address of the state",
+          "type": "string"
+        },
+        "direction": {
+          "description": "Direction of the state with respect to the local node",
+          "type": "string",
+          "enum": [
+            "in",
+            "out"
+          ]
+        },
+        "packets": {
+          "description": "Number of packets processed by this state",
+          "type": "integer"
+        },
+        "remote-node": {
+          "description": "Name of the remote node this state is associated with",
+          "type": "string"
+        },
+        "source": {
+          "description": "Source address of the state",
+          "type": "string"
+        },
+        "spi": {
+          "description": "Security parameter index of this state",
+          "type": "string"
+        },
+        "stale": {
+          "description": "True if the state does not match any known node",
+          "type": "boolean"
+        }
+      }
+    },
+    "IPsecStatus": {
+      "description": "Status of the IPsec datapath\n\n+k8s:deepcopy-gen=true",
+      "properties": {
+        "error-counters": {
+          "description": "Non-zero XFRM error counters of the kernel",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        },
+        "policies": {
+          "description": "Number of XFRM policies installed by Cilium",
+          "type": "integer"
+        },
+        "states": {
+          "description": "XFRM states installed by Cilium",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IPsecStateEntry"
+          }
+        }
+      }
+    },
     "Identity": {
       "description": "Security identity",
       "type": "object",
@@ -7692,6 +8459,14 @@ func init() {
       "description": "User desired Label configuration of an endpoint",
       "type": "object",
       "properties": {
+        "add": {
+          "description": "Labels to add to the endpoint, merged with the labels derived from the orchestration system. Labels retain their source prefix.",
+          "$ref": "#/definitions/Labels"
+        },
+        "delete": {
+          "description": "Labels to delete from the endpoint",
+          "$ref": "#/definitions/Labels"
+        },
         "user": {
           "description": "Custom labels in addition to orchestration system labels.",
           "$ref": "#/definitions/Labels"
@@ -7954,6 +8729,83 @@ func init() {
         }
       }
     },
+    "PolicyDryRunFlow": {
+      "description": "Aggregated observed flow whose verdict would change under a candidate policy",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "Number of observed flows aggregated into this entry",
+          "type": "integer"
+        },
+        "destination-labels": {
+          "description": "Labels of the destination security identity",
+          "$ref": "#/definitions/Labels"
+        },
+        "destination-port": {
+          "description": "Destination port of the flow, 0 if not applicable",
+          "type": "integer"
+        },
+        "dry-run-verdict": {
+          "description": "Verdict the flow would receive under the candidate policy",
+          "type": "string"
+        },
+        "protocol": {
+          "description": "L4 protocol of the flow",
+          "type": "string"
+        },
+        "source-labels": {
+          "description": "Labels of the source security identity",
+          "$ref": "#/definitions/Labels"
+        },
+        "traffic-direction": {
+          "description": "Direction of the flow from the perspective of the local endpoint (ingress or egress)",
+          "type": "string"
+        },
+        "verdict": {
+          "description": "Verdict observed for the flow",
+          "type": "string"
+        }
+      }
+    },
+    "PolicyDryRunResult": {
+      "description": "Result of evaluating observed flows against a candidate policy",
+      "type": "object",
+      "properties": {
+        "changed": {
+          "description": "Aggregated flows whose verdict would change under the candidate policy",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PolicyDryRunFlow"
+          }
+        },
+        "flows-changed": {
+          "description": "Number of evaluated flows whose verdict would change",
+          "type": "integer"
+        },
+        "flows-evaluated": {
+          "description": "Number of flows that were evaluated",
+          "type": "integer"
+        },
+        "truncated": {
+          "description": "True if the set of changed flows was truncated to bound memory usage",
+          "type": "boolean"
+        }
+      }
+    },
+    "PolicyDryRunSpec": {
+      "description": "Candidate policy and evaluation bounds for a policy dry-run",
+      "type": "object",
+      "properties": {
+        "flows": {
+          "description": "Maximum number of recent flows to evaluate. Defaults to 1000 and is\nbounded by the size of the Hubble ring buffer.\n",
+          "type": "integer"
+        },
+        "policy": {
+          "description": "Candidate policy rules as JSON, in the same format as the policy import API",
+          "type": "string"
+        }
+      }
+    },
     "PolicyRule": {
       "description": "A policy rule including the rule labels it derives from\n\n+k8s:deepcopy-gen=true",
       "properties": {
@@ -8138,6 +8990,10 @@ func init() {
       "description": "n-tuple filter to match traffic to be recorded",
       "type": "object",
       "properties": {
+        "dst-identity": {
+          "description": "Destination security identity, zero for any",
+          "type": "integer"
+        },
         "dst-port": {
           "description": "Layer 4 destination port, zero (or in future range)",
           "type": "string"
@@ -8155,6 +9011,10 @@ func init() {
             "ANY"
           ]
         },
+        "src-identity": {
+          "description": "Source security identity, zero for any",
+          "type": "integer"
+        },
         "src-port": {
           "description": "Layer 4 source port, zero (or in future range)",
           "type": "string"
@@ -8178,6 +9038,10 @@ func init() {
       "description": "Configuration of a recorder mask",
       "type": "object",
       "properties": {
+        "dst-identity-mask": {
+          "description": "Destination security identity mask",
+          "type": "string"
+        },
         "dst-port-mask": {
           "description": "Layer 4 destination port mask",
           "type": "string"
@@ -8194,6 +9058,10 @@ func init() {
           "description": "Layer 4 protocol mask",
           "type": "string"
         },
+        "src-identity-mask": {
+          "description": "Source security identity mask",
+          "type": "string"
+        },
         "src-port-mask": {
           "description": "Layer 4 source port mask",
           "type": "string"
@@ -8538,6 +9406,10 @@ func init() {
           "description": "Status of Hubble server",
           "$ref": "#/definitions/HubbleStatus"
         },
+        "identity-migration": {
+          "description": "Status of the lazy identity migration after a labels filter change",
+          "$ref": "#/definitions/Status"
+        },
         "ipam": {
           "description": "Status of IP address management",
           "$ref": "#/definitions/IPAMStatus"
@@ -75,6 +75,9 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		ServiceDeleteServiceIDHandler: service.DeleteServiceIDHandlerFunc(func(params service.DeleteServiceIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.DeleteServiceID has not yet been implemented")
 		}),
+		ServiceDeleteServiceIDAffinityHandler: service.DeleteServiceIDAffinityHandlerFunc(func(params service.DeleteServiceIDAffinityParams) middleware.Responder {
+			return middleware.NotImplemented("operation service.DeleteServiceIDAffinity has not yet been implemented")
+		}),
 		DaemonGetClusterNodesHandler: daemon.GetClusterNodesHandlerFunc(func(params daemon.GetClusterNodesParams) middleware.Responder {
 			return middleware.NotImplemented("operation daemon.GetClusterNodes has not yet been implemented")
 		}),
@@ -165,6 +168,9 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		ServiceGetServiceIDHandler: service.GetServiceIDHandlerFunc(func(params service.GetServiceIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.GetServiceID has not yet been implemented")
 		}),
+		ServiceGetServiceIDConnectionsHandler: service.GetServiceIDConnectionsHandlerFunc(func(params service.GetServiceIDConnectionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation service.GetServiceIDConnections has not yet been implemented")
+		}),
 		DaemonPatchConfigHandler: daemon.PatchConfigHandlerFunc(func(params daemon.PatchConfigParams) middleware.Responder {
 			return middleware.NotImplemented("operation daemon.PatchConfig has not yet been implemented")
 		}),
@@ -186,6 +192,12 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		IpamPostIpamIPHandler: ipam.PostIpamIPHandlerFunc(func(params ipam.PostIpamIPParams) middleware.Responder {
 			return middleware.NotImplemented("operation ipam.PostIpamIP has not yet been implemented")
 		}),
+		PolicyPostPolicyDryRunHandler: policy.PostPolicyDryRunHandlerFunc(func(params policy.PostPolicyDryRunParams) middleware.Responder {
+			return middleware.NotImplemented("operation policy.PostPolicyDryRun has not yet been implemented")
+		}),
+		DaemonPostWireguardRotateKeyHandler: daemon.PostWireguardRotateKeyHandlerFunc(func(params daemon.PostWireguardRotateKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation daemon.PostWireguardRotateKey has not yet been implemented")
+		}),
 		EndpointPutEndpointIDHandler: endpoint.PutEndpointIDHandlerFunc(func(params endpoint.PutEndpointIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation endpoint.PutEndpointID has not yet been implemented")
 		}),
@@ -246,6 +258,8 @@ type CiliumAPIAPI struct {
 	RecorderDeleteRecorderIDHandler recorder.DeleteRecorderIDHandler
 	// ServiceDeleteServiceIDHandler sets the operation handler for the delete service ID operation
 	ServiceDeleteServiceIDHandler service.DeleteServiceIDHandler
+	// ServiceDeleteServiceIDAffinityHandler sets the operation handler for the delete service ID affinity operation
+	ServiceDeleteServiceIDAffinityHandler service.DeleteServiceIDAffinityHandler
 	// DaemonGetClusterNodesHandler sets the operation handler for the get cluster nodes operation
 	DaemonGetClusterNodesHandler daemon.GetClusterNodesHandler
 	// DaemonGetConfigHandler sets the operation handler for the get config operation
@@ -306,6 +320,8 @@ type CiliumAPIAPI struct {
 	ServiceGetServiceHandler service.GetServiceHandler
 	// ServiceGetServiceIDHandler sets the operation handler for the get service ID operation
 	ServiceGetServiceIDHandler service.GetServiceIDHandler
+	// ServiceGetServiceIDConnectionsHandler sets the operation handler for the get service ID connections operation
+	ServiceGetServiceIDConnectionsHandler service.GetServiceIDConnectionsHandler
 	// DaemonPatchConfigHandler sets the operation handler for the patch config operation
 	DaemonPatchConfigHandler daemon.PatchConfigHandler
 	// EndpointPatchEndpointIDHandler sets the operation handler for the patch endpoint ID operation
@@ -320,6 +336,10 @@ type CiliumAPIAPI struct {
 	IpamPostIpamHandler ipam.PostIpamHandler
 	// IpamPostIpamIPHandler sets the operation handler for the post ipam IP operation
 	IpamPostIpamIPHandler ipam.PostIpamIPHandler
+	// PolicyPostPolicyDryRunHandler sets the operation handler for the post policy dry run operation
+	PolicyPostPolicyDryRunHandler policy.PostPolicyDryRunHandler
+	// DaemonPostWireguardRotateKeyHandler sets the operation handler for the post wireguard rotate key operation
+	DaemonPostWireguardRotateKeyHandler daemon.PostWireguardRotateKeyHandler
 	// EndpointPutEndpointIDHandler sets the operation handler for the put endpoint ID operation
 	EndpointPutEndpointIDHandler endpoint.PutEndpointIDHandler
 	// PolicyPutPolicyHandler sets the operation handler for the put policy operation
@@ -425,6 +445,9 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.ServiceDeleteServiceIDHandler == nil {
 		unregistered = append(unregistered, "service.DeleteServiceIDHandler")
 	}
+	if o.ServiceDeleteServiceIDAffinityHandler == nil {
+		unregistered = append(unregistered, "service.DeleteServiceIDAffinityHandler")
+	}
 	if o.DaemonGetClusterNodesHandler == nil {
 		unregistered = append(unregistered, "daemon.GetClusterNodesHandler")
 	}
@@ -515,6 +538,9 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.ServiceGetServiceIDHandler == nil {
 		unregistered = append(unregistered, "service.GetServiceIDHandler")
 	}
+	if o.ServiceGetServiceIDConnectionsHandler == nil {
+		unregistered = append(unregistered, "service.GetServiceIDConnectionsHandler")
+	}
 	if o.DaemonPatchConfigHandler == nil {
 		unregistered = append(unregistered, "daemon.PatchConfigHandler")
 	}
@@ -536,6 +562,12 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.IpamPostIpamIPHandler == nil {
 		unregistered = append(unregistered, "ipam.PostIpamIPHandler")
 	}
+	if o.PolicyPostPolicyDryRunHandler == nil {
+		unregistered = append(unregistered, "policy.PostPolicyDryRunHandler")
+	}
+	if o.DaemonPostWireguardRotateKeyHandler == nil {
+		unregistered = append(unregistered, "daemon.PostWireguardRotateKeyHandler")
+	}
 	if o.EndpointPutEndpointIDHandler == nil {
 		unregistered = append(unregistered, "endpoint.PutEndpointIDHandler")
 	}
@@ -664,6 +696,10 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/service/{id}"] = service.NewDeleteServiceID(o.context, o.ServiceDeleteServiceIDHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/service/{id}/affinity"] = service.NewDeleteServiceIDAffinity(o.context, o.ServiceDeleteServiceIDAffinityHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -784,6 +820,10 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/service/{id}"] = service.NewGetServiceID(o.context, o.ServiceGetServiceIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/service/{id}/connections"] = service.NewGetServiceIDConnections(o.context, o.ServiceGetServiceIDConnectionsHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -812,6 +852,14 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/ipam/{ip}"] = ipam.NewPostIpamIP(o.context, o.IpamPostIpamIPHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policy/dry-run"] = policy.NewPostPolicyDryRun(o.context, o.PolicyPostPolicyDryRunHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/wireguard/rotate-key"] = daemon.NewPostWireguardRotateKey(o.context, o.DaemonPostWireguardRotateKeyHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
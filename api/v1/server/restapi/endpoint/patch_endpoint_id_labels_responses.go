@@ -24,6 +24,11 @@ const PatchEndpointIDLabelsOKCode int = 200
 swagger:response patchEndpointIdLabelsOK
 */
 type PatchEndpointIDLabelsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Identity `json:"body,omitempty"`
 }
 
 // NewPatchEndpointIDLabelsOK creates PatchEndpointIDLabelsOK with default headers values
@@ -32,12 +37,27 @@ func NewPatchEndpointIDLabelsOK() *PatchEndpointIDLabelsOK {
 	return &PatchEndpointIDLabelsOK{}
 }
 
+// WithPayload adds the payload to the patch endpoint Id labels o k response
+func (o *PatchEndpointIDLabelsOK) WithPayload(payload *models.Identity) *PatchEndpointIDLabelsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch endpoint Id labels o k response
+func (o *PatchEndpointIDLabelsOK) SetPayload(payload *models.Identity) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *PatchEndpointIDLabelsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
-
 	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
 
 // PatchEndpointIDLabelsNotFoundCode is the HTTP code returned for type PatchEndpointIDLabelsNotFound
@@ -1173,6 +1173,14 @@ int tail_ipv6_policy(struct __ctx_buff *ctx)
 	ctx_store_meta(ctx, CB_SRC_LABEL, 0);
 	ctx_store_meta(ctx, CB_FROM_HOST, 0);
 
+	ret = edt_sched_arrival(ctx, LXC_ID);
+	/* No send_drop_notify_error() here given we're rate-limiting. */
+	if (ret == CTX_ACT_DROP) {
+		update_metrics(ctx_full_len(ctx), METRIC_INGRESS,
+			       -DROP_EDT_HORIZON);
+		return ret;
+	}
+
 	ret = ipv6_policy(ctx, ifindex, src_label, &reason, &tuple,
 			  &proxy_port, from_host);
 	if (ret == POLICY_ACT_PROXY_REDIRECT) {
@@ -1180,8 +1188,11 @@ int tail_ipv6_policy(struct __ctx_buff *ctx)
 		proxy_redirect = true;
 	}
 	if (IS_ERR(ret))
-		return send_drop_notify(ctx, src_label, SECLABEL, LXC_ID,
-					ret, CTX_ACT_DROP, METRIC_INGRESS);
+		return send_drop_notify_ext(ctx, src_label, SECLABEL, LXC_ID,
+					    ret, drop_ext_subreason(ret),
+					    tuple.dport, tuple.nexthdr,
+					    POLICY_INGRESS, CTX_ACT_DROP,
+					    METRIC_INGRESS);
 
 	/* Store meta: essential for proxy ingress, see bpf_host.c */
 	ctx_store_meta(ctx, CB_PROXY_MAGIC, ctx->mark);
@@ -1261,8 +1272,10 @@ int tail_ipv6_to_endpoint(struct __ctx_buff *ctx)
 	}
 out:
 	if (IS_ERR(ret))
-		return send_drop_notify(ctx, src_identity, SECLABEL, LXC_ID,
-					ret, CTX_ACT_DROP, METRIC_INGRESS);
+		return send_drop_notify_ext(ctx, src_identity, SECLABEL, LXC_ID,
+					    ret, drop_ext_subreason(ret), 0, 0,
+					    POLICY_INGRESS, CTX_ACT_DROP,
+					    METRIC_INGRESS);
 
 #ifdef ENABLE_CUSTOM_CALLS
 	/* Make sure we skip the tail call when the packet is being redirected
@@ -1482,6 +1495,14 @@ int tail_ipv4_policy(struct __ctx_buff *ctx)
 	ctx_store_meta(ctx, CB_SRC_LABEL, 0);
 	ctx_store_meta(ctx, CB_FROM_HOST, 0);
 
+	ret = edt_sched_arrival(ctx, LXC_ID);
+	/* No send_drop_notify_error() here given we're rate-limiting. */
+	if (ret == CTX_ACT_DROP) {
+		update_metrics(ctx_full_len(ctx), METRIC_INGRESS,
+			       -DROP_EDT_HORIZON);
+		return ret;
+	}
+
 	ret = ipv4_policy(ctx, ifindex, src_label, &reason, &tuple,
 			  &proxy_port, from_host);
 	if (ret == POLICY_ACT_PROXY_REDIRECT) {
@@ -1489,8 +1510,11 @@ int tail_ipv4_policy(struct __ctx_buff *ctx)
 		proxy_redirect = true;
 	}
 	if (IS_ERR(ret))
-		return send_drop_notify(ctx, src_label, SECLABEL, LXC_ID,
-					ret, CTX_ACT_DROP, METRIC_INGRESS);
+		return send_drop_notify_ext(ctx, src_label, SECLABEL, LXC_ID,
+					    ret, drop_ext_subreason(ret),
+					    tuple.dport, tuple.nexthdr,
+					    POLICY_INGRESS, CTX_ACT_DROP,
+					    METRIC_INGRESS);
 
 	/* Store meta: essential for proxy ingress, see bpf_host.c */
 	ctx_store_meta(ctx, CB_PROXY_MAGIC, ctx->mark);
@@ -1569,8 +1593,10 @@ int tail_ipv4_to_endpoint(struct __ctx_buff *ctx)
 	}
 out:
 	if (IS_ERR(ret))
-		return send_drop_notify(ctx, src_identity, SECLABEL, LXC_ID,
-					ret, CTX_ACT_DROP, METRIC_INGRESS);
+		return send_drop_notify_ext(ctx, src_identity, SECLABEL, LXC_ID,
+					    ret, drop_ext_subreason(ret), 0, 0,
+					    POLICY_INGRESS, CTX_ACT_DROP,
+					    METRIC_INGRESS);
 
 #ifdef ENABLE_CUSTOM_CALLS
 	/* Make sure we skip the tail call when the packet is being redirected
@@ -1736,6 +1762,14 @@ int handle_to_container(struct __ctx_buff *ctx)
 
 	ctx_store_meta(ctx, CB_SRC_LABEL, identity);
 
+	ret = edt_sched_arrival(ctx, LXC_ID);
+	/* No send_drop_notify_error() here given we're rate-limiting. */
+	if (ret == CTX_ACT_DROP) {
+		update_metrics(ctx_full_len(ctx), METRIC_INGRESS,
+			       -DROP_EDT_HORIZON);
+		return ret;
+	}
+
 	switch (proto) {
 #if defined(ENABLE_ARP_PASSTHROUGH) || defined(ENABLE_ARP_RESPONDER)
 	case bpf_htons(ETH_P_ARP):
@@ -104,4 +104,10 @@ ctx_get_ifindex(const struct __sk_buff *ctx)
 	return ctx->ifindex;
 }
 
+static __always_inline __maybe_unused __u32
+ctx_get_mark(const struct __sk_buff *ctx)
+{
+	return ctx->mark;
+}
+
 #endif /* __BPF_CTX_SKB_H_ */
@@ -322,4 +322,11 @@ ctx_get_ifindex(const struct xdp_md *ctx)
 	return ctx->ingress_ifindex;
 }
 
+static __always_inline __maybe_unused __u32
+ctx_get_mark(const struct xdp_md *ctx __maybe_unused)
+{
+	/* XDP programs run before an skb is allocated, there is no mark. */
+	return 0;
+}
+
 #endif /* __BPF_CTX_XDP_H_ */
@@ -433,6 +433,17 @@ enum {
 
 #define NAT_PUNT_TO_STACK	DROP_NAT_NOT_NEEDED
 
+/* Extended drop sub-reasons, carried in drop notifications of version 1 and
+ * newer to refine DROP_POLICY* drops.
+ *
+ * These are shared with pkg/monitor/api/drop.go.
+ * When modifying any of the below, those files should also be updated.
+ */
+#define DROP_EXT_NONE		0
+#define DROP_EXT_MISSING_ALLOW	1
+#define DROP_EXT_DENY_RULE	2
+#define DROP_EXT_AUTH_REQUIRED	3
+
 /* Cilium metrics reasons for forwarding packets and other stats.
  * If reason is larger than below then this is a drop reason and
  * value corresponds to -(DROP_*), see above.
@@ -313,7 +313,8 @@ ipv6_extract_tuple(struct __ctx_buff *ctx, struct ipv6_ct_tuple *tuple,
 		return ret;
 
 	if (unlikely(tuple->nexthdr != IPPROTO_TCP &&
-		     tuple->nexthdr != IPPROTO_UDP))
+		     tuple->nexthdr != IPPROTO_UDP &&
+		     tuple->nexthdr != IPPROTO_SCTP))
 		return DROP_CT_UNKNOWN_PROTO;
 
 	if (ret < 0)
@@ -436,6 +437,7 @@ static __always_inline int ct_lookup6(const void *map,
 		break;
 
 	case IPPROTO_UDP:
+	case IPPROTO_SCTP:
 		/* load sport + dport into tuple */
 		if (ctx_load_bytes(ctx, l4_off, &tuple->dport, 4) < 0)
 			return DROP_CT_INVALID_HDR;
@@ -499,7 +501,8 @@ ipv4_extract_tuple(struct __ctx_buff *ctx, struct ipv4_ct_tuple *tuple,
 	tuple->nexthdr = ip4->protocol;
 
 	if (unlikely(tuple->nexthdr != IPPROTO_TCP &&
-		     tuple->nexthdr != IPPROTO_UDP))
+		     tuple->nexthdr != IPPROTO_UDP &&
+		     tuple->nexthdr != IPPROTO_SCTP))
 		return DROP_CT_UNKNOWN_PROTO;
 
 	tuple->daddr = ip4->daddr;
@@ -655,6 +658,7 @@ static __always_inline int ct_lookup4(const void *map,
 		break;
 
 	case IPPROTO_UDP:
+	case IPPROTO_SCTP:
 		err = ipv4_ct_extract_l4_ports(ctx, off, dir, tuple, NULL);
 		if (err < 0)
 			return err;
@@ -6,6 +6,9 @@
  *
  * API:
  * int send_drop_notify(ctx, src, dst, dst_id, reason, exitcode, __u8 direction)
+ * int send_drop_notify_ext(ctx, src, dst, dst_id, reason, subreason,
+ *			    __be16 dport, __u8 proto, __u8 dir, exitcode,
+ *			    __u8 direction)
  * int send_drop_notify_error(ctx, error, exitcode, __u8 direction)
  *
  * If DROP_NOTIFY is not defined, the API will be compiled in as a NOP.
@@ -14,46 +17,148 @@
 #ifndef __LIB_DROP__
 #define __LIB_DROP__
 
+#include <linux/ip.h>
+
 #include "dbg.h"
 #include "events.h"
 #include "common.h"
 #include "utils.h"
 #include "metrics.h"
 
+#define DROP_NOTIFY_VERSION 2
+
+/* drop_ext_subreason maps a drop reason to the extended sub-reason carried
+ * in the drop notification.
+ */
+static __always_inline __u8 drop_ext_subreason(int reason)
+{
+	switch (reason) {
+	case DROP_POLICY:
+		return DROP_EXT_MISSING_ALLOW;
+	case DROP_POLICY_DENY:
+		return DROP_EXT_DENY_RULE;
+	default:
+		return DROP_EXT_NONE;
+	}
+}
+
 #ifdef DROP_NOTIFY
 struct drop_notify {
 	NOTIFY_CAPTURE_HDR
 	__u32		src_label;
 	__u32		dst_label;
 	__u32		dst_id;
-	__u32		unused;
+	__u16		version;
+	__u8		subreason;
+	__u8		pad0;
+	/* Version 1 fields: the policy map key that was looked up for
+	 * DROP_POLICY* drops. Zero for other drop reasons.
+	 */
+	__u32		policy_id;
+	__u16		policy_dport;
+	__u8		policy_proto;
+	__u8		policy_dir:2,
+			pad1:6;
+	/* Version 2 fields: forwarding state of the packet at drop time. */
+	__u32		mark;
+	__u32		ifindex;
+	__u8		ttl;
+	__u8		pad2;
+	__u16		pad3;
 };
 
+/* drop_pkt_ttl returns the TTL or hop limit of the dropped packet, or zero
+ * if the packet carries no IP header.
+ */
+static __always_inline __u8 drop_pkt_ttl(struct __ctx_buff *ctx)
+{
+	void *data, *data_end;
+	struct iphdr *ip4;
+	struct ipv6hdr *ip6;
+	__u16 proto = 0;
+
+	if (!validate_ethertype(ctx, &proto))
+		return 0;
+
+	switch (proto) {
+	case bpf_htons(ETH_P_IP):
+		if (revalidate_data(ctx, &data, &data_end, &ip4))
+			return ip4->ttl;
+		break;
+	case bpf_htons(ETH_P_IPV6):
+		if (revalidate_data(ctx, &data, &data_end, &ip6))
+			return ip6->hop_limit;
+		break;
+	}
+
+	return 0;
+}
+
 __section_tail(CILIUM_MAP_CALLS, CILIUM_CALL_DROP_NOTIFY)
 int __send_drop_notify(struct __ctx_buff *ctx)
 {
-	/* Mask needed to calm verifier. */
-	int error = ctx_load_meta(ctx, 2) & 0xFFFFFFFF;
+	/* Masks needed to calm verifier. */
+	__u32 meta = ctx_load_meta(ctx, 2) & 0xFFFFFFFF;
+	__u32 ext = ctx_load_meta(ctx, 4) & 0xFFFFFFFF;
+	int error = meta & 0xFFFF;
+	__u8 dir = (ext >> 24) & 0x3;
 	__u64 ctx_len = ctx_full_len(ctx);
 	__u64 cap_len = min_t(__u64, TRACE_PAYLOAD_LEN, ctx_len);
 	struct drop_notify msg;
 
-	if (error < 0)
-		error = -error;
-
 	msg = (typeof(msg)) {
 		__notify_common_hdr(CILIUM_NOTIFY_DROP, error),
 		__notify_pktcap_hdr(ctx_len, cap_len),
 		.src_label	= ctx_load_meta(ctx, 0),
 		.dst_label	= ctx_load_meta(ctx, 1),
 		.dst_id		= ctx_load_meta(ctx, 3),
+		.version	= DROP_NOTIFY_VERSION,
+		.subreason	= (meta >> 16) & 0xFF,
+		.policy_dport	= ext & 0xFFFF,
+		.policy_proto	= (ext >> 16) & 0xFF,
+		.policy_dir	= dir,
+		.mark		= ctx_get_mark(ctx),
+		.ifindex	= ctx_get_ifindex(ctx),
+		.ttl		= drop_pkt_ttl(ctx),
 	};
 
+	/* The remote identity of the policy key is the already carried peer
+	 * label of the drop.
+	 */
+	if (dir == POLICY_INGRESS)
+		msg.policy_id = msg.src_label;
+	else if (dir == POLICY_EGRESS)
+		msg.policy_id = msg.dst_label;
+
 	ctx_event_output(ctx, &EVENTS_MAP,
 			 (cap_len << 32) | BPF_F_CURRENT_CPU,
 			 &msg, sizeof(msg));
 
-	return ctx_load_meta(ctx, 4);
+	return (meta >> 24) & 0xFF;
+}
+
+static __always_inline int
+_send_drop_notify(struct __ctx_buff *ctx, __u32 src, __u32 dst, __u32 dst_id,
+		  int reason, __u8 subreason, __be16 dport, __u8 proto,
+		  __u8 dir, int exitcode, __u8 direction)
+{
+	int error = reason < 0 ? -reason : reason;
+
+	ctx_store_meta(ctx, 0, src);
+	ctx_store_meta(ctx, 1, dst);
+	/* Drop reasons and kernel error codes fit into 16 bits, the exit
+	 * codes handed back to the kernel into 8 bits, leaving room to carry
+	 * the extended sub-reason alongside.
+	 */
+	ctx_store_meta(ctx, 2, (error & 0xFFFF) | subreason << 16 |
+			       (exitcode & 0xFF) << 24);
+	ctx_store_meta(ctx, 3, dst_id);
+	ctx_store_meta(ctx, 4, bpf_ntohs(dport) | proto << 16 | dir << 24);
+
+	update_metrics(ctx_full_len(ctx), direction, error);
+	ep_tail_call(ctx, CILIUM_CALL_DROP_NOTIFY);
+
+	return exitcode;
 }
 
 /**
@@ -73,16 +178,35 @@ static __always_inline int send_drop_notify(struct __ctx_buff *ctx, __u32 src,
 					    __u32 dst, __u32 dst_id, int reason,
 					    int exitcode, __u8 direction)
 {
-	ctx_store_meta(ctx, 0, src);
-	ctx_store_meta(ctx, 1, dst);
-	ctx_store_meta(ctx, 2, reason);
-	ctx_store_meta(ctx, 3, dst_id);
-	ctx_store_meta(ctx, 4, exitcode);
-
-	update_metrics(ctx_full_len(ctx), direction, -reason);
-	ep_tail_call(ctx, CILIUM_CALL_DROP_NOTIFY);
+	return _send_drop_notify(ctx, src, dst, dst_id, reason, DROP_EXT_NONE,
+				 0, 0, 0, exitcode, direction);
+}
 
-	return exitcode;
+/**
+ * send_drop_notify_ext
+ * @ctx:	socket buffer
+ * @src:	source identity
+ * @dst:	destination identity
+ * @dst_id:	designated destination endpoint ID
+ * @reason:	Reason for drop
+ * @subreason:	Extended DROP_EXT_* sub-reason for the drop
+ * @dport:	destination port of the policy key, in network byte order
+ * @proto:	L4 protocol of the policy key
+ * @dir:	direction of the policy lookup, POLICY_INGRESS or POLICY_EGRESS
+ * @exitcode:	error code to return to the kernel
+ *
+ * Generate a notification to indicate a packet was dropped, including the
+ * extended sub-reason and the policy map key that was looked up.
+ *
+ * NOTE: This is terminal function and will cause the BPF program to exit
+ */
+static __always_inline int
+send_drop_notify_ext(struct __ctx_buff *ctx, __u32 src, __u32 dst,
+		     __u32 dst_id, int reason, __u8 subreason, __be16 dport,
+		     __u8 proto, __u8 dir, int exitcode, __u8 direction)
+{
+	return _send_drop_notify(ctx, src, dst, dst_id, reason, subreason,
+				 dport, proto, dir, exitcode, direction);
 }
 #else
 static __always_inline
@@ -93,6 +217,17 @@ int send_drop_notify(struct __ctx_buff *ctx, __u32 src __maybe_unused,
 	update_metrics(ctx_full_len(ctx), direction, -reason);
 	return exitcode;
 }
+
+static __always_inline
+int send_drop_notify_ext(struct __ctx_buff *ctx, __u32 src __maybe_unused,
+			 __u32 dst __maybe_unused, __u32 dst_id __maybe_unused,
+			 int reason, __u8 subreason __maybe_unused,
+			 __be16 dport __maybe_unused, __u8 proto __maybe_unused,
+			 __u8 dir __maybe_unused, int exitcode, __u8 direction)
+{
+	update_metrics(ctx_full_len(ctx), direction, -reason);
+	return exitcode;
+}
 #endif /* DROP_NOTIFY */
 
 static __always_inline int send_drop_notify_error(struct __ctx_buff *ctx, __u32 src,
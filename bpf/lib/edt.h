@@ -10,6 +10,12 @@
 #include "time.h"
 #include "maps.h"
 
+/* Ingress aggregates are stored in the same map as egress ones. The id
+ * space is split by setting this bit for ingress entries, keep in sync
+ * with pkg/maps/bwmap.
+ */
+#define EDT_ID_INGRESS	(1ULL << 16)
+
 /* From XDP layer, we neither go through an egress hook nor qdisc
  * from here, hence nothing to be set.
  */
@@ -75,11 +81,48 @@ static __always_inline int edt_sched_departure(struct __ctx_buff *ctx)
 	ctx->tstamp = t_next;
 	return CTX_ACT_OK;
 }
+
+/* On delivery to the container there is no qdisc which could defer the
+ * packet based on ctx->tstamp, hence enforce the rate on the virtual
+ * clock alone: packets within the horizon pass and advance t_last, the
+ * rest is dropped.
+ */
+static __always_inline int edt_sched_arrival(struct __ctx_buff *ctx,
+					     __u32 aggregate)
+{
+	__u64 delay, now, t_next;
+	struct edt_id aggregate_id;
+	struct edt_info *info;
+
+	aggregate_id.id = aggregate | EDT_ID_INGRESS;
+	info = map_lookup_elem(&THROTTLE_MAP, &aggregate_id);
+	if (!info)
+		return CTX_ACT_OK;
+
+	now = ktime_get_ns();
+	delay = ((__u64)ctx_wire_len(ctx)) * NSEC_PER_SEC / info->bps;
+	t_next = READ_ONCE(info->t_last) + delay;
+	if (t_next <= now) {
+		WRITE_ONCE(info->t_last, now);
+		return CTX_ACT_OK;
+	}
+	if (t_next - now >= info->t_horizon_drop)
+		return CTX_ACT_DROP;
+	WRITE_ONCE(info->t_last, t_next);
+	return CTX_ACT_OK;
+}
 #else
 static __always_inline void
 edt_set_aggregate(struct __ctx_buff *ctx __maybe_unused,
 		  __u32 aggregate __maybe_unused)
 {
 }
+
+static __always_inline int
+edt_sched_arrival(struct __ctx_buff *ctx __maybe_unused,
+		  __u32 aggregate __maybe_unused)
+{
+	return CTX_ACT_OK;
+}
 #endif /* ENABLE_BANDWIDTH_MANAGER */
 #endif /* __EDT_H_ */
@@ -357,6 +357,7 @@ static __always_inline int extract_l4_port(struct __ctx_buff *ctx, __u8 nexthdr,
 	int ret;
 
 	switch (nexthdr) {
+	case IPPROTO_SCTP:
 	case IPPROTO_TCP:
 	case IPPROTO_UDP:
 #ifdef ENABLE_IPV4_FRAGMENTS
@@ -371,7 +372,7 @@ static __always_inline int extract_l4_port(struct __ctx_buff *ctx, __u8 nexthdr,
 			break;
 		}
 #endif
-		/* Port offsets for UDP and TCP are the same */
+		/* Port offsets for SCTP, UDP and TCP are the same */
 		ret = l4_load_port(ctx, l4_off + TCP_DPORT_OFF, port);
 		if (IS_ERR(ret))
 			return ret;
@@ -415,6 +416,24 @@ static __always_inline int reverse_map_l4_port(struct __ctx_buff *ctx, __u8 next
 		}
 		break;
 
+	case IPPROTO_SCTP:
+		/* The SCTP checksum is CRC32c over the full packet, which we
+		 * cannot incrementally fix up. Rewriting the port is only
+		 * possible when it does not change.
+		 */
+		if (port) {
+			__be16 old_port;
+			int ret;
+
+			ret = l4_load_port(ctx, l4_off + TCP_SPORT_OFF, &old_port);
+			if (IS_ERR(ret))
+				return ret;
+
+			if (port != old_port)
+				return DROP_CSUM_L4;
+		}
+		break;
+
 	case IPPROTO_ICMPV6:
 	case IPPROTO_ICMP:
 		return CTX_ACT_OK;
@@ -463,7 +482,8 @@ static __always_inline int __lb6_rev_nat(struct __ctx_buff *ctx, int l4_off,
 		return DROP_WRITE_ERROR;
 
 	sum = csum_diff(old_saddr.addr, 16, new_saddr, 16, 0);
-	if (csum_l4_replace(ctx, l4_off, csum_off, 0, sum, BPF_F_PSEUDO_HDR) < 0)
+	if (csum_off->offset &&
+	    csum_l4_replace(ctx, l4_off, csum_off, 0, sum, BPF_F_PSEUDO_HDR) < 0)
 		return DROP_CSUM_L4;
 
 	return 0;
@@ -516,8 +536,10 @@ static __always_inline int lb6_extract_key(struct __ctx_buff *ctx __maybe_unused
 					   int dir)
 {
 	union v6addr *addr;
-	/* FIXME(brb): set after adding support for different L4 protocols in LB */
-	key->proto = 0;
+	/* TCP and UDP services share the wildcard protocol 0 in the service
+	 * map, only SCTP services are keyed by their protocol.
+	 */
+	key->proto = tuple->nexthdr == IPPROTO_SCTP ? IPPROTO_SCTP : 0;
 	addr = (dir == CT_INGRESS) ? &tuple->saddr : &tuple->daddr;
 	ipv6_addr_copy(&key->address, addr);
 	csum_l4_offset_and_flags(tuple->nexthdr, csum_off);
@@ -667,12 +689,20 @@ static __always_inline int lb6_xlate(struct __ctx_buff *ctx,
 		__be32 sum = csum_diff(key->address.addr, 16, new_dst->addr,
 				       16, 0);
 
-		if (csum_l4_replace(ctx, l4_off, csum_off, 0, sum,
+		if (csum_off->offset &&
+		    csum_l4_replace(ctx, l4_off, csum_off, 0, sum,
 				    BPF_F_PSEUDO_HDR) < 0)
 			return DROP_CSUM_L4;
 	}
 
 l4_xlate:
+	/* The SCTP checksum is CRC32c over the full packet which we cannot
+	 * fix up incrementally, so port translation is not supported. The
+	 * backend must expose the frontend port.
+	 */
+	if (likely(backend->port) && key->dport != backend->port &&
+	    nexthdr == IPPROTO_SCTP)
+		return DROP_CSUM_L4;
 	if (likely(backend->port) && key->dport != backend->port &&
 	    (nexthdr == IPPROTO_TCP || nexthdr == IPPROTO_UDP)) {
 		__be16 tmp = backend->port;
@@ -1045,8 +1075,10 @@ static __always_inline int lb4_extract_key(struct __ctx_buff *ctx __maybe_unused
 					   struct csum_offset *csum_off,
 					   int dir)
 {
-	/* FIXME: set after adding support for different L4 protocols in LB */
-	key->proto = 0;
+	/* TCP and UDP services share the wildcard protocol 0 in the service
+	 * map, only SCTP services are keyed by their protocol.
+	 */
+	key->proto = ip4->protocol == IPPROTO_SCTP ? IPPROTO_SCTP : 0;
 	key->address = (dir == CT_INGRESS) ? ip4->saddr : ip4->daddr;
 	if (ipv4_has_l4_header(ip4))
 		csum_l4_offset_and_flags(ip4->protocol, csum_off);
@@ -1220,6 +1252,13 @@ lb4_xlate(struct __ctx_buff *ctx, __be32 *new_daddr, __be32 *new_saddr __maybe_u
 	}
 
 l4_xlate:
+	/* The SCTP checksum is CRC32c over the full packet which we cannot
+	 * fix up incrementally, so port translation is not supported. The
+	 * backend must expose the frontend port.
+	 */
+	if (likely(backend->port) && key->dport != backend->port &&
+	    nexthdr == IPPROTO_SCTP && has_l4_header)
+		return DROP_CSUM_L4;
 	if (likely(backend->port) && key->dport != backend->port &&
 	    (nexthdr == IPPROTO_TCP || nexthdr == IPPROTO_UDP) &&
 	    has_l4_header) {
@@ -346,6 +346,12 @@ static __always_inline int snat_v4_rewrite_egress(struct __ctx_buff *ctx,
 				if (ret < 0)
 					return ret;
 				break;
+			case IPPROTO_SCTP:
+				/* The SCTP checksum is CRC32c which cannot
+				 * be fixed up incrementally, so the source
+				 * port cannot be remapped.
+				 */
+				return DROP_CSUM_L4;
 			case IPPROTO_ICMP: {
 				__be32 from, to;
 
@@ -402,6 +408,12 @@ static __always_inline int snat_v4_rewrite_ingress(struct __ctx_buff *ctx,
 			if (ret < 0)
 				return ret;
 			break;
+		case IPPROTO_SCTP:
+			/* The SCTP checksum is CRC32c which cannot be fixed
+			 * up incrementally, so the destination port cannot
+			 * be remapped.
+			 */
+			return DROP_CSUM_L4;
 		case IPPROTO_ICMP: {
 			__be32 from, to;
 
@@ -478,14 +490,15 @@ static __always_inline __maybe_unused int snat_v4_create_dsr(struct __ctx_buff *
 	switch (tuple.nexthdr) {
 	case IPPROTO_TCP:
 	case IPPROTO_UDP:
+	case IPPROTO_SCTP:
 		if (ctx_load_bytes(ctx, off, &l4hdr, sizeof(l4hdr)) < 0)
 			return DROP_INVALID;
 		tuple.dport = l4hdr.sport;
 		tuple.sport = l4hdr.dport;
 		break;
 	default:
-		/* NodePort svc can be reached only via TCP or UDP, so
-		 * drop the rest.
+		/* NodePort svc can be reached only via TCP, UDP or SCTP,
+		 * so drop the rest.
 		 */
 		return DROP_NAT_UNSUPP_PROTO;
 	}
@@ -531,6 +544,7 @@ static __always_inline __maybe_unused int snat_v4_process(struct __ctx_buff *ctx
 	switch (tuple.nexthdr) {
 	case IPPROTO_TCP:
 	case IPPROTO_UDP:
+	case IPPROTO_SCTP:
 		if (ctx_load_bytes(ctx, off, &l4hdr, sizeof(l4hdr)) < 0)
 			return DROP_INVALID;
 		tuple.dport = l4hdr.dport;
@@ -827,6 +841,12 @@ static __always_inline int snat_v6_rewrite_egress(struct __ctx_buff *ctx,
 			if (ret < 0)
 				return ret;
 			break;
+		case IPPROTO_SCTP:
+			/* The SCTP checksum is CRC32c which cannot be fixed
+			 * up incrementally, so the source port cannot be
+			 * remapped.
+			 */
+			return DROP_CSUM_L4;
 		case IPPROTO_ICMPV6: {
 			__be32 from, to;
 
@@ -876,6 +896,12 @@ static __always_inline int snat_v6_rewrite_ingress(struct __ctx_buff *ctx,
 			if (ret < 0)
 				return ret;
 			break;
+		case IPPROTO_SCTP:
+			/* The SCTP checksum is CRC32c which cannot be fixed
+			 * up incrementally, so the destination port cannot
+			 * be remapped.
+			 */
+			return DROP_CSUM_L4;
 		case IPPROTO_ICMPV6: {
 			__be32 from, to;
 
@@ -949,14 +975,15 @@ static __always_inline __maybe_unused int snat_v6_create_dsr(struct __ctx_buff *
 	switch (tuple.nexthdr) {
 	case IPPROTO_TCP:
 	case IPPROTO_UDP:
+	case IPPROTO_SCTP:
 		if (ctx_load_bytes(ctx, off, &l4hdr, sizeof(l4hdr)) < 0)
 			return DROP_INVALID;
 		tuple.dport = l4hdr.sport;
 		tuple.sport = l4hdr.dport;
 		break;
 	default:
-		/* NodePort svc can be reached only via TCP or UDP, so
-		 * drop the rest.
+		/* NodePort svc can be reached only via TCP, UDP or SCTP,
+		 * so drop the rest.
 		 */
 		return DROP_NAT_UNSUPP_PROTO;
 	}
@@ -1007,6 +1034,7 @@ static __always_inline __maybe_unused int snat_v6_process(struct __ctx_buff *ctx
 	switch (tuple.nexthdr) {
 	case IPPROTO_TCP:
 	case IPPROTO_UDP:
+	case IPPROTO_SCTP:
 		if (ctx_load_bytes(ctx, off, &l4hdr, sizeof(l4hdr)) < 0)
 			return DROP_INVALID;
 		tuple.dport = l4hdr.dport;
@@ -8,6 +8,7 @@
 #include <bpf/api.h>
 
 #include "common.h"
+#include "eps.h"
 #include "lb.h"
 
 struct pcap_timeval {
@@ -119,19 +120,21 @@ struct capture_rule {
 	__u32 cap_len;
 };
 
-/* 5-tuple wildcard key / mask. */
+/* 5-tuple wildcard key / mask extended by identity predicates. */
 struct capture4_wcard {
-	__be32 saddr;   /* masking: prefix */
-	__be32 daddr;   /* masking: prefix */
-	__be16 sport;   /* masking: 0 or 0xffff */
-	__be16 dport;   /* masking: 0 or 0xffff */
-	__u8   nexthdr; /* masking: 0 or 0xff */
-	__u8   smask;   /* prefix len: saddr */
-	__u8   dmask;   /* prefix len: daddr */
-	__u8   flags;   /* reserved: 0 */
+	__be32 saddr;      /* masking: prefix */
+	__be32 daddr;      /* masking: prefix */
+	__be16 sport;      /* masking: 0 or 0xffff */
+	__be16 dport;      /* masking: 0 or 0xffff */
+	__u8   nexthdr;    /* masking: 0 or 0xff */
+	__u8   smask;      /* prefix len: saddr */
+	__u8   dmask;      /* prefix len: daddr */
+	__u8   flags;      /* reserved: 0 */
+	__u32  sec_id_src; /* masking: 0 or 0xffffffff */
+	__u32  sec_id_dst; /* masking: 0 or 0xffffffff */
 };
 
-/* 5-tuple wildcard key / mask. */
+/* 5-tuple wildcard key / mask extended by identity predicates. */
 struct capture6_wcard {
 	union v6addr saddr; /* masking: prefix */
 	union v6addr daddr; /* masking: prefix */
@@ -141,6 +144,8 @@ struct capture6_wcard {
 	__u8   smask;       /* prefix len: saddr */
 	__u8   dmask;       /* prefix len: daddr */
 	__u8   flags;       /* reserved: 0 */
+	__u32  sec_id_src;  /* masking: 0 or 0xffffffff */
+	__u32  sec_id_dst;  /* masking: 0 or 0xffffffff */
 };
 
 #if defined(ENABLE_IPV4) && defined(ENABLE_CAPTURE)
@@ -165,6 +170,8 @@ cilium_capture4_masked_key(const struct capture4_wcard *orig,
 	out->nexthdr = orig->nexthdr & mask->nexthdr;
 	out->dmask = mask->dmask;
 	out->smask = mask->smask;
+	out->sec_id_src = orig->sec_id_src & mask->sec_id_src;
+	out->sec_id_dst = orig->sec_id_dst & mask->sec_id_dst;
 }
 
 /* The agent is generating and emitting the PREFIX_MASKS4 and regenerating
@@ -217,6 +224,7 @@ cilium_capture4_classify_wcard(struct __ctx_buff *ctx)
 {
 	struct capture4_wcard prefix_masks[] = { PREFIX_MASKS4 };
 	struct capture4_wcard okey, lkey;
+	struct remote_endpoint_info *info;
 	struct capture_rule *match;
 	void *data, *data_end;
 	struct iphdr *ip4;
@@ -233,6 +241,11 @@ cilium_capture4_classify_wcard(struct __ctx_buff *ctx)
 	okey.smask = 32;
 	okey.nexthdr = ip4->protocol;
 
+	info = lookup_ip4_remote_endpoint(ip4->saddr);
+	okey.sec_id_src = info ? info->sec_label : 0;
+	info = lookup_ip4_remote_endpoint(ip4->daddr);
+	okey.sec_id_dst = info ? info->sec_label : 0;
+
 	if (ip4->protocol != IPPROTO_TCP &&
 	    ip4->protocol != IPPROTO_UDP)
 		return NULL;
@@ -279,6 +292,8 @@ cilium_capture6_masked_key(const struct capture6_wcard *orig,
 	out->nexthdr = orig->nexthdr & mask->nexthdr;
 	out->dmask = mask->dmask;
 	out->smask = mask->smask;
+	out->sec_id_src = orig->sec_id_src & mask->sec_id_src;
+	out->sec_id_dst = orig->sec_id_dst & mask->sec_id_dst;
 }
 
 /* The agent is generating and emitting the PREFIX_MASKS6 and regenerating
@@ -339,6 +354,7 @@ cilium_capture6_classify_wcard(struct __ctx_buff *ctx)
 {
 	struct capture6_wcard prefix_masks[] = { PREFIX_MASKS6 };
 	struct capture6_wcard okey, lkey;
+	struct remote_endpoint_info *info;
 	struct capture_rule *match;
 	void *data, *data_end;
 	struct ipv6hdr *ip6;
@@ -355,6 +371,11 @@ cilium_capture6_classify_wcard(struct __ctx_buff *ctx)
 	okey.smask = 128;
 	okey.nexthdr = ip6->nexthdr;
 
+	info = lookup_ip6_remote_endpoint(&okey.saddr);
+	okey.sec_id_src = info ? info->sec_label : 0;
+	info = lookup_ip6_remote_endpoint(&okey.daddr);
+	okey.sec_id_dst = info ? info->sec_label : 0;
+
 	ret = ipv6_hdrlen(ctx, l3_off, &okey.nexthdr);
 	if (ret < 0)
 		return NULL;
@@ -59,6 +59,7 @@ func Launch() (*CiliumHealth, error) {
 		Debug:         option.Config.Opts.IsEnabled(option.Debug),
 		ProbeInterval: serverProbeInterval,
 		ProbeDeadline: serverProbeDeadline,
+		ProbeTypes:    option.Config.HealthProbes,
 	}
 
 	ch.server, err = server.NewServer(config)
@@ -39,24 +39,26 @@ func cancelOnSignal(cancel context.CancelFunc, sig ...os.Signal) {
 func main() {
 	var (
 		pidfilePath string
-		listen      int
+		listen      []int
 	)
 	flag.StringVar(&pidfilePath, "pidfile", "", "Write pid to the specified file")
-	flag.IntVar(&listen, "listen", 4240, "Port on which the responder listens")
+	flag.IntSliceVar(&listen, "listen", []int{4240, 4242}, "Ports on which the responder listens")
 	flag.Parse()
 
 	// Shutdown gracefully to halt server and remove pidfile
 	ctx, cancel := context.WithCancel(context.Background())
 	cancelOnSignal(cancel, unix.SIGINT, unix.SIGHUP, unix.SIGTERM, unix.SIGQUIT)
 
-	srv := responder.NewServer(listen)
-	defer srv.Shutdown()
-	go func() {
-		if err := srv.Serve(); err != nil {
-			fmt.Fprintf(os.Stderr, "error while listening: %s\n", err.Error())
-			cancel()
-		}
-	}()
+	for _, port := range listen {
+		srv := responder.NewServer(port)
+		defer srv.Shutdown()
+		go func() {
+			if err := srv.Serve(); err != nil {
+				fmt.Fprintf(os.Stderr, "error while listening: %s\n", err.Error())
+				cancel()
+			}
+		}()
+	}
 
 	if pidfilePath != "" {
 		defer pidfile.Clean()
@@ -16,6 +16,7 @@ package cmd
 
 import (
 	"fmt"
+	"net"
 	"os"
 
 	"github.com/cilium/cilium/pkg/command"
@@ -28,12 +29,46 @@ import (
 const (
 	ipAddrTitle   = "IP PREFIX/ADDRESS"
 	identityTitle = "IDENTITY"
+
+	clusterIDFlag = "cluster-id"
 )
 
 var (
 	ipCacheListUsage = "List endpoint IPs (local and remote) and their corresponding security identities.\n" + lpmKernelVersionWarning("cilium_ipcache")
+
+	ipCacheListPrefix    string
+	ipCacheListIdentity  uint32
+	ipCacheListClusterID uint32
 )
 
+// ipCacheListFilter builds the dump filter from the list command flags.
+func ipCacheListFilter(cmd *cobra.Command) *ipcache.DumpFilter {
+	filter := &ipcache.DumpFilter{
+		Identity: ipCacheListIdentity,
+	}
+
+	if ipCacheListPrefix != "" {
+		_, prefix, err := net.ParseCIDR(ipCacheListPrefix)
+		if err != nil {
+			ip := net.ParseIP(ipCacheListPrefix)
+			if ip == nil {
+				Usagef(cmd, "Invalid prefix %q. Prefix must be in CIDR (10.0.0.0/8) or IP (192.168.1.1) form", ipCacheListPrefix)
+			}
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			prefix = &net.IPNet{IP: ip, Mask: net.CIDRMask(8*len(ip), 8*len(ip))}
+		}
+		filter.Prefix = prefix
+	}
+
+	if cmd.Flags().Changed(clusterIDFlag) {
+		filter.ClusterID = &ipCacheListClusterID
+	}
+
+	return filter
+}
+
 var bpfIPCacheListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
@@ -42,8 +77,13 @@ var bpfIPCacheListCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		common.RequireRootPrivilege("cilium bpf ipcache list")
 
+		filter := ipCacheListFilter(cmd)
+
 		bpfIPCacheList := make(map[string][]string)
-		if err := ipcache.IPCache.Dump(bpfIPCacheList); err != nil {
+		err := ipcache.IPCache.DumpEntriesWithFilter(filter, func(key *ipcache.Key, value *ipcache.RemoteEndpointInfo) {
+			bpfIPCacheList[key.String()] = append(bpfIPCacheList[key.String()], value.String())
+		})
+		if err != nil {
 			fmt.Fprintf(os.Stderr, "error dumping contents of map: %s\n", err)
 			os.Exit(1)
 		}
@@ -66,5 +106,8 @@ var bpfIPCacheListCmd = &cobra.Command{
 
 func init() {
 	bpfIPCacheCmd.AddCommand(bpfIPCacheListCmd)
+	bpfIPCacheListCmd.Flags().StringVar(&ipCacheListPrefix, "prefix", "", "Only list entries within the given prefix")
+	bpfIPCacheListCmd.Flags().Uint32Var(&ipCacheListIdentity, "identity", 0, "Only list entries with the given security identity")
+	bpfIPCacheListCmd.Flags().Uint32Var(&ipCacheListClusterID, clusterIDFlag, 0, "Only list entries of the cluster with the given ID")
 	command.AddJSONOutput(bpfIPCacheListCmd)
 }
@@ -0,0 +1,271 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	identityApi "github.com/cilium/cilium/api/v1/client/policy"
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/api"
+	"github.com/cilium/cilium/pkg/byteorder"
+	"github.com/cilium/cilium/pkg/command"
+	"github.com/cilium/cilium/pkg/common"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/maps/policymap"
+	"github.com/cilium/cilium/pkg/policy/trafficdirection"
+	"github.com/cilium/cilium/pkg/u8proto"
+
+	"github.com/spf13/cobra"
+)
+
+// Findings reported by `cilium bpf policy check`.
+const (
+	// findingUnknownIdentity is an entry whose identity no longer exists
+	// in the identity allocator.
+	findingUnknownIdentity = "unknown-identity"
+
+	// findingStaleProxyPort is an entry redirecting to a proxy port no
+	// proxy is configured for.
+	findingStaleProxyPort = "stale-proxy-port"
+
+	// findingMissingEntry is an identity the endpoint's policy allows or
+	// denies without a corresponding map entry.
+	findingMissingEntry = "missing-desired-entry"
+)
+
+var repairMap bool
+
+// bpfPolicyCheckCmd represents the bpf_policy_check command
+var bpfPolicyCheckCmd = &cobra.Command{
+	Use:    "check <endpoint id>",
+	Short:  "Cross-reference a policy BPF map against the agent and flag stale entries",
+	PreRun: requireEndpointID,
+	Run: func(cmd *cobra.Command, args []string) {
+		common.RequireRootPrivilege("cilium bpf policy check")
+		checkMap(args)
+	},
+}
+
+func init() {
+	bpfPolicyCmd.AddCommand(bpfPolicyCheckCmd)
+	bpfPolicyCheckCmd.Flags().BoolVar(&repairMap, "repair", false, "Delete entries whose identity no longer exists")
+	command.AddJSONOutput(bpfPolicyCheckCmd)
+}
+
+// policyCheckFinding describes a single inconsistency between a policy map
+// entry and the agent's view of the world.
+type policyCheckFinding struct {
+	Finding          string `json:"finding"`
+	TrafficDirection string `json:"trafficDirection"`
+	Identity         uint32 `json:"identity"`
+	PortProto        string `json:"portProto"`
+	Detail           string `json:"detail"`
+	Repaired         bool   `json:"repaired,omitempty"`
+
+	// entry is the map entry the finding refers to, nil for missing
+	// desired entries
+	entry *policymap.PolicyEntryDump
+}
+
+// identityExists reports whether the given identity is known to the identity
+// allocator of the agent. An error is returned when the agent cannot answer
+// the question, e.g. because it is unreachable.
+func identityExists(id identity.NumericIdentity) (bool, error) {
+	params := identityApi.NewGetIdentityIDParams().WithID(id.StringID()).WithTimeout(api.ClientTimeout)
+	if _, err := client.Policy.GetIdentityID(params); err != nil {
+		notFound := &identityApi.GetIdentityIDNotFound{}
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// entryPortProto returns the PORT/PROTO representation of a map entry.
+func entryPortProto(key policymap.PolicyKey) string {
+	if key.DestPort == 0 {
+		return models.PortProtocolANY
+	}
+	dport := byteorder.NetworkToHost16(key.DestPort)
+	proto := u8proto.U8proto(key.Nexthdr)
+	return fmt.Sprintf("%d/%s", dport, proto.String())
+}
+
+// checkEntries validates each map entry against the identity allocator and
+// the configured proxy redirects.
+func checkEntries(statsMap []policymap.PolicyEntryDump, proxyPortNames map[uint16]string) []policyCheckFinding {
+	var findings []policyCheckFinding
+	identityKnown := map[identity.NumericIdentity]bool{}
+	for i := range statsMap {
+		entry := &statsMap[i]
+		id := identity.NumericIdentity(entry.Key.Identity)
+		if id != identity.IdentityUnknown && !id.IsReservedIdentity() {
+			known, ok := identityKnown[id]
+			if !ok {
+				var err error
+				known, err = identityExists(id)
+				if err != nil {
+					Fatalf("Unable to query identity %d from the agent: %s\n", id, err)
+				}
+				identityKnown[id] = known
+			}
+			if !known {
+				findings = append(findings, policyCheckFinding{
+					Finding:          findingUnknownIdentity,
+					TrafficDirection: trafficdirection.TrafficDirection(entry.Key.TrafficDirection).String(),
+					Identity:         entry.Key.Identity,
+					PortProto:        entryPortProto(entry.Key),
+					Detail:           "identity no longer exists in the identity allocator",
+					entry:            entry,
+				})
+				continue
+			}
+		}
+		if entry.ProxyPort != 0 && proxyPortNames != nil {
+			proxyPort := byteorder.NetworkToHost16(entry.ProxyPort)
+			if _, ok := proxyPortNames[proxyPort]; !ok {
+				findings = append(findings, policyCheckFinding{
+					Finding:          findingStaleProxyPort,
+					TrafficDirection: trafficdirection.TrafficDirection(entry.Key.TrafficDirection).String(),
+					Identity:         entry.Key.Identity,
+					PortProto:        entryPortProto(entry.Key),
+					Detail:           fmt.Sprintf("no proxy is listening on redirect port %d", proxyPort),
+					entry:            entry,
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// checkDesired flags identities the endpoint's desired policy allows or
+// denies for which the map holds no entry. An entry wildcarding the peer
+// identity covers all identities of its direction.
+func checkDesired(statsMap []policymap.PolicyEntryDump, desired *models.EndpointPolicy) []policyCheckFinding {
+	var findings []policyCheckFinding
+	present := map[trafficdirection.TrafficDirection]map[uint32]struct{}{
+		trafficdirection.Ingress: {},
+		trafficdirection.Egress:  {},
+	}
+	for _, entry := range statsMap {
+		direction := trafficdirection.TrafficDirection(entry.Key.TrafficDirection)
+		if ids, ok := present[direction]; ok {
+			ids[entry.Key.Identity] = struct{}{}
+		}
+	}
+
+	covered := func(direction trafficdirection.TrafficDirection, id uint32) bool {
+		ids := present[direction]
+		if _, ok := ids[uint32(identity.IdentityUnknown)]; ok {
+			return true
+		}
+		_, ok := ids[id]
+		return ok
+	}
+
+	desiredIdentities := []struct {
+		direction  trafficdirection.TrafficDirection
+		identities []int64
+	}{
+		{trafficdirection.Ingress, desired.AllowedIngressIdentities},
+		{trafficdirection.Egress, desired.AllowedEgressIdentities},
+		{trafficdirection.Ingress, desired.DeniedIngressIdentities},
+		{trafficdirection.Egress, desired.DeniedEgressIdentities},
+	}
+	for _, d := range desiredIdentities {
+		for _, id := range d.identities {
+			if !covered(d.direction, uint32(id)) {
+				findings = append(findings, policyCheckFinding{
+					Finding:          findingMissingEntry,
+					TrafficDirection: d.direction.String(),
+					Identity:         uint32(id),
+					PortProto:        models.PortProtocolANY,
+					Detail:           "identity in the endpoint's desired policy has no map entry",
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func checkMap(args []string) {
+	lbl := args[0]
+
+	mapPath, err := endpointToPolicyMapPath(lbl)
+	if err != nil {
+		Fatalf("Failed to parse endpointID %q", lbl)
+	}
+	m, err := policymap.Open(mapPath)
+	if err != nil {
+		Fatalf("Failed to open map: %s\n", err)
+	}
+	defer m.Close()
+
+	statsMap, err := m.DumpToSlice()
+	if err != nil {
+		Fatalf("Error while dumping BPF Map: %s\n", err)
+	}
+
+	ep, err := client.EndpointGet(lbl)
+	if err != nil {
+		Fatalf("Unable to retrieve endpoint %s from the agent: %s\n", lbl, err)
+	}
+
+	findings := checkEntries(statsMap, getProxyPortNames())
+	if ep.Status != nil && ep.Status.Policy != nil && ep.Status.Policy.Spec != nil {
+		findings = append(findings, checkDesired(statsMap, ep.Status.Policy.Spec)...)
+	} else {
+		fmt.Fprintf(os.Stderr, "Desired policy of endpoint %s not available, skipping missing entry detection\n", lbl)
+	}
+
+	if repairMap {
+		for i := range findings {
+			f := &findings[i]
+			if f.Finding != findingUnknownIdentity || f.entry == nil {
+				continue
+			}
+			if err := m.DeleteEntry(f.entry); err != nil {
+				fmt.Fprintf(os.Stderr, "Unable to delete entry for identity %d: %s\n", f.Identity, err)
+			} else {
+				f.Repaired = true
+			}
+		}
+	}
+
+	if command.OutputJSON() {
+		if err := command.PrintOutput(findings); err != nil {
+			os.Exit(1)
+		}
+	} else if len(findings) == 0 {
+		fmt.Printf("No inconsistencies found in policy map of endpoint %s\n", lbl)
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+		fmt.Fprintf(w, "FINDING\tDIRECTION\tIDENTITY\tPORT/PROTO\tREPAIRED\tDETAIL\t\n")
+		for _, f := range findings {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%t\t%s\t\n",
+				f.Finding, f.TrafficDirection, f.Identity, f.PortProto, f.Repaired, f.Detail)
+		}
+		w.Flush()
+	}
+
+	if len(findings) > 0 {
+		os.Exit(1)
+	}
+}
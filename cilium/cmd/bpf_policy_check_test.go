@@ -0,0 +1,67 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package cmd
+
+import (
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/maps/policymap"
+	"github.com/cilium/cilium/pkg/policy/trafficdirection"
+
+	. "gopkg.in/check.v1"
+)
+
+type BPFPolicyCheckSuite struct{}
+
+var _ = Suite(&BPFPolicyCheckSuite{})
+
+func (s *BPFPolicyCheckSuite) TestCheckDesired(c *C) {
+	statsMap := []policymap.PolicyEntryDump{
+		{Key: policymap.PolicyKey{Identity: 1000, TrafficDirection: uint8(trafficdirection.Ingress)}},
+		// An entry wildcarding the peer identity on egress.
+		{Key: policymap.PolicyKey{Identity: 0, TrafficDirection: uint8(trafficdirection.Egress)}},
+	}
+	desired := &models.EndpointPolicy{
+		AllowedIngressIdentities: []int64{1000, 2000},
+		AllowedEgressIdentities:  []int64{3000},
+		DeniedIngressIdentities:  []int64{4000},
+	}
+
+	findings := checkDesired(statsMap, desired)
+
+	// 2000 and 4000 have no ingress entry; 3000 is covered by the egress
+	// wildcard.
+	c.Assert(findings, HasLen, 2)
+	for _, f := range findings {
+		c.Assert(f.Finding, Equals, findingMissingEntry)
+		c.Assert(f.TrafficDirection, Equals, trafficdirection.Ingress.String())
+	}
+	c.Assert(findings[0].Identity, Equals, uint32(2000))
+	c.Assert(findings[1].Identity, Equals, uint32(4000))
+}
+
+func (s *BPFPolicyCheckSuite) TestCheckDesiredComplete(c *C) {
+	statsMap := []policymap.PolicyEntryDump{
+		{Key: policymap.PolicyKey{Identity: 1000, TrafficDirection: uint8(trafficdirection.Ingress)}},
+		{Key: policymap.PolicyKey{Identity: 1000, TrafficDirection: uint8(trafficdirection.Egress)}},
+	}
+	desired := &models.EndpointPolicy{
+		AllowedIngressIdentities: []int64{1000},
+		AllowedEgressIdentities:  []int64{1000},
+	}
+
+	c.Assert(checkDesired(statsMap, desired), HasLen, 0)
+}
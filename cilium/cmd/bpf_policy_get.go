@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strconv"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/cilium/cilium/api/v1/models"
@@ -38,8 +39,9 @@ import (
 )
 
 var (
-	printIDs bool
-	allList  bool
+	printIDs       bool
+	allList        bool
+	showProvenance bool
 )
 
 // bpfPolicyListCmd represents the bpf_policy_list command
@@ -61,6 +63,7 @@ func init() {
 	bpfPolicyCmd.AddCommand(bpfPolicyListCmd)
 	bpfPolicyListCmd.Flags().BoolVarP(&printIDs, "numeric", "n", false, "Do not resolve IDs")
 	bpfPolicyListCmd.Flags().BoolVarP(&allList, "all", "", false, "Dump all policy maps")
+	bpfPolicyListCmd.Flags().BoolVarP(&showProvenance, "verbose", "", false, "Also print the rule labels each entry was derived from (requires the agent to run with --enable-policy-map-provenance)")
 	command.AddJSONOutput(bpfPolicyListCmd)
 }
 
@@ -76,7 +79,7 @@ func listAllMaps() {
 	for _, file := range matchFiles {
 		fmt.Printf("%s:\n", file)
 		fmt.Println()
-		dumpMap(file)
+		dumpMap(file, nil)
 		fmt.Println()
 		fmt.Println()
 	}
@@ -89,10 +92,125 @@ func listMap(args []string) {
 	if err != nil {
 		Fatalf("Failed to parse endpointID %q", lbl)
 	}
-	dumpMap(mapPath)
+	dumpMap(mapPath, getEntryProvenance(lbl))
 }
 
-func dumpMap(file string) {
+// getEntryProvenance retrieves from the agent the rule labels each of the
+// endpoint's policy map entries was derived from, keyed by policy map key.
+// Nil is returned when provenance is not requested or not available, e.g.
+// when the agent does not track it.
+func getEntryProvenance(endpointID string) map[string][][]string {
+	if !showProvenance {
+		return nil
+	}
+	ep, err := client.EndpointGet(endpointID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to retrieve endpoint %s from the agent: %s\n", endpointID, err)
+		return nil
+	}
+	if ep.Status == nil || ep.Status.Policy == nil || ep.Status.Policy.Realized == nil {
+		return nil
+	}
+	if len(ep.Status.Policy.Realized.PolicyMapProvenance) == 0 {
+		fmt.Fprintf(os.Stderr, "No provenance available, the agent may not be running with --enable-policy-map-provenance\n")
+		return nil
+	}
+	provenance := map[string][][]string{}
+	for _, entry := range ep.Status.Policy.Realized.PolicyMapProvenance {
+		key := provenanceKey(uint32(entry.Identity), uint16(entry.DestPort), uint8(entry.Protocol), uint8(entry.TrafficDirection))
+		provenance[key] = entry.DerivedFromRules
+	}
+	return provenance
+}
+
+// provenanceKey returns the lookup key used to match a BPF policy map entry
+// with its provenance. The port is in host byte order.
+func provenanceKey(id uint32, dport uint16, proto uint8, trafficDirection uint8) string {
+	return fmt.Sprintf("%d %d %d %d", id, dport, proto, trafficDirection)
+}
+
+// policyEntryResolved pairs a raw policy map entry with the fields decoded
+// with the help of the agent, so that JSON consumers get both
+// representations.
+type policyEntryResolved struct {
+	policymap.PolicyEntryDump
+	Policy           string   `json:"policy"`
+	TrafficDirection string   `json:"trafficDirection"`
+	PortProto        string   `json:"portProto"`
+	ProxyPortName    string   `json:"proxyPortName,omitempty"`
+	Labels           []string `json:"labels,omitempty"`
+}
+
+// resolveIdentityLabels retrieves the security labels of all identities
+// referenced by the given entries from the agent.
+func resolveIdentityLabels(statsMap []policymap.PolicyEntryDump) map[identity.NumericIdentity]*identity.Identity {
+	labelsID := map[identity.NumericIdentity]*identity.Identity{}
+	for _, stat := range statsMap {
+		id := identity.NumericIdentity(stat.Key.Identity)
+		if id == identity.IdentityUnknown {
+			// An identity of 0 in the key wildcards the peer
+			// identity, there is nothing to resolve.
+			continue
+		}
+		if _, ok := labelsID[id]; ok {
+			continue
+		}
+		if lbls, err := client.IdentityGet(id.StringID()); err != nil {
+			fmt.Fprintf(os.Stderr, "Was impossible to retrieve label ID %d: %s\n",
+				id, err)
+		} else {
+			labelsID[id] = identitymodel.NewIdentityFromModel(lbls)
+		}
+	}
+	return labelsID
+}
+
+// getProxyPortNames retrieves the configured proxy redirects from the agent
+// and returns the proxy name serving each proxy port. Nil is returned when
+// the agent cannot be reached.
+func getProxyPortNames() map[uint16]string {
+	resp, err := client.Daemon.GetHealthz(nil)
+	if err != nil || resp.Payload == nil || resp.Payload.Proxy == nil {
+		return nil
+	}
+	portNames := map[uint16]string{}
+	for _, redirect := range resp.Payload.Proxy.Redirects {
+		portNames[uint16(redirect.ProxyPort)] = redirect.Proxy
+	}
+	return portNames
+}
+
+func resolveEntries(statsMap []policymap.PolicyEntryDump,
+	labelsID map[identity.NumericIdentity]*identity.Identity,
+	proxyPortNames map[uint16]string) []policyEntryResolved {
+	resolved := make([]policyEntryResolved, 0, len(statsMap))
+	for _, stat := range statsMap {
+		id := identity.NumericIdentity(stat.Key.Identity)
+		entry := policyEntryResolved{
+			PolicyEntryDump:  stat,
+			Policy:           policymap.PolicyEntryFlags(stat.Flags).String(),
+			TrafficDirection: trafficdirection.TrafficDirection(stat.Key.TrafficDirection).String(),
+			PortProto:        models.PortProtocolANY,
+		}
+		if stat.Key.DestPort != 0 {
+			dport := byteorder.NetworkToHost16(stat.Key.DestPort)
+			proto := u8proto.U8proto(stat.Key.Nexthdr)
+			entry.PortProto = fmt.Sprintf("%d/%s", dport, proto.String())
+		}
+		if stat.ProxyPort != 0 {
+			entry.ProxyPortName = proxyPortNames[byteorder.NetworkToHost16(stat.ProxyPort)]
+		}
+		if id == identity.IdentityUnknown {
+			entry.Labels = []string{"ANY"}
+		} else if lbls := labelsID[id]; lbls != nil {
+			entry.Labels = lbls.Labels.GetPrintableModel()
+		}
+		resolved = append(resolved, entry)
+	}
+	return resolved
+}
+
+func dumpMap(file string, provenance map[string][][]string) {
 	m, err := policymap.Open(file)
 	if err != nil {
 		Fatalf("Failed to open map: %s\n", err)
@@ -105,13 +223,26 @@ func dumpMap(file string) {
 	}
 	sort.Slice(statsMap, statsMap.Less)
 
+	var labelsID map[identity.NumericIdentity]*identity.Identity
+	var proxyPortNames map[uint16]string
+	if !printIDs {
+		labelsID = resolveIdentityLabels(statsMap)
+		proxyPortNames = getProxyPortNames()
+	}
+
 	if command.OutputJSON() {
-		if err := command.PrintOutput(statsMap); err != nil {
+		var err error
+		if printIDs {
+			err = command.PrintOutput(statsMap)
+		} else {
+			err = command.PrintOutput(resolveEntries(statsMap, labelsID, proxyPortNames))
+		}
+		if err != nil {
 			os.Exit(1)
 		}
 	} else {
 		w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
-		formatMap(w, statsMap)
+		formatMap(w, statsMap, provenance, labelsID, proxyPortNames)
 		w.Flush()
 		if len(statsMap) == 0 {
 			fmt.Printf("Policy stats empty. Perhaps the policy enforcement is disabled?\n")
@@ -120,7 +251,8 @@ func dumpMap(file string) {
 
 }
 
-func formatMap(w io.Writer, statsMap []policymap.PolicyEntryDump) {
+func formatMap(w io.Writer, statsMap []policymap.PolicyEntryDump, provenance map[string][][]string,
+	labelsID map[identity.NumericIdentity]*identity.Identity, proxyPortNames map[uint16]string) {
 	const (
 		policyTitle           = "POLICY"
 		trafficDirectionTitle = "DIRECTION"
@@ -132,20 +264,6 @@ func formatMap(w io.Writer, statsMap []policymap.PolicyEntryDump) {
 		packetsTitle          = "PACKETS"
 	)
 
-	labelsID := map[identity.NumericIdentity]*identity.Identity{}
-	for _, stat := range statsMap {
-		if !printIDs {
-			id := identity.NumericIdentity(stat.Key.Identity)
-			if lbls, err := client.IdentityGet(id.StringID()); err != nil {
-				fmt.Fprintf(os.Stderr, "Was impossible to retrieve label ID %d: %s\n",
-					id, err)
-			} else {
-				labelsID[id] = identitymodel.NewIdentityFromModel(lbls)
-			}
-		}
-
-	}
-
 	if printIDs {
 		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
 			policyTitle, trafficDirectionTitle, labelsIDTitle, portTitle, proxyPortTitle, bytesTitle, packetsTitle)
@@ -158,14 +276,18 @@ func formatMap(w io.Writer, statsMap []policymap.PolicyEntryDump) {
 		trafficDirection := trafficdirection.TrafficDirection(stat.Key.TrafficDirection)
 		trafficDirectionString := trafficDirection.String()
 		port := models.PortProtocolANY
+		var dport uint16
 		if stat.Key.DestPort != 0 {
-			dport := byteorder.NetworkToHost16(stat.Key.DestPort)
+			dport = byteorder.NetworkToHost16(stat.Key.DestPort)
 			proto := u8proto.U8proto(stat.Key.Nexthdr)
 			port = fmt.Sprintf("%d/%s", dport, proto.String())
 		}
 		proxyPort := "NONE"
 		if stat.ProxyPort != 0 {
 			proxyPort = strconv.FormatUint(uint64(byteorder.NetworkToHost16(stat.ProxyPort)), 10)
+			if name := proxyPortNames[byteorder.NetworkToHost16(stat.ProxyPort)]; name != "" {
+				proxyPort = fmt.Sprintf("%s (%s)", proxyPort, name)
+			}
 		}
 		var policyStr string
 		if policymap.PolicyEntryFlags(stat.Flags).IsDeny() {
@@ -176,6 +298,10 @@ func formatMap(w io.Writer, statsMap []policymap.PolicyEntryDump) {
 		if printIDs {
 			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%d\t%d\t\n",
 				policyStr, trafficDirectionString, id, port, proxyPort, stat.Bytes, stat.Packets)
+		} else if id == identity.IdentityUnknown {
+			// An identity of 0 in the key wildcards the peer identity.
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t\n",
+				policyStr, trafficDirectionString, "ANY", port, proxyPort, stat.Bytes, stat.Packets)
 		} else if lbls := labelsID[id]; lbls != nil && len(lbls.Labels) > 0 {
 			first := true
 			for _, lbl := range lbls.Labels.GetPrintableModel() {
@@ -191,5 +317,8 @@ func formatMap(w io.Writer, statsMap []policymap.PolicyEntryDump) {
 			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%d\t%d\t\n",
 				policyStr, trafficDirectionString, id, port, proxyPort, stat.Bytes, stat.Packets)
 		}
+		for _, ruleLabels := range provenance[provenanceKey(uint32(stat.Key.Identity), dport, uint8(stat.Key.Nexthdr), uint8(stat.Key.TrafficDirection))] {
+			fmt.Fprintf(w, "\t\tderived-from %s\t\t\t\t\t\t\n", strings.Join(ruleLabels, ","))
+		}
 	}
 }
@@ -0,0 +1,116 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/client/daemon"
+	"github.com/cilium/cilium/api/v1/models"
+	pkg "github.com/cilium/cilium/pkg/client"
+	"github.com/cilium/cilium/pkg/command"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	clustermeshStatusVerbose bool
+	clustermeshStatusTimeout time.Duration
+)
+
+// clustermeshStatusCmd represents the clustermesh status command
+var clustermeshStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Display per-cluster status of the ClusterMesh",
+	Run: func(cmd *cobra.Command, args []string) {
+		clustermeshStatus()
+	},
+}
+
+func init() {
+	clustermeshCmd.AddCommand(clustermeshStatusCmd)
+	clustermeshStatusCmd.Flags().BoolVar(&clustermeshStatusVerbose, "verbose", false,
+		"Show the synchronization status of the individual resource types per cluster")
+	clustermeshStatusCmd.Flags().DurationVar(&clustermeshStatusTimeout, "timeout", 30*time.Second,
+		"Sets the timeout to use when querying for the status")
+	command.AddJSONOutput(clustermeshStatusCmd)
+}
+
+func formatTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	return fmt.Sprintf("%s ago", time.Since(t).Round(time.Second))
+}
+
+func clustermeshStatus() {
+	params := daemon.NewGetHealthzParamsWithTimeout(clustermeshStatusTimeout)
+	resp, err := client.Daemon.GetHealthz(params)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", pkg.Hint(err))
+		os.Exit(1)
+	}
+
+	sr := resp.Payload
+	if sr.ClusterMesh == nil {
+		fmt.Println("ClusterMesh is disabled")
+		return
+	}
+
+	if command.OutputJSON() {
+		if err := command.PrintOutput(sr.ClusterMesh); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
+	readiness := func(cluster *models.RemoteCluster) string {
+		if cluster.Ready {
+			return "ready"
+		}
+		return "not-ready"
+	}
+
+	numReady := 0
+	for _, cluster := range sr.ClusterMesh.Clusters {
+		if cluster.Ready {
+			numReady++
+		}
+	}
+
+	fmt.Printf("ClusterMesh:\t%d/%d clusters ready, %d global-services\n",
+		numReady, len(sr.ClusterMesh.Clusters), sr.ClusterMesh.NumGlobalServices)
+
+	for _, cluster := range sr.ClusterMesh.Clusters {
+		fmt.Printf("%s: %s, %d nodes, %d identities, %d services, %d failures (last: %s)\n",
+			cluster.Name, readiness(cluster), cluster.NumNodes,
+			cluster.NumIdentities, cluster.NumSharedServices,
+			cluster.NumFailures, formatTimestamp(time.Time(cluster.LastFailure)))
+		fmt.Printf("└  %s\n", cluster.Status)
+		if !clustermeshStatusVerbose {
+			continue
+		}
+		for _, sync := range cluster.Sync {
+			line := fmt.Sprintf("└  %s: last successful sync %s",
+				sync.Resource, formatTimestamp(time.Time(sync.LastSuccess)))
+			if sync.Error != "" {
+				line += fmt.Sprintf(", error: %s", sync.Error)
+			}
+			fmt.Println(line)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/cilium/cilium/api/v1/models"
+	pkg "github.com/cilium/cilium/pkg/client"
+	"github.com/cilium/cilium/pkg/command"
+
+	"github.com/spf13/cobra"
+)
+
+var encryptStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Display the current encryption state",
+	Run: func(cmd *cobra.Command, args []string) {
+		resp, err := client.Daemon.GetHealthz(nil)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s\n", pkg.Hint(err))
+			os.Exit(1)
+		}
+
+		encryption := resp.Payload.Encryption
+		if encryption == nil {
+			fmt.Println("Encryption status not available")
+			return
+		}
+
+		if command.OutputJSON() {
+			if err := command.PrintOutput(encryption); err != nil {
+				os.Exit(1)
+			}
+			return
+		}
+
+		fmt.Printf("Encryption: %s\n", encryption.Mode)
+		if encryption.Msg != "" {
+			fmt.Printf("Message: %s\n", encryption.Msg)
+		}
+		if encryption.Ipsec != nil {
+			formatIPsecStatus(os.Stdout, encryption.Ipsec)
+		}
+	},
+}
+
+func init() {
+	encryptCmd.AddCommand(encryptStatusCmd)
+	command.AddJSONOutput(encryptStatusCmd)
+}
+
+func formatIPsecStatus(w io.Writer, status *models.IPsecStatus) {
+	fmt.Fprintf(w, "XFRM policies: %d\n", status.Policies)
+	fmt.Fprintf(w, "XFRM states: %d\n", len(status.States))
+
+	if len(status.States) > 0 {
+		tab := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(tab, "SPI\tDIRECTION\tSOURCE\tDESTINATION\tREMOTE NODE\tPACKETS\tBYTES")
+		for _, s := range status.States {
+			remoteNode := s.RemoteNode
+			if s.Stale {
+				remoteNode = "(stale)"
+			}
+			fmt.Fprintf(tab, "%s\t%s\t%s\t%s\t%s\t%d\t%d\n",
+				s.Spi, s.Direction, s.Source, s.Destination, remoteNode,
+				s.Packets, s.Bytes)
+		}
+		tab.Flush()
+	}
+
+	if len(status.ErrorCounters) > 0 {
+		counters := make([]string, 0, len(status.ErrorCounters))
+		for name := range status.ErrorCounters {
+			counters = append(counters, name)
+		}
+		sort.Strings(counters)
+
+		fmt.Fprintln(w, "XFRM error counters:")
+		for _, name := range counters {
+			fmt.Fprintf(w, "  %s: %d\n", name, status.ErrorCounters[name])
+		}
+	}
+}
@@ -58,6 +58,7 @@ programs attached to endpoints and devices. This includes:
 	printer    = format.NewMonitorFormatter(format.INFO)
 	socketPath = ""
 	verbosity  = []bool{}
+	verdicts   = []string{}
 )
 
 func init() {
@@ -67,6 +68,8 @@ func init() {
 	monitorCmd.Flags().Var(&printer.FromSource, "from", "Filter by source endpoint id")
 	monitorCmd.Flags().Var(&printer.ToDst, "to", "Filter by destination endpoint id")
 	monitorCmd.Flags().Var(&printer.Related, "related-to", "Filter by either source or destination endpoint id")
+	monitorCmd.Flags().StringSliceVar(&verdicts, "verdict", nil,
+		fmt.Sprintf("Filter by event verdict (%s, %s)", listener.FilterVerdictForwarded, listener.FilterVerdictDropped))
 	monitorCmd.Flags().BoolSliceVarP(&verbosity, "verbose", "v", nil, "Enable verbose output (-v, -vv)")
 	monitorCmd.Flags().Lookup("verbose").NoOptDefVal = "false"
 	monitorCmd.Flags().BoolVarP(&printer.JSONOutput, "json", "j", false, "Enable json output. Shadows -v flag")
@@ -102,6 +105,21 @@ func setupSigHandler() {
 	}()
 }
 
+// buildMonitorFilter assembles the server-side event filter from the
+// command-line filter flags.
+func buildMonitorFilter() *listener.Filter {
+	filter := &listener.Filter{
+		FromSource: printer.FromSource,
+		ToDst:      printer.ToDst,
+		Related:    printer.Related,
+		Verdicts:   verdicts,
+	}
+	for _, t := range printer.EventTypes {
+		filter.Types = append(filter.Types, int32(t))
+	}
+	return filter
+}
+
 // openMonitorSock attempts to open a version specific monitor socket It
 // returns a connection, with a version, or an error.
 func openMonitorSock(path string) (conn net.Conn, version listener.Version, err error) {
@@ -117,9 +135,25 @@ func openMonitorSock(path string) (conn net.Conn, version listener.Version, err
 		errors = append(errors, path+": "+err.Error())
 	}
 
-	// try the 1.2 socket
+	// try the 1.3 socket, which filters events on the agent side
+	conn, err = net.Dial("unix", defaults.MonitorSockPath1_3)
+	if err == nil {
+		if err = gob.NewEncoder(conn).Encode(buildMonitorFilter()); err == nil {
+			return conn, listener.Version1_3, nil
+		}
+		conn.Close()
+		errors = append(errors, defaults.MonitorSockPath1_3+": "+err.Error())
+	} else {
+		errors = append(errors, defaults.MonitorSockPath1_3+": "+err.Error())
+	}
+
+	// fall back to the 1.2 socket of older agents, which filters
+	// client-side
 	conn, err = net.Dial("unix", defaults.MonitorSockPath1_2)
 	if err == nil {
+		if len(verdicts) > 0 {
+			fmt.Fprintln(os.Stderr, "Agent does not support the monitor 1.3 API, ignoring verdict filter")
+		}
 		return conn, listener.Version1_2, nil
 	}
 	errors = append(errors, defaults.MonitorSockPath1_2+": "+err.Error())
@@ -161,13 +195,14 @@ type eventParserFunc func() (*payload.Payload, error)
 // appropriate for the monitor API version passed in.
 func getMonitorParser(conn net.Conn, version listener.Version) (parser eventParserFunc, err error) {
 	switch version {
-	case listener.Version1_2:
+	case listener.Version1_2, listener.Version1_3:
 		var (
 			pl  payload.Payload
 			dec = gob.NewDecoder(conn)
 		)
 		// This implemenents the newer 1.2 API. Each listener maintains its own gob
-		// session, and type information is only ever sent once.
+		// session, and type information is only ever sent once. The 1.3 API
+		// shares the same payload stream.
 		return func() (*payload.Payload, error) {
 			if err := pl.DecodeBinary(dec); err != nil {
 				return nil, err
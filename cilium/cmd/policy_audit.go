@@ -0,0 +1,98 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/cilium/cilium/pkg/command"
+	"github.com/cilium/cilium/pkg/defaults"
+	"github.com/cilium/cilium/pkg/hostfirewall"
+	"github.com/cilium/cilium/pkg/u8proto"
+
+	"github.com/spf13/cobra"
+)
+
+// policyAuditCmd represents the policy audit command
+var policyAuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Display policy audit reports",
+}
+
+var policyAuditStateDir string
+
+// policyAuditHostCmd represents the policy audit host command
+var policyAuditHostCmd = &cobra.Command{
+	Use:   "host",
+	Short: "Display the host firewall audit report",
+	Long: `Displays the distinct (remote identity, port, protocol) tuples which
+lacked an allow rule while the host endpoint was running in audit mode
+(--host-firewall-audit-mode)`,
+	Run: func(cmd *cobra.Command, args []string) {
+		path := filepath.Join(policyAuditStateDir, hostfirewall.AuditReportFile)
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				Fatalf("No host firewall audit report found at %s. Is the agent running with --%s?\n",
+					path, "host-firewall-audit-mode")
+			}
+			Fatalf("Cannot read host firewall audit report: %s\n", err)
+		}
+
+		var report []*hostfirewall.AuditEntry
+		if err := json.Unmarshal(data, &report); err != nil {
+			Fatalf("Cannot parse host firewall audit report: %s\n", err)
+		}
+
+		if command.OutputJSON() {
+			if err := command.PrintOutput(report); err != nil {
+				os.Exit(1)
+			}
+			return
+		}
+
+		sort.Slice(report, func(i, j int) bool {
+			return report[i].Count > report[j].Count
+		})
+
+		w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+		fmt.Fprintf(w, "DIRECTION\tREMOTE ID\tPORT\tPROTO\tMATCH\tCOUNT\tLAST SEEN\n")
+		for _, entry := range report {
+			dir := "egress"
+			if entry.Ingress {
+				dir = "ingress"
+			}
+			fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%d\t%s\n",
+				dir, entry.RemoteIdentity, entry.DstPort,
+				u8proto.U8proto(entry.Proto), entry.MatchType,
+				entry.Count, entry.LastSeen.Format("2006-01-02T15:04:05Z07:00"))
+		}
+		w.Flush()
+	},
+}
+
+func init() {
+	policyCmd.AddCommand(policyAuditCmd)
+	policyAuditCmd.AddCommand(policyAuditHostCmd)
+	policyAuditHostCmd.Flags().StringVar(&policyAuditStateDir, "state-dir",
+		defaults.RuntimePath, "Directory path where the agent stores runtime state")
+	command.AddJSONOutput(policyAuditHostCmd)
+}
@@ -16,6 +16,7 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cilium/cilium/api/v1/models"
@@ -42,7 +43,7 @@ func init() {
 	recorderCmd.AddCommand(recorderUpdateCmd)
 	recorderUpdateCmd.Flags().Uint64VarP(&idRec, "id", "", 0, "Identifier")
 	recorderUpdateCmd.Flags().Uint64VarP(&capLen, "caplen", "", 0, "Capture Length (0 is full capture)")
-	recorderUpdateCmd.Flags().StringSliceVarP(&filters, "filters", "", []string{}, "List of filters ('<srcCIDR> <srcPort> <dstCIDR> <dstPort> <proto>')")
+	recorderUpdateCmd.Flags().StringSliceVarP(&filters, "filters", "", []string{}, "List of filters ('<srcCIDR> <srcPort> <dstCIDR> <dstPort> <proto> [<srcIdentity> <dstIdentity>]')")
 }
 
 func updateRecorder(cmd *cobra.Command, args []string) {
@@ -68,26 +69,40 @@ func updateRecorder(cmd *cobra.Command, args []string) {
 	spec.CaptureLength = int64(capLen)
 	spec.Filters = []*models.RecorderFilter{}
 	for _, filter := range filters {
-		var dstPrefix, srcPrefix, dstPort, srcPort, protocol string
-		_, err := fmt.Sscanf(filter, "%s %s %s %s %s",
-			&srcPrefix, &srcPort, &dstPrefix, &dstPort, &protocol)
-		if err != nil {
-			Fatalf("Cannot parse filter: %s", err)
+		var srcIdentity, dstIdentity int64
+		fields := strings.Fields(filter)
+		switch len(fields) {
+		case 5:
+		case 7:
+			var err error
+			srcIdentity, err = strconv.ParseInt(fields[5], 10, 64)
+			if err != nil {
+				Fatalf("Cannot parse filter source identity: %s", err)
+			}
+			dstIdentity, err = strconv.ParseInt(fields[6], 10, 64)
+			if err != nil {
+				Fatalf("Cannot parse filter destination identity: %s", err)
+			}
+		default:
+			Fatalf("Cannot parse filter: %s", filter)
 		}
-		protocol = strings.ToUpper(protocol)
+		srcPrefix, srcPort, dstPrefix, dstPort := fields[0], fields[1], fields[2], fields[3]
+		protocol := strings.ToUpper(fields[4])
 		switch protocol {
 		case models.RecorderFilterProtocolTCP,
 			models.RecorderFilterProtocolUDP,
 			models.RecorderFilterProtocolANY:
 		default:
-			Fatalf("Cannot parse filter: %s", err)
+			Fatalf("Cannot parse filter protocol: %s", protocol)
 		}
 		f := &models.RecorderFilter{
-			DstPrefix: dstPrefix,
-			SrcPrefix: srcPrefix,
-			DstPort:   dstPort,
-			SrcPort:   srcPort,
-			Protocol:  protocol,
+			DstPrefix:   dstPrefix,
+			SrcPrefix:   srcPrefix,
+			DstPort:     dstPort,
+			SrcPort:     srcPort,
+			Protocol:    protocol,
+			SrcIdentity: srcIdentity,
+			DstIdentity: dstIdentity,
 		}
 		spec.Filters = append(spec.Filters, f)
 	}
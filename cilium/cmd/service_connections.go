@@ -0,0 +1,81 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/cilium/cilium/pkg/command"
+	"github.com/cilium/cilium/pkg/loadbalancer"
+
+	"github.com/spf13/cobra"
+)
+
+// serviceConnectionsCmd represents the service_connections command
+var serviceConnectionsCmd = &cobra.Command{
+	Use:   "connections <service id>",
+	Short: "Display per-backend connection counts of a service",
+	Long: `Displays the number of connections tracked in the datapath conntrack
+table for each backend of the given service. Backends which still have
+tracked connections but are no longer part of the service are reported
+as stale.`,
+	PreRun: requireServiceID,
+	Run: func(cmd *cobra.Command, args []string) {
+		svcIDstr := args[0]
+		id, err := strconv.ParseInt(svcIDstr, 0, 64)
+		if err != nil {
+			Fatalf("Unable to parse service ID: %s", svcIDstr)
+		}
+
+		list, err := client.GetServiceIDConnections(id)
+		if err != nil {
+			Fatalf("Cannot get connections of service '%v': %s\n", id, err)
+		}
+
+		if command.OutputJSON() {
+			if err := command.PrintOutput(list); err != nil {
+				os.Exit(1)
+			}
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "Backend ID\tBackend\tConnections\tStale\t")
+		for _, bc := range list {
+			backend := ""
+			if bc.BackendAddress != nil {
+				if bea, err := loadbalancer.NewL3n4AddrFromBackendModel(bc.BackendAddress); err == nil {
+					backend = bea.String()
+				} else {
+					backend = fmt.Sprintf("invalid backend: %+v", bc.BackendAddress)
+				}
+			}
+			stale := ""
+			if bc.Stale {
+				stale = "true"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%d\t%s\t\n", bc.BackendID, backend, bc.Connections, stale)
+		}
+		w.Flush()
+	},
+}
+
+func init() {
+	serviceCmd.AddCommand(serviceConnectionsCmd)
+	command.AddJSONOutput(serviceConnectionsCmd)
+}
@@ -90,6 +90,9 @@ func printServiceList(w *tabwriter.Writer, list []*models.Service) {
 				continue
 			}
 			str := fmt.Sprintf("%d => %s", i+1, beA.String())
+			if be.Quarantined {
+				str += " (quarantined)"
+			}
 			backendAddresses = append(backendAddresses, str)
 		}
 
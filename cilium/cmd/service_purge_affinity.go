@@ -0,0 +1,48 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+// servicePurgeAffinityCmd represents the service_purge_affinity command
+var servicePurgeAffinityCmd = &cobra.Command{
+	Use:   "purge-affinity <service id>",
+	Short: "Purge the session affinity of a service",
+	Long: `Deletes the session affinity entries of the given service, causing
+its clients to re-select a backend. Affinity entries of other services are
+left intact.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		requireServiceID(cmd, args)
+		id, err := strconv.ParseInt(args[0], 0, 64)
+		if err != nil {
+			Fatalf("%s", err)
+		}
+
+		if err := client.DeleteServiceIDAffinity(id); err != nil {
+			Fatalf("%s", err)
+		}
+
+		fmt.Printf("Session affinity of service %d purged successfully\n", id)
+	},
+}
+
+func init() {
+	serviceCmd.AddCommand(servicePurgeAffinityCmd)
+}
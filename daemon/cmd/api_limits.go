@@ -15,6 +15,7 @@
 package cmd
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/cilium/cilium/pkg/metrics"
@@ -31,6 +32,10 @@ const (
 
 var apiRateLimitDefaults = map[string]rate.APILimiterParameters{
 	// PUT /endpoint/{id}
+	//
+	// Endpoint creation is on the critical path of CNI ADD calls, thus
+	// scheduled with critical priority: while creations are waiting to be
+	// admitted, background API groups are held back.
 	apiRequestEndpointCreate: {
 		AutoAdjust:                  true,
 		EstimatedProcessingDuration: time.Second * 2,
@@ -40,6 +45,7 @@ var apiRateLimitDefaults = map[string]rate.APILimiterParameters{
 		SkipInitial:                 4,
 		MaxWaitDuration:             15 * time.Second,
 		Log:                         true,
+		Priority:                    rate.PriorityCritical,
 	},
 	// DELETE /endpoint/{id}
 	//
@@ -55,6 +61,7 @@ var apiRateLimitDefaults = map[string]rate.APILimiterParameters{
 		ParallelRequests:            4,
 		MinParallelRequests:         4,
 		Log:                         true,
+		Priority:                    rate.PriorityCritical,
 	},
 	// GET /endpoint/{id}/healthz
 	// GET /endpoint/{id}/log
@@ -72,6 +79,7 @@ var apiRateLimitDefaults = map[string]rate.APILimiterParameters{
 		MinParallelRequests:         2,
 		SkipInitial:                 4,
 		MaxWaitDuration:             10 * time.Second,
+		MaxParallelPerClient:        2,
 	},
 	// PATCH /endpoint/{id}
 	// PATCH /endpoint/{id}/config
@@ -95,7 +103,9 @@ var apiRateLimitDefaults = map[string]rate.APILimiterParameters{
 	//
 	// Listing endpoints should be relatively quick, even with a large
 	// number of endpoints on a node. Always permit two parallel requests
-	// and rely on rate limiting to throttle if load becomes high.
+	// and rely on rate limiting to throttle if load becomes high. A
+	// single client may only occupy one of the slots at a time and list
+	// calls yield to waiting endpoint creations and deletions.
 	apiRequestEndpointList: {
 		AutoAdjust:                  true,
 		EstimatedProcessingDuration: time.Millisecond * 300,
@@ -103,9 +113,22 @@ var apiRateLimitDefaults = map[string]rate.APILimiterParameters{
 		RateBurst:                   4,
 		ParallelRequests:            2,
 		MinParallelRequests:         2,
+		MaxParallelPerClient:        1,
+		Priority:                    rate.PriorityBackground,
 	},
 }
 
+// apiRequestClient derives the client identifier used for per-client
+// fairness from an API request. The agent API is served over a unix socket,
+// so the socket peer address alone rarely distinguishes clients; fall back
+// to the user agent which at least separates client binaries.
+func apiRequestClient(r *http.Request) string {
+	if ua := r.Header.Get("User-Agent"); ua != "" {
+		return ua
+	}
+	return r.RemoteAddr
+}
+
 type apiRateLimitingMetrics struct{}
 
 func (a *apiRateLimitingMetrics) ProcessedRequest(name string, v rate.MetricsValues) {
@@ -33,6 +33,9 @@ var cleaner = &daemonCleanup{
 	cleanupFuncs: &cleanupFuncList{
 		funcs: make([]func(), 0),
 	},
+	preCleanupFuncs: &cleanupFuncList{
+		funcs: make([]func(), 0),
+	},
 }
 
 type daemonCleanup struct {
@@ -45,6 +48,11 @@ type daemonCleanup struct {
 
 	cleanupFuncs *cleanupFuncList
 
+	// preCleanupFuncs are run upon receipt of a termination signal,
+	// before the daemon's context is cancelled. They are used to drain
+	// in-flight work gracefully while the agent is still operational.
+	preCleanupFuncs *cleanupFuncList
+
 	sigHandlerCancel context.CancelFunc
 }
 
@@ -74,6 +82,7 @@ func (d *daemonCleanup) registerSigHandler() <-chan struct{} {
 	go func() {
 		for s := range sig {
 			log.WithField("signal", s).Info("Exiting due to signal")
+			d.preCleanupFuncs.Run()
 			log.Debug("canceling context in signal handler")
 			d.Lock()
 			if d.sigHandlerCancel != nil {
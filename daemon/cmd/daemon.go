@@ -35,6 +35,7 @@ import (
 	"github.com/cilium/cilium/pkg/counter"
 	"github.com/cilium/cilium/pkg/crypto/certificatemanager"
 	"github.com/cilium/cilium/pkg/datapath"
+	datapathIpcache "github.com/cilium/cilium/pkg/datapath/ipcache"
 	linuxrouting "github.com/cilium/cilium/pkg/datapath/linux/routing"
 	"github.com/cilium/cilium/pkg/datapath/loader"
 	datapathOption "github.com/cilium/cilium/pkg/datapath/option"
@@ -47,6 +48,7 @@ import (
 	"github.com/cilium/cilium/pkg/envoy"
 	"github.com/cilium/cilium/pkg/eventqueue"
 	"github.com/cilium/cilium/pkg/fqdn"
+	"github.com/cilium/cilium/pkg/hostfirewall"
 	"github.com/cilium/cilium/pkg/hubble/observer"
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/identity/cache"
@@ -116,6 +118,10 @@ type Daemon struct {
 	policy           *policy.Repository
 	preFilter        datapath.PreFilter
 
+	// certManager resolves the k8s secrets referenced by imported
+	// policies and tracks them for rotation.
+	certManager *certificatemanager.Manager
+
 	statusCollectMutex lock.RWMutex
 	statusResponse     models.StatusResponse
 	statusCollector    *status.Collector
@@ -166,6 +172,10 @@ type Daemon struct {
 
 	hubbleObserver *observer.LocalObserverServer
 
+	// hubbleExporters are the configured Hubble flow exporters. They are
+	// flushed as part of the shutdown drain sequence.
+	hubbleExporters []hubbleExporter
+
 	// k8sCachesSynced is closed when all essential Kubernetes caches have
 	// been fully synchronized
 	k8sCachesSynced <-chan struct{}
@@ -184,6 +194,15 @@ type Daemon struct {
 
 	// event queue for serializing configuration updates to the daemon.
 	configModifyQueue *eventqueue.EventQueue
+
+	// identityMigration tracks the progress of the lazy identity
+	// migration after a labels filter configuration change.
+	identityMigration identityMigrationState
+
+	// ipcacheListener is the listener reflecting the IP-identity cache
+	// into the ipcache BPF map. It is kept around for the periodic map
+	// reconciliation.
+	ipcacheListener *datapathIpcache.BPFListener
 }
 
 // GetPolicyRepository returns the policy repository of the daemon
@@ -403,6 +422,11 @@ func NewDaemon(ctx context.Context, cancel context.CancelFunc, epMgr *endpointma
 		apiLimiterSet:     apiLimiterSet,
 	}
 
+	// Validate the prefix lengths in the ipcache against the datapath LPM
+	// configuration on each upsert and delete, repairing any missed
+	// registration.
+	ipcache.IPIdentityCache.SetLPMRegistry(d.prefixLengths)
+
 	d.configModifyQueue = eventqueue.NewEventQueueBuffered("config-modify-queue", ConfigModifyQueueSize)
 	d.configModifyQueue.Run()
 
@@ -415,8 +439,8 @@ func NewDaemon(ctx context.Context, cancel context.CancelFunc, epMgr *endpointma
 	}
 
 	d.identityAllocator = cache.NewCachingIdentityAllocator(&d)
-	d.policy = policy.NewPolicyRepository(d.identityAllocator.GetIdentityCache(),
-		certificatemanager.NewManager(option.Config.CertDirectory, k8s.Client()))
+	d.certManager = certificatemanager.NewManager(option.Config.CertDirectory, k8s.Client())
+	d.policy = policy.NewPolicyRepository(d.identityAllocator.GetIdentityCache(), d.certManager)
 	d.policy.SetEnvoyRulesFunc(envoy.GetEnvoyHTTPRules)
 
 	// Propagate identity allocator down to packages which themselves do not
@@ -430,6 +454,8 @@ func NewDaemon(ctx context.Context, cancel context.CancelFunc, epMgr *endpointma
 	d.endpointManager = epMgr
 	d.endpointManager.InitMetrics()
 
+	d.initHeaderMatchSecretSync()
+
 	d.redirectPolicyManager = redirectpolicy.NewRedirectPolicyManager(d.svc)
 	if option.Config.BGPAnnounceLBIP {
 		d.bgpSpeaker = speaker.New()
@@ -499,6 +525,10 @@ func NewDaemon(ctx context.Context, cancel context.CancelFunc, epMgr *endpointma
 		bootstrapStats.restore.End(true)
 	}
 
+	if option.Config.EnableLBBackendHealthCheck && !option.Config.DryMode {
+		d.svc.StartBackendHealthChecking()
+	}
+
 	t, err := trigger.NewTrigger(trigger.Parameters{
 		Name:            "policy_update",
 		MetricsObserver: &policyTriggerMetrics{},
@@ -539,6 +569,10 @@ func NewDaemon(ctx context.Context, cancel context.CancelFunc, epMgr *endpointma
 	if option.Config.EnableL7Proxy {
 		d.l7Proxy = proxy.StartProxySupport(10000, 20000, option.Config.RunDir,
 			&d, option.Config.AgentLabels, d.datapath, d.endpointManager)
+		// Restore the proxy ports of the previous agent run before any
+		// redirects are created so that the listener ports remain stable
+		// across restarts.
+		d.l7Proxy.RestoreProxyPorts()
 	} else {
 		log.Info("L7 proxies are disabled")
 	}
@@ -818,6 +852,13 @@ func NewDaemon(ctx context.Context, cancel context.CancelFunc, epMgr *endpointma
 		// identity allocator to run asynchronously.
 		d.identityAllocator.InitIdentityAllocator(k8s.CiliumClient(), nil)
 
+		// Restore the local identities of the previous run before the
+		// ipcache is populated, so that e.g. CIDR identities keep
+		// their numeric value across the restart.
+		if _, err := d.identityAllocator.RestoreLocalIdentities(); err != nil {
+			log.WithError(err).Warning("Unable to restore local identities from checkpoint")
+		}
+
 		d.bootstrapClusterMesh(nodeMngr)
 	}
 
@@ -851,6 +892,19 @@ func NewDaemon(ctx context.Context, cancel context.CancelFunc, epMgr *endpointma
 				return nil, nil, err
 			}
 		}
+
+		// In host firewall audit mode, collect the audited policy
+		// verdicts of the host endpoint into the audit report.
+		if option.Config.EnableHostFirewall && option.Config.HostFirewallAuditMode {
+			monitorAgent.RegisterNewConsumer(hostfirewall.NewAuditCollector(
+				option.Config.StateDir,
+				func() uint16 {
+					if ep := d.endpointManager.GetHostEndpoint(); ep != nil {
+						return uint16(ep.GetID())
+					}
+					return 0
+				}))
+		}
 	}
 
 	if err := d.syncEndpointsAndHostIPs(); err != nil {
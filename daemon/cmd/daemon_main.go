@@ -25,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	flowpb "github.com/cilium/cilium/api/v1/flow"
 	"github.com/cilium/cilium/api/v1/server"
 	"github.com/cilium/cilium/api/v1/server/restapi"
 	"github.com/cilium/cilium/pkg/aws/eni"
@@ -234,6 +235,9 @@ func init() {
 	flags.Duration(option.ARPPingRefreshPeriod, 5*time.Minute, "Period for remote node ARP entry refresh (set 0 to disable)")
 	option.BindEnv(option.ARPPingRefreshPeriod)
 
+	flags.Duration(option.NodeDeletionGracePeriod, 0, "Time to hold node deletions from non-kvstore sources before processing them, cancelled if the node reappears (set 0 to disable)")
+	option.BindEnv(option.NodeDeletionGracePeriod)
+
 	flags.Bool(option.AutoCreateCiliumNodeResource, defaults.AutoCreateCiliumNodeResource, "Automatically create CiliumNode resource for own node on startup")
 	option.BindEnv(option.AutoCreateCiliumNodeResource)
 
@@ -271,6 +275,9 @@ func init() {
 	flags.Duration(option.ConntrackGCInterval, time.Duration(0), "Overwrite the connection-tracking garbage collection interval")
 	option.BindEnv(option.ConntrackGCInterval)
 
+	flags.Duration(option.DatapathMapReconciliationInterval, time.Duration(0), "Interval of the periodic reconciliation of BPF maps against the agent's desired state (0 disables it)")
+	option.BindEnv(option.DatapathMapReconciliationInterval)
+
 	flags.BoolP(option.DebugArg, "D", false, "Enable debugging mode")
 	option.BindEnv(option.DebugArg)
 
@@ -301,6 +308,9 @@ func init() {
 	flags.Bool(option.EnableHealthChecking, defaults.EnableHealthChecking, "Enable connectivity health checking")
 	option.BindEnv(option.EnableHealthChecking)
 
+	flags.StringSlice(option.HealthProbes, []string{}, "Subset of connectivity health probe types to run (icmp, tcp, http, http-service; empty enables all)")
+	option.BindEnv(option.HealthProbes)
+
 	flags.Bool(option.EnableHealthCheckNodePort, defaults.EnableHealthCheckNodePort, "Enables a healthcheck nodePort server for NodePort services with 'healthCheckNodePort' being set")
 	option.BindEnv(option.EnableHealthCheckNodePort)
 
@@ -414,6 +424,12 @@ func init() {
 	flags.MarkHidden(option.HTTP403Message)
 	option.BindEnv(option.HTTP403Message)
 
+	flags.Int(option.ProxyAccessLogSampleRate, 0, "Emit only one out of every N allowed L7 requests as an access log record; denied requests are always logged (0 or 1 to log every request)")
+	option.BindEnv(option.ProxyAccessLogSampleRate)
+
+	flags.StringSlice(option.ProxyAccessLogRedactFields, nil, "List of HTTP header and URL query parameter names whose values are redacted in L7 access log records")
+	option.BindEnv(option.ProxyAccessLogRedactFields)
+
 	flags.Uint(option.HTTPRequestTimeout, 60*60, "Time after which a forwarded HTTP request is considered failed unless completed (in seconds); Use 0 for unlimited")
 	option.BindEnv(option.HTTPRequestTimeout)
 
@@ -432,6 +448,10 @@ func init() {
 	flags.Uint(option.ProxyConnectTimeout, 1, "Time after which a TCP connect attempt is considered failed unless completed (in seconds)")
 	option.BindEnv(option.ProxyConnectTimeout)
 
+	flags.String(option.ProxyRedirectFallback, option.ProxyRedirectFallbackDeny,
+		"Behavior of policy map entries of an L7 redirect while its proxy listener is not confirmed listening (\"deny\" or \"allow\")")
+	option.BindEnv(option.ProxyRedirectFallback)
+
 	flags.Int(option.ProxyPrometheusPort, 0, "Port to serve Envoy metrics on. Default 0 (disabled).")
 	option.BindEnv(option.ProxyPrometheusPort)
 
@@ -447,12 +467,28 @@ func init() {
 	flags.Duration(option.IdentityChangeGracePeriod, defaults.IdentityChangeGracePeriod, "Time to wait before using new identity on endpoint identity change")
 	option.BindEnv(option.IdentityChangeGracePeriod)
 
+	flags.Duration(option.IdentityAuditInterval, 0, "Interval in which allocated identities are cross-checked against their users to detect reference count leaks (0 to disable)")
+	flags.MarkHidden(option.IdentityAuditInterval)
+	option.BindEnv(option.IdentityAuditInterval)
+
+	flags.Bool(option.IdentityAuditRepair, false, "Release identities for which the identity audit found no user")
+	flags.MarkHidden(option.IdentityAuditRepair)
+	option.BindEnv(option.IdentityAuditRepair)
+
 	flags.String(option.IdentityAllocationMode, option.IdentityAllocationModeKVstore, "Method to use for identity allocation")
 	option.BindEnv(option.IdentityAllocationMode)
 
 	flags.String(option.IPAM, ipamOption.IPAMClusterPool, "Backend to use for IPAM")
 	option.BindEnv(option.IPAM)
 
+	flags.Duration(option.IPAMOrphanGCInterval, 0, "Interval in which allocated IPs are cross-checked against existing endpoints to reclaim orphaned allocations (0 to disable)")
+	flags.MarkHidden(option.IPAMOrphanGCInterval)
+	option.BindEnv(option.IPAMOrphanGCInterval)
+
+	flags.Bool(option.IPAMOrphanGCReportOnly, false, "Only report orphaned IPs found by the IPAM garbage collection instead of releasing them")
+	flags.MarkHidden(option.IPAMOrphanGCReportOnly)
+	option.BindEnv(option.IPAMOrphanGCReportOnly)
+
 	flags.String(option.IPv4Range, AutoCIDR, "Per-node IPv4 endpoint prefix, e.g. 10.16.0.0/16")
 	option.BindEnv(option.IPv4Range)
 
@@ -553,6 +589,21 @@ func init() {
 	flags.Bool(option.EnableSVCSourceRangeCheck, true, "Enable check of service source ranges (currently, only for LoadBalancer)")
 	option.BindEnv(option.EnableSVCSourceRangeCheck)
 
+	flags.Duration(option.LBBackendDrainGracePeriod, 0, "Maximum time terminating service backends are kept for connection draining before being removed (0 keeps them until removed from Kubernetes)")
+	option.BindEnv(option.LBBackendDrainGracePeriod)
+
+	flags.Bool(option.EnableLBBackendHealthCheck, false, "Enable active health probing of service backends, quarantining backends which fail their probes")
+	option.BindEnv(option.EnableLBBackendHealthCheck)
+
+	flags.Duration(option.LBBackendHealthCheckInterval, defaults.LBBackendHealthCheckInterval, "Interval between two active health probes of a service backend")
+	option.BindEnv(option.LBBackendHealthCheckInterval)
+
+	flags.Bool(option.EnableServiceTopology, false, "Enable topology aware routing of service backends based on EndpointSlice hints")
+	option.BindEnv(option.EnableServiceTopology)
+
+	flags.Float64(option.ServiceTopologyMinBackendsRatio, defaults.ServiceTopologyMinBackendsRatio, "Minimum ratio of local zone backends to total backends below which topology aware routing falls back to the full backend set")
+	option.BindEnv(option.ServiceTopologyMinBackendsRatio)
+
 	flags.Bool(option.EnableBandwidthManager, false, "Enable BPF bandwidth manager")
 	option.BindEnv(option.EnableBandwidthManager)
 
@@ -622,9 +673,15 @@ func init() {
 	flags.Bool(option.EnableIdentityMark, true, "Enable setting identity mark for local traffic")
 	option.BindEnv(option.EnableIdentityMark)
 
+	flags.Bool(option.EnableIdentityMigration, false, "Lazily recompute identities of restored endpoints after the identity relevant labels configuration has changed")
+	option.BindEnv(option.EnableIdentityMigration)
+
 	flags.Bool(option.EnableHostFirewall, false, "Enable host network policies (beta when using kube-proxy)")
 	option.BindEnv(option.EnableHostFirewall)
 
+	flags.Bool(option.HostFirewallAuditMode, false, "Enable audit (non-drop) mode for host policies; packets which would be denied are allowed but recorded")
+	option.BindEnv(option.HostFirewallAuditMode)
+
 	flags.String(option.NativeRoutingCIDR, "",
 		fmt.Sprintf("Allows to explicitly specify the IPv4 CIDR for native routing. This value corresponds to the configured cluster-cidr. Deprecated in favor of --%s", option.IPv4NativeRoutingCIDR))
 	option.BindEnv(option.NativeRoutingCIDR)
@@ -669,6 +726,9 @@ func init() {
 	flags.Bool(option.EnableEgressGateway, false, "Enable egress gateway")
 	option.BindEnv(option.EnableEgressGateway)
 
+	flags.Duration(option.EgressGatewayHealthCheckInterval, 5*time.Second, "Interval between egress gateway liveness probes (0 to disable health checking)")
+	option.BindEnv(option.EgressGatewayHealthCheckInterval)
+
 	flags.String(option.IPMasqAgentConfigPath, "/etc/config/ip-masq-agent", "ip-masq-agent configuration file path")
 	option.BindEnv(option.IPMasqAgentConfigPath)
 
@@ -727,6 +787,9 @@ func init() {
 	flags.String(option.SocketPath, defaults.SockPath, "Sets daemon's socket path to listen for connections")
 	option.BindEnv(option.SocketPath)
 
+	flags.Duration(option.ShutdownDrainTimeoutName, defaults.ShutdownDrainTimeout, "Time to allow for in-flight proxied requests to complete and buffered Hubble flows to be flushed on shutdown, set to 0 to disable draining")
+	option.BindEnv(option.ShutdownDrainTimeoutName)
+
 	flags.String(option.StateDir, defaults.RuntimePath, "Directory path to store runtime state")
 	option.BindEnv(option.StateDir)
 
@@ -829,6 +892,9 @@ func init() {
 	flags.Int(option.ToFQDNsMaxIPsPerHost, defaults.ToFQDNsMaxIPsPerHost, "Maximum number of IPs to maintain per FQDN name for each endpoint")
 	option.BindEnv(option.ToFQDNsMaxIPsPerHost)
 
+	flags.Int(option.ToFQDNsEndpointMaxQueriesPerSecond, 0, "Maximum number of DNS queries per second to accept from an endpoint, 0 to disable rate limiting")
+	option.BindEnv(option.ToFQDNsEndpointMaxQueriesPerSecond)
+
 	flags.Int(option.DNSMaxIPsPerRestoredRule, defaults.DNSMaxIPsPerRestoredRule, "Maximum number of IPs to maintain for each restored DNS rule")
 	option.BindEnv(option.DNSMaxIPsPerRestoredRule)
 
@@ -853,6 +919,9 @@ func init() {
 	flags.Int(option.EndpointQueueSize, defaults.EndpointQueueSize, "size of EventQueue per-endpoint")
 	option.BindEnv(option.EndpointQueueSize)
 
+	flags.Int(option.EndpointRegenParallelism, 0, "Maximum number of endpoints regenerated in parallel from the bulk regeneration queue (0 = unlimited)")
+	option.BindEnv(option.EndpointRegenParallelism)
+
 	flags.Duration(option.EndpointGCInterval, 5*time.Minute, "Periodically monitor local endpoint health via link status on this interval and garbage collect them if they become unhealthy, set to 0 to disable")
 	flags.MarkHidden(option.EndpointGCInterval)
 	option.BindEnv(option.EndpointGCInterval)
@@ -907,6 +976,15 @@ func init() {
 	flags.StringSlice(option.HubbleMetrics, []string{}, "List of Hubble metrics to enable.")
 	option.BindEnv(option.HubbleMetrics)
 
+	flags.Int(option.HubbleFlowAggregationThreshold, 0, "Number of events a connection may produce before its per-packet trace events are aggregated into periodic summary flows (0 disables aggregation).")
+	option.BindEnv(option.HubbleFlowAggregationThreshold)
+
+	flags.Duration(option.HubbleFlowAggregationInterval, 30*time.Second, "Interval in which a summary flow is emitted for an aggregated connection.")
+	option.BindEnv(option.HubbleFlowAggregationInterval)
+
+	flags.StringSlice(option.HubbleFlowAggregationVerdicts, []string{flowpb.Verdict_FORWARDED.String()}, "List of flow verdicts subject to aggregation. Dropped flows are never aggregated.")
+	option.BindEnv(option.HubbleFlowAggregationVerdicts)
+
 	flags.String(option.HubbleExportFilePath, exporteroption.Default.Path, "Filepath to write Hubble events to.")
 	option.BindEnv(option.HubbleExportFilePath)
 
@@ -919,6 +997,9 @@ func init() {
 	flags.Bool(option.HubbleExportFileCompress, exporteroption.Default.Compress, "Compress rotated Hubble export files.")
 	option.BindEnv(option.HubbleExportFileCompress)
 
+	flags.String(option.HubbleExportConfigPath, "", "Filepath of the Hubble exporter configuration, watched and reloaded at runtime.")
+	option.BindEnv(option.HubbleExportConfigPath)
+
 	flags.Bool(option.EnableHubbleRecorderAPI, true, "Enable the Hubble recorder API")
 	option.BindEnv(option.EnableHubbleRecorderAPI)
 
@@ -971,6 +1052,9 @@ func init() {
 	flags.Bool(option.EnableCustomCallsName, false, "Enable tail call hooks for custom eBPF programs")
 	option.BindEnv(option.EnableCustomCallsName)
 
+	flags.Bool(option.EnablePolicyMapProvenanceName, false, "Retain the rule labels each policy map entry was derived from and expose them via the endpoint API")
+	option.BindEnv(option.EnablePolicyMapProvenanceName)
+
 	flags.Bool(option.BGPAnnounceLBIP, false, "Announces service IPs of type LoadBalancer via BGP")
 	option.BindEnv(option.BGPAnnounceLBIP)
 
@@ -1568,6 +1652,8 @@ func runDaemon() {
 		return
 	}
 
+	cleaner.preCleanupFuncs.Add(d.drainShutdown)
+
 	// This validation needs to be done outside of the agent until
 	// datapath.NodeAddressing is used consistently across the code base.
 	log.Info("Validating configured node address ranges")
@@ -1620,11 +1706,26 @@ func runDaemon() {
 			}
 			d.dnsNameManager.CompleteBootstrap()
 
+			// Restored endpoints have re-allocated the local
+			// identities they use; release the references taken
+			// when the checkpoint was restored so that stale
+			// identities are deallocated again.
+			d.identityAllocator.ReleaseRestoredIdentities()
+
 			ms := maps.NewMapSweeper(&EndpointMapManager{
 				EndpointManager: d.endpointManager,
 			})
 			ms.CollectStaleMapGarbage()
 			ms.RemoveDisabledMaps()
+
+			// Start auditing identity reference counts once
+			// endpoint restoration has settled, to avoid flagging
+			// identities which are only held during bootstrap.
+			d.startIdentityAuditor()
+
+			// Similarly, only start reclaiming orphaned IPs once
+			// all restorable endpoints have re-claimed theirs.
+			d.startIPAMOrphanGC()
 		}()
 		d.endpointManager.Subscribe(d)
 		defer d.endpointManager.Unsubscribe(d)
@@ -1729,6 +1830,7 @@ func runDaemon() {
 	bootstrapStats.overall.End(true)
 	bootstrapStats.updateMetrics()
 	go d.launchHubble()
+	d.initMapReconciliation()
 
 	err = option.Config.StoreInFile(option.Config.StateDir)
 	if err != nil {
@@ -1813,6 +1915,9 @@ func (d *Daemon) instantiateAPI() *restapi.CiliumAPIAPI {
 		// /policy/resolve/
 		restAPI.PolicyGetPolicyResolveHandler = NewGetPolicyResolveHandler(d)
 
+		// /policy/dry-run/
+		restAPI.PolicyPostPolicyDryRunHandler = NewPostPolicyDryRunHandler(d)
+
 		// /lrp/
 		restAPI.ServiceGetLrpHandler = NewGetLrpHandler(d.redirectPolicyManager)
 	}
@@ -1822,6 +1927,12 @@ func (d *Daemon) instantiateAPI() *restapi.CiliumAPIAPI {
 	restAPI.ServiceDeleteServiceIDHandler = NewDeleteServiceIDHandler(d.svc)
 	restAPI.ServicePutServiceIDHandler = NewPutServiceIDHandler(d.svc)
 
+	// /service/{id}/affinity/
+	restAPI.ServiceDeleteServiceIDAffinityHandler = NewDeleteServiceIDAffinityHandler(d.svc)
+
+	// /service/{id}/connections/
+	restAPI.ServiceGetServiceIDConnectionsHandler = NewGetServiceIDConnectionsHandler(d.svc)
+
 	// /service/
 	restAPI.ServiceGetServiceHandler = NewGetServiceHandler(d.svc)
 
@@ -1851,6 +1962,9 @@ func (d *Daemon) instantiateAPI() *restapi.CiliumAPIAPI {
 	// /debuginfo
 	restAPI.DaemonGetDebuginfoHandler = NewGetDebugInfoHandler(d)
 
+	// /wireguard/rotate-key
+	restAPI.DaemonPostWireguardRotateKeyHandler = NewPostWireguardRotateKeyHandler(d)
+
 	// /map
 	restAPI.DaemonGetMapHandler = NewGetMapHandler(d)
 	restAPI.DaemonGetMapNameHandler = NewGetMapNameHandler(d)
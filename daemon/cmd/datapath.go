@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/common"
 	"github.com/cilium/cilium/pkg/controller"
 	"github.com/cilium/cilium/pkg/datapath"
@@ -145,6 +146,17 @@ type EndpointMapManager struct {
 	*endpointmanager.EndpointManager
 }
 
+// ListEndpointIDs returns the IDs of all endpoints known to the endpoint
+// manager.
+func (e *EndpointMapManager) ListEndpointIDs() []uint16 {
+	eps := e.GetEndpoints()
+	ids := make([]uint16, 0, len(eps))
+	for _, ep := range eps {
+		ids = append(ids, ep.GetID16())
+	}
+	return ids
+}
+
 // RemoveDatapathMapping unlinks the endpointID from the global policy map, preventing
 // packets that arrive on this node from being forwarded to the endpoint that
 // used to exist with the specified ID.
@@ -152,6 +164,14 @@ func (e *EndpointMapManager) RemoveDatapathMapping(endpointID uint16) error {
 	return policymap.RemoveGlobalMapping(uint32(endpointID))
 }
 
+// RecreatePolicyMap creates an empty policy map with the specified name. It
+// is used to repair endpoints whose policy map was lost, the map content is
+// restored upon endpoint regeneration.
+func (e *EndpointMapManager) RecreatePolicyMap(name string) error {
+	_, err := policymap.Create(bpf.MapPath(name))
+	return err
+}
+
 // RemoveMapPath removes the specified path from the filesystem.
 func (e *EndpointMapManager) RemoveMapPath(path string) {
 	if err := os.RemoveAll(path); err != nil {
@@ -389,8 +409,9 @@ func (d *Daemon) initMaps() error {
 	// Set up the list of IPCache listeners in the daemon, to be
 	// used by syncEndpointsAndHostIPs()
 	// xDS cache will be added later by calling AddListener(), but only if necessary.
+	d.ipcacheListener = datapathIpcache.NewListener(d, d)
 	ipcache.IPIdentityCache.SetListeners([]ipcache.IPIdentityMappingListener{
-		datapathIpcache.NewListener(d, d),
+		d.ipcacheListener,
 	})
 
 	if option.Config.EnableIPv4 && option.Config.EnableIPMasqAgent {
@@ -33,6 +33,7 @@ import (
 	endpointid "github.com/cilium/cilium/pkg/endpoint/id"
 	"github.com/cilium/cilium/pkg/endpoint/regeneration"
 	"github.com/cilium/cilium/pkg/fqdn/restore"
+	identitymodel "github.com/cilium/cilium/pkg/identity/model"
 	"github.com/cilium/cilium/pkg/k8s"
 	k8sConst "github.com/cilium/cilium/pkg/k8s/apis/cilium.io"
 	slim_corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
@@ -61,7 +62,7 @@ func NewGetEndpointHandler(d *Daemon) GetEndpointHandler {
 func (h *getEndpoint) Handle(params GetEndpointParams) middleware.Responder {
 	log.WithField(logfields.Params, logfields.Repr(params)).Debug("GET /endpoint request")
 
-	r, err := h.d.apiLimiterSet.Wait(params.HTTPRequest.Context(), apiRequestEndpointList)
+	r, err := h.d.apiLimiterSet.WaitForClient(params.HTTPRequest.Context(), apiRequestEndpointList, apiRequestClient(params.HTTPRequest))
 	if err != nil {
 		return api.Error(http.StatusTooManyRequests, err)
 	}
@@ -149,7 +150,7 @@ func NewGetEndpointIDHandler(d *Daemon) GetEndpointIDHandler {
 func (h *getEndpointID) Handle(params GetEndpointIDParams) middleware.Responder {
 	log.WithField(logfields.EndpointID, params.ID).Debug("GET /endpoint/{id} request")
 
-	r, err := h.d.apiLimiterSet.Wait(params.HTTPRequest.Context(), apiRequestEndpointGet)
+	r, err := h.d.apiLimiterSet.WaitForClient(params.HTTPRequest.Context(), apiRequestEndpointGet, apiRequestClient(params.HTTPRequest))
 	if err != nil {
 		return api.Error(http.StatusTooManyRequests, err)
 	}
@@ -196,7 +197,7 @@ func (d *Daemon) fetchK8sLabelsAndAnnotations(nsName, podName string) (*slim_cor
 	}
 
 	k8sLbls := labels.Map2Labels(lbls, labels.LabelSourceK8s)
-	identityLabels, infoLabels := labelsfilter.Filter(k8sLbls)
+	identityLabels, infoLabels := labelsfilter.FilterByNamespace(nsName, k8sLbls)
 	return p, containerPorts, identityLabels, infoLabels, annotations, nil
 }
 
@@ -469,12 +470,12 @@ func (d *Daemon) createEndpoint(ctx context.Context, owner regeneration.Owner, e
 			}
 			return p.Annotations[annotation.ProxyVisibility], nil
 		})
-		ep.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthEgress string, err error) {
+		ep.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthIngress, bandwidthEgress string, err error) {
 			p, err := d.k8sWatcher.GetCachedPod(ns, podName)
 			if err != nil {
-				return "", err
+				return "", "", err
 			}
-			return p.Annotations[bandwidth.EgressBandwidth], nil
+			return p.Annotations[bandwidth.IngressBandwidth], p.Annotations[bandwidth.EgressBandwidth], nil
 		})
 		ep.UpdateNoTrackRules(func(ns, podName string) (noTrackPort string, err error) {
 			p, err := d.k8sWatcher.GetCachedPod(ns, podName)
@@ -849,7 +850,7 @@ func NewGetEndpointIDConfigHandler(d *Daemon) GetEndpointIDConfigHandler {
 func (h *getEndpointIDConfig) Handle(params GetEndpointIDConfigParams) middleware.Responder {
 	log.WithField(logfields.Params, logfields.Repr(params)).Debug("GET /endpoint/{id}/config")
 
-	r, err := h.daemon.apiLimiterSet.Wait(params.HTTPRequest.Context(), apiRequestEndpointGet)
+	r, err := h.daemon.apiLimiterSet.WaitForClient(params.HTTPRequest.Context(), apiRequestEndpointGet, apiRequestClient(params.HTTPRequest))
 	if err != nil {
 		return api.Error(http.StatusTooManyRequests, err)
 	}
@@ -880,7 +881,7 @@ func NewGetEndpointIDLabelsHandler(d *Daemon) GetEndpointIDLabelsHandler {
 func (h *getEndpointIDLabels) Handle(params GetEndpointIDLabelsParams) middleware.Responder {
 	log.WithField(logfields.Params, logfields.Repr(params)).Debug("GET /endpoint/{id}/labels")
 
-	r, err := h.daemon.apiLimiterSet.Wait(params.HTTPRequest.Context(), apiRequestEndpointGet)
+	r, err := h.daemon.apiLimiterSet.WaitForClient(params.HTTPRequest.Context(), apiRequestEndpointGet, apiRequestClient(params.HTTPRequest))
 	if err != nil {
 		return api.Error(http.StatusTooManyRequests, err)
 	}
@@ -916,7 +917,7 @@ func NewGetEndpointIDLogHandler(d *Daemon) GetEndpointIDLogHandler {
 func (h *getEndpointIDLog) Handle(params GetEndpointIDLogParams) middleware.Responder {
 	log.WithField(logfields.EndpointID, params.ID).Debug("GET /endpoint/{id}/log request")
 
-	r, err := h.d.apiLimiterSet.Wait(params.HTTPRequest.Context(), apiRequestEndpointGet)
+	r, err := h.d.apiLimiterSet.WaitForClient(params.HTTPRequest.Context(), apiRequestEndpointGet, apiRequestClient(params.HTTPRequest))
 	if err != nil {
 		return api.Error(http.StatusTooManyRequests, err)
 	}
@@ -946,7 +947,7 @@ func NewGetEndpointIDHealthzHandler(d *Daemon) GetEndpointIDHealthzHandler {
 func (h *getEndpointIDHealthz) Handle(params GetEndpointIDHealthzParams) middleware.Responder {
 	log.WithField(logfields.EndpointID, params.ID).Debug("GET /endpoint/{id}/log request")
 
-	r, err := h.d.apiLimiterSet.Wait(params.HTTPRequest.Context(), apiRequestEndpointGet)
+	r, err := h.d.apiLimiterSet.WaitForClient(params.HTTPRequest.Context(), apiRequestEndpointGet, apiRequestClient(params.HTTPRequest))
 	if err != nil {
 		return api.Error(http.StatusTooManyRequests, err)
 	}
@@ -992,7 +993,9 @@ func (d *Daemon) modifyEndpointIdentityLabelsFromAPI(id string, add, del labels.
 		return PatchEndpointIDInvalidCode, err
 	}
 
-	if err := ep.ModifyIdentityLabels(addLabels, delLabels); err != nil {
+	// Block until the identity has been resolved so that the response can
+	// report the identity resulting from the label change.
+	if err := ep.ModifyIdentityLabels(addLabels, delLabels, true); err != nil {
 		return PatchEndpointIDLabelsNotFoundCode, err
 	}
 
@@ -1018,7 +1021,6 @@ func (h *putEndpointIDLabels) Handle(params PatchEndpointIDLabelsParams) middlew
 
 	d := h.daemon
 	mod := params.Configuration
-	lbls := labels.NewLabelsFromModel(mod.User)
 
 	ep, err := d.endpointManager.Lookup(params.ID)
 	if err != nil {
@@ -1029,10 +1031,17 @@ func (h *putEndpointIDLabels) Handle(params PatchEndpointIDLabelsParams) middlew
 		return NewPatchEndpointIDLabelsNotFound()
 	}
 
-	add, del, err := ep.ApplyUserLabelChanges(lbls)
-	if err != nil {
-		r.Error(err)
-		return api.Error(PutEndpointIDInvalidCode, err)
+	add := labels.NewLabelsFromModel(mod.Add)
+	del := labels.NewLabelsFromModel(mod.Delete)
+	if len(add) == 0 && len(del) == 0 {
+		// Fall back to the deprecated behavior of replacing the custom
+		// labels with the user list when no source-scoped changes are
+		// requested.
+		add, del, err = ep.ApplyUserLabelChanges(labels.NewLabelsFromModel(mod.User))
+		if err != nil {
+			r.Error(err)
+			return api.Error(PutEndpointIDInvalidCode, err)
+		}
 	}
 
 	code, err := d.modifyEndpointIdentityLabelsFromAPI(params.ID, add, del)
@@ -1040,7 +1049,13 @@ func (h *putEndpointIDLabels) Handle(params PatchEndpointIDLabelsParams) middlew
 		r.Error(err)
 		return api.Error(code, err)
 	}
-	return NewPatchEndpointIDLabelsOK()
+
+	secID, err := ep.GetSecurityIdentity()
+	if err != nil {
+		r.Error(err)
+		return api.Error(PutEndpointIDInvalidCode, err)
+	}
+	return NewPatchEndpointIDLabelsOK().WithPayload(identitymodel.CreateModel(secID))
 }
 
 // QueueEndpointBuild waits for a "build permit" for the endpoint
@@ -84,7 +84,7 @@ func identitiesForFQDNSelectorIPs(selectorsWithIPsToUpdate map[policyApi.FQDNSel
 			"ips":          selectorIPs,
 		}).Debug("getting identities for IPs associated with FQDNSelector")
 		var currentlyAllocatedIdentities []*identity.Identity
-		if currentlyAllocatedIdentities, err = ipcache.AllocateCIDRsForIPs(selectorIPs, newlyAllocatedIdentities); err != nil {
+		if currentlyAllocatedIdentities, err = ipcache.AllocateAggregatedCIDRsForIPs(selectorIPs, newlyAllocatedIdentities); err != nil {
 			identityAllocator.ReleaseSlice(context.TODO(), nil, usedIdentities)
 			log.WithError(err).WithField("prefixes", selectorIPs).Warn(
 				"failed to allocate identities for IPs")
@@ -147,6 +147,10 @@ func (d *Daemon) bootstrapFQDN(possibleEndpoints map[uint16]*endpoint.Endpoint,
 	d.policy.GetSelectorCache().SetLocalIdentityNotifier(rg)
 	d.dnsNameManager = rg
 
+	// Expire DNS data as the TTLs of the lookups elapse, so that stale IPs
+	// do not remain allowed until the next garbage collector run.
+	rg.EnableTTLExpiry(d.ctx)
+
 	// Controller to cleanup TTL expired entries from the DNS policies.
 	// dns-garbage-collector-job runs the logic to remove stale or undesired
 	// entries from the DNS caches. This is done for all per-EP DNSCache
@@ -308,8 +312,8 @@ func (d *Daemon) bootstrapFQDN(possibleEndpoints map[uint16]*endpoint.Endpoint,
 		return err
 	}
 	proxy.DefaultDNSProxy, err = dnsproxy.StartDNSProxy("", port, option.Config.ToFQDNsEnableDNSCompression,
-		option.Config.DNSMaxIPsPerRestoredRule, d.lookupEPByIP, d.LookupSecIDByIP, d.lookupIPsBySecID,
-		d.notifyOnDNSMsg)
+		option.Config.DNSMaxIPsPerRestoredRule, option.Config.ToFQDNsEndpointMaxQueriesPerSecond,
+		d.lookupEPByIP, d.LookupSecIDByIP, d.lookupIPsBySecID, d.notifyOnDNSMsg)
 	if err == nil {
 		// Increase the ProxyPort reference count so that it will never get released.
 		err = d.l7Proxy.SetProxyPort(listenerName, proxy.DefaultDNSProxy.BindPort)
@@ -324,6 +328,9 @@ func (d *Daemon) bootstrapFQDN(possibleEndpoints map[uint16]*endpoint.Endpoint,
 				proxy.DefaultDNSProxy.RestoreRules(possibleEP)
 			}
 		}
+		// Replace the proxy instance should it wedge. The rule set is
+		// retained across the swap.
+		proxy.DefaultDNSProxy.StartSupervisor()
 	}
 	return err // filled by StartDNSProxy
 }
@@ -395,10 +402,17 @@ func (d *Daemon) notifyOnDNSMsg(lookupTime time.Time, ep *endpoint.Endpoint, epI
 		metricError = metricErrorTimeout
 		endMetric()
 		return nil
+	case stat.RateLimited:
+		metricError = metricErrorDenied
+		verdict = accesslog.VerdictDenied
+		reason = "Rate limited"
 	case stat.Err != nil:
 		metricError = metricErrorProxy
 		verdict = accesslog.VerdictError
 		reason = "Error: " + stat.Err.Error()
+	case stat.Passthrough:
+		verdict = accesslog.VerdictForwarded
+		reason = "Passthrough, not recorded"
 	case allowed:
 		verdict = accesslog.VerdictForwarded
 		reason = "Allowed by policy"
@@ -515,7 +529,9 @@ func (d *Daemon) notifyOnDNSMsg(lookupTime time.Time, ep *endpoint.Endpoint, epI
 	)
 	record.Log()
 
-	if msg.Response && msg.Rcode == dns.RcodeSuccess && len(responseIPs) > 0 {
+	// Passthrough responses are not recorded: their IPs must not be granted
+	// any toFQDNs-based identity.
+	if msg.Response && msg.Rcode == dns.RcodeSuccess && len(responseIPs) > 0 && !stat.Passthrough {
 		// This must happen before the NameManager update below, to ensure that
 		// this data is included in the serialized Endpoint object.
 		// We also need to add to the cache before we purge any matching zombies
@@ -559,8 +575,10 @@ func (d *Daemon) notifyOnDNSMsg(lookupTime time.Time, ep *endpoint.Endpoint, epI
 		case <-updateComplete:
 		}
 
+		updateDelay := time.Since(updateStart)
+		metrics.FQDNProxyResponseDelay.Observe(updateDelay.Seconds())
 		log.WithFields(logrus.Fields{
-			logfields.Duration:   time.Since(updateStart),
+			logfields.Duration:   updateDelay,
 			logfields.EndpointID: ep.GetID(),
 			"qname":              qname,
 		}).Debug("Waited for endpoints to regenerate due to a DNS response")
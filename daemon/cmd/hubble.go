@@ -25,6 +25,7 @@ import (
 	"github.com/cilium/cilium/api/v1/models"
 	observerpb "github.com/cilium/cilium/api/v1/observer"
 	"github.com/cilium/cilium/pkg/crypto/certloader"
+	"github.com/cilium/cilium/pkg/hubble/aggregation"
 	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
 	"github.com/cilium/cilium/pkg/hubble/container"
 	"github.com/cilium/cilium/pkg/hubble/exporter"
@@ -55,6 +56,12 @@ import (
 	k8scache "k8s.io/client-go/tools/cache"
 )
 
+// hubbleExporter is the subset of the Hubble flow exporter interface needed
+// to flush exporters on shutdown.
+type hubbleExporter interface {
+	Stop() error
+}
+
 func (d *Daemon) getHubbleStatus(ctx context.Context) *models.HubbleStatus {
 	if !option.Config.EnableHubble {
 		return &models.HubbleStatus{State: models.HubbleStatusStateDisabled}
@@ -120,6 +127,34 @@ func (d *Daemon) launchHubble() {
 		observerOpts = append(observerOpts, opt)
 	}
 
+	if option.Config.HubbleFlowAggregationThreshold > 0 {
+		var verdicts []flowpb.Verdict
+		for _, name := range option.Config.HubbleFlowAggregationVerdicts {
+			verdict, ok := flowpb.Verdict_value[strings.ToUpper(name)]
+			if !ok {
+				logger.WithField("verdict", name).Warn("Ignoring unknown flow verdict for aggregation")
+				continue
+			}
+			verdicts = append(verdicts, flowpb.Verdict(verdict))
+		}
+		logger.WithFields(logrus.Fields{
+			"threshold": option.Config.HubbleFlowAggregationThreshold,
+			"interval":  option.Config.HubbleFlowAggregationInterval,
+			"verdicts":  option.Config.HubbleFlowAggregationVerdicts,
+		}).Info("Enabling Hubble flow aggregation")
+		aggregator := aggregation.NewAggregator(aggregation.Config{
+			Threshold: uint64(option.Config.HubbleFlowAggregationThreshold),
+			Interval:  option.Config.HubbleFlowAggregationInterval,
+			Verdicts:  verdicts,
+		})
+		// The aggregator observes the raw monitor events to account the
+		// packet sizes of the flows it aggregates.
+		observerOpts = append(observerOpts,
+			observeroption.WithOnMonitorEvent(aggregator),
+			observeroption.WithOnDecodedFlow(aggregator),
+		)
+	}
+
 	payloadParser, err := parser.New(logger, d, d, d, d, d)
 	if err != nil {
 		logger.WithError(err).Error("Failed to initialize Hubble")
@@ -149,10 +184,26 @@ func (d *Daemon) launchHubble() {
 		if err != nil {
 			logger.WithError(err).Error("Failed to configure Hubble export")
 		} else {
+			d.hubbleExporters = append(d.hubbleExporters, hubbleExporter)
 			opt := observeroption.WithOnDecodedEvent(hubbleExporter)
 			observerOpts = append(observerOpts, opt)
 		}
 	}
+	if option.Config.HubbleExportConfigPath != "" {
+		dynamicExporter, err := exporter.NewDynamicExporter(logger, option.Config.HubbleExportConfigPath)
+		if err != nil {
+			logger.WithError(err).Error("Failed to configure dynamic Hubble export")
+		} else {
+			go func() {
+				if err := dynamicExporter.Watch(d.ctx); err != nil {
+					logger.WithError(err).Error("Failed to watch Hubble exporter configuration")
+				}
+			}()
+			d.hubbleExporters = append(d.hubbleExporters, dynamicExporter)
+			opt := observeroption.WithOnDecodedEvent(dynamicExporter)
+			observerOpts = append(observerOpts, opt)
+		}
+	}
 
 	d.hubbleObserver, err = observer.NewLocalServer(payloadParser, logger,
 		observerOpts...,
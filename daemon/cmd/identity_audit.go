@@ -0,0 +1,75 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/identity/identitymanager"
+	"github.com/cilium/cilium/pkg/ipcache"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const identityAuditControllerName = "identity-leak-audit"
+
+// collectIdentityUsers returns the set of identities which are known to be in
+// use, i.e. referenced by an endpoint, an ipcache entry or a cached selector.
+func (d *Daemon) collectIdentityUsers() map[identity.NumericIdentity]struct{} {
+	users := map[identity.NumericIdentity]struct{}{}
+
+	for _, model := range identitymanager.GetIdentityModels() {
+		users[identity.NumericIdentity(model.Identity.ID)] = struct{}{}
+	}
+	for _, id := range ipcache.IPIdentityCache.UsedIdentities() {
+		users[id] = struct{}{}
+	}
+	for _, mapping := range d.policy.GetSelectorCache().GetModel() {
+		for _, id := range mapping.Identities {
+			users[identity.NumericIdentity(id)] = struct{}{}
+		}
+	}
+
+	return users
+}
+
+// startIdentityAuditor starts the periodic audit which cross-checks allocated
+// local identities against their known users and reports identities held only
+// by leaked references. It is controlled by the identity-audit-interval and
+// identity-audit-repair options.
+func (d *Daemon) startIdentityAuditor() {
+	if option.Config.IdentityAuditInterval == 0 {
+		return
+	}
+
+	controller.NewManager().UpdateController(identityAuditControllerName,
+		controller.ControllerParams{
+			RunInterval: option.Config.IdentityAuditInterval,
+			DoFunc: func(ctx context.Context) error {
+				users := d.collectIdentityUsers()
+				leaked, err := d.identityAllocator.AuditLocalIdentities(ctx,
+					func(id identity.NumericIdentity) bool {
+						_, ok := users[id]
+						return ok
+					}, option.Config.IdentityAuditRepair)
+				if len(leaked) > 0 {
+					log.WithField("numIdentities", len(leaked)).
+						Warning("Identity audit found unreferenced identities")
+				}
+				return err
+			},
+		})
+}
@@ -0,0 +1,200 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/endpoint"
+	"github.com/cilium/cilium/pkg/labelsfilter"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const (
+	// labelsfilterDigestFilename is the name of the file in the state
+	// directory recording the digest of the identity relevant labels
+	// configuration the local endpoint identities were computed with.
+	labelsfilterDigestFilename = "labelsfilter.digest"
+
+	// identityMigrationControllerName is the name of the controller lazily
+	// recomputing endpoint identities after a labels filter change.
+	identityMigrationControllerName = "identity-migration"
+
+	// identityMigrationInterval is the interval between identity migration
+	// controller runs.
+	identityMigrationInterval = 30 * time.Second
+
+	// identityMigrationBatchSize is the maximum number of endpoints for
+	// which a new identity is resolved per controller run. Pacing the
+	// migration avoids regenerating all endpoints at once.
+	identityMigrationBatchSize = 10
+)
+
+// identityMigrationState tracks the progress of the lazy identity migration
+// performed after the identity relevant labels configuration has changed.
+type identityMigrationState struct {
+	lock.Mutex
+
+	// needed is true when a labels filter change was detected on startup
+	// and restored endpoints kept their old identities.
+	needed bool
+
+	// completed is true once all outdated endpoints have been migrated.
+	completed bool
+
+	// total is the number of endpoints found outdated when the migration
+	// started.
+	total int
+
+	// remaining is the number of endpoints still carrying an identity
+	// computed with the old labels filter configuration.
+	remaining int
+}
+
+func labelsfilterDigestPath() string {
+	return filepath.Join(option.Config.StateDir, labelsfilterDigestFilename)
+}
+
+// labelsfilterChanged returns true if a labels filter configuration digest
+// from a previous run is on disk and differs from the current configuration.
+func labelsfilterChanged() bool {
+	stored, err := os.ReadFile(labelsfilterDigestPath())
+	if err != nil {
+		return false
+	}
+	return strings.TrimSpace(string(stored)) != labelsfilter.ConfigDigest()
+}
+
+// storeLabelsfilterDigest persists the digest of the current identity
+// relevant labels configuration in the state directory.
+func storeLabelsfilterDigest() error {
+	return os.WriteFile(labelsfilterDigestPath(), []byte(labelsfilter.ConfigDigest()+"\n"), 0644)
+}
+
+// startIdentityMigration stores the current labels filter digest and, if a
+// configuration change was detected while restoring endpoints, starts the
+// controller migrating the restored endpoints to their new identities. It is
+// intended to be called once restored endpoints have been regenerated.
+func (d *Daemon) startIdentityMigration() {
+	d.identityMigration.Lock()
+	needed := d.identityMigration.needed
+	d.identityMigration.Unlock()
+
+	if !needed {
+		if err := storeLabelsfilterDigest(); err != nil {
+			log.WithError(err).Warning("Unable to store labels filter configuration digest")
+		}
+		return
+	}
+
+	controller.NewManager().UpdateController(identityMigrationControllerName,
+		controller.ControllerParams{
+			RunInterval: identityMigrationInterval,
+			DoFunc:      d.migrateOutdatedIdentities,
+			Context:     d.ctx,
+		},
+	)
+}
+
+// migrateOutdatedIdentities resolves new identities for a batch of endpoints
+// whose identity labels no longer match the configured labels filter. Each
+// endpoint keeps a reference to its old identity until it has been
+// regenerated with the new one, so policies selecting either identity remain
+// effective throughout the migration.
+func (d *Daemon) migrateOutdatedIdentities(ctx context.Context) error {
+	var outdated []*endpoint.Endpoint
+	for _, ep := range d.endpointManager.GetEndpoints() {
+		if ep.IdentityLabelsOutdated() {
+			outdated = append(outdated, ep)
+		}
+	}
+
+	migrated := 0
+	for _, ep := range outdated {
+		if migrated >= identityMigrationBatchSize {
+			break
+		}
+		if ep.ApplyLabelsFilter(ctx) {
+			migrated++
+		}
+	}
+
+	remaining := len(outdated) - migrated
+
+	d.identityMigration.Lock()
+	if d.identityMigration.total < len(outdated) {
+		d.identityMigration.total = len(outdated)
+	}
+	d.identityMigration.remaining = remaining
+	d.identityMigration.completed = remaining == 0
+	total := d.identityMigration.total
+	d.identityMigration.Unlock()
+
+	if option.Config.MetricsConfig.IdentityMigrationRemainingEnabled {
+		metrics.IdentityMigrationRemaining.Set(float64(remaining))
+	}
+
+	if remaining > 0 {
+		log.WithField("remaining", remaining).Info("Identity migration in progress")
+		return nil
+	}
+
+	if err := storeLabelsfilterDigest(); err != nil {
+		log.WithError(err).Warning("Unable to store labels filter configuration digest")
+		return err
+	}
+
+	log.WithField("migrated", total).Info("Identity migration completed")
+	return controller.NewExitReason("identity migration completed")
+}
+
+// getIdentityMigrationStatus returns the status of the identity migration or
+// nil if identity migration is disabled.
+func (d *Daemon) getIdentityMigrationStatus() *models.Status {
+	if !option.Config.EnableIdentityMigration {
+		return nil
+	}
+
+	d.identityMigration.Lock()
+	defer d.identityMigration.Unlock()
+
+	switch {
+	case !d.identityMigration.needed:
+		return &models.Status{
+			State: models.StatusStateOk,
+			Msg:   "Identity relevant labels configuration unchanged",
+		}
+	case !d.identityMigration.completed:
+		return &models.Status{
+			State: models.StatusStateWarning,
+			Msg: fmt.Sprintf("%d/%d endpoints waiting for a new identity",
+				d.identityMigration.remaining, d.identityMigration.total),
+		}
+	default:
+		return &models.Status{
+			State: models.StatusStateOk,
+			Msg:   fmt.Sprintf("%d endpoints migrated to a new identity", d.identityMigration.total),
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/defaults"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const ipamOrphanGCControllerName = "ipam-orphaned-ip-gc"
+
+// ipamReservedOwners are the owners of allocations made by the agent itself
+// which never correspond to an endpoint and must not be reclaimed.
+var ipamReservedOwners = map[string]struct{}{
+	"router": {},
+	"health": {},
+}
+
+// collectOrphanedIPs returns all allocated IPs which neither belong to an
+// agent-internal reservation nor to any known endpoint. The CNI plugin keeps
+// no state of its own, it delegates allocation to the agent API, so a crashed
+// CNI DEL leaves the allocation here as the only trace of the sandbox.
+func (d *Daemon) collectOrphanedIPs() []string {
+	allocv4, allocv6, _ := d.ipam.Dump()
+
+	orphaned := []string{}
+	for ip, owner := range allocv4 {
+		if _, ok := ipamReservedOwners[owner]; ok {
+			continue
+		}
+		if ep := d.endpointManager.LookupIPv4(ip); ep == nil {
+			orphaned = append(orphaned, ip)
+		}
+	}
+	for ip, owner := range allocv6 {
+		if _, ok := ipamReservedOwners[owner]; ok {
+			continue
+		}
+		if ep := d.endpointManager.LookupIPv6(ip); ep == nil {
+			orphaned = append(orphaned, ip)
+		}
+	}
+
+	return orphaned
+}
+
+// startIPAMOrphanGC starts the periodic reconciliation which cross-checks the
+// IPAM allocator's in-use IPs against existing endpoints and releases IPs
+// which have remained without an owner for a full grace period. It is
+// controlled by the ipam-orphan-gc-interval and ipam-orphan-gc-report-only
+// options.
+func (d *Daemon) startIPAMOrphanGC() {
+	if option.Config.IPAMOrphanGCInterval == 0 {
+		return
+	}
+
+	// The grace period matches the expiration timeout handed out for API
+	// allocations, so an in-flight CNI ADD is given the same time to
+	// attach an endpoint to its IP before the allocation is reclaimed.
+	gracePeriod := defaults.IPAMExpiration
+	firstSeen := map[string]time.Time{}
+
+	controller.NewManager().UpdateController(ipamOrphanGCControllerName,
+		controller.ControllerParams{
+			RunInterval: option.Config.IPAMOrphanGCInterval,
+			DoFunc: func(ctx context.Context) error {
+				orphaned := d.collectOrphanedIPs()
+				now := time.Now()
+
+				current := map[string]struct{}{}
+				for _, ip := range orphaned {
+					current[ip] = struct{}{}
+					since, ok := firstSeen[ip]
+					if !ok {
+						firstSeen[ip] = now
+						continue
+					}
+					if now.Sub(since) < gracePeriod {
+						continue
+					}
+					scopedLog := log.WithField(logfields.IPAddr, ip)
+					if option.Config.IPAMOrphanGCReportOnly {
+						scopedLog.Warning("IP has no owning endpoint and would be reclaimed")
+						continue
+					}
+					if err := d.ipam.ReleaseIPString(ip); err != nil {
+						scopedLog.WithError(err).Warning("Unable to release orphaned IP")
+						continue
+					}
+					scopedLog.Info("Released orphaned IP with no owning endpoint")
+					metrics.IpamReclaimedIPs.Inc()
+					delete(firstSeen, ip)
+				}
+
+				// Forget candidates which have found an owner again.
+				for ip := range firstSeen {
+					if _, ok := current[ip]; !ok {
+						delete(firstSeen, ip)
+					}
+				}
+
+				metrics.IpamOrphanedIPs.Set(float64(len(firstSeen)))
+				return nil
+			},
+		})
+}
@@ -16,12 +16,15 @@ package cmd
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/cilium/cilium/api/v1/models"
 	. "github.com/cilium/cilium/api/v1/server/restapi/service"
 	"github.com/cilium/cilium/pkg/api"
 	"github.com/cilium/cilium/pkg/loadbalancer"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/maps/ctmap"
+	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/service"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -139,6 +142,30 @@ func (h *deleteServiceID) Handle(params DeleteServiceIDParams) middleware.Respon
 	}
 }
 
+type deleteServiceIDAffinity struct {
+	svc *service.Service
+}
+
+func NewDeleteServiceIDAffinityHandler(svc *service.Service) DeleteServiceIDAffinityHandler {
+	return &deleteServiceIDAffinity{svc: svc}
+}
+
+func (h *deleteServiceIDAffinity) Handle(params DeleteServiceIDAffinityParams) middleware.Responder {
+	log.WithField(logfields.Params, logfields.Repr(params)).Debug("DELETE /service/{id}/affinity request")
+
+	found, err := h.svc.PurgeServiceAffinity(loadbalancer.ServiceID(params.ID))
+	switch {
+	case err != nil:
+		log.WithError(err).WithField(logfields.ServiceID, params.ID).
+			Warn("DELETE /service/{id}/affinity: error purging session affinity")
+		return api.Error(DeleteServiceIDAffinityFailureCode, err)
+	case !found:
+		return NewDeleteServiceIDAffinityNotFound()
+	default:
+		return NewDeleteServiceIDAffinityOK()
+	}
+}
+
 type getServiceID struct {
 	svc *service.Service
 }
@@ -156,6 +183,64 @@ func (h *getServiceID) Handle(params GetServiceIDParams) middleware.Responder {
 	return NewGetServiceIDNotFound()
 }
 
+type getServiceIDConnections struct {
+	svc *service.Service
+}
+
+func NewGetServiceIDConnectionsHandler(svc *service.Service) GetServiceIDConnectionsHandler {
+	return &getServiceIDConnections{svc: svc}
+}
+
+func (h *getServiceIDConnections) Handle(params GetServiceIDConnectionsParams) middleware.Responder {
+	log.WithField(logfields.Params, logfields.Repr(params)).Debug("GET /service/{id}/connections request")
+
+	svc, ok := h.svc.GetDeepCopyServiceByID(loadbalancer.ServiceID(params.ID))
+	if !ok {
+		return NewGetServiceIDConnectionsNotFound()
+	}
+
+	maps := ctmap.GlobalMaps(option.Config.EnableIPv4, option.Config.EnableIPv6)
+	counts, err := ctmap.CountBackendConnections(maps, uint16(params.ID))
+	if err != nil {
+		log.WithError(err).WithField(logfields.ServiceID, params.ID).
+			Warn("GET /service/{id}/connections: error counting backend connections")
+		return api.Error(GetServiceIDConnectionsFailureCode, err)
+	}
+
+	backendByID := make(map[uint64]*loadbalancer.Backend, len(svc.Backends))
+	for i, be := range svc.Backends {
+		backendByID[uint64(be.ID)] = &svc.Backends[i]
+	}
+
+	list := make([]*models.BackendConnections, 0, len(backendByID))
+	for id, count := range counts {
+		bc := &models.BackendConnections{
+			BackendID:   int64(id),
+			Connections: int64(count),
+		}
+		if be, found := backendByID[id]; found {
+			bc.BackendAddress = be.GetBackendModel()
+		} else {
+			// The backend is gone from the service, but established
+			// connections towards it are still being tracked.
+			bc.Stale = true
+		}
+		list = append(list, bc)
+	}
+	// Report configured backends without any tracked connection as well.
+	for id, be := range backendByID {
+		if _, found := counts[id]; !found {
+			list = append(list, &models.BackendConnections{
+				BackendID:      int64(id),
+				BackendAddress: be.GetBackendModel(),
+			})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].BackendID < list[j].BackendID })
+
+	return NewGetServiceIDConnectionsOK().WithPayload(list)
+}
+
 type getService struct {
 	svc *service.Service
 }
@@ -16,6 +16,7 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	stdlog "log"
@@ -24,16 +25,20 @@ import (
 	"sync"
 	"time"
 
+	flowpb "github.com/cilium/cilium/api/v1/flow"
 	"github.com/cilium/cilium/api/v1/models"
 	. "github.com/cilium/cilium/api/v1/server/restapi/policy"
 	"github.com/cilium/cilium/pkg/api"
+	"github.com/cilium/cilium/pkg/controller"
 	"github.com/cilium/cilium/pkg/endpoint"
 	"github.com/cilium/cilium/pkg/endpoint/regeneration"
 	"github.com/cilium/cilium/pkg/eventqueue"
+	"github.com/cilium/cilium/pkg/hubble/container"
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/identity/cache"
 	"github.com/cilium/cilium/pkg/ipcache"
 	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	bpfIPCache "github.com/cilium/cilium/pkg/maps/ipcache"
 	"github.com/cilium/cilium/pkg/metrics"
@@ -102,6 +107,47 @@ func (d *Daemon) UpdateIdentities(added, deleted cache.IdentityCache) {
 	d.TriggerPolicyUpdates(false, "one or more identities created or deleted")
 }
 
+// headerMatchSecretSyncInterval is the interval at which the k8s secrets
+// referenced by header matches of imported policies are checked for rotation.
+const headerMatchSecretSyncInterval = time.Minute
+
+// initHeaderMatchSecretSync starts the controller detecting rotation of the
+// k8s secrets referenced by header matches of imported policies. A rotated
+// secret is resolved again and the refreshed network policy is published to
+// the L7 proxy of each affected endpoint, without regenerating the endpoints.
+func (d *Daemon) initHeaderMatchSecretSync() {
+	controller.NewManager().UpdateController("policy-secret-sync",
+		controller.ControllerParams{
+			DoFunc: func(ctx context.Context) error {
+				return d.syncHeaderMatchSecrets(ctx)
+			},
+			RunInterval: headerMatchSecretSyncInterval,
+			Context:     d.ctx,
+		})
+}
+
+func (d *Daemon) syncHeaderMatchSecrets(ctx context.Context) error {
+	rotated := d.certManager.RotatedSecrets(ctx)
+	if len(rotated) == 0 {
+		return nil
+	}
+	log.WithField("secrets", rotated).Debug("Header match secrets rotated, updating proxy policies")
+
+	var lastErr error
+	for _, epID := range d.policy.RefreshHeaderMatchSecrets(rotated) {
+		ep := d.endpointManager.LookupCiliumID(uint16(epID))
+		if ep == nil {
+			continue
+		}
+		if err := ep.SyncProxyNetworkPolicy(ctx); err != nil {
+			log.WithError(err).WithField(logfields.EndpointID, epID).
+				Warning("Failed to publish rotated header match secrets to the proxy")
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
 type getPolicyResolve struct {
 	daemon *Daemon
 }
@@ -209,6 +255,225 @@ func (h *getPolicyResolve) Handle(params GetPolicyResolveParams) middleware.Resp
 	return NewGetPolicyResolveOK().WithPayload(&result)
 }
 
+const (
+	// defaultDryRunFlows is the number of recent flows evaluated by a
+	// policy dry-run when the request does not specify a bound.
+	defaultDryRunFlows = 1000
+
+	// maxDryRunResults bounds the number of aggregated flow entries kept
+	// in memory and returned by a policy dry-run.
+	maxDryRunResults = 1000
+)
+
+type postPolicyDryRun struct {
+	daemon *Daemon
+}
+
+func NewPostPolicyDryRunHandler(d *Daemon) PostPolicyDryRunHandler {
+	return &postPolicyDryRun{daemon: d}
+}
+
+// dryRunIdentityNotifier satisfies the selector cache's identity notifier
+// without registering candidate FQDN selectors with the DNS proxy. FQDN
+// selectors in candidate rules therefore select no identities during a
+// dry-run.
+type dryRunIdentityNotifier struct {
+	lock.Mutex
+}
+
+func (n *dryRunIdentityNotifier) RegisterForIdentityUpdatesLocked(selector policyAPI.FQDNSelector) []identity.NumericIdentity {
+	return nil
+}
+
+func (n *dryRunIdentityNotifier) UnregisterForIdentityUpdatesLocked(selector policyAPI.FQDNSelector) {
+}
+
+// newDryRunRepository returns a detached policy repository containing only
+// the given rules, with selectors resolved against the current set of
+// security identities. The repository is never connected to any endpoint or
+// datapath state; release it with releaseDryRunRepository when done.
+func (d *Daemon) newDryRunRepository(rules policyAPI.Rules) *policy.Repository {
+	repo := policy.NewPolicyRepository(d.identityAllocator.GetIdentityCache(), nil)
+	repo.GetSelectorCache().SetLocalIdentityNotifier(&dryRunIdentityNotifier{})
+	repo.AddList(rules)
+	return repo
+}
+
+// releaseDryRunRepository stops the queues spawned for a dry-run repository.
+func releaseDryRunRepository(repo *policy.Repository) {
+	repo.RepositoryChangeQueue.Stop()
+	repo.RuleReactionQueue.Stop()
+}
+
+// dryRunFlowL4 extracts the destination port and protocol of a flow for
+// policy evaluation. Flows without policy relevant L4 information are
+// evaluated L3-only.
+func dryRunFlowL4(flow *flowpb.Flow) (port uint16, protocol string, ok bool) {
+	switch l4 := flow.GetL4().GetProtocol().(type) {
+	case *flowpb.Layer4_TCP:
+		return uint16(l4.TCP.GetDestinationPort()), models.PortProtocolTCP, true
+	case *flowpb.Layer4_UDP:
+		return uint16(l4.UDP.GetDestinationPort()), models.PortProtocolUDP, true
+	}
+	return 0, "", false
+}
+
+// dryRunFlowVerdict evaluates a single observed flow against the candidate
+// policy repository and returns the verdict the flow would receive. Returns
+// false if the flow cannot be evaluated, e.g. because the security identity
+// labels of one of the peers are unknown.
+func dryRunFlowVerdict(repo *policy.Repository, flow *flowpb.Flow) (policyAPI.Decision, bool) {
+	srcLabels := labels.NewSelectLabelArrayFromModel(flow.GetSource().GetLabels())
+	dstLabels := labels.NewSelectLabelArrayFromModel(flow.GetDestination().GetLabels())
+	if len(srcLabels) == 0 || len(dstLabels) == 0 {
+		return policyAPI.Undecided, false
+	}
+
+	searchCtx := policy.SearchContext{
+		From: srcLabels,
+		To:   dstLabels,
+	}
+	if port, protocol, ok := dryRunFlowL4(flow); ok {
+		searchCtx.DPorts = []*models.Port{{Port: port, Protocol: protocol}}
+	}
+
+	repo.Mutex.RLock()
+	defer repo.Mutex.RUnlock()
+
+	// Mirror the enforcement semantics of the policy repository: with
+	// default enforcement, peers not selected by any candidate rule do
+	// not have policy enforcement enabled and traffic is allowed.
+	defaultEnforcement := policy.GetPolicyEnabled() == option.DefaultEnforcement
+
+	switch flow.GetTrafficDirection() {
+	case flowpb.TrafficDirection_INGRESS:
+		if defaultEnforcement {
+			if toIngress, _ := repo.GetRulesMatching(dstLabels); !toIngress {
+				return policyAPI.Allowed, true
+			}
+		}
+		return repo.AllowsIngressRLocked(&searchCtx), true
+	case flowpb.TrafficDirection_EGRESS:
+		if defaultEnforcement {
+			if _, fromEgress := repo.GetRulesMatching(srcLabels); !fromEgress {
+				return policyAPI.Allowed, true
+			}
+		}
+		return repo.AllowsEgressRLocked(&searchCtx), true
+	}
+
+	return policyAPI.Undecided, false
+}
+
+func (h *postPolicyDryRun) Handle(params PostPolicyDryRunParams) middleware.Responder {
+	log.WithField(logfields.Params, logfields.Repr(params)).Debug("POST /policy/dry-run request")
+
+	d := h.daemon
+
+	var rules policyAPI.Rules
+	if err := json.Unmarshal([]byte(params.Spec.Policy), &rules); err != nil {
+		return NewPostPolicyDryRunInvalidPolicy()
+	}
+
+	for _, r := range rules {
+		if err := r.Sanitize(); err != nil {
+			return api.Error(PostPolicyDryRunInvalidPolicyCode, err)
+		}
+	}
+
+	if d.hubbleObserver == nil {
+		return api.Error(PostPolicyDryRunFailureCode,
+			fmt.Errorf("Hubble is not enabled, no observed flows to evaluate"))
+	}
+
+	if policy.GetPolicyEnabled() == option.NeverEnforce {
+		return api.Error(PostPolicyDryRunFailureCode,
+			fmt.Errorf("policy enforcement is disabled for the daemon"))
+	}
+
+	maxFlows := params.Spec.Flows
+	if maxFlows <= 0 {
+		maxFlows = defaultDryRunFlows
+	}
+
+	repo := d.newDryRunRepository(rules)
+	defer releaseDryRunRepository(repo)
+
+	result := &models.PolicyDryRunResult{}
+	aggregated := make(map[string]*models.PolicyDryRunFlow)
+
+	ring := d.hubbleObserver.GetRingBuffer()
+	reader := container.NewRingReader(ring, ring.LastWriteParallel())
+	for result.FlowsEvaluated < maxFlows {
+		event, err := reader.Previous()
+		if err != nil {
+			// Reached the oldest entry still in the ring buffer.
+			break
+		}
+		flow := event.GetFlow()
+		if flow == nil {
+			continue
+		}
+
+		var observed policyAPI.Decision
+		switch flow.GetVerdict() {
+		case flowpb.Verdict_FORWARDED:
+			observed = policyAPI.Allowed
+		case flowpb.Verdict_DROPPED:
+			observed = policyAPI.Denied
+		default:
+			continue
+		}
+
+		// Replies are allowed by connection tracking, not by policy.
+		if flow.GetIsReply().GetValue() {
+			continue
+		}
+
+		verdict, ok := dryRunFlowVerdict(repo, flow)
+		if !ok {
+			continue
+		}
+		result.FlowsEvaluated++
+		if verdict == observed {
+			continue
+		}
+		result.FlowsChanged++
+
+		port, protocol, _ := dryRunFlowL4(flow)
+		direction := strings.ToLower(flow.GetTrafficDirection().String())
+		key := fmt.Sprintf("%s|%s|%d|%s|%s",
+			strings.Join(flow.GetSource().GetLabels(), ","),
+			strings.Join(flow.GetDestination().GetLabels(), ","),
+			port, protocol, direction)
+		if entry, ok := aggregated[key]; ok {
+			entry.Count++
+			continue
+		}
+		if len(aggregated) >= maxDryRunResults {
+			result.Truncated = true
+			continue
+		}
+		aggregated[key] = &models.PolicyDryRunFlow{
+			Count:             1,
+			SourceLabels:      flow.GetSource().GetLabels(),
+			DestinationLabels: flow.GetDestination().GetLabels(),
+			DestinationPort:   int64(port),
+			Protocol:          protocol,
+			TrafficDirection:  direction,
+			Verdict:           observed.String(),
+			DryRunVerdict:     verdict.String(),
+		}
+	}
+
+	result.Changed = make([]*models.PolicyDryRunFlow, 0, len(aggregated))
+	for _, entry := range aggregated {
+		result.Changed = append(result.Changed, entry)
+	}
+
+	return NewPostPolicyDryRunOK().WithPayload(result)
+}
+
 // PolicyAddEvent is a wrapper around the parameters for policyAdd.
 type PolicyAddEvent struct {
 	rules policyAPI.Rules
@@ -337,28 +602,40 @@ func (d *Daemon) policyAdd(sourceRules policyAPI.Rules, opts *policy.AddOptions,
 
 	endpointsToRegen := policy.NewEndpointSet(nil)
 
+	var replaceLabels []labels.LabelArray
 	if opts != nil {
 		if opts.Replace {
 			for _, r := range sourceRules {
-				oldRules := d.policy.SearchRLocked(r.Labels)
-				removedPrefixes = append(removedPrefixes, policy.GetCIDRPrefixes(oldRules)...)
-				if len(oldRules) > 0 {
-					deletedRules, _, _ := d.policy.DeleteByLabelsLocked(r.Labels)
-					deletedRules.UpdateRulesEndpointsCaches(endpointsToBumpRevision, endpointsToRegen, &policySelectionWG)
-				}
+				replaceLabels = append(replaceLabels, r.Labels)
 			}
 		}
 		if len(opts.ReplaceWithLabels) > 0 {
-			oldRules := d.policy.SearchRLocked(opts.ReplaceWithLabels)
-			removedPrefixes = append(removedPrefixes, policy.GetCIDRPrefixes(oldRules)...)
-			if len(oldRules) > 0 {
-				deletedRules, _, _ := d.policy.DeleteByLabelsLocked(opts.ReplaceWithLabels)
-				deletedRules.UpdateRulesEndpointsCaches(endpointsToBumpRevision, endpointsToRegen, &policySelectionWG)
-			}
+			replaceLabels = append(replaceLabels, opts.ReplaceWithLabels)
 		}
 	}
+	for _, lbls := range replaceLabels {
+		oldRules := d.policy.SearchRLocked(lbls)
+		removedPrefixes = append(removedPrefixes, policy.GetCIDRPrefixes(oldRules)...)
+	}
 
-	addedRules, newRev := d.policy.AddListLocked(sourceRules)
+	// The replacement is transactional: if the new rule set fails
+	// validation, the repository is left unchanged and the old rules remain
+	// in place.
+	addedRules, deletedRules, newRev, err := d.policy.ReplaceByLabelsLocked(sourceRules, replaceLabels)
+	if err != nil {
+		d.policy.Mutex.Unlock()
+		// Release the references taken above; the repository was not
+		// modified.
+		ipcache.ReleaseCIDRs(prefixes)
+		_ = d.prefixLengths.Delete(prefixes)
+		logger.WithError(err).Warn("Policy replacement failed validation, keeping old rules")
+		resChan <- &PolicyAddResult{
+			newRev: 0,
+			err:    api.Error(PutPolicyFailureCode, err),
+		}
+		return
+	}
+	deletedRules.UpdateRulesEndpointsCaches(endpointsToBumpRevision, endpointsToRegen, &policySelectionWG)
 
 	// The information needed by the caller is available at this point, signal
 	// accordingly.
@@ -0,0 +1,106 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package cmd
+
+import (
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/policy"
+	"github.com/cilium/cilium/pkg/policy/api"
+
+	. "gopkg.in/check.v1"
+)
+
+type PolicyDryRunSuite struct {
+	oldPolicyEnabled string
+}
+
+var _ = Suite(&PolicyDryRunSuite{})
+
+func (s *PolicyDryRunSuite) SetUpSuite(c *C) {
+	s.oldPolicyEnabled = policy.GetPolicyEnabled()
+	policy.SetPolicyEnabled(option.DefaultEnforcement)
+}
+
+func (s *PolicyDryRunSuite) TearDownSuite(c *C) {
+	policy.SetPolicyEnabled(s.oldPolicyEnabled)
+}
+
+func dryRunTestFlow(src, dst string, direction flowpb.TrafficDirection, port uint32) *flowpb.Flow {
+	return &flowpb.Flow{
+		TrafficDirection: direction,
+		Source:           &flowpb.Endpoint{Labels: []string{src}},
+		Destination:      &flowpb.Endpoint{Labels: []string{dst}},
+		L4: &flowpb.Layer4{
+			Protocol: &flowpb.Layer4_TCP{
+				TCP: &flowpb.TCP{DestinationPort: port},
+			},
+		},
+	}
+}
+
+func (s *PolicyDryRunSuite) TestDryRunFlowVerdict(c *C) {
+	rule := api.Rule{
+		EndpointSelector: api.NewESFromLabels(labels.ParseSelectLabel("app=foo")),
+		Ingress: []api.IngressRule{{
+			IngressCommonRule: api.IngressCommonRule{
+				FromEndpoints: []api.EndpointSelector{
+					api.NewESFromLabels(labels.ParseSelectLabel("app=bar")),
+				},
+			},
+			ToPorts: []api.PortRule{CNPAllowTCP80},
+		}},
+	}
+	c.Assert(rule.Sanitize(), IsNil)
+
+	repo := policy.NewPolicyRepository(nil, nil)
+	defer releaseDryRunRepository(repo)
+	repo.GetSelectorCache().SetLocalIdentityNotifier(&dryRunIdentityNotifier{})
+	repo.AddList(api.Rules{&rule})
+
+	// Selected by the candidate policy, allowed peer and port.
+	verdict, ok := dryRunFlowVerdict(repo, dryRunTestFlow(
+		"k8s:app=bar", "k8s:app=foo", flowpb.TrafficDirection_INGRESS, 80))
+	c.Assert(ok, Equals, true)
+	c.Assert(verdict, Equals, api.Allowed)
+
+	// Selected by the candidate policy, peer not allowed.
+	verdict, ok = dryRunFlowVerdict(repo, dryRunTestFlow(
+		"k8s:app=baz", "k8s:app=foo", flowpb.TrafficDirection_INGRESS, 80))
+	c.Assert(ok, Equals, true)
+	c.Assert(verdict, Equals, api.Denied)
+
+	// Selected by the candidate policy, port not allowed.
+	verdict, ok = dryRunFlowVerdict(repo, dryRunTestFlow(
+		"k8s:app=bar", "k8s:app=foo", flowpb.TrafficDirection_INGRESS, 8080))
+	c.Assert(ok, Equals, true)
+	c.Assert(verdict, Equals, api.Denied)
+
+	// Not selected by any candidate rule: default enforcement leaves the
+	// peer without policy enforcement.
+	verdict, ok = dryRunFlowVerdict(repo, dryRunTestFlow(
+		"k8s:app=bar", "k8s:app=other", flowpb.TrafficDirection_INGRESS, 80))
+	c.Assert(ok, Equals, true)
+	c.Assert(verdict, Equals, api.Allowed)
+
+	// Flows without identity labels cannot be evaluated.
+	_, ok = dryRunFlowVerdict(repo, &flowpb.Flow{
+		TrafficDirection: flowpb.TrafficDirection_INGRESS,
+	})
+	c.Assert(ok, Equals, false)
+}
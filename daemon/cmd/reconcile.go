@@ -0,0 +1,89 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const (
+	mapReconciliationControllerName = "datapath-map-reconciliation"
+
+	// mapReconciliationMaxRepairs bounds the number of repairs applied to
+	// a single map type per reconciliation run to limit the map churn of
+	// one run. The remainder is repaired by subsequent runs.
+	mapReconciliationMaxRepairs = 128
+)
+
+// initMapReconciliation starts the periodic reconciliation of the BPF maps
+// owned by the agent against its in-memory desired state. Individual map
+// update bugs can leave entries out of sync until the next agent restart;
+// the reconciliation detects and repairs such drift.
+func (d *Daemon) initMapReconciliation() {
+	interval := option.Config.DatapathMapReconciliationInterval
+	if interval == 0 {
+		return
+	}
+
+	controller.NewManager().UpdateController(mapReconciliationControllerName,
+		controller.ControllerParams{
+			DoFunc:      d.reconcileDatapathMaps,
+			RunInterval: interval,
+			Context:     d.ctx,
+		})
+}
+
+// reportMapReconciliation accounts the repairs made to the maps of the given
+// type during one reconciliation run.
+func reportMapReconciliation(mapName string, repairs int, err error) {
+	if err != nil {
+		log.WithError(err).WithField(logfields.BPFMapName, mapName).
+			Warning("Reconciliation of BPF maps failed")
+	}
+	if repairs == 0 {
+		return
+	}
+	if option.Config.MetricsConfig.DatapathReconciliationRepairsEnabled {
+		metrics.DatapathReconciliationRepairs.WithLabelValues(mapName).Add(float64(repairs))
+	}
+}
+
+func (d *Daemon) reconcileDatapathMaps(ctx context.Context) error {
+	repairs, err := d.svc.ReconcileLBMaps(mapReconciliationMaxRepairs)
+	reportMapReconciliation("lbmap", repairs, err)
+
+	if d.ipcacheListener != nil {
+		repairs, err = d.ipcacheListener.Reconcile(mapReconciliationMaxRepairs)
+		reportMapReconciliation("ipcache", repairs, err)
+	}
+
+	var policyMapRepairs int
+	var policyMapErr error
+	for _, ep := range d.endpointManager.GetEndpoints() {
+		repairs, err := ep.ReconcilePolicyMap()
+		policyMapRepairs += repairs
+		if err != nil && policyMapErr == nil {
+			policyMapErr = err
+		}
+	}
+	reportMapReconciliation("policymap", policyMapRepairs, policyMapErr)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+// drainShutdown performs a best-effort graceful drain before the agent
+// exits: the L7 proxies stop accepting new connections and in-flight
+// requests are given a chance to complete, the Hubble flows buffered so
+// far are processed, and the flow exporters are flushed. The drain is
+// bounded by the configured drain window and never delays shutdown beyond
+// it, even if a proxy connection hangs.
+func (d *Daemon) drainShutdown() {
+	if option.Config.ShutdownDrainTimeout <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), option.Config.ShutdownDrainTimeout)
+	defer cancel()
+
+	log.WithField(logfields.Duration, option.Config.ShutdownDrainTimeout).
+		Info("Draining in-flight work before shutdown")
+
+	if d.l7Proxy != nil {
+		if err := d.l7Proxy.Drain(ctx); err != nil {
+			log.WithError(err).Warning("Proxy redirects did not drain within the shutdown drain window")
+		}
+	}
+
+	// Give the Hubble observer a chance to process the monitor events
+	// which are already queued so that they reach the exporters before
+	// those are flushed.
+	if d.hubbleObserver != nil {
+		queue := d.hubbleObserver.GetEventsChannel()
+	drain:
+		for len(queue) > 0 {
+			select {
+			case <-ctx.Done():
+				log.Warning("Buffered Hubble events were not processed within the shutdown drain window")
+				break drain
+			case <-time.After(50 * time.Millisecond):
+			}
+		}
+	}
+
+	for _, exporter := range d.hubbleExporters {
+		if err := exporter.Stop(); err != nil {
+			log.WithError(err).Warning("Failed to flush Hubble exporter")
+		}
+	}
+}
@@ -176,6 +176,14 @@ func (d *Daemon) restoreOldEndpoints(state *endpointRestoreState, clean bool) er
 		}
 	}
 
+	skipLabelsfilter := option.Config.EnableIdentityMigration && labelsfilterChanged()
+	if skipLabelsfilter {
+		log.Info("Identity relevant labels configuration changed, restored endpoints keep their old identity until migrated")
+		d.identityMigration.Lock()
+		d.identityMigration.needed = true
+		d.identityMigration.Unlock()
+	}
+
 	for _, ep := range state.possible {
 		scopedLog := log.WithField(logfields.EndpointID, ep.ID)
 		if k8s.IsEnabled() {
@@ -215,6 +223,9 @@ func (d *Daemon) restoreOldEndpoints(state *endpointRestoreState, clean bool) er
 		ep.SetDefaultConfiguration(true)
 		ep.SetProxy(d.l7Proxy)
 		ep.SkipStateClean()
+		if skipLabelsfilter {
+			ep.SkipLabelsfilterOnRestore()
+		}
 
 		state.restored = append(state.restored, ep)
 
@@ -409,6 +420,15 @@ func (d *Daemon) initRestore(restoredEndpoints *endpointRestoreState) chan struc
 		// No restore happened, end parallel map mode immediately
 		endParallelMapMode()
 	}
+
+	if option.Config.EnableIdentityMigration {
+		go func() {
+			if restoreComplete != nil {
+				<-restoreComplete
+			}
+			d.startIdentityMigration()
+		}()
+	}
 	bootstrapStats.restore.End(true)
 
 	return restoreComplete
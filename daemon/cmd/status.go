@@ -17,6 +17,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"net"
 	"strings"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/cilium/cilium/pkg/backoff"
 	"github.com/cilium/cilium/pkg/controller"
 	"github.com/cilium/cilium/pkg/datapath"
+	"github.com/cilium/cilium/pkg/datapath/linux/ipsec"
 	datapathOption "github.com/cilium/cilium/pkg/datapath/option"
 	"github.com/cilium/cilium/pkg/k8s"
 	k8smetrics "github.com/cilium/cilium/pkg/k8s/metrics"
@@ -388,6 +390,19 @@ func NewGetHealthzHandler(d *Daemon) GetHealthzHandler {
 	return &getHealthz{daemon: d}
 }
 
+// nodeNameByIP returns the name of the cluster node owning the given IP
+// address, or an empty string if the IP does not belong to any known node.
+func (d *Daemon) nodeNameByIP(ip net.IP) string {
+	for _, node := range d.nodeDiscovery.Manager.GetNodes() {
+		for _, addr := range node.IPAddresses {
+			if addr.IP.Equal(ip) {
+				return node.Fullname()
+			}
+		}
+	}
+	return ""
+}
+
 func (d *Daemon) getNodeStatus() *models.ClusterStatus {
 	clusterStatus := models.ClusterStatus{
 		Self: d.nodeDiscovery.LocalNode.Fullname(),
@@ -621,6 +636,7 @@ func (d *Daemon) getStatus(brief bool) models.StatusResponse {
 	}
 
 	sr.Stale = stale
+	sr.IdentityMigration = d.getIdentityMigrationStatus()
 
 	// CiliumVersion definition
 	ver := version.GetCiliumVersion()
@@ -930,8 +946,15 @@ func (d *Daemon) startStatusCollector() {
 			Probe: func(ctx context.Context) (interface{}, error) {
 				switch {
 				case option.Config.EnableIPSec:
+					var msg string
+					status, err := ipsec.Status(d.nodeNameByIP)
+					if err != nil {
+						msg = err.Error()
+					}
 					return &models.EncryptionStatus{
-						Mode: models.EncryptionStatusModeIPsec,
+						Mode:  models.EncryptionStatusModeIPsec,
+						Msg:   msg,
+						Ipsec: status,
 					}, nil
 				case option.Config.EnableWireguard:
 					var msg string
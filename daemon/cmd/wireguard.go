@@ -0,0 +1,60 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	restapi "github.com/cilium/cilium/api/v1/server/restapi/daemon"
+	"github.com/cilium/cilium/pkg/api"
+	"github.com/cilium/cilium/pkg/node"
+	"github.com/cilium/cilium/pkg/option"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+type postWireguardRotateKey struct {
+	daemon *Daemon
+}
+
+// NewPostWireguardRotateKeyHandler returns a new instance of the handler
+// rotating the Wireguard key of the local node
+func NewPostWireguardRotateKeyHandler(d *Daemon) restapi.PostWireguardRotateKeyHandler {
+	return &postWireguardRotateKey{daemon: d}
+}
+
+func (h *postWireguardRotateKey) Handle(params restapi.PostWireguardRotateKeyParams) middleware.Responder {
+	if !option.Config.EnableWireguard {
+		return api.Error(restapi.PostWireguardRotateKeyFailureCode,
+			fmt.Errorf("wireguard is not enabled"))
+	}
+
+	wgAgent := h.daemon.datapath.WireguardAgent()
+	if wgAgent == nil {
+		return api.Error(restapi.PostWireguardRotateKeyFailureCode,
+			fmt.Errorf("wireguard agent is not available"))
+	}
+
+	if err := wgAgent.RotateKey(); err != nil {
+		return api.Error(restapi.PostWireguardRotateKeyFailureCode, err)
+	}
+
+	// Publish the new public key to peers. Remote nodes keep the old key
+	// accepted for a grace window while the annotation propagates.
+	h.daemon.nodeDiscovery.LocalNode.WireguardPubKey = node.GetWireguardPubKey()
+	h.daemon.nodeDiscovery.UpdateCiliumNodeResource()
+
+	return restapi.NewPostWireguardRotateKeyOK()
+}
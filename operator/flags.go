@@ -76,6 +76,9 @@ func init() {
 	flags.Duration(operatorOption.CNPStatusUpdateInterval, 1*time.Second, "Interval between CNP status updates sent to the k8s-apiserver per-CNP")
 	option.BindEnv(operatorOption.CNPStatusUpdateInterval)
 
+	flags.Int(operatorOption.CNPStatusMaxNodeErrors, 0, "Aggregate the per-node CNP statuses into a bounded summary containing success and failure counts plus the errors of up to this many nodes, instead of one status entry per node (0 to disable)")
+	option.BindEnv(operatorOption.CNPStatusMaxNodeErrors)
+
 	flags.BoolP(option.DebugArg, "D", false, "Enable debugging mode")
 	option.BindEnv(option.DebugArg)
 
@@ -226,6 +229,14 @@ func init() {
 		fmt.Sprintf("Maximum number of security identities that will be deleted within the %s", operatorOption.IdentityGCRateInterval))
 	option.BindEnv(operatorOption.IdentityGCRateLimit)
 
+	flags.Int(operatorOption.IdentityGCBatchSize, 1,
+		fmt.Sprintf("Number of security identities deleted per batch during garbage collection. The rate limit configured via %s and %s is applied per batch instead of per identity", operatorOption.IdentityGCRateInterval, operatorOption.IdentityGCRateLimit))
+	option.BindEnv(operatorOption.IdentityGCBatchSize)
+
+	flags.Bool(operatorOption.IdentityGCDryRun, false,
+		"Only report security identities that would have been garbage collected instead of deleting them")
+	option.BindEnv(operatorOption.IdentityGCDryRun)
+
 	flags.String(option.KVStore, "", "Key-value store type")
 	option.BindEnv(option.KVStore)
 
@@ -254,6 +265,10 @@ func init() {
 	flags.Duration(operatorOption.NodesGCInterval, 2*time.Minute, "GC interval for nodes store in the kvstore")
 	option.BindEnv(operatorOption.NodesGCInterval)
 
+	flags.Duration(operatorOption.PodCIDRReleaseAfter, 0,
+		fmt.Sprintf("Duration for which a node must have had zero endpoints before its surplus pod CIDRs are released back to the cluster pool (0 to disable). Requires '%s=%s'", option.IPAM, ipamOption.IPAMClusterPool))
+	option.BindEnv(operatorOption.PodCIDRReleaseAfter)
+
 	flags.String(operatorOption.OperatorPrometheusServeAddr, operatorOption.PrometheusServeAddr, "Address to serve Prometheus metrics")
 	option.BindEnv(operatorOption.OperatorPrometheusServeAddr)
 
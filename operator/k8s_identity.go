@@ -37,15 +37,18 @@ import (
 
 var identityStore cache.Store
 
+// identitiesPendingDeletion is the set of identities which were found to be
+// stale during the previous garbage collection run, mapped to the resource
+// version at which they were observed. An identity is only deleted once it
+// has been stale for two consecutive runs. This gives agents which have just
+// restarted a full GC interval to report a lifesign for the identities they
+// are still using.
+var identitiesPendingDeletion = map[string]string{}
+
 // deleteIdentity deletes an identity. It includes the resource version and
 // will error if the object has since been changed.
 func deleteIdentity(ctx context.Context, identity *v2.CiliumIdentity) error {
-	// Wait until we can delete an identity
-	err := identityRateLimiter.Wait(ctx)
-	if err != nil {
-		return err
-	}
-	err = ciliumK8sClient.CiliumV2().CiliumIdentities().Delete(
+	err := ciliumK8sClient.CiliumV2().CiliumIdentities().Delete(
 		ctx,
 		identity.Name,
 		metav1.DeleteOptions{
@@ -89,9 +92,10 @@ func identityGCIteration(ctx context.Context) {
 
 	identityStoreList := identityStore.List()
 	totalEntries := len(identityStoreList)
-	deletedEntries := 0
 
 	timeNow := time.Now()
+	staleIdentities := make([]*v2.CiliumIdentity, 0)
+	pendingDeletion := make(map[string]string, len(identitiesPendingDeletion))
 	for _, identityObject := range identityStoreList {
 		identity, ok := identityObject.(*v2.CiliumIdentity)
 		if !ok {
@@ -106,22 +110,35 @@ func identityGCIteration(ctx context.Context) {
 			identityHeartbeat.MarkAlive(identity.Name, timeNow)
 			continue
 		}
-		if !identityHeartbeat.IsAlive(identity.Name) {
-			log.WithFields(logrus.Fields{
-				logfields.Identity: identity,
-			}).Debug("Deleting unused identity")
-			if err := deleteIdentity(ctx, identity); err != nil {
-				log.WithError(err).WithFields(logrus.Fields{
-					logfields.Identity: identity,
-				}).Error("Deleting unused identity")
-				// If Context was canceled we should break
-				if ctx.Err() != nil {
-					break
-				}
-			} else {
-				deletedEntries++
-			}
+		if identityHeartbeat.IsAlive(identity.Name) {
+			continue
+		}
+
+		// Only delete identities which were already stale in the
+		// previous run and have not been modified since. Identities
+		// becoming stale for the first time are given one more GC
+		// interval in which a just-restarted agent can report a
+		// lifesign for them.
+		if rv, marked := identitiesPendingDeletion[identity.Name]; !marked || rv != identity.ResourceVersion {
+			pendingDeletion[identity.Name] = identity.ResourceVersion
+			continue
 		}
+
+		log.WithFields(logrus.Fields{
+			logfields.Identity: identity,
+		}).Debug("Deleting unused identity")
+		staleIdentities = append(staleIdentities, identity)
+	}
+	identitiesPendingDeletion = pendingDeletion
+
+	deletedEntries := 0
+	if operatorOption.Config.IdentityGCDryRun {
+		for _, identity := range staleIdentities {
+			log.WithField(logfields.Identity, identity.Name).
+				Info("Dry run: identity would have been garbage collected")
+		}
+	} else {
+		deletedEntries = deleteIdentitiesInBatches(ctx, staleIdentities)
 	}
 
 	if operatorOption.Config.EnableMetrics {
@@ -135,11 +152,49 @@ func identityGCIteration(ctx context.Context) {
 		aliveEntries := totalEntries - deletedEntries
 		metrics.IdentityGCSize.WithLabelValues(metrics.LabelValueOutcomeAlive).Set(float64(aliveEntries))
 		metrics.IdentityGCSize.WithLabelValues(metrics.LabelValueOutcomeDeleted).Set(float64(deletedEntries))
+		metrics.IdentityGCCandidates.Set(float64(len(staleIdentities)))
 	}
 
 	identityHeartbeat.GC()
 }
 
+// deleteIdentitiesInBatches deletes the given identities in batches of
+// operatorOption.Config.IdentityGCBatchSize, waiting for the identity rate
+// limiter before each batch. It returns the number of identities deleted.
+func deleteIdentitiesInBatches(ctx context.Context, identities []*v2.CiliumIdentity) int {
+	batchSize := operatorOption.Config.IdentityGCBatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	deleted := 0
+	for start := 0; start < len(identities); start += batchSize {
+		// Wait until we can delete the next batch of identities
+		if err := identityRateLimiter.Wait(ctx); err != nil {
+			return deleted
+		}
+		end := start + batchSize
+		if end > len(identities) {
+			end = len(identities)
+		}
+		for _, identity := range identities[start:end] {
+			if err := deleteIdentity(ctx, identity); err != nil {
+				log.WithError(err).WithFields(logrus.Fields{
+					logfields.Identity: identity,
+				}).Error("Deleting unused identity")
+				// If Context was canceled we should break
+				if ctx.Err() != nil {
+					return deleted
+				}
+			} else {
+				deleted++
+			}
+		}
+	}
+
+	return deleted
+}
+
 func startCRDIdentityGC() {
 	if operatorOption.Config.EndpointGCInterval == 0 {
 		log.Fatal("The CiliumIdentity garbage collector requires the CiliumEndpoint garbage collector to be enabled")
@@ -0,0 +1,165 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/operator/identity"
+	operatorOption "github.com/cilium/cilium/operator/option"
+	"github.com/cilium/cilium/operator/watchers"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	"github.com/cilium/cilium/pkg/k8s/client/clientset/versioned/fake"
+	"github.com/cilium/cilium/pkg/rate"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sCache "k8s.io/client-go/tools/cache"
+)
+
+const numStaleIdentities = 10000
+
+// setUpIdentityGC points the identity GC at a fake clientset holding the
+// given number of stale identities and returns a function restoring the
+// previous state.
+func setUpIdentityGC(numIdentities int) func() {
+	oldClient := ciliumK8sClient
+	oldStore := identityStore
+	oldHeartbeat := identityHeartbeat
+	oldRateLimiter := identityRateLimiter
+	oldPendingDeletion := identitiesPendingDeletion
+	oldBatchSize := operatorOption.Config.IdentityGCBatchSize
+	oldDryRun := operatorOption.Config.IdentityGCDryRun
+
+	identities := make([]runtime.Object, 0, numIdentities)
+	identityStore = k8sCache.NewStore(k8sCache.DeletionHandlingMetaNamespaceKeyFunc)
+	for i := 0; i < numIdentities; i++ {
+		identity := &v2.CiliumIdentity{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            fmt.Sprintf("%d", i),
+				ResourceVersion: "1",
+			},
+		}
+		identities = append(identities, identity)
+		identityStore.Add(identity)
+	}
+	ciliumK8sClient = fake.NewSimpleClientset(identities...)
+
+	// A zero heartbeat timeout makes every identity without a lifesign
+	// immediately stale.
+	identityHeartbeat = identity.NewIdentityHeartbeatStore(0)
+	identityRateLimiter = rate.NewLimiter(time.Minute, int64(numIdentities))
+	identitiesPendingDeletion = map[string]string{}
+	operatorOption.Config.IdentityGCBatchSize = 100
+	operatorOption.Config.IdentityGCDryRun = false
+
+	// identityGCIteration waits for the CiliumEndpoint store to be synced
+	select {
+	case <-watchers.CiliumEndpointsSynced:
+	default:
+		close(watchers.CiliumEndpointsSynced)
+	}
+
+	return func() {
+		identityRateLimiter.Stop()
+		ciliumK8sClient = oldClient
+		identityStore = oldStore
+		identityHeartbeat = oldHeartbeat
+		identityRateLimiter = oldRateLimiter
+		identitiesPendingDeletion = oldPendingDeletion
+		operatorOption.Config.IdentityGCBatchSize = oldBatchSize
+		operatorOption.Config.IdentityGCDryRun = oldDryRun
+	}
+}
+
+func numRemainingIdentities(t *testing.T) int {
+	t.Helper()
+	identityList, err := ciliumK8sClient.CiliumV2().CiliumIdentities().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list identities: %s", err)
+	}
+	return len(identityList.Items)
+}
+
+// TestIdentityGCBatchedDeletion runs the identity GC against 10k stale
+// identities and verifies that they survive the first iteration as deletion
+// candidates and are all deleted in batches during the second one.
+func TestIdentityGCBatchedDeletion(t *testing.T) {
+	tearDown := setUpIdentityGC(numStaleIdentities)
+	defer tearDown()
+
+	// The first iteration only marks the identities as deletion
+	// candidates, giving just-restarted agents one GC interval to report
+	// a lifesign for them.
+	identityGCIteration(context.TODO())
+	if remaining := numRemainingIdentities(t); remaining != numStaleIdentities {
+		t.Fatalf("expected %d identities after the first GC run, got %d",
+			numStaleIdentities, remaining)
+	}
+	if len(identitiesPendingDeletion) != numStaleIdentities {
+		t.Fatalf("expected %d deletion candidates, got %d",
+			numStaleIdentities, len(identitiesPendingDeletion))
+	}
+
+	identityGCIteration(context.TODO())
+	if remaining := numRemainingIdentities(t); remaining != 0 {
+		t.Fatalf("expected all identities to be deleted, %d remain", remaining)
+	}
+}
+
+// TestIdentityGCDryRun verifies that in dry-run mode stale identities are
+// only reported and never deleted.
+func TestIdentityGCDryRun(t *testing.T) {
+	tearDown := setUpIdentityGC(numStaleIdentities)
+	defer tearDown()
+
+	operatorOption.Config.IdentityGCDryRun = true
+
+	identityGCIteration(context.TODO())
+	identityGCIteration(context.TODO())
+	if remaining := numRemainingIdentities(t); remaining != numStaleIdentities {
+		t.Fatalf("expected %d identities to survive the dry run, got %d",
+			numStaleIdentities, remaining)
+	}
+}
+
+// TestIdentityGCSkipsModifiedCandidates verifies that an identity modified
+// after being marked as a deletion candidate is not deleted.
+func TestIdentityGCSkipsModifiedCandidates(t *testing.T) {
+	tearDown := setUpIdentityGC(2)
+	defer tearDown()
+
+	identityGCIteration(context.TODO())
+
+	// Bump the resource version of the first identity, as an agent
+	// re-using the identity would.
+	identityObject, _, err := identityStore.GetByKey("0")
+	if err != nil {
+		t.Fatalf("failed to get identity from store: %s", err)
+	}
+	identity := identityObject.(*v2.CiliumIdentity).DeepCopy()
+	identity.ResourceVersion = "2"
+	identityStore.Update(identity)
+
+	identityGCIteration(context.TODO())
+	if remaining := numRemainingIdentities(t); remaining != 1 {
+		t.Fatalf("expected the modified identity to survive, %d identities remain", remaining)
+	}
+}
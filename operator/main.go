@@ -406,6 +406,12 @@ func onOperatorStartLeading(ctx context.Context) {
 			// it can allocate podCIDRs for the nodes that don't have a podCIDR
 			// set.
 			nm.Resync(context.Background(), time.Time{})
+
+			if operatorOption.Config.PodCIDRReleaseAfter != 0 {
+				if releaser, ok := nm.(podCIDRReleaser); ok {
+					startPodCIDRRebalancer(releaser)
+				}
+			}
 		}
 	default:
 		startSynchronizingCiliumNodes(NOPNodeManager)
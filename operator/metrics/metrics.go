@@ -93,6 +93,18 @@ var (
 
 	// IdentityGCRuns records how many times identity GC has run
 	IdentityGCRuns *prometheus.GaugeVec
+
+	// IdentityGCCandidates records the number of stale identities detected
+	// by the last identity GC run
+	IdentityGCCandidates prometheus.Gauge
+
+	// PodCIDRReleases records the number of pod CIDRs released back to the
+	// cluster pool
+	PodCIDRReleases prometheus.Counter
+
+	// PodCIDRsHeld records the number of pod CIDRs held by nodes without
+	// endpoints
+	PodCIDRsHeld prometheus.Gauge
 )
 
 const (
@@ -138,6 +150,27 @@ func registerMetrics() []prometheus.Collector {
 	}, []string{LabelOutcome})
 	collectors = append(collectors, IdentityGCRuns)
 
+	IdentityGCCandidates = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "identity_gc_candidates",
+		Help:      "The number of stale identities detected by the last garbage collector run. In dry-run mode these identities are only reported, not deleted",
+	})
+	collectors = append(collectors, IdentityGCCandidates)
+
+	PodCIDRReleases = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "podcidr_releases_total",
+		Help:      "The total number of pod CIDRs released back to the cluster pool",
+	})
+	collectors = append(collectors, PodCIDRReleases)
+
+	PodCIDRsHeld = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "podcidr_held",
+		Help:      "The number of pod CIDRs held by nodes which currently have no endpoints",
+	})
+	collectors = append(collectors, PodCIDRsHeld)
+
 	Registry.MustRegister(collectors...)
 
 	return collectors
@@ -54,6 +54,10 @@ const (
 	// being sent to the K8s apiserver for a given CNP.
 	CNPStatusUpdateInterval = "cnp-status-update-interval"
 
+	// CNPStatusMaxNodeErrors is the maximum number of example node errors
+	// kept in the aggregated status summary of a CNP.
+	CNPStatusMaxNodeErrors = "cnp-status-max-node-errors"
+
 	// EnableMetrics enables prometheus metrics.
 	EnableMetrics = "enable-metrics"
 
@@ -63,6 +67,14 @@ const (
 	// will simply return.
 	EndpointGCInterval = "cilium-endpoint-gc-interval"
 
+	// IdentityGCBatchSize is the number of identities deleted per batch
+	// during the GC of identities.
+	IdentityGCBatchSize = "identity-gc-batch-size"
+
+	// IdentityGCDryRun makes the GC of identities only report the
+	// identities that would have been deleted instead of deleting them.
+	IdentityGCDryRun = "identity-gc-dry-run"
+
 	// IdentityGCInterval is the interval in which allocator identities are
 	// attempted to be expired from the kvstore
 	IdentityGCInterval = "identity-gc-interval"
@@ -81,6 +93,11 @@ const (
 	// NodesGCInterval is the duration for which the nodes are GC in the KVStore.
 	NodesGCInterval = "nodes-gc-interval"
 
+	// PodCIDRReleaseAfter is the duration for which a node must have had
+	// zero endpoints before its surplus pod CIDRs are released back to the
+	// cluster pool.
+	PodCIDRReleaseAfter = "pod-cidr-release-after"
+
 	// OperatorAPIServeAddr IP:Port on which to serve api requests in
 	// operator (pass ":Port" to bind on all interfaces, "" is off)
 	OperatorAPIServeAddr = "operator-api-serve-addr"
@@ -214,6 +231,12 @@ type OperatorConfig struct {
 	// being sent to the K8s apiserver for a given CNP.
 	CNPStatusUpdateInterval time.Duration
 
+	// CNPStatusMaxNodeErrors is the maximum number of example node errors
+	// kept in the aggregated status summary of a CNP. If non-zero, the
+	// per-node statuses reported via the kvstore are aggregated into a
+	// bounded summary instead of being written into the CNP one by one.
+	CNPStatusMaxNodeErrors int
+
 	// EnableMetrics enables prometheus metrics.
 	EnableMetrics bool
 
@@ -223,6 +246,14 @@ type OperatorConfig struct {
 	// will simply return.
 	EndpointGCInterval time.Duration
 
+	// IdentityGCBatchSize is the number of identities deleted per batch
+	// during the GC of identities.
+	IdentityGCBatchSize int
+
+	// IdentityGCDryRun makes the GC of identities only report the
+	// identities that would have been deleted instead of deleting them.
+	IdentityGCDryRun bool
+
 	// IdentityGCInterval is the interval in which allocator identities are
 	// attempted to be expired from the kvstore
 	IdentityGCInterval time.Duration
@@ -241,6 +272,11 @@ type OperatorConfig struct {
 	// NodesGCInterval is the duration for which the nodes are GC in the KVStore.
 	NodesGCInterval time.Duration
 
+	// PodCIDRReleaseAfter is the duration for which a node must have had
+	// zero endpoints before its surplus pod CIDRs are released back to the
+	// cluster pool.
+	PodCIDRReleaseAfter time.Duration
+
 	OperatorAPIServeAddr        string
 	OperatorPrometheusServeAddr string
 
@@ -374,13 +410,17 @@ type OperatorConfig struct {
 func (c *OperatorConfig) Populate() {
 	c.CNPNodeStatusGCInterval = viper.GetDuration(CNPNodeStatusGCInterval)
 	c.CNPStatusUpdateInterval = viper.GetDuration(CNPStatusUpdateInterval)
+	c.CNPStatusMaxNodeErrors = viper.GetInt(CNPStatusMaxNodeErrors)
 	c.EnableMetrics = viper.GetBool(EnableMetrics)
 	c.EndpointGCInterval = viper.GetDuration(EndpointGCInterval)
+	c.IdentityGCBatchSize = viper.GetInt(IdentityGCBatchSize)
+	c.IdentityGCDryRun = viper.GetBool(IdentityGCDryRun)
 	c.IdentityGCInterval = viper.GetDuration(IdentityGCInterval)
 	c.IdentityGCRateInterval = viper.GetDuration(IdentityGCRateInterval)
 	c.IdentityGCRateLimit = viper.GetInt64(IdentityGCRateLimit)
 	c.IdentityHeartbeatTimeout = viper.GetDuration(IdentityHeartbeatTimeout)
 	c.NodesGCInterval = viper.GetDuration(NodesGCInterval)
+	c.PodCIDRReleaseAfter = viper.GetDuration(PodCIDRReleaseAfter)
 	c.OperatorAPIServeAddr = viper.GetString(OperatorAPIServeAddr)
 	c.OperatorPrometheusServeAddr = viper.GetString(OperatorPrometheusServeAddr)
 	c.PProf = viper.GetBool(PProf)
@@ -0,0 +1,129 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/cilium/cilium/operator/metrics"
+	operatorOption "github.com/cilium/cilium/operator/option"
+	"github.com/cilium/cilium/operator/watchers"
+	"github.com/cilium/cilium/pkg/annotation"
+	"github.com/cilium/cilium/pkg/controller"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+
+	"github.com/sirupsen/logrus"
+)
+
+// podCIDRReleaser is the subset of the cluster-pool node manager used to
+// release surplus pod CIDRs of scaled-down nodes.
+type podCIDRReleaser interface {
+	ReleaseSurplusCIDRs(node *v2.CiliumNode) (released, held int)
+}
+
+// podCIDRZeroSince maps a node name to the time at which it was first
+// observed without any endpoints.
+var podCIDRZeroSince = map[string]time.Time{}
+
+var podCIDRReleaseCheckInterval = time.Minute
+
+// podCIDRReleaseIteration is a single iteration of the pod CIDR release
+// reconciler. It releases the surplus pod CIDRs of all nodes which have had
+// zero endpoints for at least operatorOption.Config.PodCIDRReleaseAfter and
+// are not exempted via the annotation.PodCIDRReleaseExempt annotation.
+// A pod may be scheduled onto a node between the endpoint count check and the
+// release. This is safe because the first pod CIDR of each address family is
+// never released, so the node can keep allocating IPs from it, and because a
+// new endpoint resets the zero-endpoint period of the node on the next
+// iteration.
+func podCIDRReleaseIteration(releaser podCIDRReleaser) {
+	if ciliumNodeStore == nil {
+		return
+	}
+
+	totalReleased := 0
+	totalHeld := 0
+	timeNow := time.Now()
+	nodeNames := map[string]struct{}{}
+	for _, nodeObject := range ciliumNodeStore.List() {
+		node, ok := nodeObject.(*v2.CiliumNode)
+		if !ok {
+			log.WithField(logfields.Object, nodeObject).
+				Errorf("Saw %T object while expecting *v2.CiliumNode", nodeObject)
+			continue
+		}
+		nodeNames[node.GetName()] = struct{}{}
+
+		if _, ok := node.GetAnnotations()[annotation.PodCIDRReleaseExempt]; ok {
+			delete(podCIDRZeroSince, node.GetName())
+			continue
+		}
+		// A node hosting endpoints keeps all of its pod CIDRs.
+		if watchers.CEPCountOnNode(node) != 0 {
+			delete(podCIDRZeroSince, node.GetName())
+			continue
+		}
+		zeroSince, ok := podCIDRZeroSince[node.GetName()]
+		if !ok {
+			podCIDRZeroSince[node.GetName()] = timeNow
+			continue
+		}
+		if timeNow.Sub(zeroSince) < operatorOption.Config.PodCIDRReleaseAfter {
+			continue
+		}
+
+		released, held := releaser.ReleaseSurplusCIDRs(node)
+		totalReleased += released
+		totalHeld += held
+		if released != 0 {
+			log.WithFields(logrus.Fields{
+				logfields.NodeName: node.GetName(),
+				"released":         released,
+			}).Info("Released surplus pod CIDRs of node without endpoints")
+		}
+	}
+
+	// Forget nodes which no longer exist.
+	for nodeName := range podCIDRZeroSince {
+		if _, ok := nodeNames[nodeName]; !ok {
+			delete(podCIDRZeroSince, nodeName)
+		}
+	}
+
+	if operatorOption.Config.EnableMetrics {
+		if totalReleased != 0 {
+			metrics.PodCIDRReleases.Add(float64(totalReleased))
+		}
+		metrics.PodCIDRsHeld.Set(float64(totalHeld))
+	}
+}
+
+// startPodCIDRRebalancer starts the reconciler releasing surplus pod CIDRs
+// of nodes which no longer host any endpoints back to the cluster pool.
+func startPodCIDRRebalancer(releaser podCIDRReleaser) {
+	log.WithField(logfields.Interval, operatorOption.Config.PodCIDRReleaseAfter).
+		Info("Starting pod CIDR release for nodes without endpoints")
+
+	controller.NewManager().UpdateController("podcidr-release",
+		controller.ControllerParams{
+			RunInterval: podCIDRReleaseCheckInterval,
+			DoFunc: func(ctx context.Context) error {
+				podCIDRReleaseIteration(releaser)
+				return ctx.Err()
+			},
+		})
+}
@@ -0,0 +1,192 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	operatorOption "github.com/cilium/cilium/operator/option"
+	"github.com/cilium/cilium/operator/watchers"
+	"github.com/cilium/cilium/pkg/annotation"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	"github.com/cilium/cilium/pkg/node/addressing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+type fakePodCIDRReleaser struct {
+	released map[string]int
+}
+
+func (f *fakePodCIDRReleaser) ReleaseSurplusCIDRs(node *v2.CiliumNode) (released, held int) {
+	f.released[node.GetName()]++
+	return 1, 1
+}
+
+func newRebalanceCiliumNode(name, nodeIP string, annotations map[string]string) *v2.CiliumNode {
+	return &v2.CiliumNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: annotations,
+		},
+		Spec: v2.NodeSpec{
+			Addresses: []v2.NodeAddress{
+				{
+					Type: addressing.NodeInternalIP,
+					IP:   nodeIP,
+				},
+			},
+		},
+	}
+}
+
+func newRebalanceCEP(name, nodeIP string) *v2.CiliumEndpoint {
+	return &v2.CiliumEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Status: v2.EndpointStatus{
+			Networking: &v2.EndpointNetworking{
+				NodeIP: nodeIP,
+			},
+		},
+	}
+}
+
+func setUpPodCIDRRebalanceTest(t *testing.T) {
+	oldCiliumNodeStore := ciliumNodeStore
+	oldCEPStore := watchers.CiliumEndpointStore
+	oldReleaseAfter := operatorOption.Config.PodCIDRReleaseAfter
+	oldEnableMetrics := operatorOption.Config.EnableMetrics
+
+	ciliumNodeStore = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+	watchers.CiliumEndpointStore = cache.NewIndexer(
+		cache.DeletionHandlingMetaNamespaceKeyFunc,
+		cache.Indexers{
+			"node-ip": func(obj interface{}) ([]string, error) {
+				cep, ok := obj.(*v2.CiliumEndpoint)
+				if !ok {
+					return nil, fmt.Errorf("unexpected object type: %T", obj)
+				}
+				return []string{cep.Status.Networking.NodeIP}, nil
+			},
+		})
+	operatorOption.Config.PodCIDRReleaseAfter = 10 * time.Millisecond
+	operatorOption.Config.EnableMetrics = false
+	podCIDRZeroSince = map[string]time.Time{}
+
+	t.Cleanup(func() {
+		ciliumNodeStore = oldCiliumNodeStore
+		watchers.CiliumEndpointStore = oldCEPStore
+		operatorOption.Config.PodCIDRReleaseAfter = oldReleaseAfter
+		operatorOption.Config.EnableMetrics = oldEnableMetrics
+		podCIDRZeroSince = map[string]time.Time{}
+	})
+}
+
+func TestPodCIDRReleaseAfterGracePeriod(t *testing.T) {
+	setUpPodCIDRRebalanceTest(t)
+
+	releaser := &fakePodCIDRReleaser{released: map[string]int{}}
+	node := newRebalanceCiliumNode("node-1", "10.0.0.1", nil)
+	if err := ciliumNodeStore.Add(node); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first iteration only marks the node as a candidate.
+	podCIDRReleaseIteration(releaser)
+	if len(releaser.released) != 0 {
+		t.Fatalf("CIDRs released before the grace period elapsed: %v", releaser.released)
+	}
+	if _, ok := podCIDRZeroSince["node-1"]; !ok {
+		t.Fatal("Expected node-1 to be marked as having zero endpoints")
+	}
+
+	time.Sleep(operatorOption.Config.PodCIDRReleaseAfter)
+	podCIDRReleaseIteration(releaser)
+	if releaser.released["node-1"] != 1 {
+		t.Fatalf("Expected CIDRs of node-1 to be released once, got %v", releaser.released)
+	}
+}
+
+func TestPodCIDRReleaseResetOnNewEndpoint(t *testing.T) {
+	setUpPodCIDRRebalanceTest(t)
+
+	releaser := &fakePodCIDRReleaser{released: map[string]int{}}
+	node := newRebalanceCiliumNode("node-1", "10.0.0.1", nil)
+	if err := ciliumNodeStore.Add(node); err != nil {
+		t.Fatal(err)
+	}
+
+	podCIDRReleaseIteration(releaser)
+
+	// A pod scheduled onto the node during the grace period resets the
+	// zero-endpoint period so no CIDRs are released.
+	cep := newRebalanceCEP("foo", "10.0.0.1")
+	if err := watchers.CiliumEndpointStore.Add(cep); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(operatorOption.Config.PodCIDRReleaseAfter)
+	podCIDRReleaseIteration(releaser)
+	if len(releaser.released) != 0 {
+		t.Fatalf("CIDRs released for a node hosting endpoints: %v", releaser.released)
+	}
+	if _, ok := podCIDRZeroSince["node-1"]; ok {
+		t.Fatal("Expected the zero-endpoint period of node-1 to be reset")
+	}
+
+	// Once the endpoint is gone the node has to go through a full grace
+	// period again before its CIDRs are released.
+	if err := watchers.CiliumEndpointStore.Delete(cep); err != nil {
+		t.Fatal(err)
+	}
+	podCIDRReleaseIteration(releaser)
+	if len(releaser.released) != 0 {
+		t.Fatalf("CIDRs released before the grace period elapsed: %v", releaser.released)
+	}
+	time.Sleep(operatorOption.Config.PodCIDRReleaseAfter)
+	podCIDRReleaseIteration(releaser)
+	if releaser.released["node-1"] != 1 {
+		t.Fatalf("Expected CIDRs of node-1 to be released once, got %v", releaser.released)
+	}
+}
+
+func TestPodCIDRReleaseExemptAnnotation(t *testing.T) {
+	setUpPodCIDRRebalanceTest(t)
+
+	releaser := &fakePodCIDRReleaser{released: map[string]int{}}
+	node := newRebalanceCiliumNode("node-1", "10.0.0.1", map[string]string{
+		annotation.PodCIDRReleaseExempt: "true",
+	})
+	if err := ciliumNodeStore.Add(node); err != nil {
+		t.Fatal(err)
+	}
+
+	podCIDRReleaseIteration(releaser)
+	time.Sleep(operatorOption.Config.PodCIDRReleaseAfter)
+	podCIDRReleaseIteration(releaser)
+	if len(releaser.released) != 0 {
+		t.Fatalf("CIDRs released for an exempted node: %v", releaser.released)
+	}
+	if _, ok := podCIDRZeroSince["node-1"]; ok {
+		t.Fatal("Expected an exempted node to not be tracked as a candidate")
+	}
+}
@@ -30,13 +30,17 @@ import (
 	"k8s.io/client-go/tools/cache"
 )
 
-const identityIndex = "identity"
+const (
+	identityIndex = "identity"
+	nodeIPIndex   = "node-ip"
+)
 
 var (
 	errNoCE  = errors.New("object is not a *cilium_api_v2.CiliumEndpoint")
 	indexers = cache.Indexers{
 		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
 		identityIndex:        identityIndexFunc,
+		nodeIPIndex:          nodeIPIndexFunc,
 	}
 
 	// CiliumEndpointStore contains all CiliumEndpoint present in k8s.
@@ -65,6 +69,18 @@ func identityIndexFunc(obj interface{}) ([]string, error) {
 	return nil, fmt.Errorf("%w - found %T", errNoCE, obj)
 }
 
+// nodeIPIndexFunc indexes CiliumEndpoints by the IP of the node hosting them.
+func nodeIPIndexFunc(obj interface{}) ([]string, error) {
+	switch t := obj.(type) {
+	case *cilium_api_v2.CiliumEndpoint:
+		if t.Status.Networking != nil {
+			return []string{t.Status.Networking.NodeIP}, nil
+		}
+		return []string{""}, nil
+	}
+	return nil, fmt.Errorf("%w - found %T", errNoCE, obj)
+}
+
 // CiliumEndpointsInit starts a CiliumEndpointWatcher
 func CiliumEndpointsInit(ciliumNPClient cilium_cli.CiliumV2Interface, stopCh <-chan struct{}) {
 	once.Do(func() {
@@ -102,7 +118,8 @@ func convertToCiliumEndpoint(obj interface{}) interface{} {
 				OwnerReferences: concreteObj.OwnerReferences,
 			},
 			Status: cilium_api_v2.EndpointStatus{
-				Identity: concreteObj.Status.Identity,
+				Identity:   concreteObj.Status.Identity,
+				Networking: concreteObj.Status.Networking,
 			},
 		}
 		*concreteObj = cilium_api_v2.CiliumEndpoint{}
@@ -123,7 +140,8 @@ func convertToCiliumEndpoint(obj interface{}) interface{} {
 					OwnerReferences: ciliumEndpoint.OwnerReferences,
 				},
 				Status: cilium_api_v2.EndpointStatus{
-					Identity: ciliumEndpoint.Status.Identity,
+					Identity:   ciliumEndpoint.Status.Identity,
+					Networking: ciliumEndpoint.Status.Networking,
 				},
 			},
 		}
@@ -135,6 +153,23 @@ func convertToCiliumEndpoint(obj interface{}) interface{} {
 	}
 }
 
+// CEPCountOnNode returns the number of CiliumEndpoints hosted on the given
+// node, based on the node IP reported in the CiliumEndpoint status.
+func CEPCountOnNode(node *cilium_api_v2.CiliumNode) int {
+	if CiliumEndpointStore == nil {
+		return 0
+	}
+	count := 0
+	for _, addr := range node.Spec.Addresses {
+		if addr.IP == "" {
+			continue
+		}
+		ceps, _ := CiliumEndpointStore.IndexKeys(nodeIPIndex, addr.IP)
+		count += len(ceps)
+	}
+	return count
+}
+
 // HasCEWithIdentity returns true or false if the Cilium Endpoint store has
 // the given identity.
 func HasCEWithIdentity(identity string) bool {
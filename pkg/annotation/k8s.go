@@ -60,6 +60,24 @@ const (
 	// sharing local endpoints.
 	SharedService = Prefix + "/shared-service"
 
+	// ServiceAffinity annotation determines the preferred endpoint destination
+	// for a global service, e.g. "local" to prefer endpoints from the local
+	// cluster, "remote" to prefer endpoints from remote clusters, or "none"
+	// (default) for no preference. Backends from the non-preferred location
+	// are only selected while no preferred backend is available.
+	ServiceAffinity = "service.cilium.io/affinity"
+
+	// ServiceAffinityTimeout overrides the session affinity timeout in
+	// seconds for a service with sessionAffinity: ClientIP. It takes
+	// precedence over the timeout in the sessionAffinityConfig of the
+	// service spec.
+	ServiceAffinityTimeout = Prefix + "/affinity-timeout"
+
+	// ServiceHealthProbePath sets the HTTP path which the active backend
+	// health checker requests on each backend of the service. If the
+	// annotation is not set, backends are probed with a plain TCP connect.
+	ServiceHealthProbePath = Prefix + "/health-probe-path"
+
 	// ProxyVisibility is the annotation name used to indicate whether proxy
 	// visibility should be enabled for a given pod (i.e., all traffic for the
 	// pod is redirected to the proxy for the given port / protocol in the
@@ -71,8 +89,19 @@ const (
 	// both TCP and UDP connection. Current use case is NodeLocalDNS.
 	NoTrack = Prefix + ".no-track-port"
 
+	// LabelPrefixes is the annotation name used on a namespace to configure
+	// additional label filter patterns for pods in that namespace. The value
+	// is a comma separated list of patterns using the same syntax as the
+	// labels agent option, e.g. "!build-id".
+	LabelPrefixes = Prefix + ".label-prefixes"
+
 	// WireguardPubKey is the annotation name used to store the Wireguard
 	// public key in the CiliumNode CRD that we need to use to encrypt traffic
 	// to that node.
 	WireguardPubKey = Prefix + ".network.wg-pub-key"
+
+	// PodCIDRReleaseExempt is the annotation name used on a CiliumNode to
+	// exempt it from having its surplus pod CIDRs released back to the
+	// cluster pool by the operator.
+	PodCIDRReleaseExempt = Prefix + ".podcidr-release-exempt"
 )
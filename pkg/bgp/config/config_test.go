@@ -20,6 +20,7 @@ package config
 import (
 	"strings"
 	"testing"
+	"time"
 
 	. "gopkg.in/check.v1"
 )
@@ -36,6 +37,11 @@ func (s *BGPConfigTestSuite) TestParse(c *C) {
 	config, err := Parse(strings.NewReader(yaml))
 	c.Assert(err, IsNil)
 	c.Assert(config, Not(IsNil))
+	// Timers are configured per peer: the first peer uses a custom hold
+	// time while the second falls back to the default of 90s.
+	c.Assert(config.Peers, HasLen, 2)
+	c.Assert(config.Peers[0].HoldTime, Equals, 30*time.Second)
+	c.Assert(config.Peers[1].HoldTime, Equals, 90*time.Second)
 
 	config, err = Parse(strings.NewReader(json))
 	c.Assert(err, IsNil)
@@ -54,6 +60,10 @@ peers:
   - peer-address: 172.19.0.5
     peer-asn: 64512
     my-asn: 64512
+    hold-time: 30s
+  - peer-address: 172.19.0.6
+    peer-asn: 64512
+    my-asn: 64512
 address-pools:
   - name: default
     protocol: bgp
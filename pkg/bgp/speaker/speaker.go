@@ -18,6 +18,7 @@ package speaker
 
 import (
 	"os"
+	"time"
 
 	bgpconfig "github.com/cilium/cilium/pkg/bgp/config"
 	bgpk8s "github.com/cilium/cilium/pkg/bgp/k8s"
@@ -33,6 +34,12 @@ import (
 	"k8s.io/client-go/util/workqueue"
 )
 
+// endpointDebounceDuration is the duration for which endpoint events of a
+// service are coalesced before being reconciled into BGP announcements. This
+// prevents announcing and withdrawing routes at pod churn frequency when the
+// backends of a service flap.
+const endpointDebounceDuration = 1 * time.Second
+
 // New creates a new MetalLB BGP speaker controller.
 func New() *Speaker {
 	logger := &bgplog.Logger{Entry: log}
@@ -62,9 +69,11 @@ func New() *Speaker {
 	spkr := &Speaker{
 		Controller: c,
 
-		logger:   logger,
-		queue:    workqueue.New(),
-		services: make(map[k8s.ServiceID]*slim_corev1.Service),
+		logger:     logger,
+		queue:      workqueue.New(),
+		epDebounce: endpointDebounceDuration,
+		services:   make(map[k8s.ServiceID]*slim_corev1.Service),
+		pendingEps: make(map[k8s.ServiceID]*metallbspr.Endpoints),
 	}
 	go spkr.run()
 
@@ -84,9 +93,16 @@ type Speaker struct {
 	endpointsGetter endpointsGetter
 	// queue holds all the events to process for the Speaker.
 	queue workqueue.Interface
+	// epDebounce is the duration for which endpoint events of a service
+	// are coalesced before being queued. A zero duration queues endpoint
+	// events immediately.
+	epDebounce time.Duration
 
 	lock.Mutex
 	services map[k8s.ServiceID]*slim_corev1.Service
+	// pendingEps holds the most recent endpoints of services for which a
+	// debounced flush is scheduled.
+	pendingEps map[k8s.ServiceID]*metallbspr.Endpoints
 }
 
 // OnUpdateService notifies the Speaker of an update to a service.
@@ -116,6 +132,7 @@ func (s *Speaker) OnDeleteService(svc *slim_corev1.Service) {
 
 	s.Lock()
 	delete(s.services, svcID)
+	delete(s.pendingEps, svcID)
 	s.Unlock()
 
 	// Passing nil as the service will force the MetalLB speaker to withdraw
@@ -128,19 +145,64 @@ func (s *Speaker) OnDeleteService(svc *slim_corev1.Service) {
 }
 
 // OnUpdateEndpoints notifies the Speaker of an update to the backends of a
-// service.
+// service. Consecutive updates to the backends of the same service are
+// coalesced for the debounce duration so that flapping backends don't
+// translate into BGP route flaps; only the most recent state is reconciled.
 func (s *Speaker) OnUpdateEndpoints(eps *slim_corev1.Endpoints) {
 	svcID := k8s.ParseEndpointsID(eps)
 
 	s.Lock()
 	defer s.Unlock()
-	if svc, ok := s.services[svcID]; ok {
+
+	svc, ok := s.services[svcID]
+	if !ok {
+		return
+	}
+
+	if s.epDebounce == 0 {
 		s.queue.Add(epEvent{
 			id:  svcID,
 			svc: convertService(svc),
 			eps: convertEndpoints(eps),
 		})
+		return
+	}
+
+	if _, scheduled := s.pendingEps[svcID]; scheduled {
+		// A flush is already scheduled, only update the state it will
+		// announce.
+		s.pendingEps[svcID] = convertEndpoints(eps)
+		return
 	}
+
+	s.pendingEps[svcID] = convertEndpoints(eps)
+	time.AfterFunc(s.epDebounce, func() { s.flushEndpoints(svcID) })
+}
+
+// flushEndpoints queues the most recent backend state of the given service
+// recorded during the debounce window.
+func (s *Speaker) flushEndpoints(svcID k8s.ServiceID) {
+	s.Lock()
+	defer s.Unlock()
+
+	eps, ok := s.pendingEps[svcID]
+	if !ok {
+		return
+	}
+	delete(s.pendingEps, svcID)
+
+	svc, ok := s.services[svcID]
+	if !ok {
+		// The service was deleted during the debounce window, the
+		// withdrawal has already been queued by OnDeleteService.
+		return
+	}
+
+	s.queue.Add(epEvent{
+		id:  svcID,
+		svc: convertService(svc),
+		eps: eps,
+	})
 }
 
 // OnUpdateNode notifies the Speaker of an update to a node.
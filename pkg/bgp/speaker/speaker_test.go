@@ -0,0 +1,156 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package speaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/pkg/k8s"
+	slim_corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
+	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
+
+	metallbspr "go.universe.tf/metallb/pkg/speaker"
+	. "gopkg.in/check.v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type BGPSpeakerTestSuite struct{}
+
+var _ = Suite(&BGPSpeakerTestSuite{})
+
+func newTestSpeaker(debounce time.Duration) *Speaker {
+	return &Speaker{
+		queue:      workqueue.New(),
+		epDebounce: debounce,
+		services:   make(map[k8s.ServiceID]*slim_corev1.Service),
+		pendingEps: make(map[k8s.ServiceID]*metallbspr.Endpoints),
+	}
+}
+
+func newTestEndpoints(ips ...string) *slim_corev1.Endpoints {
+	nodeName := "node1"
+	eps := &slim_corev1.Endpoints{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "bar",
+		},
+	}
+	sub := slim_corev1.EndpointSubset{}
+	for _, ip := range ips {
+		sub.Addresses = append(sub.Addresses, slim_corev1.EndpointAddress{
+			IP:       ip,
+			NodeName: &nodeName,
+		})
+	}
+	eps.Subsets = append(eps.Subsets, sub)
+	return eps
+}
+
+func (s *BGPSpeakerTestSuite) TestEndpointDebounce(c *C) {
+	spkr := newTestSpeaker(50 * time.Millisecond)
+
+	svc := &slim_corev1.Service{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "bar",
+		},
+	}
+	svcID := k8s.ParseServiceID(svc)
+	spkr.services[svcID] = svc
+
+	// Flap the backends of the service at a frequency well above the
+	// debounce duration. Only a single event carrying the final backend
+	// state must be reconciled.
+	for i := 0; i < 10; i++ {
+		if i%2 == 0 {
+			spkr.OnUpdateEndpoints(newTestEndpoints())
+		} else {
+			spkr.OnUpdateEndpoints(newTestEndpoints("10.0.0.1"))
+		}
+	}
+
+	c.Assert(spkr.queue.Len(), Equals, 0)
+
+	for i := 0; i < 100 && spkr.queue.Len() == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.Assert(spkr.queue.Len(), Equals, 1)
+
+	key, _ := spkr.queue.Get()
+	ev, ok := key.(epEvent)
+	c.Assert(ok, Equals, true)
+	c.Assert(ev.id, Equals, svcID)
+	c.Assert(ev.eps.Ready, HasLen, 1)
+	c.Assert(ev.eps.Ready[0].IP, Equals, "10.0.0.1")
+	spkr.queue.Done(key)
+
+	// A backend landing after the flush schedules a new flush and is
+	// announced again.
+	spkr.OnUpdateEndpoints(newTestEndpoints("10.0.0.2"))
+	for i := 0; i < 100 && spkr.queue.Len() == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.Assert(spkr.queue.Len(), Equals, 1)
+}
+
+func (s *BGPSpeakerTestSuite) TestEndpointDebounceServiceDeleted(c *C) {
+	spkr := newTestSpeaker(50 * time.Millisecond)
+
+	svc := &slim_corev1.Service{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "bar",
+		},
+	}
+	svcID := k8s.ParseServiceID(svc)
+	spkr.services[svcID] = svc
+
+	// Deleting the service during the debounce window drops the pending
+	// endpoint event: the withdrawal is queued by OnDeleteService.
+	spkr.OnUpdateEndpoints(newTestEndpoints("10.0.0.1"))
+	spkr.OnDeleteService(svc)
+
+	c.Assert(spkr.queue.Len(), Equals, 1)
+	key, _ := spkr.queue.Get()
+	_, ok := key.(svcEvent)
+	c.Assert(ok, Equals, true)
+	spkr.queue.Done(key)
+
+	time.Sleep(100 * time.Millisecond)
+	c.Assert(spkr.queue.Len(), Equals, 0)
+}
+
+func (s *BGPSpeakerTestSuite) TestEndpointNoDebounce(c *C) {
+	spkr := newTestSpeaker(0)
+
+	svc := &slim_corev1.Service{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "bar",
+		},
+	}
+	spkr.services[k8s.ParseServiceID(svc)] = svc
+
+	// With debouncing disabled endpoint events are queued immediately.
+	spkr.OnUpdateEndpoints(newTestEndpoints("10.0.0.1"))
+	c.Assert(spkr.queue.Len(), Equals, 1)
+}
@@ -51,6 +51,14 @@ const (
 	BPF_BTF_GET_FD_BY_ID    = 19
 	BPF_TASK_FD_QUERY       = 20
 
+	BPF_MAP_LOOKUP_AND_DELETE_ELEM  = 21
+	BPF_MAP_FREEZE                  = 22
+	BPF_BTF_GET_NEXT_ID             = 23
+	BPF_MAP_LOOKUP_BATCH            = 24
+	BPF_MAP_LOOKUP_AND_DELETE_BATCH = 25
+	BPF_MAP_UPDATE_BATCH            = 26
+	BPF_MAP_DELETE_BATCH            = 27
+
 	// BPF syscall attach types
 	BPF_CGROUP_INET_INGRESS      = 0
 	BPF_CGROUP_INET_EGRESS       = 1
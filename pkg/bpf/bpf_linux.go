@@ -215,6 +215,121 @@ func DeleteElement(fd int, key unsafe.Pointer) error {
 	return nil
 }
 
+// This struct must be in sync with union bpf_attr's anonymous struct used by
+// the BPF_MAP_*_BATCH commands
+type bpfAttrMapOpBatch struct {
+	inBatch   uint64
+	outBatch  uint64
+	keys      uint64
+	values    uint64
+	count     uint32
+	mapFd     uint32
+	elemFlags uint64
+	flags     uint64
+}
+
+// updateBatch updates 'count' elements of the map in fd from the given arrays
+// of keys and values with a single syscall. It returns the number of elements
+// which were updated; on failure this may be less than 'count', in which case
+// the returned errno refers to the first element which was not processed.
+// Kernels without support for BPF_MAP_UPDATE_BATCH fail with EINVAL, map types
+// without batch operation support with ENOTSUPP.
+func updateBatch(fd int, keys, values unsafe.Pointer, count uint32) (uint32, unix.Errno) {
+	uba := bpfAttrMapOpBatch{
+		keys:   uint64(uintptr(keys)),
+		values: uint64(uintptr(values)),
+		count:  count,
+		mapFd:  uint32(fd),
+	}
+
+	var duration *spanstat.SpanStat
+	if option.Config.MetricsConfig.BPFSyscallDurationEnabled {
+		duration = spanstat.Start()
+	}
+	_, _, err := unix.Syscall(
+		unix.SYS_BPF,
+		BPF_MAP_UPDATE_BATCH,
+		uintptr(unsafe.Pointer(&uba)),
+		unsafe.Sizeof(uba),
+	)
+	runtime.KeepAlive(keys)
+	runtime.KeepAlive(values)
+	runtime.KeepAlive(&uba)
+	if option.Config.MetricsConfig.BPFSyscallDurationEnabled {
+		metrics.BPFSyscallDuration.WithLabelValues(metricOpUpdateBatch, metrics.Errno2Outcome(err)).Observe(duration.End(err == 0).Total().Seconds())
+	}
+
+	return uba.count, err
+}
+
+// lookupBatch retrieves up to 'count' elements of the map in fd into the
+// given arrays of keys and values with a single syscall. Iteration starts at
+// the position pointed to by inBatch, or at the beginning of the map if
+// inBatch is nil, and the position to resume from is stored in outBatch. It
+// returns the number of elements retrieved. When the end of the map has been
+// reached, errno is ENOENT and the returned elements, if any, are valid.
+func lookupBatch(fd int, inBatch, outBatch, keys, values unsafe.Pointer, count uint32) (uint32, unix.Errno) {
+	uba := bpfAttrMapOpBatch{
+		inBatch:  uint64(uintptr(inBatch)),
+		outBatch: uint64(uintptr(outBatch)),
+		keys:     uint64(uintptr(keys)),
+		values:   uint64(uintptr(values)),
+		count:    count,
+		mapFd:    uint32(fd),
+	}
+
+	var duration *spanstat.SpanStat
+	if option.Config.MetricsConfig.BPFSyscallDurationEnabled {
+		duration = spanstat.Start()
+	}
+	_, _, err := unix.Syscall(
+		unix.SYS_BPF,
+		BPF_MAP_LOOKUP_BATCH,
+		uintptr(unsafe.Pointer(&uba)),
+		unsafe.Sizeof(uba),
+	)
+	runtime.KeepAlive(inBatch)
+	runtime.KeepAlive(outBatch)
+	runtime.KeepAlive(keys)
+	runtime.KeepAlive(values)
+	runtime.KeepAlive(&uba)
+	if option.Config.MetricsConfig.BPFSyscallDurationEnabled {
+		metrics.BPFSyscallDuration.WithLabelValues(metricOpLookupBatch, metrics.Errno2Outcome(err)).Observe(duration.End(err == 0).Total().Seconds())
+	}
+
+	return uba.count, err
+}
+
+// deleteBatch deletes 'count' elements of the map in fd from the given array
+// of keys with a single syscall. It returns the number of elements which were
+// deleted; on failure this may be less than 'count', in which case the
+// returned errno refers to the first element which was not processed.
+func deleteBatch(fd int, keys unsafe.Pointer, count uint32) (uint32, unix.Errno) {
+	uba := bpfAttrMapOpBatch{
+		keys:  uint64(uintptr(keys)),
+		count: count,
+		mapFd: uint32(fd),
+	}
+
+	var duration *spanstat.SpanStat
+	if option.Config.MetricsConfig.BPFSyscallDurationEnabled {
+		duration = spanstat.Start()
+	}
+	_, _, err := unix.Syscall(
+		unix.SYS_BPF,
+		BPF_MAP_DELETE_BATCH,
+		uintptr(unsafe.Pointer(&uba)),
+		unsafe.Sizeof(uba),
+	)
+	runtime.KeepAlive(keys)
+	runtime.KeepAlive(&uba)
+	if option.Config.MetricsConfig.BPFSyscallDurationEnabled {
+		metrics.BPFSyscallDuration.WithLabelValues(metricOpDeleteBatch, metrics.Errno2Outcome(err)).Observe(duration.End(err == 0).Total().Seconds())
+	}
+
+	return uba.count, err
+}
+
 // GetNextKeyFromPointers stores, in nextKey, the next key after the key of the
 // map in fd. When there are no more keys, io.EOF is returned.
 func GetNextKeyFromPointers(fd int, structPtr unsafe.Pointer, sizeOfStruct uintptr) error {
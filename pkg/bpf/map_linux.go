@@ -125,6 +125,14 @@ type Map struct {
 	// pressure metric is enabled
 	cache map[string]*cacheEntry
 
+	// batchSupportProbed is true when batchSupport has been initialized
+	// by probing the kernel for batch operation support on this map
+	batchSupportProbed bool
+
+	// batchSupport is true when the kernel supports the batch update and
+	// delete commands on this map
+	batchSupport bool
+
 	// errorResolverLastScheduled is the timestamp when the error resolver
 	// was last scheduled
 	errorResolverLastScheduled time.Time
@@ -728,6 +736,97 @@ func (m *Map) DumpWithCallbackIfExists(cb DumpCallback) error {
 // The caller must provide a callback for handling each entry, and a stats
 // object initialized via a call to NewDumpStats().
 func (m *Map) DumpReliablyWithCallback(cb DumpCallback, stats *DumpStats) error {
+	stats.start()
+	defer stats.finish()
+
+	if err := m.Open(); err != nil {
+		return err
+	}
+
+	// Use the batch lookup API where the kernel supports it, which retrieves
+	// many entries per syscall instead of one.
+	if m.batchSupported() {
+		return m.dumpReliablyBatch(cb, stats)
+	}
+
+	return m.dumpReliablyWalk(cb, stats)
+}
+
+// dumpReliablyBatch iterates over the map with the batch lookup API, which
+// retrieves up to maxBatchEntries entries per syscall. If a bucket of the
+// underlying hash table holds more entries than the current batch size, the
+// kernel fails the lookup with ENOSPC and the batch size is doubled.
+func (m *Map) dumpReliablyBatch(cb DumpCallback, stats *DumpStats) error {
+	var (
+		keySize   = int(m.KeySize)
+		valueSize = int(m.ReadValueSize)
+		batchSize = maxBatchEntries
+
+		// The batch position tokens are opaque to userspace, but are
+		// defined to not exceed the key size.
+		inBatch  = make([]byte, m.KeySize)
+		outBatch = make([]byte, m.KeySize)
+
+		firstBatch = true
+	)
+
+	keyBuf := make([]byte, batchSize*keySize)
+	valueBuf := make([]byte, batchSize*valueSize)
+
+	mk := m.MapKey.DeepCopyMapKey()
+	mv := m.MapValue.DeepCopyMapValue()
+
+	for {
+		var inPtr unsafe.Pointer
+		if !firstBatch {
+			inPtr = unsafe.Pointer(&inBatch[0])
+		}
+
+		stats.Lookup++
+		processed, errno := lookupBatch(m.fd, inPtr, unsafe.Pointer(&outBatch[0]),
+			unsafe.Pointer(&keyBuf[0]), unsafe.Pointer(&valueBuf[0]), uint32(batchSize))
+		switch errno {
+		case 0, unix.ENOENT:
+		case unix.ENOSPC:
+			// A bucket of the hash table holds more entries than the
+			// batch size. Grow the buffers and retry from the same
+			// position.
+			batchSize *= 2
+			keyBuf = make([]byte, batchSize*keySize)
+			valueBuf = make([]byte, batchSize*valueSize)
+			stats.LookupFailed++
+			continue
+		default:
+			stats.LookupFailed++
+			return fmt.Errorf("unable to dump map %s in batches: %w", m.name, errno)
+		}
+
+		var err error
+		for i := 0; i < int(processed); i++ {
+			mk, mv, err = m.DumpParser(keyBuf[i*keySize:(i+1)*keySize], valueBuf[i*valueSize:(i+1)*valueSize], mk, mv)
+			if err != nil {
+				stats.Interrupted++
+				return err
+			}
+			if cb != nil {
+				cb(mk, mv)
+			}
+		}
+
+		if errno == unix.ENOENT {
+			// The end of the map has been reached.
+			stats.Completed = true
+			return nil
+		}
+
+		copy(inBatch, outBatch)
+		firstBatch = false
+	}
+}
+
+// dumpReliablyWalk iterates over the map one GetNextKey/Lookup syscall pair
+// per entry. It is the fallback for kernels without batch operation support.
+func (m *Map) dumpReliablyWalk(cb DumpCallback, stats *DumpStats) error {
 	var (
 		prevKey    = make([]byte, m.KeySize)
 		currentKey = make([]byte, m.KeySize)
@@ -736,12 +835,6 @@ func (m *Map) DumpReliablyWithCallback(cb DumpCallback, stats *DumpStats) error
 
 		prevKeyValid = false
 	)
-	stats.start()
-	defer stats.finish()
-
-	if err := m.Open(); err != nil {
-		return err
-	}
 
 	if err := GetFirstKey(m.fd, unsafe.Pointer(&currentKey[0])); err != nil {
 		stats.Lookup = 1
@@ -928,6 +1021,181 @@ func (m *Map) Update(key MapKey, value MapValue) error {
 	return err
 }
 
+// maxBatchEntries is the maximum number of map entries passed to the kernel
+// in a single batch syscall.
+const maxBatchEntries = 256
+
+// structBytes returns the memory of the structure behind ptr as a byte slice.
+func structBytes(ptr unsafe.Pointer, size int) []byte {
+	return (*[1 << 24]byte)(ptr)[:size:size]
+}
+
+// batchSupported returns true if the kernel supports the batch update and
+// delete commands (Linux 5.6 or later) on the map. The result is determined
+// on first use by issuing an empty batch update, which is a no-op on kernels
+// implementing the command and fails on older kernels or on map types without
+// batch operation support.
+func (m *Map) batchSupported() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !m.batchSupportProbed {
+		m.batchSupportProbed = true
+		if err := m.open(); err == nil {
+			if _, errno := updateBatch(m.fd, nil, nil, 0); errno == 0 {
+				_, errno := deleteBatch(m.fd, nil, 0)
+				m.batchSupport = errno == 0
+			}
+		}
+	}
+	return m.batchSupport
+}
+
+// UpdateBatch updates all of the given entries in the map, using a single
+// syscall for up to maxBatchEntries entries at a time if the kernel supports
+// the batch map update API. On older kernels the entries are updated one at a
+// time.
+func (m *Map) UpdateBatch(keys []MapKey, values []MapValue) error {
+	if len(keys) != len(values) {
+		return fmt.Errorf("number of keys (%d) does not match number of values (%d) for map %s", len(keys), len(values), m.name)
+	}
+
+	if !m.batchSupported() {
+		for i := range keys {
+			if err := m.Update(keys[i], values[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	defer m.updatePressureMetric()
+
+	if err := m.open(); err != nil {
+		return err
+	}
+
+	keySize := int(m.KeySize)
+	valueSize := int(m.ValueSize)
+	keyBuf := make([]byte, maxBatchEntries*keySize)
+	valueBuf := make([]byte, maxBatchEntries*valueSize)
+
+	for start := 0; start < len(keys); start += maxBatchEntries {
+		chunk := keys[start:]
+		if len(chunk) > maxBatchEntries {
+			chunk = chunk[:maxBatchEntries]
+		}
+		for i, key := range chunk {
+			copy(keyBuf[i*keySize:], structBytes(key.GetKeyPtr(), keySize))
+			copy(valueBuf[i*valueSize:], structBytes(values[start+i].GetValuePtr(), valueSize))
+		}
+
+		processed, errno := updateBatch(m.fd, unsafe.Pointer(&keyBuf[0]), unsafe.Pointer(&valueBuf[0]), uint32(len(chunk)))
+		if option.Config.MetricsConfig.BPFMapOps {
+			metrics.BPFMapOps.WithLabelValues(m.commonName(), metricOpUpdateBatch, metrics.Errno2Outcome(errno)).Add(float64(len(chunk)))
+		}
+
+		if m.withValueCache {
+			for i := 0; i < int(processed); i++ {
+				m.cache[chunk[i].String()] = &cacheEntry{
+					Key:           chunk[i],
+					Value:         values[start+i],
+					DesiredAction: OK,
+				}
+			}
+		} else if m.cache != nil {
+			for i := 0; i < int(processed); i++ {
+				m.cache[chunk[i].String()] = nil
+			}
+		}
+
+		if errno != 0 {
+			err := fmt.Errorf("unable to update %d elements of map %s: %w", len(keys)-start-int(processed), m.name, errno)
+			if m.withValueCache {
+				// Mark all entries which were not processed for
+				// insertion by the error resolver.
+				for i := start + int(processed); i < len(keys); i++ {
+					m.cache[keys[i].String()] = &cacheEntry{
+						Key:           keys[i],
+						Value:         values[i],
+						DesiredAction: Insert,
+						LastError:     err,
+					}
+				}
+				m.scheduleErrorResolver()
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteBatch deletes all of the given keys from the map, using a single
+// syscall for up to maxBatchEntries keys at a time if the kernel supports the
+// batch map delete API. On older kernels the keys are deleted one at a time.
+// Keys which are not present in the map are ignored.
+func (m *Map) DeleteBatch(keys []MapKey) error {
+	if !m.batchSupported() {
+		for _, key := range keys {
+			if _, err := m.SilentDelete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	defer m.updatePressureMetric()
+
+	if err := m.open(); err != nil {
+		return err
+	}
+
+	keySize := int(m.KeySize)
+	keyBuf := make([]byte, maxBatchEntries*keySize)
+
+	for start := 0; start < len(keys); {
+		chunk := keys[start:]
+		if len(chunk) > maxBatchEntries {
+			chunk = chunk[:maxBatchEntries]
+		}
+		for i, key := range chunk {
+			copy(keyBuf[i*keySize:], structBytes(key.GetKeyPtr(), keySize))
+		}
+
+		processed, errno := deleteBatch(m.fd, unsafe.Pointer(&keyBuf[0]), uint32(len(chunk)))
+		if option.Config.MetricsConfig.BPFMapOps && errno != unix.ENOENT {
+			metrics.BPFMapOps.WithLabelValues(m.commonName(), metricOpDeleteBatch, metrics.Errno2Outcome(errno)).Add(float64(len(chunk)))
+		}
+
+		for i := 0; i < int(processed); i++ {
+			m.deleteCacheEntry(chunk[i], nil)
+		}
+		start += int(processed)
+
+		switch {
+		case errno == 0:
+		case errno == unix.ENOENT && int(processed) < len(chunk):
+			// The kernel stops at the first key which is not present
+			// in the map. The entry is gone either way, skip it.
+			m.deleteCacheEntry(keys[start], nil)
+			start++
+		default:
+			err := fmt.Errorf("unable to delete %d elements from map %s: %w", len(keys)-start, m.name, errno)
+			for _, key := range keys[start:] {
+				m.deleteCacheEntry(key, err)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // deleteCacheEntry evaluates the specified error, if nil the map key is
 // removed from the cache to indicate successful deletion. If non-nil, the map
 // key entry in the cache is updated to indicate deletion failure with the
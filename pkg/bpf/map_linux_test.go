@@ -724,6 +724,178 @@ func (s *BPFPrivilegedTestSuite) TestCreateUnpinned(c *C) {
 	c.Assert(*value1, Equals, value2)
 }
 
+func (s *BPFPrivilegedTestSuite) TestBatchOperations(c *C) {
+	batchMap := NewMap("cilium_test_batch",
+		MapTypeHash,
+		&TestKey{},
+		int(unsafe.Sizeof(TestKey{})),
+		&TestValue{},
+		int(unsafe.Sizeof(TestValue{})),
+		1024,
+		BPF_F_NO_PREALLOC,
+		0,
+		ConvertKeyValue).WithCache()
+	_, err := batchMap.OpenOrCreate()
+	c.Assert(err, IsNil)
+	defer func() {
+		path, _ := batchMap.Path()
+		os.Remove(path)
+	}()
+	defer batchMap.Close()
+
+	// More entries than fit into a single batch syscall.
+	numEntries := maxBatchEntries + 100
+	keys := make([]MapKey, numEntries)
+	values := make([]MapValue, numEntries)
+	for i := 0; i < numEntries; i++ {
+		keys[i] = &TestKey{Key: uint32(i)}
+		values[i] = &TestValue{Value: uint32(i + 100)}
+	}
+
+	err = batchMap.UpdateBatch(keys, values)
+	c.Assert(err, IsNil)
+
+	value, err := batchMap.Lookup(&TestKey{Key: uint32(numEntries - 1)})
+	c.Assert(err, IsNil)
+	c.Assert(value, checker.DeepEquals, values[numEntries-1])
+
+	count := 0
+	err = batchMap.DumpWithCallback(func(key MapKey, value MapValue) { count++ })
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, numEntries)
+
+	// Delete all entries again, including a key which is not present in
+	// the map.
+	err = batchMap.DeleteBatch(append(keys, &TestKey{Key: uint32(numEntries + 1)}))
+	c.Assert(err, IsNil)
+
+	count = 0
+	err = batchMap.DumpWithCallback(func(key MapKey, value MapValue) { count++ })
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 0)
+}
+
+// benchSyncEntries is the number of entries written per iteration by the
+// synthetic initial sync benchmarks below.
+const benchSyncEntries = 100000
+
+func (s *BPFPrivilegedTestSuite) benchmarkSync(c *C, batch bool) {
+	syncMap := NewMap("cilium_test_sync",
+		MapTypeHash,
+		&TestKey{},
+		int(unsafe.Sizeof(TestKey{})),
+		&TestValue{},
+		int(unsafe.Sizeof(TestValue{})),
+		benchSyncEntries,
+		BPF_F_NO_PREALLOC,
+		0,
+		ConvertKeyValue)
+	_, err := syncMap.OpenOrCreate()
+	c.Assert(err, IsNil)
+	defer func() {
+		path, _ := syncMap.Path()
+		os.Remove(path)
+	}()
+	defer syncMap.Close()
+
+	keys := make([]MapKey, benchSyncEntries)
+	values := make([]MapValue, benchSyncEntries)
+	for i := 0; i < benchSyncEntries; i++ {
+		keys[i] = &TestKey{Key: uint32(i)}
+		values[i] = &TestValue{Value: uint32(i)}
+	}
+
+	c.ResetTimer()
+	for n := 0; n < c.N; n++ {
+		if batch {
+			err := syncMap.UpdateBatch(keys, values)
+			c.Check(err, IsNil)
+		} else {
+			for i := range keys {
+				err := syncMap.Update(keys[i], values[i])
+				c.Check(err, IsNil)
+			}
+		}
+	}
+}
+
+// BenchmarkBatchSync measures a synthetic initial sync of 100k map entries
+// using the kernel batch map update API where supported.
+func (s *BPFPrivilegedTestSuite) BenchmarkBatchSync(c *C) {
+	s.benchmarkSync(c, true)
+}
+
+// BenchmarkSingleSync measures a synthetic initial sync of 100k map entries
+// using one update syscall per entry.
+func (s *BPFPrivilegedTestSuite) BenchmarkSingleSync(c *C) {
+	s.benchmarkSync(c, false)
+}
+
+// benchDumpEntries is the number of entries scanned per iteration by the dump
+// benchmarks below. It matches the default size of the global TCP conntrack
+// map.
+const benchDumpEntries = 1 << 20
+
+func (s *BPFPrivilegedTestSuite) benchmarkDump(c *C, batch bool) {
+	dumpMap := NewMap("cilium_test_dump",
+		MapTypeHash,
+		&TestKey{},
+		int(unsafe.Sizeof(TestKey{})),
+		&TestValue{},
+		int(unsafe.Sizeof(TestValue{})),
+		benchDumpEntries,
+		BPF_F_NO_PREALLOC,
+		0,
+		ConvertKeyValue)
+	_, err := dumpMap.OpenOrCreate()
+	c.Assert(err, IsNil)
+	defer func() {
+		path, _ := dumpMap.Path()
+		os.Remove(path)
+	}()
+	defer dumpMap.Close()
+
+	keys := make([]MapKey, benchDumpEntries)
+	values := make([]MapValue, benchDumpEntries)
+	for i := 0; i < benchDumpEntries; i++ {
+		keys[i] = &TestKey{Key: uint32(i)}
+		values[i] = &TestValue{Value: uint32(i)}
+	}
+	err = dumpMap.UpdateBatch(keys, values)
+	c.Assert(err, IsNil)
+
+	if !batch {
+		// Force the per-entry walker fallback.
+		dumpMap.batchSupportProbed = true
+		dumpMap.batchSupport = false
+	}
+
+	var stats *DumpStats
+	c.ResetTimer()
+	for n := 0; n < c.N; n++ {
+		stats = NewDumpStats(dumpMap)
+		count := 0
+		err := dumpMap.DumpReliablyWithCallback(func(key MapKey, value MapValue) { count++ }, stats)
+		c.Check(err, IsNil)
+		c.Check(count, Equals, benchDumpEntries)
+	}
+	c.StopTimer()
+	c.Logf("dumped %d entries in %d syscalls", benchDumpEntries, stats.Lookup)
+}
+
+// BenchmarkBatchDump measures a full scan of a map with 1M entries using the
+// kernel batch lookup API where supported. The number of syscalls needed for
+// the scan is logged alongside the timing.
+func (s *BPFPrivilegedTestSuite) BenchmarkBatchDump(c *C) {
+	s.benchmarkDump(c, true)
+}
+
+// BenchmarkWalkDump measures a full scan of a map with 1M entries using one
+// GetNextKey/Lookup syscall pair per entry.
+func (s *BPFPrivilegedTestSuite) BenchmarkWalkDump(c *C) {
+	s.benchmarkDump(c, false)
+}
+
 func newMockProber(mt probes.MapTypes) *mockProber {
 	return &mockProber{
 		mt: mt,
@@ -17,8 +17,11 @@ package bpf
 const (
 	metricOpCreate         = "create"
 	metricOpUpdate         = "update"
+	metricOpUpdateBatch    = "updateBatch"
 	metricOpLookup         = "lookup"
+	metricOpLookupBatch    = "lookupBatch"
 	metricOpDelete         = "delete"
+	metricOpDeleteBatch    = "deleteBatch"
 	metricOpGetNextKey     = "getNextKey"
 	metricOpObjPin         = "objPin"
 	metricOpObjGet         = "objGet"
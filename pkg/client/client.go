@@ -285,6 +285,10 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 		fmt.Fprintf(w, "ContainerRuntime:\t%s\t%s\n",
 			sr.ContainerRuntime.State, sr.ContainerRuntime.Msg)
 	}
+	if sr.IdentityMigration != nil {
+		fmt.Fprintf(w, "IdentityMigration:\t%s\t%s\n",
+			sr.IdentityMigration.State, sr.IdentityMigration.Msg)
+	}
 
 	kubeProxyDevices := ""
 	if sr.Kubernetes != nil {
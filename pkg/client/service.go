@@ -39,6 +39,17 @@ func (c *Client) GetServiceID(id int64) (*models.Service, error) {
 	return resp.Payload, nil
 }
 
+// GetServiceIDConnections returns the per-backend connection counts of a
+// service.
+func (c *Client) GetServiceIDConnections(id int64) ([]*models.BackendConnections, error) {
+	params := service.NewGetServiceIDConnectionsParams().WithID(id).WithTimeout(api.ClientTimeout)
+	resp, err := c.Service.GetServiceIDConnections(params)
+	if err != nil {
+		return nil, Hint(err)
+	}
+	return resp.Payload, nil
+}
+
 // PutServiceID creates or updates a service. Returns true if service was created.
 func (c *Client) PutServiceID(id int64, svc *models.ServiceSpec) (bool, error) {
 	svc.ID = id
@@ -53,3 +64,10 @@ func (c *Client) DeleteServiceID(id int64) error {
 	_, err := c.Service.DeleteServiceID(params)
 	return Hint(err)
 }
+
+// DeleteServiceIDAffinity purges the session affinity entries of a service.
+func (c *Client) DeleteServiceIDAffinity(id int64) error {
+	params := service.NewDeleteServiceIDAffinityParams().WithID(id).WithTimeout(api.ClientTimeout)
+	_, err := c.Service.DeleteServiceIDAffinity(params)
+	return Hint(err)
+}
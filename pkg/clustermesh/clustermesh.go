@@ -24,6 +24,7 @@ import (
 	"github.com/cilium/cilium/pkg/kvstore"
 	"github.com/cilium/cilium/pkg/kvstore/store"
 	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/metrics"
 	nodemanager "github.com/cilium/cilium/pkg/node/manager"
 	nodeStore "github.com/cilium/cilium/pkg/node/store"
 	"github.com/cilium/cilium/pkg/option"
@@ -98,6 +99,10 @@ type ClusterMesh struct {
 	// globalServices is a list of all global services. The datastructure
 	// is protected by its own mutex inside of the structure.
 	globalServices *globalServiceCache
+
+	// collector exposes the synchronization lag of the remote clusters as
+	// prometheus metrics
+	collector metricsCollector
 }
 
 // NewClusterMesh creates a new remote cluster cache based on the
@@ -121,6 +126,11 @@ func NewClusterMesh(c Configuration) (*ClusterMesh, error) {
 		return nil, err
 	}
 
+	cm.collector = metricsCollector{mesh: cm}
+	if err := metrics.Register(cm.collector); err != nil {
+		return nil, fmt.Errorf("unable to register clustermesh metrics: %s", err)
+	}
+
 	return cm, nil
 }
 
@@ -139,6 +149,7 @@ func (cm *ClusterMesh) Close() {
 		delete(cm.clusters, name)
 	}
 	cm.controllers.RemoveAllAndWait()
+	metrics.Unregister(cm.collector)
 }
 
 func (cm *ClusterMesh) newRemoteCluster(name, path string) *remoteCluster {
@@ -149,6 +160,7 @@ func (cm *ClusterMesh) newRemoteCluster(name, path string) *remoteCluster {
 		changed:     make(chan bool, configNotificationsChannelSize),
 		controllers: controller.NewManager(),
 		swg:         lock.NewStoppableWaitGroup(),
+		syncStatus:  map[string]resourceSyncStatus{},
 	}
 }
 
@@ -187,3 +187,28 @@ func (s *ClusterMeshTestSuite) TestClusterMesh(c *C) {
 
 	cm.Close()
 }
+
+func (s *ClusterMeshTestSuite) TestRemoteClusterSyncStatus(c *C) {
+	rc := &remoteCluster{
+		name:       "cluster1",
+		syncStatus: map[string]resourceSyncStatus{},
+	}
+
+	rc.markSyncFailed(syncNodes, fmt.Errorf("connection refused"))
+	status := rc.status()
+	c.Assert(status.Sync, HasLen, 1)
+	c.Assert(status.Sync[0].Resource, Equals, syncNodes)
+	c.Assert(status.Sync[0].Error, Equals, "connection refused")
+	c.Assert(time.Time(status.Sync[0].LastSuccess).IsZero(), Equals, true)
+
+	rc.markSynced(syncNodes)
+	rc.markSynced(syncServices)
+	status = rc.status()
+	c.Assert(status.Sync, HasLen, 2)
+	// Sync entries are sorted by resource name.
+	c.Assert(status.Sync[0].Resource, Equals, syncNodes)
+	c.Assert(status.Sync[1].Resource, Equals, syncServices)
+	// A successful synchronization clears any previous error.
+	c.Assert(status.Sync[0].Error, Equals, "")
+	c.Assert(time.Time(status.Sync[0].LastSuccess).IsZero(), Equals, false)
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustermesh
+
+import (
+	"time"
+
+	"github.com/cilium/cilium/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var syncLagDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(metrics.Namespace, "clustermesh", "remote_cluster_sync_lag_seconds"),
+	"Seconds since the last successful synchronization of a resource type from a remote cluster",
+	[]string{"target_cluster", "resource"}, nil,
+)
+
+// metricsCollector exposes the synchronization lag of all remote clusters of
+// a cluster mesh. The lag is computed at scrape time so that it keeps growing
+// while a remote cluster is stale. Clusters which have been removed from the
+// configuration are no longer part of the cluster map and therefore stop
+// being reported automatically.
+type metricsCollector struct {
+	mesh *ClusterMesh
+}
+
+// Describe implements prometheus.Collector.
+func (mc metricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- syncLagDesc
+}
+
+// Collect implements prometheus.Collector.
+func (mc metricsCollector) Collect(ch chan<- prometheus.Metric) {
+	mc.mesh.mutex.RLock()
+	clusters := make(map[string]*remoteCluster, len(mc.mesh.clusters))
+	for name, cluster := range mc.mesh.clusters {
+		clusters[name] = cluster
+	}
+	mc.mesh.mutex.RUnlock()
+
+	for name, cluster := range clusters {
+		for resource, status := range cluster.syncStatusSnapshot() {
+			if status.lastSuccess.IsZero() {
+				// Never synchronized successfully, there is no lag
+				// to report yet.
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(syncLagDesc, prometheus.GaugeValue,
+				time.Since(status.lastSuccess).Seconds(), name, resource)
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sort"
 	"time"
 
 	"github.com/cilium/cilium/api/v1/models"
@@ -63,6 +64,7 @@ type remoteCluster struct {
 	// - remoteNodes
 	// - ipCacheWatcher
 	// - remoteIdentityCache
+	// - syncStatus
 	mutex lock.RWMutex
 
 	// store is the shared store representing all nodes in the remote cluster
@@ -90,6 +92,29 @@ type remoteCluster struct {
 
 	// lastFailure is the timestamp of the last failure
 	lastFailure time.Time
+
+	// syncStatus is the synchronization status of the individual resource
+	// types from the remote cluster, keyed by resource name
+	syncStatus map[string]resourceSyncStatus
+}
+
+// Names of the resource types synchronized from a remote cluster.
+const (
+	syncNodes      = "nodes"
+	syncServices   = "services"
+	syncIdentities = "identities"
+	syncEndpoints  = "endpoints"
+)
+
+// resourceSyncStatus is the synchronization status of a single resource type
+// from a remote cluster.
+type resourceSyncStatus struct {
+	// lastSuccess is the timestamp of the last successful synchronization
+	lastSuccess time.Time
+
+	// lastError is the error of the last synchronization attempt, it is
+	// cleared once a synchronization succeeds
+	lastError string
 }
 
 var (
@@ -97,6 +122,38 @@ var (
 	skipKvstoreConnection bool
 )
 
+// markSynced records a successful synchronization of the given resource type
+// and clears any previously recorded error.
+func (rc *remoteCluster) markSynced(resource string) {
+	rc.mutex.Lock()
+	rc.syncStatus[resource] = resourceSyncStatus{lastSuccess: time.Now()}
+	rc.mutex.Unlock()
+}
+
+// markSyncFailed records a failed synchronization attempt of the given
+// resource type. The timestamp of the last successful synchronization is
+// retained.
+func (rc *remoteCluster) markSyncFailed(resource string, err error) {
+	rc.mutex.Lock()
+	status := rc.syncStatus[resource]
+	status.lastError = err.Error()
+	rc.syncStatus[resource] = status
+	rc.mutex.Unlock()
+}
+
+// syncStatusSnapshot returns a copy of the synchronization status of all
+// resource types of the remote cluster.
+func (rc *remoteCluster) syncStatusSnapshot() map[string]resourceSyncStatus {
+	rc.mutex.RLock()
+	defer rc.mutex.RUnlock()
+
+	snapshot := make(map[string]resourceSyncStatus, len(rc.syncStatus))
+	for resource, status := range rc.syncStatus {
+		snapshot[resource] = status
+	}
+	return snapshot
+}
+
 func (rc *remoteCluster) getLogger() *logrus.Entry {
 	var (
 		status string
@@ -191,9 +248,11 @@ func (rc *remoteCluster) restartRemoteConnection(allocator RemoteIdentityWatcher
 					Observer:                rc.mesh.conf.NodeObserver(),
 				})
 				if err != nil {
+					rc.markSyncFailed(syncNodes, err)
 					backend.Close()
 					return err
 				}
+				rc.markSynced(syncNodes)
 
 				remoteServices, err := store.JoinSharedStore(store.Configuration{
 					Prefix: path.Join(serviceStore.ServiceStorePrefix, rc.name),
@@ -209,22 +268,27 @@ func (rc *remoteCluster) restartRemoteConnection(allocator RemoteIdentityWatcher
 					},
 				})
 				if err != nil {
+					rc.markSyncFailed(syncServices, err)
 					remoteNodes.Close(context.TODO())
 					backend.Close()
 					return err
 				}
+				rc.markSynced(syncServices)
 				rc.swg.Stop()
 
 				remoteIdentityCache, err := allocator.WatchRemoteIdentities(backend)
 				if err != nil {
+					rc.markSyncFailed(syncIdentities, err)
 					remoteServices.Close(context.TODO())
 					remoteNodes.Close(context.TODO())
 					backend.Close()
 					return err
 				}
+				rc.markSynced(syncIdentities)
 
 				ipCacheWatcher := ipcache.NewIPIdentityWatcher(backend)
 				go ipCacheWatcher.Watch(ctx)
+				rc.markSynced(syncEndpoints)
 
 				rc.mutex.Lock()
 				rc.remoteNodes = remoteNodes
@@ -338,6 +402,19 @@ func (rc *remoteCluster) status() *models.RemoteCluster {
 		}
 	}
 
+	sync := make([]*models.RemoteClusterSyncStatus, 0, len(rc.syncStatus))
+	for resource, status := range rc.syncStatus {
+		syncStatus := &models.RemoteClusterSyncStatus{
+			Resource: resource,
+			Error:    status.lastError,
+		}
+		if !status.lastSuccess.IsZero() {
+			syncStatus.LastSuccess = strfmt.DateTime(status.lastSuccess)
+		}
+		sync = append(sync, syncStatus)
+	}
+	sort.Slice(sync, func(i, j int) bool { return sync[i].Resource < sync[j].Resource })
+
 	return &models.RemoteCluster{
 		Name:              rc.name,
 		Ready:             rc.isReadyLocked(),
@@ -347,5 +424,6 @@ func (rc *remoteCluster) status() *models.RemoteCluster {
 		Status:            backendStatus,
 		NumFailures:       int64(rc.failures),
 		LastFailure:       strfmt.DateTime(rc.lastFailure),
+		Sync:              sync,
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/policy/api"
 )
 
@@ -38,6 +39,24 @@ type k8sClient interface {
 type Manager struct {
 	rootPath  string
 	k8sClient k8sClient
+
+	// mutex protects 'monitored' below.
+	mutex lock.Mutex
+
+	// monitored are the secrets that have been resolved for header value
+	// matching, keyed by '<namespace>/<name>/<key>'. The last resolved
+	// values are kept so that rotation of a secret can be detected.
+	monitored map[string]*monitoredSecret
+}
+
+// monitoredSecret is a secret reference resolved via GetSecretString,
+// together with the value it resolved to at the time, so that it can be
+// resolved again to detect rotation. An unresolvable secret is monitored
+// with an empty value, making its later creation count as a rotation.
+type monitoredSecret struct {
+	secret *api.Secret
+	ns     string
+	value  string
 }
 
 // NewManager returns a new manager.
@@ -45,6 +64,7 @@ func NewManager(certsRootPath string, k8sClient k8sClient) *Manager {
 	return &Manager{
 		rootPath:  certsRootPath,
 		k8sClient: k8sClient,
+		monitored: make(map[string]*monitoredSecret),
 	}
 }
 
@@ -137,13 +157,28 @@ func (m *Manager) GetTLSContext(ctx context.Context, tlsCtx *api.TLSContext, ns
 	return ca, public, private, nil
 }
 
-// GetSecretString returns a secret string stored in a k8s secret
+// GetSecretString returns a secret string stored in a k8s secret. The secret
+// reference is remembered so that RotatedSecrets can detect when the secret
+// is changed after it has been resolved into imported policy.
 func (m *Manager) GetSecretString(ctx context.Context, secret *api.Secret, ns string) (string, error) {
+	value, err := m.getSecretString(ctx, secret, ns)
+	m.monitorSecret(secret, ns, value)
+	return value, err
+}
+
+func (m *Manager) getSecretString(ctx context.Context, secret *api.Secret, ns string) (string, error) {
 	name, secrets, err := m.GetSecrets(ctx, secret, ns)
 	if err != nil {
 		return "", err
 	}
 
+	if secret.Key != "" {
+		if value, ok := secrets[secret.Key]; ok {
+			return string(value), nil
+		}
+		return "", fmt.Errorf("Key %s not found in secret %s", secret.Key, name)
+	}
+
 	if len(secrets) == 1 {
 		// get the lone item by looping into the map
 		for _, value := range secrets {
@@ -152,3 +187,57 @@ func (m *Manager) GetSecretString(ctx context.Context, secret *api.Secret, ns st
 	}
 	return "", fmt.Errorf("Secret %s must have exactly one item", name)
 }
+
+// monitorSecret stores the last resolved value of the given secret reference
+// for rotation detection.
+func (m *Manager) monitorSecret(secret *api.Secret, ns, value string) {
+	if secret == nil || secret.Name == "" {
+		return
+	}
+	if secret.Namespace != "" {
+		ns = secret.Namespace
+	}
+	key := filepath.Join(ns, secret.Name, secret.Key)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if monitored, ok := m.monitored[key]; ok {
+		monitored.value = value
+		return
+	}
+	m.monitored[key] = &monitoredSecret{
+		secret: secret,
+		ns:     ns,
+		value:  value,
+	}
+}
+
+// RotatedSecrets resolves all monitored secrets again and returns the names
+// ('<namespace>/<name>') of the secrets whose value has changed since they
+// were last resolved. The stored values are updated in the process, so each
+// rotation is only reported once.
+func (m *Manager) RotatedSecrets(ctx context.Context) []string {
+	m.mutex.Lock()
+	monitored := make(map[string]*monitoredSecret, len(m.monitored))
+	for key, secret := range m.monitored {
+		monitored[key] = secret
+	}
+	m.mutex.Unlock()
+
+	var rotated []string
+	for _, ms := range monitored {
+		// Errors are ignored; an unresolvable secret keeps its old
+		// value until it can be resolved again.
+		value, err := m.getSecretString(ctx, ms.secret, ms.ns)
+		if err != nil {
+			continue
+		}
+		m.mutex.Lock()
+		if value != ms.value {
+			ms.value = value
+			rotated = append(rotated, filepath.Join(ms.ns, ms.secret.Name))
+		}
+		m.mutex.Unlock()
+	}
+	return rotated
+}
@@ -116,6 +116,49 @@ func (l *BPFListener) notifyMonitor(modType ipcache.CacheModification,
 	}
 }
 
+// ipcacheValue builds the BPF map value for an upserted ipcache entry.
+func ipcacheValue(newHostIP net.IP, newID ipcache.Identity, encryptKey uint8) ipcacheMap.RemoteEndpointInfo {
+	value := ipcacheMap.RemoteEndpointInfo{
+		SecurityIdentity: uint32(newID.ID),
+		Key:              encryptKey,
+	}
+
+	if newHostIP != nil {
+		// If the hostIP is specified and it doesn't point to
+		// the local host, then the ipcache should be populated
+		// with the hostIP so that this traffic can be guided
+		// to a tunnel endpoint destination.
+		nodeIPv4 := node.GetIPv4()
+		if ip4 := newHostIP.To4(); ip4 != nil && !ip4.Equal(nodeIPv4) {
+			copy(value.TunnelEndpoint[:], ip4)
+		}
+	}
+	return value
+}
+
+// batchCollector buffers upsert events from a dump of the ipcache so that
+// they can be written to a BPF map in bulk with UpdateBatch. It implements
+// the ipcache.IPIdentityMappingListener interface.
+type batchCollector struct {
+	keys   []bpf.MapKey
+	values []bpf.MapValue
+}
+
+func (b *batchCollector) OnIPIdentityCacheChange(modType ipcache.CacheModification, cidr net.IPNet,
+	oldHostIP, newHostIP net.IP, oldID *ipcache.Identity, newID ipcache.Identity,
+	encryptKey uint8, k8sMeta *ipcache.K8sMetadata) {
+	if modType != ipcache.Upsert {
+		return
+	}
+
+	key := ipcacheMap.NewKey(cidr.IP, cidr.Mask)
+	value := ipcacheValue(newHostIP, newID, encryptKey)
+	b.keys = append(b.keys, &key)
+	b.values = append(b.values, &value)
+}
+
+func (b *batchCollector) OnIPIdentityCacheGC() {}
+
 // OnIPIdentityCacheChange is called whenever there is a change of state in the
 // IPCache (pkg/ipcache).
 // TODO (FIXME): GH-3161.
@@ -150,21 +193,7 @@ func (l *BPFListener) OnIPIdentityCacheChange(modType ipcache.CacheModification,
 
 	switch modType {
 	case ipcache.Upsert:
-		value := ipcacheMap.RemoteEndpointInfo{
-			SecurityIdentity: uint32(newID.ID),
-			Key:              encryptKey,
-		}
-
-		if newHostIP != nil {
-			// If the hostIP is specified and it doesn't point to
-			// the local host, then the ipcache should be populated
-			// with the hostIP so that this traffic can be guided
-			// to a tunnel endpoint destination.
-			nodeIPv4 := node.GetIPv4()
-			if ip4 := newHostIP.To4(); ip4 != nil && !ip4.Equal(nodeIPv4) {
-				copy(value.TunnelEndpoint[:], ip4)
-			}
-		}
+		value := ipcacheValue(newHostIP, newID, encryptKey)
 		err := l.bpfMap.Update(&key, &value)
 		if err != nil {
 			scopedLog.WithError(err).WithFields(logrus.Fields{
@@ -273,13 +302,16 @@ func (l *BPFListener) garbageCollect(ctx context.Context) (*sync.WaitGroup, erro
 		}
 
 		// Remove all keys which are not in in-memory cache from BPF map
-		// for consistency.
+		// for consistency. The deletion is batched into as few syscalls
+		// as the kernel allows.
+		staleKeys := make([]bpf.MapKey, 0, len(keysToRemove))
 		for _, k := range keysToRemove {
 			log.WithFields(logrus.Fields{logfields.BPFMapKey: k}).
 				Debug("deleting from ipcache BPF map")
-			if err := l.bpfMap.DeleteWithOverwrite(k); err != nil {
-				return nil, fmt.Errorf("error deleting key %s from ipcache BPF map: %s", k, err)
-			}
+			staleKeys = append(staleKeys, k)
+		}
+		if err := l.bpfMap.DeleteBatch(staleKeys); err != nil {
+			return nil, fmt.Errorf("error deleting stale keys from ipcache BPF map: %s", err)
 		}
 	} else {
 		// Since controllers run asynchronously, need to make sure
@@ -294,8 +326,12 @@ func (l *BPFListener) garbageCollect(ctx context.Context) (*sync.WaitGroup, erro
 			ipcache.IPIdentityCache.RUnlock()
 			return nil, fmt.Errorf("Unable to create %s map: %s", pendingMapName, err)
 		}
-		pendingListener := newListener(pendingMap, l.datapath, nil)
-		ipcache.IPIdentityCache.DumpToListenerLocked(pendingListener)
+		collector := &batchCollector{}
+		ipcache.IPIdentityCache.DumpToListenerLocked(collector)
+		if err := pendingMap.UpdateBatch(collector.keys, collector.values); err != nil {
+			log.WithError(err).WithField("map-name", pendingMapName).
+				Warning("unable to populate pending ipcache map")
+		}
 		err := pendingMap.Close()
 		if err != nil {
 			log.WithError(err).WithField("map-name", pendingMapName).Warning("unable to close map")
@@ -339,6 +375,84 @@ func (l *BPFListener) garbageCollect(ctx context.Context) (*sync.WaitGroup, erro
 	return nil, nil
 }
 
+// Reconcile diffs the BPF ipcache map against the in-memory IP-identity
+// cache and repairs any divergence: missing or diverging entries are
+// re-written and stale entries are removed. The in-memory cache is
+// read-locked for the duration of the reconciliation so that concurrent
+// updates cannot race the repairs.
+//
+// At most maxRepairs discrepancies are repaired in one invocation to bound
+// the map churn of a single run; the remainder is picked up by subsequent
+// invocations. Returns the number of repaired entries.
+func (l *BPFListener) Reconcile(maxRepairs int) (int, error) {
+	if !ipcacheMap.SupportsDelete() {
+		// Without kernel support for LPM map deletion the periodic
+		// garbage collection rewrites the whole map instead, see
+		// garbageCollect().
+		return 0, nil
+	}
+
+	ipcache.IPIdentityCache.RLock()
+	defer ipcache.IPIdentityCache.RUnlock()
+
+	desired := &batchCollector{}
+	ipcache.IPIdentityCache.DumpToListenerLocked(desired)
+
+	type dumpedEntry struct {
+		key   *ipcacheMap.Key
+		value ipcacheMap.RemoteEndpointInfo
+	}
+	dumped := map[string]dumpedEntry{}
+	cb := func(key bpf.MapKey, value bpf.MapValue) {
+		k := key.(*ipcacheMap.Key)
+		dumped[k.String()] = dumpedEntry{
+			key:   k.DeepCopy(),
+			value: *value.(*ipcacheMap.RemoteEndpointInfo),
+		}
+	}
+	if err := l.bpfMap.DumpWithCallback(cb); err != nil {
+		return 0, fmt.Errorf("error dumping ipcache BPF map: %s", err)
+	}
+
+	repaired := 0
+	desiredKeys := map[string]struct{}{}
+	for i, k := range desired.keys {
+		key := k.(*ipcacheMap.Key)
+		value := desired.values[i].(*ipcacheMap.RemoteEndpointInfo)
+		keyToIP := key.String()
+		desiredKeys[keyToIP] = struct{}{}
+		if entry, found := dumped[keyToIP]; found && entry.value == *value {
+			continue
+		}
+		if repaired >= maxRepairs {
+			return repaired, nil
+		}
+		log.WithFields(logrus.Fields{logfields.BPFMapKey: keyToIP}).
+			Warning("Reconciliation re-writing ipcache entry diverging from BPF map state")
+		if err := l.bpfMap.Update(key, value); err != nil {
+			return repaired, fmt.Errorf("error updating ipcache BPF map: %s", err)
+		}
+		repaired++
+	}
+
+	for keyToIP, entry := range dumped {
+		if _, found := desiredKeys[keyToIP]; found {
+			continue
+		}
+		if repaired >= maxRepairs {
+			return repaired, nil
+		}
+		log.WithFields(logrus.Fields{logfields.BPFMapKey: keyToIP}).
+			Warning("Reconciliation removing stale entry from ipcache BPF map")
+		if err := l.bpfMap.Delete(entry.key); err != nil {
+			return repaired, fmt.Errorf("error deleting stale key from ipcache BPF map: %s", err)
+		}
+		repaired++
+	}
+
+	return repaired, nil
+}
+
 // OnIPIdentityCacheGC spawns a controller which synchronizes the BPF IPCache Map
 // with the in-memory IP-Identity cache.
 func (l *BPFListener) OnIPIdentityCacheGC() {
@@ -0,0 +1,125 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// +build linux
+
+package ipsec
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/datapath/linux/linux_defaults"
+
+	"github.com/vishvananda/netlink"
+)
+
+// xfrmStatProcFile contains the kernel-wide XFRM error counters.
+const xfrmStatProcFile = "/proc/net/xfrm_stat"
+
+// Status returns the state of the IPsec datapath as observed via the kernel
+// XFRM subsystem. XFRM states installed by Cilium are correlated to cluster
+// nodes via nodeByIP, states not matching any known node are flagged as
+// stale.
+func Status(nodeByIP func(ip net.IP) string) (*models.IPsecStatus, error) {
+	status := &models.IPsecStatus{}
+
+	xfrmStateList, err := netlink.XfrmStateList(0)
+	if err != nil {
+		return nil, fmt.Errorf("xfrm state list error: %s", err)
+	}
+	for _, s := range xfrmStateList {
+		if s.Mark == nil {
+			continue
+		}
+
+		var direction string
+		var remoteIP net.IP
+		switch s.Mark.Value & linux_defaults.RouteMarkMask {
+		case linux_defaults.RouteMarkDecrypt:
+			direction = models.IPsecStateEntryDirectionIn
+			remoteIP = s.Src
+		case linux_defaults.RouteMarkEncrypt:
+			direction = models.IPsecStateEntryDirectionOut
+			remoteIP = s.Dst
+		default:
+			continue
+		}
+
+		remoteNode := nodeByIP(remoteIP)
+		status.States = append(status.States, &models.IPsecStateEntry{
+			Spi:         fmt.Sprintf("%#x", s.Spi),
+			Direction:   direction,
+			Source:      s.Src.String(),
+			Destination: s.Dst.String(),
+			RemoteNode:  remoteNode,
+			Packets:     int64(s.Statistics.Packets),
+			Bytes:       int64(s.Statistics.Bytes),
+			Stale:       remoteNode == "",
+		})
+	}
+
+	xfrmPolicyList, err := netlink.XfrmPolicyList(0)
+	if err != nil {
+		return nil, fmt.Errorf("xfrm policy list error: %s", err)
+	}
+	for _, p := range xfrmPolicyList {
+		if p.Mark == nil {
+			continue
+		}
+		status.Policies++
+	}
+
+	status.ErrorCounters, err = xfrmErrorCounters()
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// xfrmErrorCounters returns the non-zero error counters of the kernel XFRM
+// subsystem, e.g. XfrmInNoStates (no matching state found for an encrypted
+// packet) or XfrmInStateModeError (inner packet did not match the state).
+func xfrmErrorCounters() (map[string]int64, error) {
+	f, err := os.Open(xfrmStatProcFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open %s: %s", xfrmStatProcFile, err)
+	}
+	defer f.Close()
+
+	counters := map[string]int64{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil || value == 0 {
+			continue
+		}
+		counters[fields[0]] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read %s: %s", xfrmStatProcFile, err)
+	}
+
+	return counters, nil
+}
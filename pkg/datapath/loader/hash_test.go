@@ -69,3 +69,48 @@ func (s *LoaderTestSuite) TesthashDatapath(c *C) {
 	c.Assert(h.String(), Not(Equals), baseHash)
 	c.Assert(h.String(), Not(Equals), dummyHash)
 }
+
+// TestCompileCacheBusting ensures that semantic changes to the endpoint or
+// node configuration always change the hash that keys the compile cache, so
+// that CompileOrLoad never reuses a stale program.
+func (s *LoaderTestSuite) TestCompileCacheBusting(c *C) {
+	cfg := &config.HeaderfileWriter{}
+	l := NewLoader(true)
+	l.templateCache = &objectCache{
+		ConfigWriter: cfg,
+		baseHash:     hashDatapath(cfg, &dummyNodeCfg, nil, nil),
+	}
+
+	ep := testutils.NewTestEndpoint()
+	hash, err := l.EndpointHash(&ep)
+	c.Assert(err, IsNil)
+	l.setLoadedHash(&ep, hash)
+	c.Assert(l.loadedHash(&ep), Equals, hash)
+
+	// An option change busts the cache.
+	ep.Opts.SetBool("foo", true)
+	optHash, err := l.EndpointHash(&ep)
+	c.Assert(err, IsNil)
+	c.Assert(optHash, Not(Equals), hash)
+	c.Assert(l.loadedHash(&ep), Not(Equals), optHash)
+	ep.Opts.Delete("foo")
+
+	// A static data change (here, the endpoint ID) busts the cache, even
+	// though it does not change the template hash.
+	ep.Id++
+	idHash, err := l.EndpointHash(&ep)
+	c.Assert(err, IsNil)
+	c.Assert(idHash, Not(Equals), hash)
+
+	// A node configuration change busts the cache for all endpoints.
+	ep = testutils.NewTestEndpoint()
+	l.templateCache.baseHash = hashDatapath(cfg, &dummyNodeCfg, &dummyDevCfg, nil)
+	nodeHash, err := l.EndpointHash(&ep)
+	c.Assert(err, IsNil)
+	c.Assert(nodeHash, Not(Equals), hash)
+
+	// Unloading the endpoint clears the recorded hash.
+	l.setLoadedHash(&ep, nodeHash)
+	l.Unload(&ep)
+	c.Assert(l.loadedHash(&ep), Equals, "")
+}
@@ -27,13 +27,15 @@ import (
 	"github.com/cilium/cilium/pkg/datapath"
 	"github.com/cilium/cilium/pkg/datapath/link"
 	"github.com/cilium/cilium/pkg/datapath/linux/route"
-	"github.com/cilium/cilium/pkg/datapath/loader/metrics"
+	loaderMetrics "github.com/cilium/cilium/pkg/datapath/loader/metrics"
 	datapathOption "github.com/cilium/cilium/pkg/datapath/option"
 	"github.com/cilium/cilium/pkg/defaults"
 	"github.com/cilium/cilium/pkg/elf"
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/maps/callsmap"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/node"
 	"github.com/cilium/cilium/pkg/option"
 
@@ -55,6 +57,11 @@ const (
 
 	dirIngress = "ingress"
 	dirEgress  = "egress"
+
+	// compilationCacheHit and compilationCacheMiss are the values of the
+	// result label on the datapath compilation cache metric.
+	compilationCacheHit  = "hit"
+	compilationCacheMiss = "miss"
 )
 
 const (
@@ -72,16 +79,46 @@ type Loader struct {
 	// templateCache is the cache of pre-compiled datapaths.
 	templateCache *objectCache
 
+	// loadedHashesMutex protects loadedHashes.
+	loadedHashesMutex lock.RWMutex
+
+	// loadedHashes maps each endpoint to the hash of the datapath
+	// configuration that was last successfully written and loaded for it.
+	// It allows CompileOrLoad to skip ELF patching when the configuration
+	// is byte-identical to the loaded program.
+	loadedHashes map[uint64]string
+
 	canDisableDwarfRelocations bool
 }
 
 // NewLoader returns a new loader.
 func NewLoader(canDisableDwarfRelocations bool) *Loader {
 	return &Loader{
+		loadedHashes:               make(map[uint64]string),
 		canDisableDwarfRelocations: canDisableDwarfRelocations,
 	}
 }
 
+// loadedHash returns the hash of the datapath configuration that was last
+// loaded for the endpoint, or an empty string if none was recorded.
+func (l *Loader) loadedHash(ep datapath.Endpoint) string {
+	l.loadedHashesMutex.RLock()
+	defer l.loadedHashesMutex.RUnlock()
+	return l.loadedHashes[ep.GetID()]
+}
+
+func (l *Loader) setLoadedHash(ep datapath.Endpoint, hash string) {
+	l.loadedHashesMutex.Lock()
+	l.loadedHashes[ep.GetID()] = hash
+	l.loadedHashesMutex.Unlock()
+}
+
+func (l *Loader) forgetLoadedHash(ep datapath.Endpoint) {
+	l.loadedHashesMutex.Lock()
+	delete(l.loadedHashes, ep.GetID())
+	l.loadedHashesMutex.Unlock()
+}
+
 // Init initializes the datapath cache with base program hashes derived from
 // the LocalNodeConfiguration.
 func (l *Loader) init(dp datapath.ConfigWriter, nodeCfg *datapath.LocalNodeConfiguration) {
@@ -380,7 +417,7 @@ func (l *Loader) replaceNetworkDatapath(ctx context.Context, interfaces []string
 	return nil
 }
 
-func (l *Loader) compileAndLoad(ctx context.Context, ep datapath.Endpoint, dirs *directoryInfo, stats *metrics.SpanStat) error {
+func (l *Loader) compileAndLoad(ctx context.Context, ep datapath.Endpoint, dirs *directoryInfo, stats *loaderMetrics.SpanStat) error {
 	stats.BpfCompilation.Start()
 	err := compileDatapath(ctx, dirs, ep.IsHost(), ep.Logger(Subsystem))
 	stats.BpfCompilation.End(err == nil)
@@ -398,7 +435,7 @@ func (l *Loader) compileAndLoad(ctx context.Context, ep datapath.Endpoint, dirs
 // and loads it onto the interface associated with the endpoint.
 //
 // Expects the caller to have created the directory at the path ep.StateDir().
-func (l *Loader) CompileAndLoad(ctx context.Context, ep datapath.Endpoint, stats *metrics.SpanStat) error {
+func (l *Loader) CompileAndLoad(ctx context.Context, ep datapath.Endpoint, stats *loaderMetrics.SpanStat) error {
 	if ep == nil {
 		log.Fatalf("LoadBPF() doesn't support non-endpoint load")
 	}
@@ -409,7 +446,15 @@ func (l *Loader) CompileAndLoad(ctx context.Context, ep datapath.Endpoint, stats
 		State:   ep.StateDir(),
 		Output:  ep.StateDir(),
 	}
-	return l.compileAndLoad(ctx, ep, &dirs, stats)
+	if err := l.compileAndLoad(ctx, ep, &dirs, stats); err != nil {
+		return err
+	}
+	if l.templateCache != nil {
+		if hash, err := l.EndpointHash(ep); err == nil {
+			l.setLoadedHash(ep, hash)
+		}
+	}
+	return nil
 }
 
 // CompileOrLoad loads the BPF datapath programs for the specified endpoint.
@@ -420,12 +465,34 @@ func (l *Loader) CompileAndLoad(ctx context.Context, ep datapath.Endpoint, stats
 // parameters as the specified endpoint, this function will compile a new
 // template for this configuration.
 //
+// If the endpoint's complete datapath configuration hashes to the same value
+// as the configuration from which the currently loaded program was built,
+// both compilation and ELF patching are skipped and the existing object is
+// only reloaded.
+//
 // This function will block if the cache does not contain an entry for the
 // same EndpointConfiguration and multiple goroutines attempt to concurrently
 // CompileOrLoad with the same configuration parameters. When the first
 // goroutine completes compilation of the template, all other CompileOrLoad
 // invocations will be released.
-func (l *Loader) CompileOrLoad(ctx context.Context, ep datapath.Endpoint, stats *metrics.SpanStat) error {
+func (l *Loader) CompileOrLoad(ctx context.Context, ep datapath.Endpoint, stats *loaderMetrics.SpanStat) error {
+	hash, err := l.EndpointHash(ep)
+	if err != nil {
+		ep.Logger(Subsystem).WithError(err).Warn("Unable to hash datapath configuration")
+		hash = ""
+	}
+	if hash != "" && hash == l.loadedHash(ep) {
+		// The loaded program was built from a byte-identical
+		// configuration. Skip compilation and ELF patching, but still
+		// reload to ensure that the programs are attached and the maps
+		// are correctly pinned.
+		metrics.DatapathCompilationCache.WithLabelValues(compilationCacheHit).Inc()
+		ep.Logger(Subsystem).WithField(logfields.BPFHeaderfileHash, hash).
+			Debug("Datapath configuration unchanged, skipping compilation and ELF patching")
+		return l.ReloadDatapath(ctx, ep, stats)
+	}
+	metrics.DatapathCompilationCache.WithLabelValues(compilationCacheMiss).Inc()
+
 	templatePath, _, err := l.templateCache.fetchOrCompile(ctx, ep, stats)
 	if err != nil {
 		return err
@@ -474,11 +541,17 @@ func (l *Loader) CompileOrLoad(ctx context.Context, ep datapath.Endpoint, stats
 	}
 	stats.BpfWriteELF.End(err == nil)
 
-	return l.ReloadDatapath(ctx, ep, stats)
+	if err := l.ReloadDatapath(ctx, ep, stats); err != nil {
+		return err
+	}
+	if hash != "" {
+		l.setLoadedHash(ep, hash)
+	}
+	return nil
 }
 
 // ReloadDatapath reloads the BPF datapath pgorams for the specified endpoint.
-func (l *Loader) ReloadDatapath(ctx context.Context, ep datapath.Endpoint, stats *metrics.SpanStat) (err error) {
+func (l *Loader) ReloadDatapath(ctx context.Context, ep datapath.Endpoint, stats *loaderMetrics.SpanStat) (err error) {
 	dirs := directoryInfo{
 		Library: option.Config.BpfDir,
 		Runtime: option.Config.StateDir,
@@ -493,6 +566,7 @@ func (l *Loader) ReloadDatapath(ctx context.Context, ep datapath.Endpoint, stats
 
 // Unload removes the datapath specific program aspects
 func (l *Loader) Unload(ep datapath.Endpoint) {
+	l.forgetLoadedHash(ep)
 	if ep.RequireEndpointRoute() {
 		if ip := ep.IPv4Address(); ip.IsSet() {
 			removeEndpointRoute(ep, *ip.IPNet(32))
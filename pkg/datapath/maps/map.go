@@ -40,14 +40,17 @@ var (
 )
 
 // endpointManager checks against its list of the current endpoints to determine
-// whether map paths should be removed, and implements map removal.
+// whether map paths should be removed, and implements map removal and
+// recreation.
 //
 // This interface is provided to abstract epmanager/filesystem access for unit
 // testing.
 type endpointManager interface {
 	EndpointExists(endpointID uint16) bool
+	ListEndpointIDs() []uint16
 	RemoveDatapathMapping(endpointID uint16) error
 	RemoveMapPath(path string)
+	RecreatePolicyMap(name string) error
 	HasGlobalCT() bool
 }
 
@@ -56,6 +59,15 @@ type endpointManager interface {
 // exists.
 type MapSweeper struct {
 	endpointManager
+
+	// policyMapsSeen records the endpoints for which a current policy map
+	// path was encountered during the walk.
+	policyMapsSeen map[uint16]struct{}
+
+	// removed and repaired count the map paths deleted respectively
+	// recreated while reconciling the endpoint BPF state.
+	removed  int
+	repaired int
 }
 
 // NewMapSweeper creates an object that walks map paths and garbage-collects
@@ -63,9 +75,17 @@ type MapSweeper struct {
 func NewMapSweeper(g endpointManager) *MapSweeper {
 	return &MapSweeper{
 		endpointManager: g,
+		policyMapsSeen:  make(map[uint16]struct{}),
 	}
 }
 
+// removeMapPath removes the specified path and accounts for it in the
+// reconciliation summary.
+func (ms *MapSweeper) removeMapPath(path string) {
+	ms.RemoveMapPath(path)
+	ms.removed++
+}
+
 // deleteMapIfStale uses the endpointManager implementation to determine for
 // the given path whether it should be deleted, and if so deletes the path.
 func (ms *MapSweeper) deleteMapIfStale(path string, filename string, endpointID string) {
@@ -74,14 +94,16 @@ func (ms *MapSweeper) deleteMapIfStale(path string, filename string, endpointID
 		if ms.EndpointExists(epID) {
 			prefix := strings.TrimSuffix(filename, endpointID)
 			if filename != bpf.LocalMapName(prefix, epID) {
-				ms.RemoveMapPath(path)
+				ms.removeMapPath(path)
+			} else if prefix == policymap.MapName {
+				ms.policyMapsSeen[epID] = struct{}{}
 			}
 		} else {
 			err2 := ms.RemoveDatapathMapping(epID)
 			if err2 != nil {
 				log.WithError(err2).Debugf("Failed to remove ID %d from global policy map", tmp)
 			}
-			ms.RemoveMapPath(path)
+			ms.removeMapPath(path)
 		}
 	}
 }
@@ -92,7 +114,7 @@ func (ms *MapSweeper) checkStaleGlobalMap(path string, filename string) {
 			option.Config.EnableIPv6Masquerade)
 
 	if !globalCTinUse && ctmap.NameIsGlobal(filename) {
-		ms.RemoveMapPath(path)
+		ms.removeMapPath(path)
 	}
 }
 
@@ -123,12 +145,39 @@ func (ms *MapSweeper) walk(path string, _ os.FileInfo, _ error) error {
 	return nil
 }
 
-// CollectStaleMapGarbage cleans up stale content in the BPF maps from the
-// datapath.
+// repairMissingPolicyMaps recreates the policy map of restored endpoints
+// whose map path was lost, e.g. after an unclean shutdown, such that the
+// endpoints can be regenerated instead of failed. Calls and conntrack maps do
+// not need to be recreated here as they are rebuilt when the endpoint
+// datapath is regenerated.
+func (ms *MapSweeper) repairMissingPolicyMaps() {
+	for _, epID := range ms.ListEndpointIDs() {
+		if _, ok := ms.policyMapsSeen[epID]; ok {
+			continue
+		}
+
+		name := bpf.LocalMapName(policymap.MapName, epID)
+		if err := ms.RecreatePolicyMap(name); err != nil {
+			log.WithError(err).WithField(logfields.BPFMapName, name).Warn("Unable to recreate missing policy map")
+			continue
+		}
+		log.WithField(logfields.BPFMapName, name).Info("Recreated missing policy map")
+		ms.repaired++
+	}
+}
+
+// CollectStaleMapGarbage reconciles the per-endpoint content of the BPF maps
+// from the datapath with the restored endpoints: stale content is cleaned up
+// and missing policy maps of existing endpoints are recreated.
 func (ms *MapSweeper) CollectStaleMapGarbage() {
 	if err := filepath.Walk(bpf.MapPrefixPath(), ms.walk); err != nil {
 		log.WithError(err).Warn("Error while scanning for stale maps")
 	}
+
+	ms.repairMissingPolicyMaps()
+
+	log.Infof("Reconciled endpoint BPF state: %d stale map path(s) removed, %d missing policy map(s) recreated",
+		ms.removed, ms.repaired)
 }
 
 // RemoveDisabledMaps removes BPF maps in the filesystem for features that have
@@ -20,7 +20,9 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/checker"
+	"github.com/cilium/cilium/pkg/maps/policymap"
 
 	. "gopkg.in/check.v1"
 )
@@ -38,6 +40,7 @@ type testEPManager struct {
 	endpoints       map[uint16]struct{}
 	removedPaths    []string
 	removedMappings []int
+	recreatedPaths  []string
 }
 
 func (tm *testEPManager) EndpointExists(id uint16) bool {
@@ -45,6 +48,14 @@ func (tm *testEPManager) EndpointExists(id uint16) bool {
 	return exists
 }
 
+func (tm *testEPManager) ListEndpointIDs() []uint16 {
+	ids := make([]uint16, 0, len(tm.endpoints))
+	for id := range tm.endpoints {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 func (tm *testEPManager) HasGlobalCT() bool {
 	return false
 }
@@ -58,6 +69,11 @@ func (tm *testEPManager) RemoveMapPath(path string) {
 	tm.removedPaths = append(tm.removedPaths, path)
 }
 
+func (tm *testEPManager) RecreatePolicyMap(name string) error {
+	tm.recreatedPaths = append(tm.recreatedPaths, name)
+	return nil
+}
+
 func (tm *testEPManager) addEndpoint(id uint16) {
 	tm.endpoints[id] = struct{}{}
 }
@@ -67,6 +83,7 @@ func newTestEPManager() *testEPManager {
 		endpoints:       make(map[uint16]struct{}),
 		removedPaths:    make([]string, 0),
 		removedMappings: make([]int, 0),
+		recreatedPaths:  make([]string, 0),
 	}
 }
 
@@ -216,3 +233,31 @@ func (s *MapTestSuite) TestCollectStaleMapGarbage(c *C) {
 		c.Assert(testEPManager.removedPaths, checker.DeepEquals, tt.removedPaths)
 	}
 }
+
+func (s *MapTestSuite) TestRepairMissingPolicyMaps(c *C) {
+	testEPManager := newTestEPManager()
+	sweeper := NewMapSweeper(testEPManager)
+
+	testEPManager.addEndpoint(1)
+	testEPManager.addEndpoint(42)
+
+	// Endpoint 42 has lost its policy map, endpoint 1 is intact.
+	paths := []string{
+		"cilium_policy_00001",
+		"cilium_ct6_00001",
+		"cilium_ct4_00001",
+		"cilium_calls_00042",
+	}
+	for _, path := range paths {
+		err := sweeper.walk(path, nil, nil)
+		c.Assert(err, IsNil)
+	}
+
+	sweeper.repairMissingPolicyMaps()
+
+	c.Assert(testEPManager.recreatedPaths, checker.DeepEquals, []string{
+		bpf.LocalMapName(policymap.MapName, 42),
+	})
+	c.Assert(sweeper.repaired, Equals, 1)
+	c.Assert(sweeper.removed, Equals, 0)
+}
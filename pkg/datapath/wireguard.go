@@ -26,5 +26,6 @@ type WireguardAgent interface {
 	Init(mtuConfig mtu.Configuration) error
 	UpdatePeer(nodeName, pubKeyHex string, nodeIPv4, nodeIPv6 net.IP) error
 	DeletePeer(nodeName string) error
+	RotateKey() error
 	Status(includePeers bool) (*models.WireguardStatus, error)
 }
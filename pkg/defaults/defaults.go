@@ -87,6 +87,12 @@ const (
 	// This is the 1.2 protocol version.
 	MonitorSockPath1_2 = RuntimePath + "/monitor1_2.sock"
 
+	// MonitorSockPath1_3 is the path to the UNIX domain socket used to
+	// distribute BPF and agent events to listeners which negotiate a
+	// server-side event filter on connect.
+	// This is the 1.3 protocol version.
+	MonitorSockPath1_3 = RuntimePath + "/monitor1_3.sock"
+
 	// PidFilePath is the path to the pid file for the agent.
 	PidFilePath = RuntimePath + "/cilium.pid"
 
@@ -150,6 +156,15 @@ const (
 	// StatusCollectorInterval is the interval between a probe invocations
 	StatusCollectorInterval = 5 * time.Second
 
+	// LBBackendHealthCheckInterval is the interval between two active
+	// health probes of a service backend
+	LBBackendHealthCheckInterval = 5 * time.Second
+
+	// ServiceTopologyMinBackendsRatio is the minimum ratio of local zone
+	// backends to total backends below which topology aware routing falls
+	// back to the full backend set
+	ServiceTopologyMinBackendsRatio = 0.25
+
 	// StatusCollectorWarningThreshold is the duration after which a probe
 	// is declared as stale
 	StatusCollectorWarningThreshold = 15 * time.Second
@@ -268,6 +283,11 @@ const (
 	// initial allocator state from kvstore before exiting.
 	AllocatorListTimeout = 3 * time.Minute
 
+	// ShutdownDrainTimeout specifies the standard time to allow for
+	// draining in-flight proxied requests and flushing buffered Hubble
+	// flows before exiting.
+	ShutdownDrainTimeout = 10 * time.Second
+
 	// K8sWatcherEndpointSelector specifies the k8s endpoints that Cilium
 	// should watch for.
 	K8sWatcherEndpointSelector = "metadata.name!=kube-scheduler,metadata.name!=kube-controller-manager,metadata.name!=etcd-operator,metadata.name!=gcp-controller-manager"
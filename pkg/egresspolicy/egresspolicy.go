@@ -15,8 +15,13 @@
 package egresspolicy
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
 	"net"
+	"sort"
+	"strings"
 
 	k8sConst "github.com/cilium/cilium/pkg/k8s/apis/cilium.io"
 	v2alpha1 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
@@ -30,6 +35,10 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// maxEgressIPPoolSize is the maximum number of egress IPs a single policy
+// may provide, counting all addresses covered by the configured CIDRs.
+const maxEgressIPPoolSize = 256
+
 // Config is the internal representation of Cilium Egress NAT Policy.
 type Config struct {
 	// id is the parsed config name and namespace
@@ -37,7 +46,40 @@ type Config struct {
 
 	endpointSelectors []api.EndpointSelector
 	dstCIDRs          []*net.IPNet
-	egressIP          net.IP
+
+	// egressIPs is the pool of egress IPs of the policy, sorted in
+	// ascending order and free of duplicates
+	egressIPs []net.IP
+}
+
+// selectEgressIP returns the egress IP of the pool assigned to the given
+// source IP.
+func (config *Config) selectEgressIP(sourceIP net.IP) net.IP {
+	return selectEgressIPFrom(config.egressIPs, sourceIP)
+}
+
+// selectEgressIPFrom returns the egress IP of the given pool assigned to the
+// given source IP. The assignment uses rendezvous (highest random weight)
+// hashing so that a pod keeps its egress IP across policy reprocessing and
+// removing an IP from the pool only remaps the pods that were assigned to it.
+func selectEgressIPFrom(egressIPs []net.IP, sourceIP net.IP) net.IP {
+	var (
+		selected  net.IP
+		bestScore uint64
+	)
+
+	for _, egressIP := range egressIPs {
+		h := fnv.New64a()
+		h.Write(sourceIP)
+		h.Write(egressIP)
+
+		if score := h.Sum64(); selected == nil || score > bestScore {
+			selected = egressIP
+			bestScore = score
+		}
+	}
+
+	return selected
 }
 
 // PolicyID includes endpoint name and namespace
@@ -131,16 +173,101 @@ func Parse(cenp *v2alpha1.CiliumEgressNATPolicy) (*Config, error) {
 		}
 	}
 
+	egressIPs, err := parseEgressSourceIPs(cenp.Spec)
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{logfields.CiliumEgressNATPolicyName: name}).Warn("Error parsing egress source IPs.")
+		return nil, err
+	}
+
 	return &Config{
 		endpointSelectors: endpointSelectorList,
 		dstCIDRs:          dstCidrList,
-		egressIP:          net.ParseIP(cenp.Spec.EgressSourceIP).To4(),
+		egressIPs:         egressIPs,
 		id: types.NamespacedName{
 			Name: name,
 		},
 	}, nil
 }
 
+// parseEgressSourceIPs builds the egress IP pool of a policy from either the
+// single EgressSourceIP field or the EgressSourceIPs list. The returned pool
+// is sorted and free of duplicates.
+func parseEgressSourceIPs(spec v2alpha1.CiliumEgressNATPolicySpec) ([]net.IP, error) {
+	if spec.EgressSourceIP != "" && len(spec.EgressSourceIPs) != 0 {
+		return nil, fmt.Errorf("egressSourceIP and egressSourceIPs are mutually exclusive")
+	}
+
+	var egressIPs []net.IP
+
+	if spec.EgressSourceIP != "" {
+		ip := net.ParseIP(spec.EgressSourceIP).To4()
+		if ip == nil {
+			return nil, fmt.Errorf("cannot parse egress source IP %q", spec.EgressSourceIP)
+		}
+		return []net.IP{ip}, nil
+	}
+
+	for _, entry := range spec.EgressSourceIPs {
+		if strings.Contains(entry, "/") {
+			_, cidr, err := net.ParseCIDR(entry)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse egress source CIDR %q: %w", entry, err)
+			}
+			egressIPs = append(egressIPs, expandEgressCIDR(cidr)...)
+		} else {
+			ip := net.ParseIP(entry).To4()
+			if ip == nil {
+				return nil, fmt.Errorf("cannot parse egress source IP %q", entry)
+			}
+			egressIPs = append(egressIPs, ip)
+		}
+	}
+
+	if len(egressIPs) == 0 {
+		return nil, fmt.Errorf("policy does not specify any egress source IP")
+	}
+
+	sort.Slice(egressIPs, func(i, j int) bool {
+		return bytes.Compare(egressIPs[i], egressIPs[j]) < 0
+	})
+
+	deduped := egressIPs[:1]
+	for _, ip := range egressIPs[1:] {
+		if !ip.Equal(deduped[len(deduped)-1]) {
+			deduped = append(deduped, ip)
+		}
+	}
+
+	if len(deduped) > maxEgressIPPoolSize {
+		return nil, fmt.Errorf("egress source IP pool exceeds the maximum of %d addresses", maxEgressIPPoolSize)
+	}
+
+	return deduped, nil
+}
+
+// expandEgressCIDR returns all usable IPv4 addresses within the given CIDR,
+// skipping the network and broadcast addresses for prefixes shorter than /31.
+func expandEgressCIDR(cidr *net.IPNet) []net.IP {
+	first := binary.BigEndian.Uint32(cidr.IP.To4())
+	ones, bits := cidr.Mask.Size()
+	last := first | (^uint32(0) >> ones)
+
+	if bits-ones > 1 {
+		// Skip the network and broadcast addresses
+		first++
+		last--
+	}
+
+	ips := make([]net.IP, 0, last-first+1)
+	for addr := first; addr <= last && addr >= first; addr++ {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, addr)
+		ips = append(ips, ip)
+	}
+
+	return ips
+}
+
 // ParseConfigID takes a CiliumEgressNATPolicy CR and returns only the config id
 func ParseConfigID(cenp *v2alpha1.CiliumEgressNATPolicy) types.NamespacedName {
 	return policyID{
@@ -0,0 +1,189 @@
+//  Copyright 2021 Authors of Cilium
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// +build !privileged_tests
+
+package egresspolicy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	v2alpha1 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type EgressPolicySuite struct{}
+
+var _ = Suite(&EgressPolicySuite{})
+
+func (s *EgressPolicySuite) TestParseEgressSourceIPs(c *C) {
+	// Legacy single egress IP
+	egressIPs, err := parseEgressSourceIPs(v2alpha1.CiliumEgressNATPolicySpec{
+		EgressSourceIP: "192.168.1.100",
+	})
+	c.Assert(err, IsNil)
+	c.Assert(egressIPs, HasLen, 1)
+	c.Assert(egressIPs[0].String(), Equals, "192.168.1.100")
+
+	// List of individual IPs, sorted and deduplicated
+	egressIPs, err = parseEgressSourceIPs(v2alpha1.CiliumEgressNATPolicySpec{
+		EgressSourceIPs: []string{"192.168.1.102", "192.168.1.100", "192.168.1.102"},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(egressIPs, HasLen, 2)
+	c.Assert(egressIPs[0].String(), Equals, "192.168.1.100")
+	c.Assert(egressIPs[1].String(), Equals, "192.168.1.102")
+
+	// CIDR entries are expanded, skipping network and broadcast addresses
+	egressIPs, err = parseEgressSourceIPs(v2alpha1.CiliumEgressNATPolicySpec{
+		EgressSourceIPs: []string{"192.168.2.0/30"},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(egressIPs, HasLen, 2)
+	c.Assert(egressIPs[0].String(), Equals, "192.168.2.1")
+	c.Assert(egressIPs[1].String(), Equals, "192.168.2.2")
+
+	// /31 and /32 prefixes have no network or broadcast address to skip
+	egressIPs, err = parseEgressSourceIPs(v2alpha1.CiliumEgressNATPolicySpec{
+		EgressSourceIPs: []string{"192.168.2.0/31", "192.168.3.1/32"},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(egressIPs, HasLen, 3)
+
+	// EgressSourceIP and EgressSourceIPs are mutually exclusive
+	_, err = parseEgressSourceIPs(v2alpha1.CiliumEgressNATPolicySpec{
+		EgressSourceIP:  "192.168.1.100",
+		EgressSourceIPs: []string{"192.168.1.101"},
+	})
+	c.Assert(err, NotNil)
+
+	// At least one egress IP must be specified
+	_, err = parseEgressSourceIPs(v2alpha1.CiliumEgressNATPolicySpec{})
+	c.Assert(err, NotNil)
+
+	// Pool size is bounded
+	_, err = parseEgressSourceIPs(v2alpha1.CiliumEgressNATPolicySpec{
+		EgressSourceIPs: []string{"192.168.0.0/23"},
+	})
+	c.Assert(err, NotNil)
+
+	// Invalid entries are rejected
+	_, err = parseEgressSourceIPs(v2alpha1.CiliumEgressNATPolicySpec{
+		EgressSourceIPs: []string{"not-an-ip"},
+	})
+	c.Assert(err, NotNil)
+}
+
+func (s *EgressPolicySuite) TestSelectEgressIP(c *C) {
+	egressIPs, err := parseEgressSourceIPs(v2alpha1.CiliumEgressNATPolicySpec{
+		EgressSourceIPs: []string{"192.168.1.0/28"},
+	})
+	c.Assert(err, IsNil)
+
+	config := &Config{egressIPs: egressIPs}
+
+	sourceIPs := make([]net.IP, 0, 100)
+	for i := 0; i < 100; i++ {
+		sourceIPs = append(sourceIPs, net.ParseIP(fmt.Sprintf("10.0.0.%d", i)).To4())
+	}
+
+	// Selection is deterministic across calls and stays within the pool
+	assigned := make(map[string]string)
+	for _, sip := range sourceIPs {
+		egressIP := config.selectEgressIP(sip)
+		c.Assert(egressIP, NotNil)
+		c.Assert(egressIP.Equal(config.selectEgressIP(sip)), Equals, true)
+		assigned[sip.String()] = egressIP.String()
+	}
+
+	// Removing one IP from the pool only remaps the pods that were
+	// assigned to it
+	removed := assigned[sourceIPs[0].String()]
+	shrunkIPs := make([]net.IP, 0, len(egressIPs)-1)
+	for _, ip := range egressIPs {
+		if ip.String() != removed {
+			shrunkIPs = append(shrunkIPs, ip)
+		}
+	}
+	shrunk := &Config{egressIPs: shrunkIPs}
+
+	for _, sip := range sourceIPs {
+		egressIP := shrunk.selectEgressIP(sip)
+		if assigned[sip.String()] != removed {
+			c.Assert(egressIP.String(), Equals, assigned[sip.String()])
+		} else {
+			c.Assert(egressIP.String(), Not(Equals), removed)
+		}
+	}
+}
+
+func (s *EgressPolicySuite) TestGatewayHealthFailover(c *C) {
+	egressIPs, err := parseEgressSourceIPs(v2alpha1.CiliumEgressNATPolicySpec{
+		EgressSourceIPs: []string{"192.168.1.100", "192.168.1.101"},
+	})
+	c.Assert(err, IsNil)
+
+	config := &Config{
+		id:        policyID{Name: "test-policy"},
+		egressIPs: egressIPs,
+	}
+
+	manager := &Manager{
+		policyEndpoints: make(map[endpointID][]policyID),
+		policyConfigs:   map[policyID]*Config{config.id: config},
+		epDataStore:     make(map[endpointID]*endpointMetadata),
+		gatewayHealth:   make(map[string]bool),
+	}
+
+	unhealthy := map[string]bool{}
+	oldProbe := probeGatewayHealth
+	probeGatewayHealth = func(gatewayIP net.IP) error {
+		if unhealthy[gatewayIP.String()] {
+			return fmt.Errorf("gateway down")
+		}
+		return nil
+	}
+	defer func() { probeGatewayHealth = oldProbe }()
+
+	// All gateways healthy: the full pool is eligible
+	c.Assert(manager.checkGatewayHealth(context.TODO()), IsNil)
+	c.Assert(manager.healthyEgressIPs(config), HasLen, 2)
+
+	// One gateway goes down: it is excluded from the pool
+	unhealthy["192.168.1.100"] = true
+	c.Assert(manager.checkGatewayHealth(context.TODO()), IsNil)
+	healthy := manager.healthyEgressIPs(config)
+	c.Assert(healthy, HasLen, 1)
+	c.Assert(healthy[0].String(), Equals, "192.168.1.101")
+
+	// All gateways down: fall back to the full pool
+	unhealthy["192.168.1.101"] = true
+	c.Assert(manager.checkGatewayHealth(context.TODO()), IsNil)
+	c.Assert(manager.healthyEgressIPs(config), HasLen, 2)
+
+	// The original gateway recovers: traffic flips back to it
+	delete(unhealthy, "192.168.1.100")
+	c.Assert(manager.checkGatewayHealth(context.TODO()), IsNil)
+	healthy = manager.healthyEgressIPs(config)
+	c.Assert(healthy, HasLen, 1)
+	c.Assert(healthy[0].String(), Equals, "192.168.1.100")
+}
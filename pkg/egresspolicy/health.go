@@ -0,0 +1,156 @@
+//  Copyright 2021 Authors of Cilium
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package egresspolicy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cilium/cilium/pkg/controller"
+	healthDefaults "github.com/cilium/cilium/pkg/health/defaults"
+	"github.com/cilium/cilium/pkg/health/probe"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
+)
+
+const gatewayHealthCheckControllerName = "egress-gateway-health-check"
+
+// probeGatewayHealth checks the liveness of the given gateway node by probing
+// the /hello endpoint of its cilium-health server.
+var probeGatewayHealth = func(gatewayIP net.IP) error {
+	host := "http://" + net.JoinHostPort(gatewayIP.String(), strconv.Itoa(healthDefaults.HTTPPathPort))
+	return probe.GetHello(host)
+}
+
+// runGatewayHealthChecker starts the controller which periodically probes the
+// liveness of all gateway nodes referenced by the installed egress policies
+// and reprograms the egress map when the health state of a gateway changes.
+func (manager *Manager) runGatewayHealthChecker(interval time.Duration) {
+	manager.controllers.UpdateController(gatewayHealthCheckControllerName,
+		controller.ControllerParams{
+			RunInterval: interval,
+			DoFunc:      manager.checkGatewayHealth,
+		})
+}
+
+// checkGatewayHealth probes all gateway nodes referenced by the installed
+// egress policies. When the health state of a gateway changes, the affected
+// policies are reprogrammed so that traffic fails over to the remaining
+// healthy gateways, or flips back once the original gateway recovered.
+func (manager *Manager) checkGatewayHealth(ctx context.Context) error {
+	manager.mutex.Lock()
+	gateways := make(map[string]net.IP)
+	for _, config := range manager.policyConfigs {
+		for _, egressIP := range config.egressIPs {
+			gateways[egressIP.String()] = egressIP
+		}
+	}
+	manager.mutex.Unlock()
+
+	// Probe all gateways in parallel without holding the manager lock, as
+	// each probe may block for up to the probe timeout.
+	var (
+		wg       sync.WaitGroup
+		healthMu lock.Mutex
+		health   = make(map[string]bool, len(gateways))
+	)
+	for _, gatewayIP := range gateways {
+		wg.Add(1)
+		go func(ip net.IP) {
+			defer wg.Done()
+			err := probeGatewayHealth(ip)
+			healthMu.Lock()
+			health[ip.String()] = err == nil
+			healthMu.Unlock()
+		}(gatewayIP)
+	}
+	wg.Wait()
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	changed := false
+	for ip, healthy := range health {
+		previous, tracked := manager.gatewayHealth[ip]
+		manager.gatewayHealth[ip] = healthy
+
+		if (tracked && previous == healthy) || (!tracked && healthy) {
+			continue
+		}
+
+		changed = true
+		metrics.EgressGatewayFailovers.Inc()
+		if healthy {
+			log.WithField(logfields.IPAddr, ip).Info("Egress gateway recovered, flipping affected egress policies back")
+		} else {
+			log.WithField(logfields.IPAddr, ip).Warn("Egress gateway became unhealthy, failing over affected egress policies")
+		}
+	}
+
+	// Forget about gateways which are no longer referenced by any policy.
+	for ip := range manager.gatewayHealth {
+		if _, ok := health[ip]; !ok {
+			delete(manager.gatewayHealth, ip)
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return manager.reprogramPolicies()
+}
+
+// reprogramPolicies rewrites the egress map entries of all policy to endpoint
+// mappings. The caller must hold the manager lock.
+func (manager *Manager) reprogramPolicies() error {
+	for endpointID, policies := range manager.policyEndpoints {
+		epData, ok := manager.epDataStore[endpointID]
+		if !ok {
+			return fmt.Errorf("failed to get endpoint data for %v", endpointID)
+		}
+		for _, policyID := range policies {
+			if err := manager.updateEgressMap(epData.ips, manager.policyConfigs[policyID]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// healthyEgressIPs returns the subset of the policy's egress IP pool whose
+// gateway nodes are currently considered healthy. If no gateway of the pool
+// is healthy, the full pool is returned so that traffic keeps its stable
+// assignment instead of being black-holed at the source. The caller must hold
+// the manager lock.
+func (manager *Manager) healthyEgressIPs(config *Config) []net.IP {
+	healthy := make([]net.IP, 0, len(config.egressIPs))
+	for _, egressIP := range config.egressIPs {
+		if h, tracked := manager.gatewayHealth[egressIP.String()]; !tracked || h {
+			healthy = append(healthy, egressIP)
+		}
+	}
+
+	if len(healthy) == 0 {
+		return config.egressIPs
+	}
+
+	return healthy
+}
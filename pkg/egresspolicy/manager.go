@@ -19,12 +19,14 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/cilium/cilium/pkg/controller"
 	k8sTypes "github.com/cilium/cilium/pkg/k8s/types"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/maps/egressmap"
+	"github.com/cilium/cilium/pkg/option"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/types"
@@ -46,14 +48,27 @@ type Manager struct {
 	policyConfigs map[policyID]*Config
 	// Stores endpointId to endpoint metadata mapping
 	epDataStore map[endpointID]*endpointMetadata
+	// Stores the last observed health state of the gateway nodes, keyed
+	// by egress IP
+	gatewayHealth map[string]bool
+
+	controllers *controller.Manager
 }
 
 func NewEgressPolicyManager() *Manager {
-	return &Manager{
+	manager := &Manager{
 		policyEndpoints: make(map[endpointID][]policyID),
 		policyConfigs:   make(map[policyID]*Config),
 		epDataStore:     make(map[endpointID]*endpointMetadata),
+		gatewayHealth:   make(map[string]bool),
+		controllers:     controller.NewManager(),
+	}
+
+	if option.Config.EnableEgressGateway && option.Config.EgressGatewayHealthCheckInterval > 0 {
+		manager.runGatewayHealthChecker(option.Config.EgressGatewayHealthCheckInterval)
 	}
+
+	return manager
 }
 
 // Event handlers
@@ -249,11 +264,14 @@ func getEndpointMetadata(endpoint *k8sTypes.CiliumEndpoint) (*endpointMetadata,
 // isValidConfig validates the given policy config.
 func (manager *Manager) isValidConfig(config Config) error {
 	for _, policyConfig := range manager.policyConfigs {
-		if policyConfig.egressIP.String() == config.egressIP.String() {
-			return fmt.Errorf(
-				"CiliumEgressNatPolicy for egress IP %v already exists",
-				config.egressIP.String())
-
+		for _, existingIP := range policyConfig.egressIPs {
+			for _, egressIP := range config.egressIPs {
+				if existingIP.Equal(egressIP) {
+					return fmt.Errorf(
+						"CiliumEgressNatPolicy for egress IP %v already exists",
+						egressIP.String())
+				}
+			}
 		}
 	}
 	return nil
@@ -286,13 +304,14 @@ func (manager *Manager) upsertPolicyEndpoint(config *Config, epData *endpointMet
 func (manager *Manager) updateEgressMap(ips []string, config *Config) error {
 	for _, ip := range ips {
 		sip := net.ParseIP(ip).To4()
+		egressIP := selectEgressIPFrom(manager.healthyEgressIPs(config), sip)
 		for _, dstCIDR := range config.dstCIDRs {
 			key := egressmap.NewKey(sip, dstCIDR.IP, dstCIDR.Mask)
 			value := &egressmap.EgressInfo4{}
 			// As currently designed, the egressIP serves two purposes, one for forwarding traffic
 			// to the gateway node, the other for SNATing the egress traffic on the gateway.
-			copy(value.TunnelEndpoint[:], config.egressIP)
-			copy(value.EgressIP[:], config.egressIP)
+			copy(value.TunnelEndpoint[:], egressIP)
+			copy(value.EgressIP[:], egressIP)
 
 			err := egressmap.EgressMap.Update(&key, value)
 			if err != nil {
@@ -113,6 +113,8 @@ func NewEndpointFromChangeModel(ctx context.Context, owner regeneration.Owner, p
 		ep.DatapathConfiguration = *base.DatapathConfiguration
 	}
 
+	ep.secondaryInterfaces = base.SecondaryInterfaces
+
 	if base.Labels != nil {
 		lbls := labels.NewLabelsFromModel(base.Labels)
 		identityLabels, infoLabels := labelsfilter.Filter(lbls)
@@ -143,10 +145,11 @@ func (e *Endpoint) getModelNetworkingRLocked() *models.EndpointNetworking {
 			IPV4: e.IPv4.String(),
 			IPV6: e.IPv6.String(),
 		}},
-		InterfaceIndex: int64(e.ifIndex),
-		InterfaceName:  e.ifName,
-		Mac:            e.mac.String(),
-		HostMac:        e.nodeMAC.String(),
+		InterfaceIndex:      int64(e.ifIndex),
+		InterfaceName:       e.ifName,
+		Mac:                 e.mac.String(),
+		HostMac:             e.nodeMAC.String(),
+		SecondaryInterfaces: e.secondaryInterfaces,
 	}
 }
 
@@ -206,18 +209,33 @@ func (e *Endpoint) GetModelRLocked() *models.Endpoint {
 			ExternalIdentifiers: e.getModelEndpointIdentitiersRLocked(),
 			// FIXME GH-3280 When we begin returning endpoint revisions this should
 			// change to return the configured and in-datapath policies.
-			Policy:      e.GetPolicyModel(),
-			Log:         statusLog,
-			Controllers: controllerMdl,
-			State:       e.getModelCurrentStateRLocked(), // TODO: Validate
-			Health:      e.getHealthModel(),
-			NamedPorts:  e.getNamedPortsModel(),
+			Policy:                 e.GetPolicyModel(),
+			Log:                    statusLog,
+			Controllers:            controllerMdl,
+			State:                  e.getModelCurrentStateRLocked(), // TODO: Validate
+			Health:                 e.getHealthModel(),
+			NamedPorts:             e.getNamedPortsModel(),
+			RegenerationStatistics: e.getRegenerationStatisticsModel(),
+			Bandwidth:              e.getBandwidthModel(),
 		},
 	}
 
 	return mdl
 }
 
+// getBandwidthModel returns the bandwidth limits configured for the endpoint.
+//
+// Must be called with e.mutex RLock()ed.
+func (e *Endpoint) getBandwidthModel() *models.EndpointBandwidth {
+	if e.bps == 0 && e.ingressBps == 0 {
+		return nil
+	}
+	return &models.EndpointBandwidth{
+		Ingress: int64(e.ingressBps),
+		Egress:  int64(e.bps),
+	}
+}
+
 // GetHealthModel returns the endpoint's health object.
 //
 // Must be called with e.mutex RLock()ed.
@@ -368,10 +386,12 @@ func (e *Endpoint) GetPolicyModel() *models.EndpointPolicyStatus {
 	var (
 		realizedCIDRPolicy *policy.CIDRPolicy
 		realizedL4Policy   *policy.L4Policy
+		realizedProvenance []*models.PolicyMapEntryProvenance
 	)
 	if e.realizedPolicy != nil {
 		realizedL4Policy = e.realizedPolicy.L4Policy
 		realizedCIDRPolicy = e.realizedPolicy.CIDRPolicy
+		realizedProvenance = e.realizedPolicy.PolicyMapState.GetProvenanceModel()
 	}
 
 	mdl := &models.EndpointPolicy{
@@ -385,16 +405,19 @@ func (e *Endpoint) GetPolicyModel() *models.EndpointPolicyStatus {
 		DeniedEgressIdentities:   realizedDenyEgressIdentities,
 		CidrPolicy:               realizedCIDRPolicy.GetModel(),
 		L4:                       realizedL4Policy.GetModel(),
+		PolicyMapProvenance:      realizedProvenance,
 		PolicyEnabled:            policyEnabled,
 	}
 
 	var (
 		desiredCIDRPolicy *policy.CIDRPolicy
 		desiredL4Policy   *policy.L4Policy
+		desiredProvenance []*models.PolicyMapEntryProvenance
 	)
 	if e.desiredPolicy != nil {
 		desiredCIDRPolicy = e.desiredPolicy.CIDRPolicy
 		desiredL4Policy = e.desiredPolicy.L4Policy
+		desiredProvenance = e.desiredPolicy.PolicyMapState.GetProvenanceModel()
 	}
 
 	desiredMdl := &models.EndpointPolicy{
@@ -408,6 +431,7 @@ func (e *Endpoint) GetPolicyModel() *models.EndpointPolicyStatus {
 		DeniedEgressIdentities:   desiredDenyEgressIdentities,
 		CidrPolicy:               desiredCIDRPolicy.GetModel(),
 		L4:                       desiredL4Policy.GetModel(),
+		PolicyMapProvenance:      desiredProvenance,
 		PolicyEnabled:            policyEnabled,
 	}
 	// FIXME GH-3280 Once we start returning revisions Realized should be the
@@ -417,6 +441,7 @@ func (e *Endpoint) GetPolicyModel() *models.EndpointPolicyStatus {
 		Realized:            mdl,
 		ProxyPolicyRevision: int64(e.proxyPolicyRevision),
 		ProxyStatistics:     proxyStats,
+		PendingRedirects:    e.pendingRedirectsModel(),
 	}
 }
 
@@ -22,6 +22,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -65,6 +67,11 @@ const (
 	// ciliumCHeaderPrefix is the prefix using when printing/writing an endpoint in a
 	// base64 form.
 	ciliumCHeaderPrefix = "CILIUM_BASE64_"
+
+	// redirectRetryInterval is the interval in which an endpoint with
+	// pending proxy redirects is regenerated until all of its redirects
+	// are confirmed listening.
+	redirectRetryInterval = 15 * time.Second
 )
 
 var (
@@ -269,33 +276,50 @@ func (e *Endpoint) addNewRedirectsFromDesiredPolicy(ingress bool, desiredRedirec
 				var err error
 				redirectPort, err, finalizeFunc, revertFunc = e.proxy.CreateOrUpdateRedirect(l4, proxyID, e, proxyWaitGroup)
 				if err != nil {
-					revertStack.Revert() // Ignore errors while reverting. This is best-effort.
-					return err, nil, nil
-				}
-				finalizeList.Append(finalizeFunc)
-				revertStack.Push(revertFunc)
+					// The proxy listener could not be confirmed
+					// listening. Do not fail the regeneration,
+					// apply the configured fallback to the map
+					// entries of this redirect instead and retry
+					// until the proxy comes up.
+					e.getLogger().WithError(err).WithField(logfields.L4PolicyID, proxyID).
+						Warning("Proxy redirect unavailable, applying fallback")
+					e.markRedirectPending(proxyID)
+					if option.Config.ProxyRedirectFallback != option.ProxyRedirectFallbackAllow {
+						// Fail closed: leave the map entries
+						// of this redirect uninstalled until
+						// the proxy is confirmed listening.
+						continue
+					}
+					// Bypass the proxy with a plain L4 allow
+					// until it is confirmed listening.
+					redirectPort = 0
+				} else {
+					delete(e.pendingRedirects, proxyID)
+					finalizeList.Append(finalizeFunc)
+					revertStack.Push(revertFunc)
 
-				if e.realizedRedirects == nil {
-					e.realizedRedirects = make(map[string]uint16)
-				}
-				if _, found := e.realizedRedirects[proxyID]; !found {
-					revertStack.Push(func() error {
-						delete(e.realizedRedirects, proxyID)
-						return nil
-					})
-				}
-				e.realizedRedirects[proxyID] = redirectPort
+					if e.realizedRedirects == nil {
+						e.realizedRedirects = make(map[string]uint16)
+					}
+					if _, found := e.realizedRedirects[proxyID]; !found {
+						revertStack.Push(func() error {
+							delete(e.realizedRedirects, proxyID)
+							return nil
+						})
+					}
+					e.realizedRedirects[proxyID] = redirectPort
 
-				desiredRedirects[proxyID] = true
+					desiredRedirects[proxyID] = true
 
-				// Update the endpoint API model to report that Cilium manages a
-				// redirect for that port.
-				e.proxyStatisticsMutex.Lock()
-				proxyStats := e.getProxyStatisticsLocked(proxyID, string(l4.L7Parser), uint16(l4.Port), l4.Ingress)
-				proxyStats.AllocatedProxyPort = int64(redirectPort)
-				e.proxyStatisticsMutex.Unlock()
+					// Update the endpoint API model to report that Cilium manages a
+					// redirect for that port.
+					e.proxyStatisticsMutex.Lock()
+					proxyStats := e.getProxyStatisticsLocked(proxyID, string(l4.L7Parser), uint16(l4.Port), l4.Ingress)
+					proxyStats.AllocatedProxyPort = int64(redirectPort)
+					e.proxyStatisticsMutex.Unlock()
 
-				updatedStats = append(updatedStats, proxyStats)
+					updatedStats = append(updatedStats, proxyStats)
+				}
 			}
 
 			if e.desiredPolicy == e.realizedPolicy {
@@ -463,6 +487,65 @@ func (e *Endpoint) addVisibilityRedirects(ingress bool, desiredRedirects map[str
 	return nil, finalizeList.Finalize, revertStack.Revert
 }
 
+// markRedirectPending records that the proxy listener of the given redirect
+// is not yet confirmed listening.
+// Must be called with endpoint.mutex Lock()ed.
+func (e *Endpoint) markRedirectPending(proxyID string) {
+	if e.pendingRedirects == nil {
+		e.pendingRedirects = make(map[string]bool)
+	}
+	e.pendingRedirects[proxyID] = true
+}
+
+// redirectsPending returns true if any proxy redirect of the endpoint is not
+// yet confirmed listening.
+func (e *Endpoint) redirectsPending() bool {
+	if err := e.rlockAlive(); err != nil {
+		return false
+	}
+	defer e.runlock()
+
+	return len(e.pendingRedirects) > 0
+}
+
+// pendingRedirectsModel returns the sorted list of pending proxy redirect IDs
+// for the endpoint API model.
+// Must be called with e.mutex RLock()ed.
+func (e *Endpoint) pendingRedirectsModel() []string {
+	if len(e.pendingRedirects) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(e.pendingRedirects))
+	for id := range e.pendingRedirects {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// scheduleRedirectRetry starts a controller which regenerates the endpoint
+// until all of its proxy redirects are confirmed listening, upgrading the
+// fallback policy map entries to the real redirects.
+// Must be called with endpoint.mutex Lock()ed.
+func (e *Endpoint) scheduleRedirectRetry() {
+	e.controllers.UpdateController(fmt.Sprintf("proxy-redirect-retry-%d", e.ID),
+		controller.ControllerParams{
+			RunInterval: redirectRetryInterval,
+			Context:     e.aliveCtx,
+			DoFunc: func(ctx context.Context) error {
+				if !e.redirectsPending() {
+					return controller.NewExitReason("no pending proxy redirects")
+				}
+				<-e.RegenerateIfAlive(&regeneration.ExternalRegenerationMetadata{
+					Reason:            "retry pending proxy redirects",
+					RegenerationLevel: regeneration.RegenerateWithoutDatapath,
+				})
+				return nil
+			},
+		})
+}
+
 // addNewRedirects must be called while holding the endpoint lock for writing.
 // On success, returns nil; otherwise, returns an error indicating the problem
 // that occurred while adding an l7 redirect for the specified policy.
@@ -486,6 +569,9 @@ func (e *Endpoint) addNewRedirects(proxyWaitGroup *completion.WaitGroup) (desire
 	}()
 
 	desiredRedirects = make(map[string]bool)
+	// Redirects which are still pending are re-discovered below, drop any
+	// state left behind by redirects no longer part of the desired policy.
+	e.pendingRedirects = nil
 
 	for dirLogStr, ingress := range map[string]bool{"ingress": true, "egress": false} {
 		err, ff, rf = e.addNewRedirectsFromDesiredPolicy(ingress, desiredRedirects, proxyWaitGroup)
@@ -503,6 +589,10 @@ func (e *Endpoint) addNewRedirects(proxyWaitGroup *completion.WaitGroup) (desire
 		revertStack.Push(rf)
 	}
 
+	if len(e.pendingRedirects) > 0 {
+		e.scheduleRedirectRetry()
+	}
+
 	return desiredRedirects, nil, finalizeList.Finalize, func() error {
 		e.getLogger().Debug("Reverting proxy redirect additions")
 
@@ -1027,6 +1117,13 @@ func (e *Endpoint) deleteMaps() []error {
 		}
 	}
 
+	// Remove ingress rate-limit from bandwidth manager map.
+	if e.ingressBps != 0 && option.Config.EnableBandwidthManager {
+		if err := bwmap.DeleteIngress(e.ID); err != nil {
+			errors = append(errors, fmt.Errorf("unable to remove endpoint ingress limit from bandwidth manager map: %s", err))
+		}
+	}
+
 	return errors
 }
 
@@ -1106,13 +1203,46 @@ func (e *Endpoint) initPolicyMapPressureMetric() {
 	e.policyMapPressureGauge = metrics.NewBPFMapPressureGauge(e.policyMap.NonPrefixedName(), policymap.PressureMetricThreshold)
 }
 
+// policyMapPressureWarnThreshold is the policy map fill ratio above which a
+// warning naming the selectors with the most entries is logged.
+const policyMapPressureWarnThreshold = 0.9
+
+// policyMapPressureTopSelectors is the number of selectors included when the
+// policy map is over the warning threshold or over capacity.
+const policyMapPressureTopSelectors = 5
+
 func (e *Endpoint) updatePolicyMapPressureMetric() {
-	if e.policyMapPressureGauge == nil {
+	value := float64(len(e.realizedPolicy.PolicyMapState)) / float64(e.policyMap.MapInfo.MaxEntries)
+
+	if e.policyMapPressureGauge != nil {
+		e.policyMapPressureGauge.Set(value)
+	}
+
+	if value < policyMapPressureWarnThreshold {
+		e.policyMapPressureWarned = false
 		return
 	}
+	if !e.policyMapPressureWarned {
+		e.policyMapPressureWarned = true
+		e.getLogger().WithFields(logrus.Fields{
+			logfields.BPFMapName: e.policyMap.Name(),
+			"entries":            len(e.realizedPolicy.PolicyMapState),
+			"maxEntries":         e.policyMap.MapInfo.MaxEntries,
+			"topSelectors":       e.realizedPolicy.PolicyMapState.TopSelectors(policyMapPressureTopSelectors),
+		}).Warning("Policy map is almost full, new policy entries may not be applied")
+	}
+}
 
-	value := float64(len(e.realizedPolicy.PolicyMapState)) / float64(e.policyMap.MapInfo.MaxEntries)
-	e.policyMapPressureGauge.Set(value)
+// checkPolicyMapCapacity returns an error if the desired policy map state
+// does not fit into the endpoint's BPF policy map.
+func (e *Endpoint) checkPolicyMapCapacity() error {
+	desired := len(e.desiredPolicy.PolicyMapState)
+	if max := int(e.policyMap.MapInfo.MaxEntries); desired > max {
+		return fmt.Errorf("desired policy map state with %d entries exceeds the capacity of policy map %s (%d entries), top selectors: %s",
+			desired, e.policyMap.Name(), max,
+			strings.Join(e.desiredPolicy.PolicyMapState.TopSelectors(policyMapPressureTopSelectors), ", "))
+	}
+	return nil
 }
 
 // The bool pointed by hadProxy, if not nil, will be set to 'true' if
@@ -1303,40 +1433,43 @@ func (e *Endpoint) syncPolicyMap() error {
 func (e *Endpoint) syncDesiredPolicyMapWith(realized policy.MapState, withDiffs bool) (diffCount int, diffs []policy.MapChange, err error) {
 	errors := 0
 
+	// Refuse to apply a desired state that can not fit into the policy map.
+	// Applying it partially would mis-verdict traffic in a hard to debug
+	// manner; keeping the old state and failing the regeneration is more
+	// transparent.
+	if err := e.checkPolicyMapCapacity(); err != nil {
+		return 0, nil, err
+	}
+
+	adds, deletes := e.desiredPolicy.Diff(realized)
+
 	// Add policy map entries before deleting to avoid transient drops
-	for keyToAdd, entry := range e.desiredPolicy.PolicyMapState {
-		if oldEntry, ok := realized[keyToAdd]; !ok || !oldEntry.DatapathEqual(&entry) {
-			// Redirect entries currently come in with a dummy redirect port ("1"), replace it with
-			// the actual proxy port number. This is due to the fact that proxies may not yet have
-			// bound to a specific port when a proxy policy is first instantiated.
-			if entry.IsRedirectEntry() {
-				// Will change to 0 if on a sidecar
-				entry.ProxyPort = e.realizedRedirects[policy.ProxyIDFromKey(e.ID, keyToAdd)]
-			}
-			if !e.addPolicyKey(keyToAdd, entry, false) {
-				errors++
-			}
-			diffCount++
-			if withDiffs {
-				diffs = append(diffs, policy.MapChange{Add: true, Key: keyToAdd, Value: entry})
-			}
+	for _, change := range adds {
+		entry := change.Value
+		// Redirect entries currently come in with a dummy redirect port ("1"), replace it with
+		// the actual proxy port number. This is due to the fact that proxies may not yet have
+		// bound to a specific port when a proxy policy is first instantiated.
+		if entry.IsRedirectEntry() {
+			// Will change to 0 if on a sidecar
+			entry.ProxyPort = e.realizedRedirects[policy.ProxyIDFromKey(e.ID, change.Key)]
+		}
+		if !e.addPolicyKey(change.Key, entry, false) {
+			errors++
 		}
 	}
 
 	// Delete policy keys present in the realized state, but not present in the desired state
-	for keyToDelete := range realized {
-		// If key that is in realized state is not in desired state, just remove it.
-		if entry, ok := e.desiredPolicy.PolicyMapState[keyToDelete]; !ok {
-			if !e.deletePolicyKey(keyToDelete, false, nil) {
-				errors++
-			}
-			diffCount++
-			if withDiffs {
-				diffs = append(diffs, policy.MapChange{Add: false, Key: keyToDelete, Value: entry})
-			}
+	for _, change := range deletes {
+		if !e.deletePolicyKey(change.Key, false, nil) {
+			errors++
 		}
 	}
 
+	diffCount = len(adds) + len(deletes)
+	if withDiffs {
+		diffs = append(adds, deletes...)
+	}
+
 	if errors > 0 {
 		err = fmt.Errorf("syncPolicyMap failed")
 	}
@@ -1378,17 +1511,18 @@ func (e *Endpoint) dumpPolicyMapToMapState() (policy.MapState, error) {
 // is inserted successfully to the endpoint's BPF PolicyMap, it is added to the
 // endpoint's realizedMapState field. Returns an error if the endpoint's BPF
 // PolicyMap is unable to be dumped, or any update operation to the map fails.
-// Must be called with e.mutex Lock()ed.
-func (e *Endpoint) syncPolicyMapWithDump() error {
+// Returns the number of repaired entries. Must be called with e.mutex
+// Lock()ed.
+func (e *Endpoint) syncPolicyMapWithDump() (int, error) {
 	if e.policyMap == nil {
-		return fmt.Errorf("not syncing PolicyMap state for endpoint because PolicyMap is nil")
+		return 0, fmt.Errorf("not syncing PolicyMap state for endpoint because PolicyMap is nil")
 	}
 
 	// Apply pending policy map changes first so that desired map is up-to-date before
 	// we diff the maps below.
 	_, err := e.applyPolicyMapChanges()
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	currentMap, err := e.dumpPolicyMapToMapState()
@@ -1408,13 +1542,13 @@ func (e *Endpoint) syncPolicyMapWithDump() error {
 
 		e.policyMap, _, err = policymap.OpenOrCreate(e.policyMapPath())
 		if err != nil {
-			return fmt.Errorf("unable to open PolicyMap for endpoint: %s", err)
+			return 0, fmt.Errorf("unable to open PolicyMap for endpoint: %s", err)
 		}
 
 		// Try to dump again, fail if error occurs.
 		currentMap, err = e.dumpPolicyMapToMapState()
 		if err != nil {
-			return err
+			return 0, err
 		}
 	}
 
@@ -1429,7 +1563,19 @@ func (e *Endpoint) syncPolicyMapWithDump() error {
 		e.policyDebug(logrus.Fields{"dumpedDiffs": diffs}, "syncPolicyMapWithDump")
 	}
 
-	return err
+	return diffCount, err
+}
+
+// ReconcilePolicyMap dumps the endpoint's BPF policy map and repairs any
+// divergence from the desired policy state. Returns the number of repaired
+// entries.
+func (e *Endpoint) ReconcilePolicyMap() (int, error) {
+	if err := e.lockAlive(); err != nil {
+		// The endpoint is being removed, nothing left to reconcile.
+		return 0, nil
+	}
+	defer e.unlock()
+	return e.syncPolicyMapWithDump()
 }
 
 func (e *Endpoint) syncPolicyMapController() {
@@ -1444,7 +1590,8 @@ func (e *Endpoint) syncPolicyMapController() {
 					return controller.NewExitReason("Endpoint disappeared")
 				}
 				defer e.unlock()
-				return e.syncPolicyMapWithDump()
+				_, err := e.syncPolicyMapWithDump()
+				return err
 			},
 			RunInterval: 1 * time.Minute,
 			Context:     e.aliveCtx,
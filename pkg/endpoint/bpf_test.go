@@ -22,7 +22,10 @@ import (
 	"os"
 	"testing"
 
+	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/datapath/linux"
+	"github.com/cilium/cilium/pkg/maps/policymap"
+	"github.com/cilium/cilium/pkg/policy"
 	"github.com/cilium/cilium/pkg/testutils/allocator"
 	. "gopkg.in/check.v1"
 )
@@ -35,6 +38,27 @@ func (s *EndpointSuite) TestWriteInformationalComments(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (s *EndpointSuite) TestSyncPolicyMapCapacity(c *C) {
+	e := NewEndpointWithState(s, &FakeEndpointProxy{}, &allocator.FakeIdentityAllocator{}, 100, StateWaitingForIdentity)
+	e.policyMap = &policymap.PolicyMap{Map: &bpf.Map{MapInfo: bpf.MapInfo{MaxEntries: 2}}}
+
+	// Fill the desired state beyond the capacity of the policy map.
+	e.desiredPolicy = policy.NewEndpointPolicy(s.GetPolicyRepository())
+	for i := uint32(1); i <= 3; i++ {
+		e.desiredPolicy.PolicyMapState[policy.Key{Identity: i}] = policy.MapStateEntry{}
+	}
+
+	err := e.syncPolicyMap()
+	c.Assert(err, ErrorMatches, "desired policy map state with 3 entries exceeds the capacity of policy map.*")
+
+	// The desired state must not have been applied partially.
+	c.Assert(e.realizedPolicy.PolicyMapState, HasLen, 0)
+
+	// A desired state within capacity passes the check.
+	delete(e.desiredPolicy.PolicyMapState, policy.Key{Identity: 3})
+	c.Assert(e.checkPolicyMapCapacity(), IsNil)
+}
+
 type writeFunc func(io.Writer) error
 
 func BenchmarkWriteHeaderfile(b *testing.B) {
@@ -160,6 +160,11 @@ type Endpoint struct {
 	// ifIndex is the interface index of the host face interface (veth pair)
 	ifIndex int
 
+	// secondaryInterfaces are the additional network interfaces of the
+	// endpoint, e.g. attached by a chained CNI plugin providing secondary
+	// networks
+	secondaryInterfaces []*models.EndpointSecondaryInterface
+
 	// OpLabels is the endpoint's label configuration
 	//
 	// FIXME: Rename this field to Labels
@@ -172,6 +177,9 @@ type Endpoint struct {
 	// bps is the egress rate of the endpoint
 	bps uint64
 
+	// ingressBps is the ingress rate of the endpoint
+	ingressBps uint64
+
 	// mac is the MAC address of the endpoint
 	//
 	mac mac.MAC // Container MAC address.
@@ -204,6 +212,11 @@ type Endpoint struct {
 	// policyMap.
 	policyMapPressureGauge *metrics.GaugeWithThreshold
 
+	// policyMapPressureWarned is true when a warning has been logged for the
+	// policy map pressure exceeding the warning threshold. It is reset when
+	// the pressure drops below the threshold again.
+	policyMapPressureWarned bool
+
 	// Options determine the datapath configuration of the endpoint.
 	Options *option.IntOptions
 
@@ -311,6 +324,12 @@ type Endpoint struct {
 	// You must hold Endpoint.mutex to read or write it.
 	realizedRedirects map[string]uint16
 
+	// pendingRedirects tracks the ID of each proxy redirect whose proxy
+	// listener is not yet confirmed listening and whose policy map entries
+	// are subject to the configured proxy redirect fallback.
+	// You must hold Endpoint.mutex to read or write it.
+	pendingRedirects map[string]bool
+
 	// ctCleaned indicates whether the conntrack table has already been
 	// cleaned when this endpoint was first created
 	ctCleaned bool
@@ -325,6 +344,12 @@ type Endpoint struct {
 
 	realizedPolicy *policy.EndpointPolicy
 
+	// unresolvedNamedPorts is true when the last policy calculation left
+	// the desired policy partial because one or more named ports could not
+	// be resolved for this endpoint.
+	// You must hold Endpoint.mutex to read or write it.
+	unresolvedNamedPorts bool
+
 	visibilityPolicy *policy.VisibilityPolicy
 
 	eventQueue *eventqueue.EventQueue
@@ -345,11 +370,26 @@ type Endpoint struct {
 	aliveCancel     context.CancelFunc
 	regenFailedChan chan struct{}
 
+	// regenStatsMutex protects regenStats. Regeneration statistics are
+	// recorded without holding the endpoint lock, hence the separate mutex.
+	regenStatsMutex lock.Mutex
+
+	// regenStats holds the duration breakdowns of the most recent
+	// regenerations of this endpoint, most recent first.
+	regenStats []*models.EndpointRegenerationStatistics
+
 	allocator cache.IdentityAllocator
 
 	isHost bool
 
 	noTrackPort uint16
+
+	// skipLabelsfilterOnRestore indicates that the endpoint's restored
+	// identity labels are to be kept as-is during restoration instead of
+	// being re-filtered with the current labels filter. It is set when the
+	// labels filter changed across restarts and the identities are
+	// migrated lazily afterwards.
+	skipLabelsfilterOnRestore bool
 }
 
 // EndpointSyncControllerName returns the controller name to synchronize
@@ -382,6 +422,12 @@ func (e *Endpoint) LXCMac() mac.MAC {
 	return e.mac
 }
 
+// GetSecondaryInterfaces returns the secondary network interfaces registered
+// for this endpoint.
+func (e *Endpoint) GetSecondaryInterfaces() []*models.EndpointSecondaryInterface {
+	return e.secondaryInterfaces
+}
+
 func (e *Endpoint) IsHost() bool {
 	return e.isHost
 }
@@ -501,6 +547,13 @@ func CreateHostEndpoint(owner regeneration.Owner, proxy EndpointProxy, allocator
 	ep.nodeMAC = mac
 	ep.DatapathConfiguration = NewDatapathConfiguration()
 
+	// In host firewall audit mode, the host endpoint is put into audit
+	// (non-drop) mode without affecting the enforcement of any other
+	// endpoint on the node.
+	if option.Config.EnableHostFirewall && option.Config.HostFirewallAuditMode {
+		ep.Options.SetBool(option.PolicyAuditMode, true)
+	}
+
 	ep.setState(StateWaitingForIdentity, "Endpoint creation")
 
 	return ep, nil
@@ -1635,12 +1688,12 @@ func (e *Endpoint) RunMetadataResolver(resolveMetadata MetadataResolverCB) {
 					}
 					return annotations[annotation.ProxyVisibility], nil
 				})
-				e.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthEgress string, err error) {
+				e.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthIngress, bandwidthEgress string, err error) {
 					_, _, _, _, annotations, err := resolveMetadata(ns, podName)
 					if err != nil {
-						return "", err
+						return "", "", err
 					}
-					return annotations[bandwidth.EgressBandwidth], nil
+					return annotations[bandwidth.IngressBandwidth], annotations[bandwidth.EgressBandwidth], nil
 				})
 				e.UpdateLabels(ctx, identityLabels, info, true)
 				close(done)
@@ -1654,8 +1707,9 @@ func (e *Endpoint) RunMetadataResolver(resolveMetadata MetadataResolverCB) {
 // ModifyIdentityLabels changes the custom and orchestration identity labels of an endpoint.
 // Labels can be added or deleted. If a label change is performed, the
 // endpoint will receive a new identity and will be regenerated. Both of these
-// operations will happen in the background.
-func (e *Endpoint) ModifyIdentityLabels(addLabels, delLabels labels.Labels) error {
+// operations will run first synchronously if 'blocking' is true, and then in
+// the background.
+func (e *Endpoint) ModifyIdentityLabels(addLabels, delLabels labels.Labels, blocking bool) error {
 	if err := e.lockAlive(); err != nil {
 		return err
 	}
@@ -1690,7 +1744,7 @@ func (e *Endpoint) ModifyIdentityLabels(addLabels, delLabels labels.Labels) erro
 	e.unlock()
 
 	if changed {
-		e.runIdentityResolver(context.Background(), rev, false)
+		e.runIdentityResolver(context.Background(), rev, blocking)
 	}
 	return nil
 }
@@ -1759,6 +1813,42 @@ func (e *Endpoint) UpdateLabels(ctx context.Context, identityLabels, infoLabels
 	return false
 }
 
+// IdentityLabelsOutdated returns true if re-running the endpoint's label set
+// through the current labels filter would yield a different set of identity
+// labels, i.e. the endpoint's identity was computed with an older filter
+// configuration.
+func (e *Endpoint) IdentityLabelsOutdated() bool {
+	if err := e.rlockAlive(); err != nil {
+		return false
+	}
+	defer e.runlock()
+	identityLabels, _ := labelsfilter.Filter(e.OpLabels.AllLabels())
+	return !identityLabels.Equals(e.OpLabels.IdentityLabels())
+}
+
+// ApplyLabelsFilter re-runs the endpoint's label set through the current
+// labels filter. If the set of identity-relevant labels changed, a new
+// identity is resolved in the background; the old identity stays referenced
+// until the endpoint has been regenerated with the new one, at which point it
+// is released.
+//
+// Returns true if an identity re-resolution was triggered.
+func (e *Endpoint) ApplyLabelsFilter(ctx context.Context) bool {
+	if err := e.lockAlive(); err != nil {
+		e.logDisconnectedMutexAction(err, "when trying to re-apply the labels filter")
+		return false
+	}
+	identityLabels, infoLabels := labelsfilter.Filter(e.OpLabels.AllLabels())
+	e.replaceInformationLabels(infoLabels)
+	rev := e.replaceIdentityLabels(identityLabels)
+	e.unlock()
+	if rev != 0 {
+		return e.runIdentityResolver(ctx, rev, false)
+	}
+
+	return false
+}
+
 func (e *Endpoint) identityResolutionIsObsolete(myChangeRev int) bool {
 	// Check if the endpoint has since received a new identity revision, if
 	// so, abort as a new resolution routine will have been started.
@@ -1980,6 +2070,16 @@ func (e *Endpoint) identityLabelsChanged(ctx context.Context, myChangeRev int) (
 	return readyToRegenerate, nil
 }
 
+// GetPolicyRevision returns the policy revision this endpoint is currently
+// on. A revision of zero means that no policy has been programmed for the
+// endpoint yet.
+func (e *Endpoint) GetPolicyRevision() uint64 {
+	e.unconditionalRLock()
+	rev := e.policyRevision
+	e.runlock()
+	return rev
+}
+
 // SetPolicyRevision sets the endpoint's policy revision with the given
 // revision.
 func (e *Endpoint) SetPolicyRevision(rev uint64) {
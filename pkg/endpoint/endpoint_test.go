@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/cilium/cilium/pkg/addressing"
+	"github.com/cilium/cilium/pkg/checker"
 	"github.com/cilium/cilium/pkg/datapath"
 	"github.com/cilium/cilium/pkg/datapath/fake"
 	"github.com/cilium/cilium/pkg/endpoint/regeneration"
@@ -524,6 +525,23 @@ func (s *EndpointSuite) TestProxyID(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (s *EndpointSuite) TestPendingRedirects(c *C) {
+	e := NewEndpointWithState(s, &FakeEndpointProxy{}, &allocator.FakeIdentityAllocator{}, 123, StateReady)
+
+	c.Assert(e.redirectsPending(), Equals, false)
+	c.Assert(e.pendingRedirectsModel(), IsNil)
+
+	e.markRedirectPending("123:ingress:TCP:8080")
+	e.markRedirectPending("123:egress:TCP:53")
+	c.Assert(e.redirectsPending(), Equals, true)
+	c.Assert(e.pendingRedirectsModel(), checker.DeepEquals,
+		[]string{"123:egress:TCP:53", "123:ingress:TCP:8080"})
+
+	delete(e.pendingRedirects, "123:ingress:TCP:8080")
+	delete(e.pendingRedirects, "123:egress:TCP:53")
+	c.Assert(e.redirectsPending(), Equals, false)
+}
+
 func TestEndpoint_GetK8sPodLabels(t *testing.T) {
 	type fields struct {
 		OpLabels pkgLabels.OpLabels
@@ -267,12 +267,12 @@ func (ev *EndpointPolicyVisibilityEvent) Handle(res chan interface{}) {
 // the Pod's bandwidth policy.
 type EndpointPolicyBandwidthEvent struct {
 	ep     *Endpoint
-	annoCB AnnotationsResolverCB
+	annoCB BandwidthAnnotationsResolverCB
 }
 
 // Handle handles the policy bandwidth update.
 func (ev *EndpointPolicyBandwidthEvent) Handle(res chan interface{}) {
-	var bps uint64
+	var bps, ingressBps uint64
 
 	e := ev.ep
 	if err := e.lockAlive(); err != nil {
@@ -287,7 +287,7 @@ func (ev *EndpointPolicyBandwidthEvent) Handle(res chan interface{}) {
 		e.unlock()
 	}()
 
-	bandwidthEgress, err := ev.annoCB(e.K8sNamespace, e.K8sPodName)
+	bandwidthIngress, bandwidthEgress, err := ev.annoCB(e.K8sNamespace, e.K8sPodName)
 	if err != nil || !option.Config.EnableBandwidthManager {
 		res <- &EndpointRegenerationResult{
 			err: err,
@@ -308,6 +308,20 @@ func (ev *EndpointPolicyBandwidthEvent) Handle(res chan interface{}) {
 		}
 		return
 	}
+	if bandwidthIngress != "" {
+		ingressBps, err = bandwidth.GetBytesPerSec(bandwidthIngress)
+		if err == nil {
+			err = bwmap.UpdateIngress(e.ID, ingressBps)
+		}
+	} else {
+		err = bwmap.DeleteIngress(e.ID)
+	}
+	if err != nil {
+		res <- &EndpointRegenerationResult{
+			err: err,
+		}
+		return
+	}
 
 	bpsOld := "inf"
 	bpsNew := "inf"
@@ -320,6 +334,18 @@ func (ev *EndpointPolicyBandwidthEvent) Handle(res chan interface{}) {
 	e.getLogger().Debugf("Updating %s from %s to %s bytes/sec", bandwidth.EgressBandwidth,
 		bpsOld, bpsNew)
 	e.bps = bps
+
+	bpsOld = "inf"
+	bpsNew = "inf"
+	if e.ingressBps != 0 {
+		bpsOld = strconv.FormatUint(e.ingressBps, 10)
+	}
+	if ingressBps != 0 {
+		bpsNew = strconv.FormatUint(ingressBps, 10)
+	}
+	e.getLogger().Debugf("Updating %s from %s to %s bytes/sec", bandwidth.IngressBandwidth,
+		bpsOld, bpsNew)
+	e.ingressBps = ingressBps
 	res <- &EndpointRegenerationResult{
 		err: nil,
 	}
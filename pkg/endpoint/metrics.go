@@ -23,6 +23,7 @@ import (
 	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/spanstat"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -50,6 +51,44 @@ func sendMetrics(stats statistics, metric prometheus.ObserverVec) {
 	}
 }
 
+// regenerationStatsLogSize is the number of regeneration duration breakdowns
+// retained per endpoint for consumption via the endpoint API.
+const regenerationStatsLogSize = 5
+
+// recordRegenerationStatistics stores the duration breakdown of a completed
+// regeneration in the endpoint's regeneration log, evicting the oldest entry
+// once the log is full.
+func (e *Endpoint) recordRegenerationStatistics(reason string, stats *regenerationStatistics) {
+	mdl := &models.EndpointRegenerationStatistics{
+		Reason:         reason,
+		Success:        stats.success,
+		Completed:      strfmt.DateTime(time.Now().UTC()),
+		PhaseDurations: map[string]float64{},
+	}
+	// Skip phases that have not been hit (zero duration) to keep the
+	// breakdown limited to the phases relevant for this regeneration.
+	for phase, stat := range stats.GetMap() {
+		if total := stat.Total(); total != time.Duration(0) {
+			mdl.PhaseDurations[phase] = total.Seconds()
+		}
+	}
+
+	e.regenStatsMutex.Lock()
+	e.regenStats = append([]*models.EndpointRegenerationStatistics{mdl}, e.regenStats...)
+	if len(e.regenStats) > regenerationStatsLogSize {
+		e.regenStats = e.regenStats[:regenerationStatsLogSize]
+	}
+	e.regenStatsMutex.Unlock()
+}
+
+// getRegenerationStatisticsModel returns the retained regeneration duration
+// breakdowns of this endpoint, most recent first.
+func (e *Endpoint) getRegenerationStatisticsModel() []*models.EndpointRegenerationStatistics {
+	e.regenStatsMutex.Lock()
+	defer e.regenStatsMutex.Unlock()
+	return append([]*models.EndpointRegenerationStatistics{}, e.regenStats...)
+}
+
 type regenerationStatistics struct {
 	success                bool
 	endpointID             uint16
@@ -152,6 +152,27 @@ func (e *Endpoint) updateNetworkPolicy(proxyWaitGroup *completion.WaitGroup) (re
 	return e.proxy.UpdateNetworkPolicy(e, e.desiredPolicy.L4Policy, e.desiredPolicy.IngressPolicyEnabled, e.desiredPolicy.EgressPolicyEnabled, proxyWaitGroup)
 }
 
+// SyncProxyNetworkPolicy publishes the endpoint's current network policy to
+// the L7 proxy again, without regenerating the endpoint. This is used when
+// the proxy representation of the policy has changed without a corresponding
+// policy recomputation, such as when a secret referenced by a header match
+// has been rotated.
+func (e *Endpoint) SyncProxyNetworkPolicy(ctx context.Context) error {
+	proxyWaitGroup := completion.NewWaitGroup(ctx)
+
+	if err := e.rlockAlive(); err != nil {
+		return err
+	}
+	err, _ := e.updateNetworkPolicy(proxyWaitGroup)
+	e.runlock()
+	if err != nil {
+		return err
+	}
+
+	// Wait for the proxy to ack the update.
+	return proxyWaitGroup.Wait()
+}
+
 func (e *Endpoint) useCurrentNetworkPolicy(proxyWaitGroup *completion.WaitGroup) {
 	if e.SecurityIdentity == nil {
 		return
@@ -242,7 +263,24 @@ func (e *Endpoint) regeneratePolicy() (retErr error) {
 		e.getLogger().WithError(err).Warning("Failed to update policy")
 		return err
 	}
-	calculatedPolicy := e.selectorPolicy.Consume(e)
+	calculatedPolicy, err := e.selectorPolicy.Consume(e)
+	if err != nil {
+		var npErr *policy.NamedPortsError
+		if !errors.As(err, &npErr) {
+			e.getLogger().WithError(err).Warning("Failed to consume policy")
+			return err
+		}
+		// The policy is partial: map entries for the unresolved named
+		// ports are missing until the owner's pods expose them. Apply
+		// what was resolved, surface the failure in the endpoint
+		// status and retry with backoff, as the ports may appear once
+		// the pods become ready.
+		e.logStatusLocked(Policy, Warning, fmt.Sprintf("Policy is only partially resolved: %s (will retry)", npErr))
+		e.unresolvedNamedPorts = true
+		e.startNamedPortsRetryHandler()
+	} else {
+		e.unresolvedNamedPorts = false
+	}
 
 	stats.policyCalculation.End(true)
 
@@ -487,6 +525,7 @@ func (e *Endpoint) updateRegenerationStatistics(context *regenerationContext, er
 	stats.policyStatus = e.policyStatus()
 	e.runlock()
 	stats.SendMetrics()
+	e.recordRegenerationStatistics(context.Reason, stats)
 
 	fields := logrus.Fields{
 		logfields.Reason: context.Reason,
@@ -695,6 +734,53 @@ func (e *Endpoint) startRegenerationFailureHandler() {
 	})
 }
 
+var reasonNamedPortsRetry = "named ports resolution retry"
+
+// startNamedPortsRetryHandler regenerates the endpoint with backoff until a
+// policy calculation no longer reports unresolvable named ports. This covers
+// the case where policy referring to a named port is imported before the pods
+// exposing the port become ready; the partial policy applied in the meantime
+// is completed as soon as the port can be resolved.
+func (e *Endpoint) startNamedPortsRetryHandler() {
+	e.controllers.UpdateController(fmt.Sprintf("endpoint-%s-named-ports-resolution", e.StringID()),
+		controller.ControllerParams{
+			DoFunc: func(ctx context.Context) error {
+				if err := e.rlockAlive(); err != nil {
+					// Endpoint is being deleted, stop retrying.
+					return nil
+				}
+				unresolved := e.unresolvedNamedPorts
+				e.runlock()
+				if !unresolved {
+					return nil
+				}
+
+				regenMetadata := &regeneration.ExternalRegenerationMetadata{
+					ParentContext:     ctx,
+					Reason:            reasonNamedPortsRetry,
+					RegenerationLevel: regeneration.RegenerateWithoutDatapath,
+				}
+				regen, _ := e.SetRegenerateStateIfAlive(regenMetadata)
+				if regen {
+					<-e.Regenerate(regenMetadata)
+				}
+
+				if err := e.rlockAlive(); err != nil {
+					return nil
+				}
+				unresolved = e.unresolvedNamedPorts
+				e.runlock()
+				if unresolved {
+					return fmt.Errorf("named ports still unresolved")
+				}
+				e.LogStatusOK(Policy, "Named ports resolved")
+				return nil
+			},
+			ErrorRetryBaseDuration: 2 * time.Second,
+			Context:                e.aliveCtx,
+		})
+}
+
 func (e *Endpoint) notifyEndpointRegeneration(err error) {
 	reprerr := e.owner.SendNotification(monitorAPI.EndpointRegenMessage(e, err))
 	if reprerr != nil {
@@ -864,9 +950,13 @@ func (e *Endpoint) UpdateVisibilityPolicy(annoCB AnnotationsResolverCB) {
 	<-ch
 }
 
-// UpdateBandwidthPolicy updates the egress bandwidth of this endpoint to
-// progagate the throttle rate to the BPF data path.
-func (e *Endpoint) UpdateBandwidthPolicy(annoCB AnnotationsResolverCB) {
+// BandwidthAnnotationsResolverCB provides an implementation for resolving
+// the pod bandwidth annotations.
+type BandwidthAnnotationsResolverCB func(ns, podName string) (bandwidthIngress, bandwidthEgress string, err error)
+
+// UpdateBandwidthPolicy updates the ingress and egress bandwidth of this
+// endpoint to progagate the throttle rates to the BPF data path.
+func (e *Endpoint) UpdateBandwidthPolicy(annoCB BandwidthAnnotationsResolverCB) {
 	ch, err := e.eventQueue.Enqueue(eventqueue.NewEvent(&EndpointPolicyBandwidthEvent{
 		ep:     e,
 		annoCB: annoCB,
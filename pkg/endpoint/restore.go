@@ -234,14 +234,31 @@ func (e *Endpoint) RegenerateAfterRestore() error {
 	return nil
 }
 
+// SkipLabelsfilterOnRestore marks the endpoint to be restored with its
+// previous identity labels instead of re-filtering them with the current
+// labels filter. See restoreIdentity.
+func (e *Endpoint) SkipLabelsfilterOnRestore() {
+	e.unconditionalLock()
+	e.skipLabelsfilterOnRestore = true
+	e.unlock()
+}
+
 func (e *Endpoint) restoreIdentity() error {
 	if err := e.rlockAlive(); err != nil {
 		e.logDisconnectedMutexAction(err, "before filtering labels during regenerating restored endpoint")
 		return err
 	}
 	scopedLog := log.WithField(logfields.EndpointID, e.ID)
-	// Filter the restored labels with the new daemon's filter
-	l, _ := labelsfilter.Filter(e.OpLabels.AllLabels())
+	// Filter the restored labels with the new daemon's filter. When the
+	// filter changed across restarts and the identities are migrated
+	// lazily, keep the restored identity labels so the endpoint comes
+	// back up with its previous identity.
+	var l labels.Labels
+	if e.skipLabelsfilterOnRestore {
+		l = e.OpLabels.IdentityLabels()
+	} else {
+		l, _ = labelsfilter.Filter(e.OpLabels.AllLabels())
+	}
 	e.runlock()
 
 	// Getting the ep's identity while we are restoring should block the
@@ -405,6 +422,7 @@ func (e *Endpoint) toSerializedEndpoint() *serializableEndpoint {
 		K8sPodName:            e.K8sPodName,
 		K8sNamespace:          e.K8sNamespace,
 		DatapathConfiguration: e.DatapathConfiguration,
+		SecondaryInterfaces:   e.secondaryInterfaces,
 	}
 }
 
@@ -496,6 +514,10 @@ type serializableEndpoint struct {
 	// plugin which performed the plumbing will enable certain datapath
 	// features according to the mode selected.
 	DatapathConfiguration models.EndpointDatapathConfiguration
+
+	// SecondaryInterfaces are the additional network interfaces of the
+	// endpoint as registered by the plugin that created the endpoint
+	SecondaryInterfaces []*models.EndpointSecondaryInterface
 }
 
 // UnmarshalJSON expects that the contents of `raw` are a serializableEndpoint,
@@ -544,4 +566,5 @@ func (ep *Endpoint) fromSerializedEndpoint(r *serializableEndpoint) {
 	ep.K8sNamespace = r.K8sNamespace
 	ep.DatapathConfiguration = r.DatapathConfiguration
 	ep.Options = r.Options
+	ep.secondaryInterfaces = r.SecondaryInterfaces
 }
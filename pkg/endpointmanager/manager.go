@@ -30,6 +30,7 @@ import (
 	"github.com/cilium/cilium/pkg/endpoint/regeneration"
 	"github.com/cilium/cilium/pkg/endpointmanager/idallocator"
 	"github.com/cilium/cilium/pkg/identity/cache"
+	"github.com/cilium/cilium/pkg/identity/identitymanager"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
@@ -60,6 +61,17 @@ type EndpointManager struct {
 	endpoints    map[uint16]*endpoint.Endpoint
 	endpointsAux map[string]*endpoint.Endpoint
 
+	// watchMutex protects watchCh. It is separate from mutex as watchCh
+	// is also replaced from identity manager observer callbacks which may
+	// run while other subsystem locks are held.
+	watchMutex lock.Mutex
+
+	// watchCh is closed and replaced whenever the endpoint lookup tables
+	// or the identity of a managed endpoint change, waking up all watchers
+	// handed out by the *WithWatch lookup functions. watchMutex must be
+	// held to access.
+	watchCh chan struct{}
+
 	// mcastManager handles IPv6 multicast group join/leave for pods. This is required for the
 	// node to receive ICMPv6 NDP messages, especially NS (Neighbor Solicitation) message, so
 	// pod's IPv6 address is discoverable.
@@ -69,6 +81,11 @@ type EndpointManager struct {
 	// up-to-date information about endpoints managed by the endpoint manager.
 	EndpointResourceSynchronizer
 
+	// regenQueue schedules bulk endpoint regenerations, coalescing
+	// multiple pending triggers per endpoint and bounding the number of
+	// concurrent regenerations.
+	regenQueue *regenerationQueue
+
 	// subscribers are notified when events occur in the EndpointManager.
 	subscribers map[Subscriber]struct{}
 
@@ -105,10 +122,16 @@ func NewEndpointManager(epSynchronizer EndpointResourceSynchronizer) *EndpointMa
 		endpointsAux:                 make(map[string]*endpoint.Endpoint),
 		mcastManager:                 mcastmanager.New(option.Config.IPv6MCastDevice),
 		EndpointResourceSynchronizer: epSynchronizer,
+		regenQueue:                   newRegenerationQueue(option.Config.EndpointRegenParallelism),
 		subscribers:                  make(map[Subscriber]struct{}),
+		watchCh:                      make(chan struct{}),
 	}
 	mgr.deleteEndpoint = mgr.removeEndpoint
 
+	// Identity-based endpoint queries are watchable as well, wake up
+	// watchers when the identity of a local endpoint changes.
+	identitymanager.Subscribe(&mgr)
+
 	return &mgr
 }
 
@@ -231,8 +254,9 @@ func (mgr *EndpointManager) removeIDLocked(currID uint16) {
 // RemoveID removes the id from the endpoints map in the EndpointManager.
 func (mgr *EndpointManager) RemoveID(currID uint16) {
 	mgr.mutex.Lock()
-	defer mgr.mutex.Unlock()
 	mgr.removeIDLocked(currID)
+	mgr.mutex.Unlock()
+	mgr.markChanged()
 }
 
 // Lookup looks up the endpoint by prefix id
@@ -351,6 +375,7 @@ func (mgr *EndpointManager) unexpose(ep *endpoint.Endpoint) {
 	}
 	previousState := ep.GetState()
 
+	defer mgr.markChanged()
 	mgr.mutex.Lock()
 	defer mgr.mutex.Unlock()
 
@@ -396,6 +421,7 @@ func (mgr *EndpointManager) RemoveEndpoint(ep *endpoint.Endpoint, conf endpoint.
 
 // RemoveAll removes all endpoints from the global maps.
 func (mgr *EndpointManager) RemoveAll() {
+	defer mgr.markChanged()
 	mgr.mutex.Lock()
 	defer mgr.mutex.Unlock()
 	idallocator.ReallocatePool()
@@ -462,15 +488,31 @@ func (mgr *EndpointManager) updateIDReferenceLocked(ep *endpoint.Endpoint) {
 	mgr.endpoints[ep.ID] = ep
 }
 
+// updateReferencesLocked updates the endpointsAux map to contain exactly the
+// given identifiers for ep. References installed by a previous set of
+// identifiers which are no longer valid (e.g. after a pod rename) are
+// removed, so that the lookup tables cannot diverge from the endpoint's
+// current state.
 func (mgr *EndpointManager) updateReferencesLocked(ep *endpoint.Endpoint, identifiers endpointid.Identifiers) {
+	newIDs := make(map[string]struct{}, len(identifiers))
 	for k := range identifiers {
 		id := endpointid.NewID(k, identifiers[k])
+		newIDs[id] = struct{}{}
 		mgr.endpointsAux[id] = ep
 	}
+
+	for id, e := range mgr.endpointsAux {
+		if e == ep {
+			if _, ok := newIDs[id]; !ok {
+				delete(mgr.endpointsAux, id)
+			}
+		}
+	}
 }
 
 // UpdateReferences updates maps the contents of mappings to the specified endpoint.
 func (mgr *EndpointManager) UpdateReferences(ep *endpoint.Endpoint) error {
+	defer mgr.markChanged()
 	mgr.mutex.Lock()
 	defer mgr.mutex.Unlock()
 
@@ -501,9 +543,10 @@ func (mgr *EndpointManager) RemoveIPv6Address(ipv6 addressing.CiliumIPv6) {
 	mgr.mcastManager.RemoveAddress(ipv6)
 }
 
-// RegenerateAllEndpoints calls a setState for each endpoint and
-// regenerates if state transaction is valid. During this process, the endpoint
-// list is locked and cannot be modified.
+// RegenerateAllEndpoints queues a regeneration of each endpoint into the
+// regeneration queue, which regenerates if the state transaction is valid.
+// Multiple pending triggers for the same endpoint are coalesced into a single
+// regeneration.
 // Returns a waiting group that can be used to know when all the endpoints are
 // regenerated.
 func (mgr *EndpointManager) RegenerateAllEndpoints(regenMetadata *regeneration.ExternalRegenerationMetadata) *sync.WaitGroup {
@@ -517,10 +560,7 @@ func (mgr *EndpointManager) RegenerateAllEndpoints(regenMetadata *regeneration.E
 	reason := regenMetadata.Reason
 	log.WithFields(logrus.Fields{"reason": reason}).Info("regenerating all endpoints")
 	for _, ep := range eps {
-		go func(ep *endpoint.Endpoint) {
-			<-ep.RegenerateIfAlive(regenMetadata)
-			wg.Done()
-		}(ep)
+		mgr.regenQueue.enqueue(ep, regenMetadata, &wg)
 	}
 
 	return &wg
@@ -585,6 +625,7 @@ func (mgr *EndpointManager) expose(ep *endpoint.Endpoint) error {
 	mgr.updateIDReferenceLocked(ep)
 	mgr.updateReferencesLocked(ep, identifiers)
 	mgr.mutex.Unlock()
+	mgr.markChanged()
 
 	mgr.RunK8sCiliumEndpointSync(ep, option.Config)
 
@@ -18,6 +18,7 @@ package endpointmanager
 
 import (
 	"context"
+	"net"
 	"sync"
 	"testing"
 	"time"
@@ -943,3 +944,96 @@ func (s *EndpointManagerSuite) TestWaitForEndpointsAtPolicyRev(c *C) {
 		tt.postTestRun()
 	}
 }
+
+func (s *EndpointManagerSuite) TestUpdateReferencesRemovesStale(c *C) {
+	mgr := NewEndpointManager(&dummyEpSyncher{})
+	ep := endpoint.NewEndpointWithState(s, &endpoint.FakeEndpointProxy{}, &allocator.FakeIdentityAllocator{}, 91, endpoint.StateReady)
+	ep.SetK8sNamespace("default")
+	ep.SetK8sPodName("foo")
+	c.Assert(mgr.expose(ep), IsNil)
+	c.Assert(mgr.LookupPodName("default/foo"), checker.DeepEquals, ep)
+
+	// Renaming the pod must drop the reference installed under the old
+	// name, both lookups can never succeed at the same time.
+	ep.SetK8sPodName("bar")
+	c.Assert(mgr.UpdateReferences(ep), IsNil)
+	c.Assert(mgr.LookupPodName("default/foo"), IsNil)
+	c.Assert(mgr.LookupPodName("default/bar"), checker.DeepEquals, ep)
+
+	mgr.WaitEndpointRemoved(ep)
+}
+
+func (s *EndpointManagerSuite) TestLookupIPWithWatch(c *C) {
+	mgr := NewEndpointManager(&dummyEpSyncher{})
+	ep := endpoint.NewEndpointWithState(s, &endpoint.FakeEndpointProxy{}, &allocator.FakeIdentityAllocator{}, 92, endpoint.StateReady)
+	ip, err := addressing.NewCiliumIPv4("127.0.0.1")
+	c.Assert(err, IsNil)
+	ep.IPv4 = ip
+
+	got, watch := mgr.LookupIPWithWatch(net.ParseIP("127.0.0.1"))
+	c.Assert(got, IsNil)
+
+	// The watch channel may only be closed upon a modification
+	select {
+	case <-watch:
+		c.Fatal("watch channel closed without a modification")
+	default:
+	}
+
+	c.Assert(mgr.expose(ep), IsNil)
+
+	// Exposing the endpoint must wake up the watcher
+	select {
+	case <-watch:
+	case <-time.After(5 * time.Second):
+		c.Fatal("timeout while waiting for the watch channel to close")
+	}
+
+	got, watch = mgr.LookupIPWithWatch(net.ParseIP("127.0.0.1"))
+	c.Assert(got, checker.DeepEquals, ep)
+
+	mgr.WaitEndpointRemoved(ep)
+
+	// Removing the endpoint must wake up the watcher again
+	select {
+	case <-watch:
+	case <-time.After(5 * time.Second):
+		c.Fatal("timeout while waiting for the watch channel to close")
+	}
+
+	got, _ = mgr.LookupIPWithWatch(net.ParseIP("127.0.0.1"))
+	c.Assert(got, IsNil)
+}
+
+func (s *EndpointManagerSuite) TestLookupWithWatchConcurrent(c *C) {
+	mgr := NewEndpointManager(&dummyEpSyncher{})
+
+	// Cycle an endpoint with a fixed IP through creation and removal while
+	// a watcher concurrently follows the IP through the lookup table,
+	// re-running the query whenever the watch channel fires. Primarily
+	// meaningful under the race detector.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			ep := endpoint.NewEndpointWithState(s, &endpoint.FakeEndpointProxy{}, &allocator.FakeIdentityAllocator{}, 0, endpoint.StateReady)
+			ip, err := addressing.NewCiliumIPv4("10.93.0.1")
+			c.Assert(err, IsNil)
+			ep.IPv4 = ip
+			c.Assert(mgr.expose(ep), IsNil)
+			mgr.WaitEndpointRemoved(ep)
+		}
+	}()
+
+	for alive := true; alive; {
+		_, watch := mgr.LookupIPWithWatch(net.ParseIP("10.93.0.1"))
+		select {
+		case <-watch:
+		case <-done:
+			alive = false
+		}
+	}
+
+	got, _ := mgr.LookupIPWithWatch(net.ParseIP("10.93.0.1"))
+	c.Assert(got, IsNil)
+}
@@ -0,0 +1,181 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpointmanager
+
+import (
+	"sync"
+
+	"github.com/cilium/cilium/pkg/endpoint"
+	"github.com/cilium/cilium/pkg/endpoint/regeneration"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/metrics"
+)
+
+// regenRequest is a pending regeneration of a single endpoint in the
+// regeneration queue.
+type regenRequest struct {
+	ep *endpoint.Endpoint
+
+	// regenMetadata describes the most invasive trigger coalesced into
+	// this request.
+	regenMetadata *regeneration.ExternalRegenerationMetadata
+
+	// waiters are signalled once the regeneration of the endpoint has
+	// completed.
+	waiters []*sync.WaitGroup
+}
+
+// regenerationQueue schedules bulk endpoint regenerations. Multiple pending
+// triggers for the same endpoint are coalesced into a single regeneration,
+// endpoints without any programmed policy are regenerated first and the
+// number of concurrent regenerations may be bounded via the
+// endpoint-regen-parallelism option.
+type regenerationQueue struct {
+	mutex lock.Mutex
+
+	// pending maps the endpoint ID to its queued request. Requests are
+	// removed from the map once they are handed to a worker, such that
+	// triggers arriving during an in-flight regeneration schedule a new
+	// one.
+	pending map[uint16]*regenRequest
+
+	// queue holds the queued requests in FIFO order.
+	queue []*regenRequest
+
+	// wakeup signals the dispatcher that new requests have been queued.
+	wakeup chan struct{}
+
+	// parallelism bounds the number of concurrent regenerations. A value
+	// of 0 does not bound the parallelism.
+	parallelism int
+
+	// regenFn regenerates a single endpoint. It may be overridden for
+	// testing purposes.
+	regenFn func(ep *endpoint.Endpoint, regenMetadata *regeneration.ExternalRegenerationMetadata) <-chan bool
+}
+
+func newRegenerationQueue(parallelism int) *regenerationQueue {
+	q := &regenerationQueue{
+		pending:     make(map[uint16]*regenRequest),
+		wakeup:      make(chan struct{}, 1),
+		parallelism: parallelism,
+		regenFn: func(ep *endpoint.Endpoint, regenMetadata *regeneration.ExternalRegenerationMetadata) <-chan bool {
+			return ep.RegenerateIfAlive(regenMetadata)
+		},
+	}
+	go q.run()
+	return q
+}
+
+// enqueue schedules a regeneration of ep. If a regeneration of ep is already
+// queued but has not started yet, the trigger is coalesced into the queued
+// request and wg is signalled once that single regeneration has completed.
+func (q *regenerationQueue) enqueue(ep *endpoint.Endpoint, regenMetadata *regeneration.ExternalRegenerationMetadata, wg *sync.WaitGroup) {
+	q.mutex.Lock()
+	metrics.EndpointRegenerationQueuedTotal.WithLabelValues(regenMetadata.Reason).Inc()
+
+	if req, ok := q.pending[ep.GetID16()]; ok {
+		// The queued regeneration will observe the state of this
+		// trigger as well, only the datapath regeneration level needs
+		// to be merged.
+		if regenMetadata.RegenerationLevel > req.regenMetadata.RegenerationLevel {
+			req.regenMetadata = regenMetadata
+		}
+		if wg != nil {
+			req.waiters = append(req.waiters, wg)
+		}
+		q.mutex.Unlock()
+		return
+	}
+
+	req := &regenRequest{
+		ep:            ep,
+		regenMetadata: regenMetadata,
+	}
+	if wg != nil {
+		req.waiters = append(req.waiters, wg)
+	}
+	q.pending[ep.GetID16()] = req
+	q.queue = append(q.queue, req)
+	metrics.EndpointRegenerationQueueDepth.Set(float64(len(q.queue)))
+	q.mutex.Unlock()
+
+	select {
+	case q.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// pop removes the next request from the queue. Endpoints which do not have
+// any policy programmed yet, i.e. new pods, are regenerated before routine
+// refreshes of already running endpoints.
+func (q *regenerationQueue) pop() *regenRequest {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.queue) == 0 {
+		return nil
+	}
+
+	next := 0
+	for i, req := range q.queue {
+		if req.ep.GetPolicyRevision() == 0 {
+			next = i
+			break
+		}
+	}
+
+	req := q.queue[next]
+	q.queue = append(q.queue[:next], q.queue[next+1:]...)
+	delete(q.pending, req.ep.GetID16())
+	metrics.EndpointRegenerationQueueDepth.Set(float64(len(q.queue)))
+	return req
+}
+
+// run dispatches queued regenerations to workers until the queue is drained,
+// then waits for the next wakeup.
+func (q *regenerationQueue) run() {
+	var sem chan struct{}
+	if q.parallelism > 0 {
+		sem = make(chan struct{}, q.parallelism)
+	}
+
+	for range q.wakeup {
+		for {
+			// Wait for a free worker slot before popping, such that
+			// requests remain in the queue, and thereby coalescible
+			// and reorderable, while all slots are busy.
+			if sem != nil {
+				sem <- struct{}{}
+			}
+			req := q.pop()
+			if req == nil {
+				if sem != nil {
+					<-sem
+				}
+				break
+			}
+			go func(req *regenRequest) {
+				<-q.regenFn(req.ep, req.regenMetadata)
+				if sem != nil {
+					<-sem
+				}
+				for _, wg := range req.waiters {
+					wg.Done()
+				}
+			}(req)
+		}
+	}
+}
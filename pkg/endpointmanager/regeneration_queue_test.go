@@ -0,0 +1,175 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package endpointmanager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cilium/cilium/pkg/endpoint"
+	"github.com/cilium/cilium/pkg/endpoint/regeneration"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/testutils"
+	"github.com/cilium/cilium/pkg/testutils/allocator"
+
+	. "gopkg.in/check.v1"
+)
+
+// testRegenQueue records the regenerations dispatched by a regenerationQueue
+// and allows the test to block them until released.
+type testRegenQueue struct {
+	queue *regenerationQueue
+
+	mutex    lock.Mutex
+	runs     map[uint16]int
+	order    []uint16
+	metadata map[uint16]*regeneration.ExternalRegenerationMetadata
+	releases map[uint16]chan bool
+}
+
+func newTestRegenQueue(parallelism int) *testRegenQueue {
+	tq := &testRegenQueue{
+		runs:     map[uint16]int{},
+		metadata: map[uint16]*regeneration.ExternalRegenerationMetadata{},
+		releases: map[uint16]chan bool{},
+	}
+	tq.queue = &regenerationQueue{
+		pending:     make(map[uint16]*regenRequest),
+		wakeup:      make(chan struct{}, 1),
+		parallelism: parallelism,
+		regenFn: func(ep *endpoint.Endpoint, regenMetadata *regeneration.ExternalRegenerationMetadata) <-chan bool {
+			tq.mutex.Lock()
+			defer tq.mutex.Unlock()
+			tq.runs[ep.GetID16()]++
+			tq.order = append(tq.order, ep.GetID16())
+			tq.metadata[ep.GetID16()] = regenMetadata
+			return tq.releases[ep.GetID16()]
+		},
+	}
+	go tq.queue.run()
+	return tq
+}
+
+// add registers an endpoint whose regeneration blocks until release is
+// called.
+func (tq *testRegenQueue) add(ep *endpoint.Endpoint) {
+	tq.mutex.Lock()
+	tq.releases[ep.GetID16()] = make(chan bool)
+	tq.mutex.Unlock()
+}
+
+func (tq *testRegenQueue) release(ep *endpoint.Endpoint) {
+	tq.mutex.Lock()
+	close(tq.releases[ep.GetID16()])
+	tq.mutex.Unlock()
+}
+
+func (tq *testRegenQueue) runsOf(ep *endpoint.Endpoint) int {
+	tq.mutex.Lock()
+	defer tq.mutex.Unlock()
+	return tq.runs[ep.GetID16()]
+}
+
+func (tq *testRegenQueue) waitForRun(c *C, ep *endpoint.Endpoint) {
+	err := testutils.WaitUntil(func() bool {
+		return tq.runsOf(ep) > 0
+	}, 5*time.Second)
+	c.Assert(err, IsNil)
+}
+
+func (s *EndpointManagerSuite) TestRegenQueueCoalescing(c *C) {
+	tq := newTestRegenQueue(1)
+
+	ep1 := endpoint.NewEndpointWithState(s, &endpoint.FakeEndpointProxy{}, &allocator.FakeIdentityAllocator{}, 1, endpoint.StateReady)
+	ep2 := endpoint.NewEndpointWithState(s, &endpoint.FakeEndpointProxy{}, &allocator.FakeIdentityAllocator{}, 2, endpoint.StateReady)
+	tq.add(ep1)
+	tq.add(ep2)
+
+	// Occupy the single worker slot with ep1.
+	var wg1 sync.WaitGroup
+	wg1.Add(1)
+	tq.queue.enqueue(ep1, &regeneration.ExternalRegenerationMetadata{Reason: "initial"}, &wg1)
+	tq.waitForRun(c, ep1)
+
+	// While the worker is busy, multiple triggers for ep2 must be
+	// coalesced into a single regeneration carrying the most invasive
+	// datapath regeneration level.
+	var wg2, wg3, wg4 sync.WaitGroup
+	wg2.Add(1)
+	wg3.Add(1)
+	wg4.Add(1)
+	tq.queue.enqueue(ep2, &regeneration.ExternalRegenerationMetadata{
+		Reason:            "policy update",
+		RegenerationLevel: regeneration.RegenerateWithoutDatapath,
+	}, &wg2)
+	tq.queue.enqueue(ep2, &regeneration.ExternalRegenerationMetadata{
+		Reason:            "datapath config changed",
+		RegenerationLevel: regeneration.RegenerateWithDatapathRewrite,
+	}, &wg3)
+	tq.queue.enqueue(ep2, &regeneration.ExternalRegenerationMetadata{
+		Reason:            "identities changed",
+		RegenerationLevel: regeneration.RegenerateWithoutDatapath,
+	}, &wg4)
+
+	tq.release(ep1)
+	wg1.Wait()
+
+	tq.waitForRun(c, ep2)
+	tq.release(ep2)
+	wg2.Wait()
+	wg3.Wait()
+	wg4.Wait()
+
+	c.Assert(tq.runsOf(ep1), Equals, 1)
+	c.Assert(tq.runsOf(ep2), Equals, 1)
+
+	tq.mutex.Lock()
+	metadata := tq.metadata[ep2.GetID16()]
+	tq.mutex.Unlock()
+	c.Assert(metadata.RegenerationLevel, Equals, regeneration.RegenerateWithDatapathRewrite)
+}
+
+func (s *EndpointManagerSuite) TestRegenQueuePriority(c *C) {
+	tq := newTestRegenQueue(1)
+
+	ep1 := endpoint.NewEndpointWithState(s, &endpoint.FakeEndpointProxy{}, &allocator.FakeIdentityAllocator{}, 1, endpoint.StateReady)
+	epOld := endpoint.NewEndpointWithState(s, &endpoint.FakeEndpointProxy{}, &allocator.FakeIdentityAllocator{}, 2, endpoint.StateReady)
+	epNew := endpoint.NewEndpointWithState(s, &endpoint.FakeEndpointProxy{}, &allocator.FakeIdentityAllocator{}, 3, endpoint.StateReady)
+	epOld.SetPolicyRevision(1)
+	tq.add(ep1)
+	tq.add(epOld)
+	tq.add(epNew)
+	tq.release(epOld)
+	tq.release(epNew)
+
+	// Occupy the single worker slot with ep1, then queue an endpoint
+	// which already has policy programmed before one which does not.
+	tq.queue.enqueue(ep1, &regeneration.ExternalRegenerationMetadata{Reason: "initial"}, nil)
+	tq.waitForRun(c, ep1)
+	tq.queue.enqueue(epOld, &regeneration.ExternalRegenerationMetadata{Reason: "refresh"}, nil)
+	tq.queue.enqueue(epNew, &regeneration.ExternalRegenerationMetadata{Reason: "new endpoint"}, nil)
+
+	tq.release(ep1)
+	tq.waitForRun(c, epOld)
+	tq.waitForRun(c, epNew)
+
+	// The endpoint without any policy programmed is regenerated first.
+	tq.mutex.Lock()
+	order := tq.order
+	tq.mutex.Unlock()
+	c.Assert(order, DeepEquals, []uint16{ep1.GetID16(), epNew.GetID16(), epOld.GetID16()})
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpointmanager
+
+import (
+	"net"
+
+	"github.com/cilium/cilium/pkg/endpoint"
+	"github.com/cilium/cilium/pkg/identity"
+)
+
+// markChanged wakes up all watchers of the endpoint lookup tables by closing
+// the current watch channel and replacing it with a fresh one.
+func (mgr *EndpointManager) markChanged() {
+	mgr.watchMutex.Lock()
+	close(mgr.watchCh)
+	mgr.watchCh = make(chan struct{})
+	mgr.watchMutex.Unlock()
+}
+
+// watchChannel returns the channel closed upon the next modification of the
+// endpoint lookup tables. It must be fetched before running the query it
+// guards, that way a modification racing with the query at worst causes a
+// spurious wake-up but can never be missed.
+func (mgr *EndpointManager) watchChannel() <-chan struct{} {
+	mgr.watchMutex.Lock()
+	defer mgr.watchMutex.Unlock()
+	return mgr.watchCh
+}
+
+// LocalEndpointIdentityAdded implements identitymanager.Observer. Identity
+// transitions change the result of identity-based endpoint queries, so
+// watchers are woken up to re-run their queries.
+func (mgr *EndpointManager) LocalEndpointIdentityAdded(*identity.Identity) {
+	mgr.markChanged()
+}
+
+// LocalEndpointIdentityRemoved implements identitymanager.Observer.
+func (mgr *EndpointManager) LocalEndpointIdentityRemoved(*identity.Identity) {
+	mgr.markChanged()
+}
+
+// LookupIPWithWatch looks up an endpoint by IP address and additionally
+// returns a channel which is closed once the result of the lookup may have
+// changed. This allows consumers such as the DNS proxy to react to endpoint
+// changes without polling; upon wake-up, the query is simply re-run.
+func (mgr *EndpointManager) LookupIPWithWatch(ip net.IP) (*endpoint.Endpoint, <-chan struct{}) {
+	watch := mgr.watchChannel()
+	return mgr.LookupIP(ip), watch
+}
+
+// LookupPodNameWithWatch looks up an endpoint by namespace + pod name, which
+// is also the name of the endpoint's CiliumEndpoint resource, and returns a
+// channel closed once the result of the lookup may have changed.
+func (mgr *EndpointManager) LookupPodNameWithWatch(name string) (*endpoint.Endpoint, <-chan struct{}) {
+	watch := mgr.watchChannel()
+	return mgr.LookupPodName(name), watch
+}
+
+// LookupEndpointsByIdentity returns all local endpoints which currently have
+// the given security identity. The query is answered from the primary list of
+// endpoints and therefore cannot transiently diverge from it the way a
+// separately locked secondary map could.
+func (mgr *EndpointManager) LookupEndpointsByIdentity(id identity.NumericIdentity) []*endpoint.Endpoint {
+	eps := []*endpoint.Endpoint{}
+	for _, ep := range mgr.GetEndpoints() {
+		if ep.GetIdentity() == id {
+			eps = append(eps, ep)
+		}
+	}
+	return eps
+}
+
+// LookupEndpointsByIdentityWithWatch is the watchable variant of
+// LookupEndpointsByIdentity. The returned channel is closed once the result
+// of the query may have changed, including identity transitions of already
+// managed endpoints.
+func (mgr *EndpointManager) LookupEndpointsByIdentityWithWatch(id identity.NumericIdentity) ([]*endpoint.Endpoint, <-chan struct{}) {
+	watch := mgr.watchChannel()
+	return mgr.LookupEndpointsByIdentity(id), watch
+}
@@ -199,10 +199,42 @@ func StartXDSServer(stateDir string) *XDSServer {
 	}
 }
 
-func (s *XDSServer) getHttpFilterChainProto(clusterName string, tls bool) *envoy_config_listener.FilterChain {
+// ListenerHTTPSettings are the per-listener HTTP settings resolved from the
+// network policy rules which caused the listener to be created. Zero values
+// fall back to the agent-wide defaults.
+type ListenerHTTPSettings struct {
+	// RequestTimeout overrides option.Config.HTTPRequestTimeout when
+	// non-zero.
+	RequestTimeout time.Duration
+	// IdleTimeout overrides option.Config.HTTPIdleTimeout when non-zero.
+	IdleTimeout time.Duration
+	// MaxConcurrentRequests bounds the number of concurrent requests on
+	// each downstream connection when non-zero.
+	MaxConcurrentRequests uint32
+}
+
+// durationSeconds returns d in seconds, rounded up to at least one second as
+// a zero value would disable the corresponding timeout in Envoy.
+func durationSeconds(d time.Duration) int64 {
+	secs := int64(d / time.Second)
+	if secs == 0 {
+		secs = 1
+	}
+	return secs
+}
+
+func (s *XDSServer) getHttpFilterChainProto(clusterName string, tls bool, settings *ListenerHTTPSettings) *envoy_config_listener.FilterChain {
 	denied403body := option.Config.HTTP403Message
 	requestTimeout := int64(option.Config.HTTPRequestTimeout) // seconds
 	idleTimeout := int64(option.Config.HTTPIdleTimeout)       // seconds
+	if settings != nil {
+		if settings.RequestTimeout > 0 {
+			requestTimeout = durationSeconds(settings.RequestTimeout)
+		}
+		if settings.IdleTimeout > 0 {
+			idleTimeout = durationSeconds(settings.IdleTimeout)
+		}
+	}
 	maxGRPCTimeout := int64(option.Config.HTTPMaxGRPCTimeout) // seconds
 	numRetries := uint32(option.Config.HTTPRetryCount)
 	retryTimeout := int64(option.Config.HTTPRetryTimeout) //seconds
@@ -277,6 +309,15 @@ func (s *XDSServer) getHttpFilterChainProto(clusterName string, tls bool) *envoy
 		hcmConfig.PathWithEscapedSlashesAction = envoy_config_http.HttpConnectionManager_UNESCAPE_AND_REDIRECT
 	}
 
+	if settings != nil && settings.MaxConcurrentRequests > 0 {
+		// Bound the number of concurrent requests on each downstream
+		// connection. HTTP/1 limits a connection to a single outstanding
+		// request by itself.
+		hcmConfig.Http2ProtocolOptions = &envoy_config_core.Http2ProtocolOptions{
+			MaxConcurrentStreams: &wrappers.UInt32Value{Value: settings.MaxConcurrentRequests},
+		}
+	}
+
 	// Idle timeout can only be specified if non-zero
 	if idleTimeout > 0 {
 		hcmConfig.GetRouteConfig().VirtualHosts[0].Routes[1].GetRoute().IdleTimeout = &duration.Duration{Seconds: idleTimeout}
@@ -512,7 +553,7 @@ func (s *XDSServer) addListener(name string, port uint16, listenerConf func() *e
 	s.mutex.Unlock()
 }
 
-func (s *XDSServer) getListenerConf(name string, kind policy.L7ParserType, port uint16, isIngress bool, mayUseOriginalSourceAddr bool) *envoy_config_listener.Listener {
+func (s *XDSServer) getListenerConf(name string, kind policy.L7ParserType, port uint16, isIngress bool, mayUseOriginalSourceAddr bool, httpSettings *ListenerHTTPSettings) *envoy_config_listener.Listener {
 	clusterName := egressClusterName
 	socketMark := int64(0xB00)
 	if isIngress {
@@ -560,14 +601,14 @@ func (s *XDSServer) getListenerConf(name string, kind policy.L7ParserType, port
 			Name: "envoy.filters.listener.tls_inspector",
 		}}, listenerConf.ListenerFilters...)
 
-		listenerConf.FilterChains = append(listenerConf.FilterChains, s.getHttpFilterChainProto(clusterName, false))
+		listenerConf.FilterChains = append(listenerConf.FilterChains, s.getHttpFilterChainProto(clusterName, false, httpSettings))
 
 		// Add a TLS variant
 		tlsClusterName := egressTLSClusterName
 		if isIngress {
 			tlsClusterName = ingressTLSClusterName
 		}
-		listenerConf.FilterChains = append(listenerConf.FilterChains, s.getHttpFilterChainProto(tlsClusterName, true))
+		listenerConf.FilterChains = append(listenerConf.FilterChains, s.getHttpFilterChainProto(tlsClusterName, true, httpSettings))
 	} else {
 		// Default TCP chain, takes care of all parsers in proxylib
 		listenerConf.FilterChains = append(listenerConf.FilterChains, s.getTcpFilterChainProto(clusterName, "", nil))
@@ -588,12 +629,14 @@ func (s *XDSServer) getListenerConf(name string, kind policy.L7ParserType, port
 	return listenerConf
 }
 
-// AddListener adds a listener to a running Envoy proxy.
-func (s *XDSServer) AddListener(name string, kind policy.L7ParserType, port uint16, isIngress bool, mayUseOriginalSourceAddr bool, wg *completion.WaitGroup) {
+// AddListener adds a listener to a running Envoy proxy. 'httpSettings' are
+// only applied when the listener does not exist yet, as the listener
+// configuration is shared by all redirects using it.
+func (s *XDSServer) AddListener(name string, kind policy.L7ParserType, port uint16, isIngress bool, mayUseOriginalSourceAddr bool, httpSettings *ListenerHTTPSettings, wg *completion.WaitGroup) {
 	log.Debugf("Envoy: %s AddListener %s (mayUseOriginalSourceAddr: %v)", kind, name, mayUseOriginalSourceAddr)
 
 	s.addListener(name, port, func() *envoy_config_listener.Listener {
-		return s.getListenerConf(name, kind, port, isIngress, mayUseOriginalSourceAddr)
+		return s.getListenerConf(name, kind, port, isIngress, mayUseOriginalSourceAddr, httpSettings)
 	}, wg, nil, true)
 }
 
@@ -268,6 +268,27 @@ func (c *DNSCache) cleanupExpiredEntries(expires time.Time) (affectedNames []str
 	return affectedNames, removed
 }
 
+// NextExpiration returns the earliest pending TTL expiration of any entry in
+// the cache, and false when no entries are pending expiry.
+// Note: The returned time may belong to an entry that was since superseded by
+// a newer lookup; callers must tolerate a cleanup pass that expires no names.
+func (c *DNSCache) NextExpiration() (next time.Time, pending bool) {
+	c.RLock()
+	defer c.RUnlock()
+
+	var earliest int64
+	for expiration := range c.cleanup {
+		if !pending || expiration < earliest {
+			earliest = expiration
+			pending = true
+		}
+	}
+	if pending {
+		next = time.Unix(earliest, 0)
+	}
+	return next, pending
+}
+
 // cleanupOverLimitEntries returns the names that has reached the max number of
 // IP per host. Internally the function sort the entries by the expiration
 // time.
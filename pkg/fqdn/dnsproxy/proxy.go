@@ -34,6 +34,7 @@ import (
 	"github.com/cilium/cilium/pkg/ipcache"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy"
 	"github.com/cilium/cilium/pkg/policy/api"
@@ -41,6 +42,7 @@ import (
 
 	"github.com/miekg/dns"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -56,6 +58,14 @@ const (
 	// ProxyBindRetryInterval is how long to wait between attempts to bind to the
 	// proxy address:port
 	ProxyBindRetryInterval = ProxyBindTimeout / 5
+
+	// verdictCacheTTL bounds how long a cached policy verdict may be reused
+	// before the policy is evaluated again for the same query.
+	verdictCacheTTL = 10 * time.Second
+
+	// maxCachedVerdictsPerEndpoint bounds the number of policy verdicts
+	// cached for a single endpoint.
+	maxCachedVerdictsPerEndpoint = 128
 )
 
 // DNSProxy is a L7 proxy for DNS traffic. It keeps a list of allowed DNS
@@ -99,7 +109,9 @@ type DNSProxy struct {
 	NotifyOnDNSMsg NotifyOnDNSMsgFunc
 
 	// UDPServer, TCPServer are the miekg/dns server instances. They handle DNS
-	// parsing etc. for us.
+	// parsing etc. for us. Unlike the other public fields these may be
+	// replaced by the supervisor when it takes over from a wedged instance,
+	// see supervisor.go, and must be read under the mutex below.
 	UDPServer, TCPServer *dns.Server
 
 	// UDPClient, TCPClient are the miekg/dns client instances. Forwarded
@@ -123,6 +135,21 @@ type DNSProxy struct {
 	// restored DNS rule.
 	maxIPsPerRestoredDNSRule int
 
+	// queriesPerSecond is the default maximum number of DNS queries per
+	// second to accept from a single endpoint. 0 disables rate limiting
+	// unless a DNS rule overrides the limit for an endpoint.
+	queriesPerSecond int
+
+	// bindAddress, enableIPv4 and enableIPv6 capture how the listening
+	// sockets were bound so that the supervisor can bind replacement
+	// sockets the same way.
+	bindAddress            string
+	enableIPv4, enableIPv6 bool
+
+	// udpSessionFactory is the shared session factory of the current and
+	// any replacement UDP server.
+	udpSessionFactory *sessionUDPFactory
+
 	// this mutex protects variables below this point
 	lock.Mutex
 
@@ -145,6 +172,27 @@ type DNSProxy struct {
 	// mapping restored endpoint IP (both IPv4 and IPv6) to *Endpoint
 	restoredEPs restoredEPs
 
+	// limiters rate limit the DNS queries of each endpoint with a token
+	// bucket. Entries are created lazily on the first query of an endpoint
+	// subject to a limit and removed when the last rules of the endpoint
+	// are removed.
+	limiters map[uint64]*rate.Limiter
+
+	// qpsOverrides tracks the largest QueriesPerSecond set by the DNS rules
+	// of an endpoint for a destination port. Overrides take precedence over
+	// the proxy-wide default limit.
+	qpsOverrides map[uint64]map[uint16]uint32
+
+	// passthrough tracks whether a DNS rule of an endpoint enables
+	// passthrough of queries that match no allowed name for a destination
+	// port, forwarding them instead of refusing them.
+	passthrough map[uint64]map[uint16]bool
+
+	// verdicts caches recent policy verdicts of each endpoint so that
+	// repeated identical queries skip policy evaluation. The cache of an
+	// endpoint is flushed whenever its rules are updated.
+	verdicts map[uint64]map[verdictKey]verdictEntry
+
 	// rejectReply is the OPCode send from the DNS-proxy to the endpoint if the
 	// DNS request is invalid
 	rejectReply int32
@@ -166,6 +214,20 @@ type perEPRestored map[uint64]restore.DNSRules
 // map from EP IPs to *Endpoint
 type restoredEPs map[string]*endpoint.Endpoint
 
+// verdictKey identifies a cached policy verdict for a DNS query of an endpoint
+type verdictKey struct {
+	destPort uint16
+	destID   identity.NumericIdentity
+	qtype    uint16
+	qname    string
+}
+
+// verdictEntry is a cached policy verdict that is valid until 'expires'
+type verdictEntry struct {
+	allowed bool
+	expires time.Time
+}
+
 // CheckRestored checks endpointID, destPort, destIP, and name against the restored rules,
 // and only returns true if a restored rule matches.
 func (p *DNSProxy) checkRestored(endpointID uint64, destPort uint16, destIP string, name string) bool {
@@ -347,6 +409,13 @@ type ProxyRequestContext struct {
 	UpstreamTime spanstat.SpanStat
 	Success      bool
 	Err          error
+	// RateLimited is true when the query was rejected by the DNS proxy rate
+	// limiter
+	RateLimited bool
+	// Passthrough is true when the query matched no allowed name but was
+	// forwarded anyway because a DNS rule enabled passthrough. The response
+	// must not be recorded.
+	Passthrough bool
 }
 
 // IsTimeout return true if the ProxyRequest timeout
@@ -370,7 +439,9 @@ func (proxyStat *ProxyRequestContext) IsTimeout() bool {
 // notifyFunc will be called with DNS response data that is returned to a
 // requesting endpoint. Note that denied requests will not trigger this
 // callback.
-func StartDNSProxy(address string, port uint16, enableDNSCompression bool, maxRestoreDNSIPs int, lookupEPFunc LookupEndpointIDByIPFunc, lookupSecIDFunc LookupSecIDByIPFunc, lookupIPsFunc LookupIPsBySecIDFunc, notifyFunc NotifyOnDNSMsgFunc) (*DNSProxy, error) {
+// dnsQueriesPerSecond is the default maximum number of DNS queries per second
+// to accept from a single endpoint, 0 to disable rate limiting.
+func StartDNSProxy(address string, port uint16, enableDNSCompression bool, maxRestoreDNSIPs int, dnsQueriesPerSecond int, lookupEPFunc LookupEndpointIDByIPFunc, lookupSecIDFunc LookupSecIDByIPFunc, lookupIPsFunc LookupIPsBySecIDFunc, notifyFunc NotifyOnDNSMsgFunc) (*DNSProxy, error) {
 	if port == 0 {
 		log.Debug("DNS Proxy port is configured to 0. A random port will be assigned by the OS.")
 	}
@@ -389,22 +460,28 @@ func StartDNSProxy(address string, port uint16, enableDNSCompression bool, maxRe
 		allowed:                  make(perEPAllow),
 		restored:                 make(perEPRestored),
 		restoredEPs:              make(restoredEPs),
+		limiters:                 make(map[uint64]*rate.Limiter),
+		qpsOverrides:             make(map[uint64]map[uint16]uint32),
+		passthrough:              make(map[uint64]map[uint16]bool),
+		verdicts:                 make(map[uint64]map[verdictKey]verdictEntry),
 		EnableDNSCompression:     enableDNSCompression,
 		maxIPsPerRestoredDNSRule: maxRestoreDNSIPs,
+		queriesPerSecond:         dnsQueriesPerSecond,
 	}
 	atomic.StoreInt32(&p.rejectReply, dns.RcodeRefused)
 
 	// Start the DNS listeners on UDP and TCP
 	var (
-		UDPConn                *net.UDPConn
-		TCPListener            *net.TCPListener
-		err                    error
-		EnableIPv4, EnableIPv6 = option.Config.EnableIPv4, option.Config.EnableIPv6
+		UDPConn     *net.UDPConn
+		TCPListener *net.TCPListener
+		err         error
 	)
+	p.bindAddress = address
+	p.enableIPv4, p.enableIPv6 = option.Config.EnableIPv4, option.Config.EnableIPv6
 
 	start := time.Now()
 	for time.Since(start) < ProxyBindTimeout {
-		UDPConn, TCPListener, err = bindToAddr(address, port, EnableIPv4, EnableIPv6)
+		UDPConn, TCPListener, err = bindToAddr(address, port, p.enableIPv4, p.enableIPv6)
 		if err == nil {
 			break
 		}
@@ -417,26 +494,10 @@ func StartDNSProxy(address string, port uint16, enableDNSCompression bool, maxRe
 
 	p.BindAddr = UDPConn.LocalAddr().String()
 	p.BindPort = uint16(UDPConn.LocalAddr().(*net.UDPAddr).Port)
-	p.UDPServer = &dns.Server{PacketConn: UDPConn, Addr: p.BindAddr, Net: "udp", Handler: p,
-		SessionUDPFactory: &sessionUDPFactory{ipv4Enabled: EnableIPv4, ipv6Enabled: EnableIPv6},
-	}
-	p.TCPServer = &dns.Server{Listener: TCPListener, Addr: p.BindAddr, Net: "tcp", Handler: p}
+	p.udpSessionFactory = &sessionUDPFactory{ipv4Enabled: p.enableIPv4, ipv6Enabled: p.enableIPv6}
 	log.WithField("address", p.BindAddr).Debug("DNS Proxy bound to address")
 
-	for _, s := range []*dns.Server{p.UDPServer, p.TCPServer} {
-		go func(server *dns.Server) {
-			// try 5 times during a single ProxyBindTimeout period. We fatal here
-			// because we have no other way to indicate failure this late.
-			start := time.Now()
-			for time.Since(start) < ProxyBindTimeout {
-				if err := server.ActivateAndServe(); err != nil {
-					log.WithError(err).Errorf("Failed to start the %s DNS proxy on %s", server.Net, server.Addr)
-				}
-				time.Sleep(ProxyBindRetryInterval)
-			}
-			log.Fatalf("Failed to start %s DNS Proxy on %s", server.Net, server.Addr)
-		}(s)
-	}
+	p.installServers(UDPConn, TCPListener)
 
 	// Bind the DNS forwarding clients on UDP and TCP
 	// Note: SingleInFlight should remain disabled. When enabled it folds DNS
@@ -447,12 +508,69 @@ func StartDNSProxy(address string, port uint16, enableDNSCompression bool, maxRe
 	return p, nil
 }
 
+// installServers creates DNS servers for the given listening sockets, makes
+// them the current servers of the proxy and starts serving on them. Any
+// previous servers are left to be torn down by the caller.
+func (p *DNSProxy) installServers(UDPConn *net.UDPConn, TCPListener *net.TCPListener) {
+	p.Lock()
+	p.UDPServer = &dns.Server{PacketConn: UDPConn, Addr: p.BindAddr, Net: "udp", Handler: p,
+		SessionUDPFactory: p.udpSessionFactory,
+	}
+	// MaxTCPQueries is unlimited so that resolvers pipelining many queries
+	// over a single TCP connection are not cut off mid-stream; queries on
+	// one connection are serviced in order.
+	p.TCPServer = &dns.Server{Listener: TCPListener, Addr: p.BindAddr, Net: "tcp", Handler: p,
+		MaxTCPQueries: -1,
+	}
+	servers := []*dns.Server{p.UDPServer, p.TCPServer}
+	p.Unlock()
+
+	for _, s := range servers {
+		go p.serve(s)
+	}
+}
+
+// serve runs 'server' until it stops serving or is replaced by the
+// supervisor.
+func (p *DNSProxy) serve(server *dns.Server) {
+	// try 5 times during a single ProxyBindTimeout period. We fatal here
+	// because we have no other way to indicate failure this late.
+	start := time.Now()
+	for time.Since(start) < ProxyBindTimeout {
+		if err := server.ActivateAndServe(); err != nil {
+			log.WithError(err).Errorf("Failed to start the %s DNS proxy on %s", server.Net, server.Addr)
+		}
+		if p.replaced(server) {
+			// The supervisor has replaced this server, it was shut
+			// down deliberately.
+			return
+		}
+		time.Sleep(ProxyBindRetryInterval)
+	}
+	if p.replaced(server) {
+		return
+	}
+	log.Fatalf("Failed to start %s DNS Proxy on %s", server.Net, server.Addr)
+}
+
+// replaced returns true when 'server' is no longer a current server of the
+// proxy, i.e. the supervisor has replaced it.
+func (p *DNSProxy) replaced(server *dns.Server) bool {
+	p.Lock()
+	defer p.Unlock()
+	return server != p.UDPServer && server != p.TCPServer
+}
+
 // LookupEndpointByIP wraps LookupRegisteredEndpoint by falling back to an restored EP, if available
 func (p *DNSProxy) LookupEndpointByIP(ip net.IP) (endpoint *endpoint.Endpoint, err error) {
 	endpoint, err = p.LookupRegisteredEndpoint(ip)
 	if err != nil {
-		// Check restored endpoints
+		// Check restored endpoints. restoredEPs is mutated by RestoreRules
+		// and RemoveRestoredRules while queries are served, so it must be
+		// read under the lock.
+		p.Lock()
 		endpoint, found := p.restoredEPs[ip.String()]
+		p.Unlock()
 		if found {
 			return endpoint, nil
 		}
@@ -470,31 +588,179 @@ func (p *DNSProxy) UpdateAllowed(endpointID uint64, destPort uint16, newRules po
 	if err == nil {
 		// Rules were updated based on policy, remove restored rules
 		p.removeRestoredRulesLocked(endpointID)
+		p.updateRateLimitLocked(endpointID, destPort, newRules)
+		p.updatePassthroughLocked(endpointID, destPort, newRules)
+		delete(p.verdicts, endpointID)
 	}
 	return err
 }
 
-// CheckAllowed checks endpointID, destPort, destID, destIP, and name against the rules
-// added to the proxy or restored during restart, and only returns true if this all match
-// something that was added (via UpdateAllowed or RestoreRules) previously.
-func (p *DNSProxy) CheckAllowed(endpointID uint64, destPort uint16, destID identity.NumericIdentity, destIP net.IP, name string) (allowed bool, err error) {
+// updateRateLimitLocked records the largest QueriesPerSecond set by newRules
+// for endpointID and destPort, and applies the resulting limit to the token
+// bucket of the endpoint. 'p' must be locked.
+func (p *DNSProxy) updateRateLimitLocked(endpointID uint64, destPort uint16, newRules policy.L7DataMap) {
+	var override uint32
+	for _, l7Rules := range newRules {
+		if l7Rules == nil {
+			continue
+		}
+		for _, dnsRule := range l7Rules.DNS {
+			if dnsRule.QueriesPerSecond > override {
+				override = dnsRule.QueriesPerSecond
+			}
+		}
+	}
+
+	epOverrides := p.qpsOverrides[endpointID]
+	if override == 0 {
+		delete(epOverrides, destPort)
+		if len(epOverrides) == 0 {
+			delete(p.qpsOverrides, endpointID)
+		}
+	} else {
+		if epOverrides == nil {
+			epOverrides = make(map[uint16]uint32)
+			p.qpsOverrides[endpointID] = epOverrides
+		}
+		epOverrides[destPort] = override
+	}
+
+	if _, exists := p.allowed[endpointID]; !exists {
+		// The last rules of the endpoint were removed
+		delete(p.limiters, endpointID)
+		return
+	}
+
+	if limiter, exists := p.limiters[endpointID]; exists {
+		limit := p.queryLimitLocked(endpointID)
+		if limit == rate.Inf {
+			delete(p.limiters, endpointID)
+		} else if limiter.Limit() != limit {
+			limiter.SetLimit(limit)
+			limiter.SetBurst(int(limit))
+		}
+	}
+}
+
+// updatePassthroughLocked records whether any of newRules enables passthrough
+// of queries that match no allowed name for endpointID and destPort. 'p' must
+// be locked.
+func (p *DNSProxy) updatePassthroughLocked(endpointID uint64, destPort uint16, newRules policy.L7DataMap) {
+	var enabled bool
+	for _, l7Rules := range newRules {
+		if l7Rules == nil {
+			continue
+		}
+		for _, dnsRule := range l7Rules.DNS {
+			if dnsRule.Passthrough {
+				enabled = true
+			}
+		}
+	}
+
+	epPassthrough := p.passthrough[endpointID]
+	if !enabled {
+		delete(epPassthrough, destPort)
+		if len(epPassthrough) == 0 {
+			delete(p.passthrough, endpointID)
+		}
+		return
+	}
+	if epPassthrough == nil {
+		epPassthrough = make(map[uint16]bool)
+		p.passthrough[endpointID] = epPassthrough
+	}
+	epPassthrough[destPort] = true
+}
+
+// passthroughEnabled returns true when a DNS rule of endpointID enables
+// passthrough of non-matching queries to destPort.
+func (p *DNSProxy) passthroughEnabled(endpointID uint64, destPort uint16) bool {
+	p.Lock()
+	defer p.Unlock()
+	return p.passthrough[endpointID][destPort]
+}
+
+// queryLimitLocked returns the effective DNS query rate limit of the
+// endpoint. The largest limit set by the DNS rules of the endpoint takes
+// precedence over the proxy-wide default. rate.Inf is returned when the
+// endpoint is not subject to a limit. 'p' must be locked.
+func (p *DNSProxy) queryLimitLocked(endpointID uint64) rate.Limit {
+	var qps uint32
+	for _, override := range p.qpsOverrides[endpointID] {
+		if override > qps {
+			qps = override
+		}
+	}
+	if qps == 0 && p.queriesPerSecond > 0 {
+		qps = uint32(p.queriesPerSecond)
+	}
+	if qps == 0 {
+		return rate.Inf
+	}
+	return rate.Limit(qps)
+}
+
+// checkQueryRate accounts one DNS query of the endpoint against its token
+// bucket. It returns false when the query exceeds the rate limit of the
+// endpoint and must be rejected.
+func (p *DNSProxy) checkQueryRate(endpointID uint64) bool {
+	p.Lock()
+	defer p.Unlock()
+
+	limiter, exists := p.limiters[endpointID]
+	if !exists {
+		limit := p.queryLimitLocked(endpointID)
+		if limit == rate.Inf {
+			return true
+		}
+		limiter = rate.NewLimiter(limit, int(limit))
+		p.limiters[endpointID] = limiter
+	}
+	return limiter.Allow()
+}
+
+// CheckAllowed checks endpointID, destPort, destID, destIP, name and qtype
+// against the rules added to the proxy or restored during restart, and only
+// returns true if this all match something that was added (via UpdateAllowed
+// or RestoreRules) previously. Verdicts are cached briefly per endpoint so
+// that repeated identical queries skip policy evaluation.
+func (p *DNSProxy) CheckAllowed(endpointID uint64, destPort uint16, destID identity.NumericIdentity, destIP net.IP, name string, qtype uint16) (allowed bool, err error) {
 	name = strings.ToLower(dns.Fqdn(name))
 	p.Lock()
 	defer p.Unlock()
 
 	epAllow, exists := p.allowed.getPortRulesForID(endpointID, destPort)
 	if !exists {
+		// Restored rules also match on the destination IP, which is not
+		// part of the verdict cache key, so they bypass the cache.
 		return p.checkRestored(endpointID, destPort, destIP.String(), name), nil
 	}
 
+	key := verdictKey{destPort: destPort, destID: destID, qtype: qtype, qname: name}
+	if entry, cached := p.verdicts[endpointID][key]; cached && time.Now().Before(entry.expires) {
+		return entry.allowed, nil
+	}
+
 	for selector, re := range epAllow {
 		// The port was matched in getPortRulesForID, above.
 		if selector.Selects(destID) && re.MatchString(name) {
-			return true, nil
+			allowed = true
+			break
 		}
 	}
 
-	return false, nil
+	epVerdicts := p.verdicts[endpointID]
+	if epVerdicts == nil || len(epVerdicts) >= maxCachedVerdictsPerEndpoint {
+		// Flush the cache of the endpoint when it is full rather than
+		// tracking the age of individual entries; the cache is small
+		// and refills quickly.
+		epVerdicts = make(map[verdictKey]verdictEntry)
+		p.verdicts[endpointID] = epVerdicts
+	}
+	epVerdicts[key] = verdictEntry{allowed: allowed, expires: time.Now().Add(verdictCacheTTL)}
+
+	return allowed, nil
 }
 
 // ServeDNS handles individual DNS requests forwarded to the proxy, and meets
@@ -520,6 +786,13 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 		logfields.DNSRequestID: request.Id})
 	scopedLog.Debug("Handling DNS query from endpoint")
 
+	// Answer supervisor health probes directly, without policy evaluation
+	// or forwarding. A reply proves that the serve loop is alive.
+	if qname == supervisorHealthName {
+		p.sendRcode(scopedLog, w, request, dns.RcodeSuccess)
+		return
+	}
+
 	epIPPort := w.RemoteAddr().String()
 	addr, _, err := net.SplitHostPort(epIPPort)
 	if err != nil {
@@ -542,6 +815,19 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 
 	scopedLog = scopedLog.WithField(logfields.EndpointID, ep.StringID())
 
+	if !p.checkQueryRate(uint64(ep.ID)) {
+		scopedLog.Debug("Rejecting DNS query from endpoint due to rate limiting")
+		metrics.FQDNRateLimitRejectionsTotal.WithLabelValues(ep.StringID()).Inc()
+		stat.RateLimited = true
+		// Always answer rate limited queries with REFUSED, as NXDOMAIN
+		// would wrongly signal to the endpoint that the name does not
+		// exist.
+		stat.Err = p.sendRcode(scopedLog, w, request, dns.RcodeRefused)
+		stat.ProcessingTime.End(true)
+		p.NotifyOnDNSMsg(time.Now(), ep, epIPPort, "", request, protocol, false, &stat)
+		return
+	}
+
 	targetServerIP, targetServerPort, targetServerAddr, err := p.lookupTargetDNSServer(w)
 	if err != nil {
 		log.WithError(err).Error("cannot extract destination IP:port from DNS request")
@@ -565,7 +851,7 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 	// Note: The cache doesn't know about the source of the DNS data (yet) and so
 	// it won't enforce any separation between results from different endpoints.
 	// This isn't ideal but we are trusting the DNS responses anyway.
-	allowed, err := p.CheckAllowed(uint64(ep.ID), targetServerPort, targetServerID, targetServerIP, qname)
+	allowed, err := p.CheckAllowed(uint64(ep.ID), targetServerPort, targetServerID, targetServerIP, qname, request.Question[0].Qtype)
 	switch {
 	case err != nil:
 		scopedLog.WithError(err).Error("Rejecting DNS query from endpoint due to error")
@@ -575,6 +861,13 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 		p.sendRefused(scopedLog, w, request)
 		return
 
+	case !allowed && p.passthroughEnabled(uint64(ep.ID), targetServerPort):
+		// The query is forwarded but the response is not recorded, so its
+		// IPs do not gain any toFQDNs-based identity. See
+		// api.PortRuleDNS.Passthrough.
+		scopedLog.Debug("Forwarding DNS query that matches no rule due to passthrough")
+		stat.Passthrough = true
+
 	case !allowed:
 		scopedLog.Debug("Rejecting DNS query from endpoint due to policy")
 		stat.Err = p.sendRefused(scopedLog, w, request)
@@ -583,7 +876,9 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 		return
 	}
 
-	scopedLog.Debug("Forwarding DNS request for a name that is allowed")
+	if !stat.Passthrough {
+		scopedLog.Debug("Forwarding DNS request for a name that is allowed")
+	}
 	p.NotifyOnDNSMsg(time.Now(), ep, epIPPort, targetServerAddr, request, protocol, true, &stat)
 
 	// Keep the same L4 protocol. This handles DNS re-requests over TCP, for
@@ -605,6 +900,14 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 	stat.ProcessingTime.End(true)
 	stat.UpstreamTime.Start()
 
+	// The buffer size the requester can receive over UDP, from its EDNS0
+	// option if present. The forwarding client passes the option through,
+	// sizing its own receive buffer from it.
+	clientBufferSize := dns.MinMsgSize
+	if opt := request.IsEdns0(); opt != nil && int(opt.UDPSize()) > clientBufferSize {
+		clientBufferSize = int(opt.UDPSize())
+	}
+
 	request.Id = dns.Id() // force a random new ID for this request
 	response, _, err := client.Exchange(request, targetServerAddr)
 	stat.UpstreamTime.End(err == nil)
@@ -621,6 +924,21 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 		return
 	}
 
+	if protocol == "udp" && response.Truncated {
+		// The answer did not fit into the UDP buffer. Retry over TCP so
+		// that the full response is recorded below; resolvers which never
+		// retry over TCP would otherwise leave the name manager without
+		// the remaining IPs.
+		scopedLog.Debug("Upstream DNS response truncated, retrying over TCP")
+		tcpResponse, _, tcpErr := p.TCPClient.Exchange(request, targetServerAddr)
+		if tcpErr != nil {
+			scopedLog.WithError(tcpErr).
+				Debug("TCP retry for truncated DNS response failed, using the truncated response")
+		} else {
+			response = tcpResponse
+		}
+	}
+
 	scopedLog.WithField(logfields.Response, response).Debug("Received DNS response to proxied lookup")
 	stat.Success = true
 
@@ -630,6 +948,12 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 	scopedLog.Debug("Responding to original DNS query")
 	// restore the ID to the one in the initial request so it matches what the requester expects.
 	response.Id = requestID
+	if protocol == "udp" {
+		// Cut the response down to what the requester can receive. The
+		// full response was already recorded above; the requester may
+		// retry over TCP for the rest.
+		response.Truncate(clientBufferSize)
+	}
 	response.Compress = p.EnableDNSCompression && shouldCompressResponse(request, response)
 	err = w.WriteMsg(response)
 	if err != nil {
@@ -645,19 +969,25 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 	}
 }
 
-// sendRefused creates and sends a REFUSED response for request to w
+// sendRcode creates and sends a response with the given rcode for request to w
 // The returned error is logged with scopedLog and is returned for convenience
-func (p *DNSProxy) sendRefused(scopedLog *logrus.Entry, w dns.ResponseWriter, request *dns.Msg) (err error) {
-	refused := new(dns.Msg)
-	refused.SetRcode(request, int(atomic.LoadInt32(&p.rejectReply)))
+func (p *DNSProxy) sendRcode(scopedLog *logrus.Entry, w dns.ResponseWriter, request *dns.Msg, rcode int) (err error) {
+	response := new(dns.Msg)
+	response.SetRcode(request, rcode)
 
-	if err = w.WriteMsg(refused); err != nil {
+	if err = w.WriteMsg(response); err != nil {
 		scopedLog.WithError(err).Error("Cannot send REFUSED response")
 		err = fmt.Errorf("cannot send REFUSED response: %s", err)
 	}
 	return err
 }
 
+// sendRefused creates and sends a REFUSED response for request to w
+// The returned error is logged with scopedLog and is returned for convenience
+func (p *DNSProxy) sendRefused(scopedLog *logrus.Entry, w dns.ResponseWriter, request *dns.Msg) (err error) {
+	return p.sendRcode(scopedLog, w, request, int(atomic.LoadInt32(&p.rejectReply)))
+}
+
 // SetRejectReply sets the default reject reply on denied dns responses.
 func (p *DNSProxy) SetRejectReply(opt string) {
 	switch strings.ToLower(opt) {
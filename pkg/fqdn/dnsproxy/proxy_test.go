@@ -136,15 +136,42 @@ func teardown(dnsServer *dns.Server) {
 	dnsServer.Listener.Close()
 }
 
+// manyRecordsName is answered by the test DNS server with
+// manyRecordsCount A records, enough to not fit into a 512 byte UDP
+// response.
+const manyRecordsName = "hundred.cilium.io."
+
+const manyRecordsCount = 100
+
 func serveDNS(w dns.ResponseWriter, r *dns.Msg) {
 	m := new(dns.Msg)
 	m.SetReply(r)
 
-	retARR, err := dns.NewRR(m.Question[0].Name + " 60 IN A 1.1.1.1")
-	if err != nil {
-		panic(err)
+	if r.Question[0].Name == manyRecordsName {
+		for i := 0; i < manyRecordsCount; i++ {
+			retARR, err := dns.NewRR(fmt.Sprintf("%s 60 IN A 10.0.%d.%d", m.Question[0].Name, i/256, i%256+1))
+			if err != nil {
+				panic(err)
+			}
+			m.Answer = append(m.Answer, retARR)
+		}
+	} else {
+		retARR, err := dns.NewRR(m.Question[0].Name + " 60 IN A 1.1.1.1")
+		if err != nil {
+			panic(err)
+		}
+		m.Answer = append(m.Answer, retARR)
+	}
+
+	// Truncate UDP responses to the buffer size the requester advertised,
+	// setting the TC bit, as a real server would.
+	if w.LocalAddr() != nil && w.LocalAddr().Network() == "udp" {
+		size := dns.MinMsgSize
+		if opt := r.IsEdns0(); opt != nil && int(opt.UDPSize()) > size {
+			size = int(opt.UDPSize())
+		}
+		m.Truncate(size)
 	}
-	m.Answer = append(m.Answer, retARR)
 
 	w.WriteMsg(m)
 }
@@ -196,7 +223,7 @@ func (s *DNSProxyTestSuite) SetUpTest(c *C) {
 	s.dnsServer = setupServer(c)
 	c.Assert(s.dnsServer, Not(IsNil), Commentf("unable to setup DNS server"))
 
-	proxy, err := StartDNSProxy("", 0, true, 1000, // any address, any port, enable compression, max 1000 restore IPs
+	proxy, err := StartDNSProxy("", 0, true, 1000, 0, // any address, any port, enable compression, max 1000 restore IPs, no rate limit
 		// LookupEPByIP
 		func(ip net.IP) (*endpoint.Endpoint, error) {
 			if s.restoring {
@@ -272,7 +299,7 @@ func (s *DNSProxyTestSuite) TestRejectFromDifferentEndpoint(c *C) {
 	// Reject a query from not endpoint 1
 	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
-	allowed, err := s.proxy.CheckAllowed(epID2, dstPort, dstID1, nil, query)
+	allowed, err := s.proxy.CheckAllowed(epID2, dstPort, dstID1, nil, query, dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was not rejected when it should be blocked"))
 }
@@ -291,7 +318,7 @@ func (s *DNSProxyTestSuite) TestAcceptFromMatchingEndpoint(c *C) {
 	// accept a query that matches from endpoint1
 	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
-	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query)
+	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 }
@@ -310,7 +337,7 @@ func (s *DNSProxyTestSuite) TestAcceptNonRegex(c *C) {
 	// accept a query that matches from endpoint1
 	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
-	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query)
+	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 }
@@ -329,7 +356,7 @@ func (s *DNSProxyTestSuite) TestRejectNonRegex(c *C) {
 	// reject a query for a non-regex where a . is different (i.e. ensure simple FQDNs treat . as .)
 	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
-	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query)
+	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was not rejected when it should be blocked"))
 }
@@ -347,7 +374,7 @@ func (s *DNSProxyTestSuite) requestRejectNonMatchingRefusedResponse(c *C) *dns.M
 
 	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
-	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query)
+	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was not rejected when it should be blocked"))
 
@@ -394,7 +421,7 @@ func (s *DNSProxyTestSuite) TestRespondViaCorrectProtocol(c *C) {
 
 	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
-	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query)
+	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
@@ -407,6 +434,162 @@ func (s *DNSProxyTestSuite) TestRespondViaCorrectProtocol(c *C) {
 
 }
 
+// fakeUDPResponseWriter feeds a request into ServeDNS as if it arrived on
+// the UDP listener and captures the response written back to the requester.
+type fakeUDPResponseWriter struct {
+	local  net.Addr
+	remote net.Addr
+	msg    *dns.Msg
+}
+
+func (w *fakeUDPResponseWriter) LocalAddr() net.Addr         { return w.local }
+func (w *fakeUDPResponseWriter) RemoteAddr() net.Addr        { return w.remote }
+func (w *fakeUDPResponseWriter) WriteMsg(m *dns.Msg) error   { w.msg = m; return nil }
+func (w *fakeUDPResponseWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *fakeUDPResponseWriter) Close() error                { return nil }
+func (w *fakeUDPResponseWriter) TsigStatus() error           { return nil }
+func (w *fakeUDPResponseWriter) TsigTimersOnly(bool)         {}
+func (w *fakeUDPResponseWriter) Hijack()                     {}
+
+// setupUDPUpstream starts a UDP DNS server on the same port as the TCP test
+// server, sharing its handler, and returns it for shutdown by the caller.
+func (s *DNSProxyTestSuite) setupUDPUpstream(c *C) *dns.Server {
+	tcpAddr := s.dnsServer.Listener.Addr().(*net.TCPAddr)
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: tcpAddr.IP, Port: tcpAddr.Port})
+	c.Assert(err, IsNil, Commentf("unable to bind UDP upstream DNS server"))
+	udpServer := &dns.Server{PacketConn: conn, Net: "udp"}
+	go udpServer.ActivateAndServe()
+	return udpServer
+}
+
+// serveManyRecords passes a UDP query for manyRecordsName through ServeDNS
+// and returns the response written to the requester as well as the number of
+// answers in the response shared with the name manager.
+func (s *DNSProxyTestSuite) serveManyRecords(c *C, request *dns.Msg) (clientResponse *dns.Msg, notifiedAnswers int) {
+	l7map := policy.L7DataMap{
+		cachedDstID1Selector: &policy.PerSelectorPolicy{
+			L7Rules: api.L7Rules{
+				DNS: []api.PortRuleDNS{{MatchName: manyRecordsName}},
+			},
+		},
+	}
+	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+
+	oldNotify := s.proxy.NotifyOnDNSMsg
+	defer func() { s.proxy.NotifyOnDNSMsg = oldNotify }()
+	s.proxy.NotifyOnDNSMsg = func(lookupTime time.Time, ep *endpoint.Endpoint, epIPPort string, dstAddr string, msg *dns.Msg, protocol string, allowed bool, stat *ProxyRequestContext) error {
+		if msg.Response && allowed {
+			notifiedAnswers = len(msg.Answer)
+		}
+		return nil
+	}
+
+	writer := &fakeUDPResponseWriter{
+		local:  s.proxy.UDPServer.PacketConn.LocalAddr(),
+		remote: &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 11111},
+	}
+	s.proxy.ServeDNS(writer, request)
+	c.Assert(writer.msg, Not(IsNil), Commentf("no response was written to the requester"))
+
+	return writer.msg, notifiedAnswers
+}
+
+func (s *DNSProxyTestSuite) TestTruncatedResponseRetriedOverTCP(c *C) {
+	udpServer := s.setupUDPUpstream(c)
+	defer udpServer.Shutdown()
+
+	// Without EDNS0 the 100 A records do not fit into the 512 byte UDP
+	// response and the upstream server truncates it. The proxy must retry
+	// over TCP and record the full answer, while the requester receives a
+	// truncated response and may retry over TCP itself.
+	request := new(dns.Msg)
+	request.SetQuestion(manyRecordsName, dns.TypeA)
+	response, notifiedAnswers := s.serveManyRecords(c, request)
+
+	c.Assert(notifiedAnswers, Equals, manyRecordsCount, Commentf("name manager did not see the full TCP-retried response"))
+	c.Assert(response.Truncated, Equals, true, Commentf("response to the requester was not marked truncated"))
+	c.Assert(len(response.Answer) < manyRecordsCount, Equals, true, Commentf("response to the requester was not cut down to its buffer size"))
+}
+
+func (s *DNSProxyTestSuite) TestLargeResponseWithEDNS0(c *C) {
+	udpServer := s.setupUDPUpstream(c)
+	defer udpServer.Shutdown()
+
+	// With an EDNS0 buffer size of 4096 the full response fits over UDP,
+	// both upstream and back to the requester.
+	request := new(dns.Msg)
+	request.SetQuestion(manyRecordsName, dns.TypeA)
+	request.SetEdns0(4096, false)
+	response, notifiedAnswers := s.serveManyRecords(c, request)
+
+	c.Assert(notifiedAnswers, Equals, manyRecordsCount, Commentf("name manager did not see the full response"))
+	c.Assert(response.Truncated, Equals, false, Commentf("response fitting the advertised buffer was truncated"))
+	c.Assert(len(response.Answer), Equals, manyRecordsCount, Commentf("Proxy returned incorrect number of answer RRs %s", response))
+}
+
+func (s *DNSProxyTestSuite) TestLargeResponseOverTCP(c *C) {
+	// Over TCP no truncation applies and the full response is passed
+	// through and recorded like a UDP one.
+	l7map := policy.L7DataMap{
+		cachedDstID1Selector: &policy.PerSelectorPolicy{
+			L7Rules: api.L7Rules{
+				DNS: []api.PortRuleDNS{{MatchName: manyRecordsName}},
+			},
+		},
+	}
+	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+
+	request := new(dns.Msg)
+	request.SetQuestion(manyRecordsName, dns.TypeA)
+	response, _, err := s.dnsTCPClient.Exchange(request, s.proxy.TCPServer.Listener.Addr().String())
+	c.Assert(err, IsNil, Commentf("DNS request from test client failed when it should succeed"))
+	c.Assert(len(response.Answer), Equals, manyRecordsCount, Commentf("Proxy returned incorrect number of answer RRs %s", response))
+}
+
+func (s *DNSProxyTestSuite) TestHealthProbe(c *C) {
+	// Health probes are answered directly by the serve loop, without any
+	// rules installed and without endpoint or policy lookups.
+	c.Assert(s.proxy.probeHealth(), Equals, true, Commentf("health probe failed on a healthy proxy"))
+
+	request := new(dns.Msg)
+	request.SetQuestion(supervisorHealthName, dns.TypeA)
+	response, _, err := s.dnsTCPClient.Exchange(request, s.proxy.TCPServer.Listener.Addr().String())
+	c.Assert(err, IsNil, Commentf("health query from test client failed when it should succeed"))
+	c.Assert(response.Rcode, Equals, dns.RcodeSuccess, Commentf("health query was not answered with NOERROR"))
+}
+
+func (s *DNSProxyTestSuite) TestSupervisorTakeover(c *C) {
+	name := "cilium.io."
+	l7map := policy.L7DataMap{
+		cachedDstID1Selector: &policy.PerSelectorPolicy{
+			L7Rules: api.L7Rules{
+				DNS: []api.PortRuleDNS{{MatchName: name}},
+			},
+		},
+	}
+	query := name
+
+	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+
+	oldUDPServer, oldTCPServer := s.proxy.UDPServer, s.proxy.TCPServer
+	s.proxy.takeover()
+	c.Assert(s.proxy.UDPServer, Not(Equals), oldUDPServer, Commentf("takeover did not replace the UDP server"))
+	c.Assert(s.proxy.TCPServer, Not(Equals), oldTCPServer, Commentf("takeover did not replace the TCP server"))
+	c.Assert(s.proxy.replaced(oldUDPServer), Equals, true, Commentf("old UDP server still considered current"))
+
+	// The replacement answers queries with the rules installed before the
+	// takeover.
+	request := new(dns.Msg)
+	request.SetQuestion(query, dns.TypeA)
+	response, _, err := s.dnsTCPClient.Exchange(request, s.proxy.TCPServer.Listener.Addr().String())
+	c.Assert(err, IsNil, Commentf("DNS request from test client failed when it should succeed"))
+	c.Assert(len(response.Answer), Equals, 1, Commentf("Proxy returned incorrect number of answer RRs %s", response))
+	c.Assert(s.proxy.probeHealth(), Equals, true, Commentf("health probe failed after takeover"))
+}
+
 func (s *DNSProxyTestSuite) TestRespondMixedCaseInRequestResponse(c *C) {
 	// Test that mixed case query is allowed out and then back in to support
 	// high-order-bit query uniqueing schemes (and a data exfiltration
@@ -423,7 +606,7 @@ func (s *DNSProxyTestSuite) TestRespondMixedCaseInRequestResponse(c *C) {
 
 	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
-	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query)
+	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
@@ -457,25 +640,138 @@ func (s *DNSProxyTestSuite) TestCheckAllowedTwiceRemovedOnce(c *C) {
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
 	err = s.proxy.UpdateAllowed(epID1, dstPort, l7map)
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
-	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query)
+	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// Delete once, it should reject
 	err = s.proxy.UpdateAllowed(epID1, dstPort, nil)
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
-	allowed, err = s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query)
+	allowed, err = s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Delete once, it should reject and not crash
 	err = s.proxy.UpdateAllowed(epID1, dstPort, nil)
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
-	allowed, err = s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query)
+	allowed, err = s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 }
 
+func (s *DNSProxyTestSuite) TestVerdictCache(c *C) {
+	name := "cilium.io."
+	l7map := policy.L7DataMap{
+		cachedDstID1Selector: &policy.PerSelectorPolicy{
+			L7Rules: api.L7Rules{
+				DNS: []api.PortRuleDNS{{MatchName: name}},
+			},
+		},
+	}
+	query := name
+
+	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+
+	// The first check populates the verdict cache
+	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
+	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
+	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
+	c.Assert(len(s.proxy.verdicts[epID1]), Equals, 1, Commentf("verdict was not cached"))
+
+	// A repeated identical query is served from the cache
+	allowed, err = s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
+	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
+	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
+	c.Assert(len(s.proxy.verdicts[epID1]), Equals, 1, Commentf("repeated query added a cache entry"))
+
+	// A different qtype is a separate cache entry
+	allowed, err = s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeAAAA)
+	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
+	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
+	c.Assert(len(s.proxy.verdicts[epID1]), Equals, 2, Commentf("query with other qtype did not add a cache entry"))
+
+	// Updating the rules of the endpoint flushes its cache
+	err = s.proxy.UpdateAllowed(epID1, dstPort, nil)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+	c.Assert(len(s.proxy.verdicts[epID1]), Equals, 0, Commentf("verdict cache was not flushed on rule update"))
+}
+
+func (s *DNSProxyTestSuite) TestRateLimitFromRule(c *C) {
+	name := "cilium.io."
+	l7map := policy.L7DataMap{
+		cachedDstID1Selector: &policy.PerSelectorPolicy{
+			L7Rules: api.L7Rules{
+				DNS: []api.PortRuleDNS{{MatchName: name, QueriesPerSecond: 1}},
+			},
+		},
+	}
+
+	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+
+	// The token bucket starts with a one second burst, so the first query
+	// passes and the immediate second query exceeds the rate.
+	c.Assert(s.proxy.checkQueryRate(epID1), Equals, true, Commentf("first query was rate limited"))
+	c.Assert(s.proxy.checkQueryRate(epID1), Equals, false, Commentf("second query was not rate limited"))
+
+	// Endpoints without a limit are not affected
+	c.Assert(s.proxy.checkQueryRate(epID2), Equals, true, Commentf("query of unlimited endpoint was rate limited"))
+	c.Assert(s.proxy.checkQueryRate(epID2), Equals, true, Commentf("query of unlimited endpoint was rate limited"))
+
+	// Removing the rules of the endpoint drops its rate limiter state
+	err = s.proxy.UpdateAllowed(epID1, dstPort, nil)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+	c.Assert(s.proxy.checkQueryRate(epID1), Equals, true, Commentf("query was rate limited after rules were removed"))
+	_, exists := s.proxy.limiters[epID1]
+	c.Assert(exists, Equals, false, Commentf("rate limiter was not removed with the rules"))
+}
+
+func (s *DNSProxyTestSuite) TestPassthroughNonMatchingQuery(c *C) {
+	name := "cilium.io."
+	l7map := policy.L7DataMap{
+		cachedDstID1Selector: &policy.PerSelectorPolicy{
+			L7Rules: api.L7Rules{
+				DNS: []api.PortRuleDNS{{MatchName: name, Passthrough: true}},
+			},
+		},
+	}
+	query := "notcilium.io."
+
+	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+
+	// The non-matching query is still denied by policy, but the proxy
+	// forwards it instead of refusing it
+	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
+	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
+	c.Assert(allowed, Equals, false, Commentf("non-matching request was allowed by policy"))
+	c.Assert(s.proxy.passthroughEnabled(epID1, dstPort), Equals, true, Commentf("passthrough was not enabled by the rule"))
+
+	request := new(dns.Msg)
+	request.SetQuestion(query, dns.TypeA)
+	response, _, err := s.dnsTCPClient.Exchange(request, s.proxy.TCPServer.Listener.Addr().String())
+	c.Assert(err, IsNil, Commentf("DNS request from test client failed when it should succeed"))
+	c.Assert(len(response.Answer), Equals, 1, Commentf("Proxy returned incorrect number of answer RRs %s", response))
+	c.Assert(response.Answer[0].String(), Equals, "notcilium.io.\t60\tIN\tA\t1.1.1.1", Commentf("Proxy returned incorrect RRs"))
+
+	// Replacing the rules with ones without the flag also removes the
+	// passthrough state, and the same query is refused again
+	l7map = policy.L7DataMap{
+		cachedDstID1Selector: &policy.PerSelectorPolicy{
+			L7Rules: api.L7Rules{
+				DNS: []api.PortRuleDNS{{MatchName: name}},
+			},
+		},
+	}
+	err = s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+	c.Assert(s.proxy.passthroughEnabled(epID1, dstPort), Equals, false, Commentf("passthrough was not removed with the rule"))
+	response, _, err = s.dnsTCPClient.Exchange(request, s.proxy.TCPServer.Listener.Addr().String())
+	c.Assert(err, IsNil, Commentf("DNS request from test client failed when it should succeed"))
+	c.Assert(response.Rcode, Equals, dns.RcodeRefused, Commentf("DNS request from test client was not rejected when it should be blocked"))
+}
+
 func (s *DNSProxyTestSuite) TestFullPathDependence(c *C) {
 	// Test that we consider each of endpoint ID, destination SecID (via the
 	// selector in L7DataMap), destination port (set in the redirect itself) and
@@ -570,62 +866,62 @@ func (s *DNSProxyTestSuite) TestFullPathDependence(c *C) {
 
 	// Test cases
 	// Case 1 | EPID1 | DstID1 |   53 | www.ubuntu.com | Allowed
-	allowed, err := s.proxy.CheckAllowed(epID1, 53, dstID1, nil, "www.ubuntu.com")
+	allowed, err := s.proxy.CheckAllowed(epID1, 53, dstID1, nil, "www.ubuntu.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// Case 2 | EPID1 | DstID1 |   54 | cilium.io      | Rejected | Port 54 only allows example.com
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, nil, "cilium.io")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, nil, "cilium.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 3 | EPID1 | DstID2 |   53 | cilium.io      | Allowed
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, nil, "cilium.io")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, nil, "cilium.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// Case 4 | EPID1 | DstID2 |   53 | aws.amazon.com | Rejected | Only cilium.io is allowed with DstID2
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, nil, "aws.amazon.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, nil, "aws.amazon.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 5 | EPID1 | DstID1 |   54 | example.com    | Allowed
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, nil, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, nil, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// Case 6 | EPID2 | DstID1 |   53 | cilium.io      | Rejected | EPID2 is not allowed as a source by any policy
-	allowed, err = s.proxy.CheckAllowed(epID2, 53, dstID1, nil, "cilium.io")
+	allowed, err = s.proxy.CheckAllowed(epID2, 53, dstID1, nil, "cilium.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 7 | EPID3 | DstID1 |   53 | example.com    | Allowed
-	allowed, err = s.proxy.CheckAllowed(epID3, 53, dstID1, nil, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID3, 53, dstID1, nil, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// Case 8 | EPID3 | DstID1 |   53 | aws.amazon.com | Rejected | EPID3 is only allowed to ask DstID1 on Port 53 for example.com
-	allowed, err = s.proxy.CheckAllowed(epID3, 53, dstID1, nil, "aws.amazon.io")
+	allowed, err = s.proxy.CheckAllowed(epID3, 53, dstID1, nil, "aws.amazon.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 8 | EPID3 | DstID1 |   54 | example.com    | Rejected | EPID3 is only allowed to ask DstID1 on Port 53 for example.com
-	allowed, err = s.proxy.CheckAllowed(epID3, 54, dstID1, nil, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID3, 54, dstID1, nil, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 9 | EPID3 | DstID2 |   53 | example.com    | Rejected | EPID3 is only allowed to ask DstID1 on Port 53 for example.com
-	allowed, err = s.proxy.CheckAllowed(epID3, 53, dstID2, nil, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID3, 53, dstID2, nil, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 10 | EPID3 | DstID3 |   53 | example.com    | Allowed due to wildcard match pattern
-	allowed, err = s.proxy.CheckAllowed(epID3, 53, dstID3, nil, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID3, 53, dstID3, nil, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// Case 11 | EPID3 | DstID4 |   53 | example.com    | Allowed due to a nil rule
-	allowed, err = s.proxy.CheckAllowed(epID3, 53, dstID4, nil, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID3, 53, dstID4, nil, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
@@ -705,27 +1001,27 @@ func (s *DNSProxyTestSuite) TestFullPathDependence(c *C) {
 
 	// Before restore: all rules removed above, everything is dropped
 	// Case 1 | EPID1 | DstID1 |   53 | www.ubuntu.com | Rejected | No rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID1, dstIP1, "www.ubuntu.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID1, dstIP1, "www.ubuntu.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 2 | EPID1 | DstID1 |   54 | cilium.io      | Rejected | No rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, dstIP1, "cilium.io")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, dstIP1, "cilium.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 3 | EPID1 | DstID2 |   53 | cilium.io      | Rejected | No rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, dstIP2a, "cilium.io")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, dstIP2a, "cilium.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 4 | EPID1 | DstID2 |   53 | aws.amazon.com | Rejected | No rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, dstIP2b, "aws.amazon.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, dstIP2b, "aws.amazon.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 5 | EPID1 | DstID1 |   54 | example.com    | Rejected | No rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, dstIP1, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, dstIP1, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
@@ -739,39 +1035,39 @@ func (s *DNSProxyTestSuite) TestFullPathDependence(c *C) {
 	// Same tests with 2 (WORLD) dstID to make sure it is not used, but with correct destination IP
 
 	// Case 1 | EPID1 | dstIP1 |   53 | www.ubuntu.com | Allowed due to restored rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP1, "www.ubuntu.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP1, "www.ubuntu.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// Case 2 | EPID1 | dstIP1 |   54 | cilium.io      | Rejected due to restored rules | Port 54 only allows example.com
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIP1, "cilium.io")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIP1, "cilium.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 3 | EPID1 | dstIP2a |   53 | cilium.io      | Allowed due to restored rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP2a, "cilium.io")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP2a, "cilium.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// Case 4 | EPID1 | dstIP2b |   53 | aws.amazon.com | Rejected due to restored rules | Only cilium.io is allowed with DstID2
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP2b, "aws.amazon.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP2b, "aws.amazon.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 5 | EPID1 | dstIP1 |   54 | example.com    | Allowed due to restored rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIP1, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIP1, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// make sure random IP is not allowed
 	// Case 5 | EPID1 | random IP |   53 | example.com    | Rejected due to restored rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIPrandom, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIPrandom, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// make sure random destination IP is allowed in a wildcard selector
 	// Case 5 | EPID1 | random IP |   54 | example.com    | Allowed due to restored rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIPrandom, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIPrandom, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
@@ -822,32 +1118,32 @@ func (s *DNSProxyTestSuite) TestFullPathDependence(c *C) {
 
 	// Before restore after marshal: previous restored rules are removed, everything is dropped
 	// Case 1 | EPID1 | DstID1 |   53 | www.ubuntu.com | Rejected | No rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID1, dstIP1, "www.ubuntu.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID1, dstIP1, "www.ubuntu.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 2 | EPID1 | DstID1 |   54 | cilium.io      | Rejected | No rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, dstIP1, "cilium.io")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, dstIP1, "cilium.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 3 | EPID1 | DstID2 |   53 | cilium.io      | Rejected | No rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, dstIP2a, "cilium.io")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, dstIP2a, "cilium.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 4 | EPID1 | DstID2 |   53 | aws.amazon.com | Rejected | No rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, dstIP2b, "aws.amazon.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, dstID2, dstIP2b, "aws.amazon.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 5 | EPID1 | DstID1 |   54 | example.com    | Rejected | No rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, dstIP1, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, dstID1, dstIP1, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 5 | EPID1 | random IP |   54 | example.com    | Rejected
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIPrandom, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIPrandom, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
@@ -872,39 +1168,39 @@ func (s *DNSProxyTestSuite) TestFullPathDependence(c *C) {
 	// After restoration of JSON marshaled/unmarshaled rules
 
 	// Case 1 | EPID1 | dstIP1 |   53 | www.ubuntu.com | Allowed due to restored rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP1, "www.ubuntu.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP1, "www.ubuntu.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// Case 2 | EPID1 | dstIP1 |   54 | cilium.io      | Rejected due to restored rules | Port 54 only allows example.com
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIP1, "cilium.io")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIP1, "cilium.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 3 | EPID1 | dstIP2a |   53 | cilium.io      | Allowed due to restored rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP2a, "cilium.io")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP2a, "cilium.io", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// Case 4 | EPID1 | dstIP2b |   53 | aws.amazon.com | Rejected due to restored rules | Only cilium.io is allowed with DstID2
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP2b, "aws.amazon.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIP2b, "aws.amazon.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// Case 5 | EPID1 | dstIP1 |   54 | example.com    | Allowed due to restored rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIP1, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIP1, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
 	// make sure random IP is not allowed
 	// Case 5 | EPID1 | random IP |   53 | example.com    | Rejected due to restored rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIPrandom, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 53, 2, dstIPrandom, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, false, Commentf("request was allowed when it should be rejected"))
 
 	// make sure random IP is allowed on a wildcard
 	// Case 5 | EPID1 | random IP |   54 | example.com    | Allowed due to restored rules
-	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIPrandom, "example.com")
+	allowed, err = s.proxy.CheckAllowed(epID1, 54, 2, dstIPrandom, "example.com", dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
@@ -930,7 +1226,7 @@ func (s *DNSProxyTestSuite) TestRestoredEndpoint(c *C) {
 
 	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
 	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
-	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query)
+	allowed, err := s.proxy.CheckAllowed(epID1, dstPort, dstID1, nil, query, dns.TypeA)
 	c.Assert(err, Equals, nil, Commentf("Error when checking allowed"))
 	c.Assert(allowed, Equals, true, Commentf("request was rejected when it should be allowed"))
 
@@ -975,10 +1271,18 @@ func (s *DNSProxyTestSuite) TestRestoredEndpoint(c *C) {
 	c.Assert(len(response.Answer), Equals, 1, Commentf("Proxy returned incorrect number of answer RRs %s", response))
 	c.Assert(response.Answer[0].String(), Equals, "cilium.io.\t60\tIN\tA\t1.1.1.1", Commentf("Proxy returned incorrect RRs"))
 
-	// cleanup
-	s.proxy.RemoveRestoredRules(uint16(epID1))
+	// Updating with the real policy reconciles the restored rules away
+	err = s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
 	_, exists = s.proxy.restored[epID1]
 	c.Assert(exists, Equals, false)
-
 	s.restoring = false
+
+	// 4th request, answered due to the real policy
+	request = new(dns.Msg)
+	request.SetQuestion(query, dns.TypeA)
+	response, rtt, err = s.dnsTCPClient.Exchange(request, s.proxy.TCPServer.Listener.Addr().String())
+	c.Assert(err, IsNil, Commentf("DNS request from test client failed when it should succeed (RTT: %v)", rtt))
+	c.Assert(len(response.Answer), Equals, 1, Commentf("Proxy returned incorrect number of answer RRs %s", response))
+	c.Assert(response.Answer[0].String(), Equals, "cilium.io.\t60\tIN\tA\t1.1.1.1", Commentf("Proxy returned incorrect RRs"))
 }
@@ -0,0 +1,147 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsproxy
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/cilium/cilium/pkg/metrics"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	// supervisorProbeInterval is how often the supervisor probes the
+	// health of the DNS proxy instance.
+	supervisorProbeInterval = 30 * time.Second
+
+	// supervisorProbeTimeout is how long a single health probe may take
+	// before it is considered failed.
+	supervisorProbeTimeout = 5 * time.Second
+
+	// supervisorProbeRetries is how many times a failed health probe is
+	// retried before the instance is considered wedged and replaced.
+	supervisorProbeRetries = 3
+
+	// supervisorHealthName is the query name the supervisor probes the
+	// listening sockets with. ServeDNS answers it directly, without policy
+	// evaluation or forwarding, so a reply proves the serve loop is alive.
+	supervisorHealthName = "health.cilium.internal."
+)
+
+// StartSupervisor starts a supervisor for the DNS proxy instance. The
+// supervisor periodically probes the health of the UDP and TCP serve loops
+// through the listening sockets and replaces a wedged instance with a fresh
+// one bound to the same port.
+func (p *DNSProxy) StartSupervisor() {
+	go p.supervise()
+}
+
+func (p *DNSProxy) supervise() {
+	ticker := time.NewTicker(supervisorProbeInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if !p.probeHealth() {
+			p.takeover()
+		}
+	}
+}
+
+// probeAddr returns the address health probes are sent to. The proxy binds
+// to all local addresses by default, in which case the probes go through
+// loopback.
+func (p *DNSProxy) probeAddr() string {
+	address := p.bindAddress
+	if address == "" {
+		if p.enableIPv4 {
+			address = "127.0.0.1"
+		} else {
+			address = "::1"
+		}
+	}
+	return net.JoinHostPort(address, strconv.Itoa(int(p.BindPort)))
+}
+
+// probeHealth sends a health query to the UDP and TCP listening sockets of
+// the proxy and returns false when either fails to reply within
+// supervisorProbeTimeout for supervisorProbeRetries attempts in a row.
+func (p *DNSProxy) probeHealth() bool {
+	addr := p.probeAddr()
+	probe := new(dns.Msg)
+	probe.SetQuestion(supervisorHealthName, dns.TypeA)
+
+	for _, proto := range []string{"udp", "tcp"} {
+		healthy := false
+		for i := 0; i < supervisorProbeRetries; i++ {
+			client := &dns.Client{Net: proto, Timeout: supervisorProbeTimeout}
+			if _, _, err := client.Exchange(probe, addr); err == nil {
+				healthy = true
+				break
+			}
+		}
+		if !healthy {
+			log.Warnf("DNS proxy failed to answer %d %s health probes on %s, replacing the instance",
+				supervisorProbeRetries, proto, addr)
+			return false
+		}
+	}
+	return true
+}
+
+// takeover replaces the wedged DNS proxy instance with a fresh one. The
+// replacement sockets are bound while the old instance is still serving; the
+// listening sockets are opened with SO_REUSEPORT so both instances can
+// coexist and no queries are refused during the swap. The compiled rule set
+// lives in the DNSProxy itself and carries over to the replacement as is.
+func (p *DNSProxy) takeover() {
+	start := time.Now()
+
+	var (
+		UDPConn     *net.UDPConn
+		TCPListener *net.TCPListener
+		err         error
+	)
+	for time.Since(start) < ProxyBindTimeout {
+		UDPConn, TCPListener, err = bindToAddr(p.bindAddress, p.BindPort, p.enableIPv4, p.enableIPv6)
+		if err == nil {
+			break
+		}
+		log.WithError(err).Warnf("Attempt to bind replacement DNS Proxy failed, retrying in %v", ProxyBindRetryInterval)
+		time.Sleep(ProxyBindRetryInterval)
+	}
+	if err != nil {
+		log.WithError(err).Error("Cannot bind replacement DNS Proxy, keeping the wedged instance")
+		return
+	}
+
+	p.Lock()
+	oldServers := []*dns.Server{p.UDPServer, p.TCPServer}
+	p.Unlock()
+
+	p.installServers(UDPConn, TCPListener)
+
+	// Tear down the old instance only once the replacement is serving. The
+	// old serve loops may be wedged, so do not wait for them to finish.
+	for _, server := range oldServers {
+		go server.Shutdown()
+	}
+
+	duration := time.Since(start)
+	metrics.FQDNProxyRestartsTotal.Inc()
+	metrics.FQDNProxyTakeoverDuration.Observe(duration.Seconds())
+	log.Infof("Replaced wedged DNS proxy instance on %s in %v", p.BindAddr, duration)
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/inctimer"
 	"github.com/cilium/cilium/pkg/ip"
 	"github.com/cilium/cilium/pkg/ipcache"
 	"github.com/cilium/cilium/pkg/lock"
@@ -53,6 +54,10 @@ type NameManager struct {
 	// cache is a private copy of the pointer from config.
 	cache *DNSCache
 
+	// expiryNotify wakes the TTL expiry goroutine started by
+	// EnableTTLExpiry when new DNS data is ingested.
+	expiryNotify chan struct{}
+
 	bootstrapCompleted bool
 }
 
@@ -124,7 +129,7 @@ func (n *NameManager) RegisterForIdentityUpdatesLocked(selector api.FQDNSelector
 	// any existing IPs would typically already have been pushed to the ipcache as they would
 	// not be newly allocated. We need the 'allocation' here to get a reference count on the
 	// allocations.
-	if currentlyAllocatedIdentities, err = ipcache.AllocateCIDRsForIPs(selectorIPs, nil); err != nil {
+	if currentlyAllocatedIdentities, err = ipcache.AllocateAggregatedCIDRsForIPs(selectorIPs, nil); err != nil {
 		log.WithError(err).WithField("prefixes", selectorIPs).Warn(
 			"failed to allocate identities for IPs")
 		return nil
@@ -162,6 +167,7 @@ func NewNameManager(config Config) *NameManager {
 		config:       config,
 		allSelectors: make(map[api.FQDNSelector]*regexp.Regexp),
 		cache:        config.Cache,
+		expiryNotify: make(chan struct{}, 1),
 	}
 
 }
@@ -193,6 +199,13 @@ func (n *NameManager) UpdateGenerateDNS(ctx context.Context, lookupTime time.Tim
 			Debug("No IPs to insert when generating DNS name selected by ToFQDN rule")
 	}
 
+	// Wake the TTL expiry goroutine in case the new data expires sooner than
+	// it is currently waiting for.
+	select {
+	case n.expiryNotify <- struct{}{}:
+	default:
+	}
+
 	return n.config.UpdateSelectors(ctx, selectorIPMapping, namesMissingIPs)
 }
 
@@ -232,6 +245,57 @@ func (n *NameManager) CompleteBootstrap() {
 	n.Unlock()
 }
 
+// ExpireDNSNames removes cache entries that have passed their TTL and
+// regenerates policy for the affected names, releasing the identity and
+// ipcache associations of the expired IPs via UpdateSelectors. It returns the
+// time of the next pending expiration, and false when no entries are pending
+// expiry.
+func (n *NameManager) ExpireDNSNames(ctx context.Context, now time.Time) (next time.Time, pending bool) {
+	expiredNames := n.cache.GC(now, nil)
+	next, pending = n.cache.NextExpiration()
+
+	if len(expiredNames) > 0 {
+		log.WithField(logfields.DNSName, expiredNames).
+			Debug("Regenerating policy for DNS names with expired TTLs")
+		if _, err := n.ForceGenerateDNS(ctx, expiredNames); err != nil {
+			log.WithError(err).Warning("Failed to regenerate policy for DNS names with expired TTLs")
+		}
+	}
+
+	return next, pending
+}
+
+// EnableTTLExpiry starts a background goroutine that expires DNS cache
+// entries as their TTLs elapse, instead of leaving them to the interval of
+// the periodic DNS garbage collector. The TTLs already have the configured
+// minimum TTL floor applied on insertion. IPs that back an active connection
+// are kept alive by the garbage collector via the zombie tracking of each
+// endpoint cache and are re-inserted into the cache until conntrack reports
+// the connection closed. The goroutine exits when ctx is cancelled.
+func (n *NameManager) EnableTTLExpiry(ctx context.Context) {
+	go func() {
+		timer, stopTimer := inctimer.New()
+		defer stopTimer()
+
+		for {
+			var wake <-chan time.Time
+			if next, pending := n.ExpireDNSNames(ctx, time.Now()); pending {
+				// The cleanup index of the cache has per-second
+				// granularity, wake just after the expiration
+				// second has elapsed.
+				wake = timer.After(time.Until(next.Add(time.Second)))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-wake:
+			case <-n.expiryNotify:
+			}
+		}
+	}()
+}
+
 // updateDNSIPs updates the IPs for each DNS name in updatedDNSIPs.
 // It returns:
 // affectedSelectors: a set of all FQDNSelectors which match DNS Names whose
@@ -156,3 +156,59 @@ func (ds *FQDNTestSuite) TestNameManagerMultiIPUpdate(c *C) {
 	nameManager.Unlock()
 
 }
+
+// TestNameManagerTTLExpiry tests that when a name re-resolves to a disjoint
+// IP set, the IPs of the older lookup are removed from the selector mapping
+// once their TTL has elapsed, while the still-valid IPs are retained.
+func (ds *FQDNTestSuite) TestNameManagerTTLExpiry(c *C) {
+	var (
+		selIPMap map[api.FQDNSelector][]net.IP
+
+		nameManager = NewNameManager(Config{
+			MinTTL: 1,
+			Cache:  NewDNSCache(0),
+
+			UpdateSelectors: func(ctx context.Context, selectorIPMapping map[api.FQDNSelector][]net.IP, selectorsWithoutIPs []api.FQDNSelector) (*sync.WaitGroup, map[string]*identity.Identity, error) {
+				for k, v := range selectorIPMapping {
+					selIPMap[k] = v
+				}
+				return &sync.WaitGroup{}, nil, nil
+			},
+		})
+	)
+
+	// add rules
+	nameManager.Lock()
+	ids := nameManager.RegisterForIdentityUpdatesLocked(ciliumIOSel)
+	nameManager.Unlock()
+	c.Assert(ids, Not(IsNil))
+
+	// Insert a lookup that has already passed its TTL, and a newer lookup
+	// that resolved to a disjoint set of IPs.
+	now := time.Now()
+	selIPMap = make(map[api.FQDNSelector][]net.IP)
+	_, _, err := nameManager.UpdateGenerateDNS(context.Background(), now.Add(-10*time.Second), map[string]*DNSIPRecords{dns.Fqdn("cilium.io"): {TTL: 3, IPs: []net.IP{net.ParseIP("1.1.1.1")}}})
+	c.Assert(err, IsNil, Commentf("Error mapping selectors to IPs"))
+	_, _, err = nameManager.UpdateGenerateDNS(context.Background(), now, map[string]*DNSIPRecords{dns.Fqdn("cilium.io"): {TTL: 300, IPs: []net.IP{net.ParseIP("2.2.2.2")}}})
+	c.Assert(err, IsNil, Commentf("Error mapping selectors to IPs"))
+
+	// Before expiry runs, the expired IP still lingers in the selector
+	// mapping alongside the new one.
+	c.Assert(len(selIPMap[ciliumIOSel]), Equals, 2, Commentf("Expired IP already removed before expiry ran"))
+
+	// Expiring at 'now' removes the older lookup and regenerates the
+	// selector with only the still-valid IP.
+	selIPMap = make(map[api.FQDNSelector][]net.IP)
+	next, pending := nameManager.ExpireDNSNames(context.Background(), now)
+	c.Assert(pending, Equals, true, Commentf("No pending expiration reported for the still-valid lookup"))
+	c.Assert(next.After(now), Equals, true, Commentf("Next expiration is not in the future"))
+	c.Assert(len(selIPMap), Equals, 1, Commentf("Expiry did not regenerate the affected selector"))
+	c.Assert(len(selIPMap[ciliumIOSel]), Equals, 1, Commentf("Expired IP was not removed from the selector"))
+	c.Assert(selIPMap[ciliumIOSel][0].Equal(net.ParseIP("2.2.2.2")), Equals, true, Commentf("Incorrect IP mapping to FQDN"))
+
+	// A second pass with nothing newly expired does not regenerate.
+	selIPMap = make(map[api.FQDNSelector][]net.IP)
+	_, pending = nameManager.ExpireDNSNames(context.Background(), now)
+	c.Assert(pending, Equals, true, Commentf("No pending expiration reported for the still-valid lookup"))
+	c.Assert(len(selIPMap), Equals, 0, Commentf("Expiry regenerated selectors without expired entries"))
+}
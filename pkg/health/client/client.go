@@ -144,14 +144,28 @@ func GetConnectivityStatusType(cs *models.ConnectivityStatus) ConnectivityStatus
 	return ConnStatusUnreachable
 }
 
-func GetPathConnectivityStatusType(cp *models.PathStatus) ConnectivityStatusType {
-	if cp == nil {
-		return ConnStatusUnreachable
-	}
+// connectivityStatuses returns the list of per-probe statuses to consider
+// when summarizing the state of a path. The TCP and service probe types may
+// not be reported by older or differently configured agents, so their absence
+// is not treated as a lack of connectivity.
+func connectivityStatuses(cp *models.PathStatus) []*models.ConnectivityStatus {
 	statuses := []*models.ConnectivityStatus{
 		cp.Icmp,
 		cp.HTTP,
 	}
+	for _, cs := range []*models.ConnectivityStatus{cp.TCP, cp.HTTPService} {
+		if cs != nil {
+			statuses = append(statuses, cs)
+		}
+	}
+	return statuses
+}
+
+func GetPathConnectivityStatusType(cp *models.PathStatus) ConnectivityStatusType {
+	if cp == nil {
+		return ConnStatusUnreachable
+	}
+	statuses := connectivityStatuses(cp)
 	// Initially assume healthy status.
 	status := ConnStatusReachable
 	for _, cs := range statuses {
@@ -192,9 +206,15 @@ func formatPathStatus(w io.Writer, name string, cp *models.PathStatus, indent st
 	if cp.Icmp != nil {
 		formatConnectivityStatus(w, cp.Icmp, "ICMP to stack", indent)
 	}
+	if cp.TCP != nil {
+		formatConnectivityStatus(w, cp.TCP, "TCP to agent", indent)
+	}
 	if cp.HTTP != nil {
 		formatConnectivityStatus(w, cp.HTTP, "HTTP to agent", indent)
 	}
+	if cp.HTTPService != nil {
+		formatConnectivityStatus(w, cp.HTTPService, "HTTP via service", indent)
+	}
 }
 
 // pathIsHealthyOrUnknown checks whether ICMP and TCP(HTTP) connectivity to the
@@ -205,11 +225,7 @@ func pathIsHealthyOrUnknown(cp *models.PathStatus) bool {
 		return false
 	}
 
-	statuses := []*models.ConnectivityStatus{
-		cp.Icmp,
-		cp.HTTP,
-	}
-	for _, status := range statuses {
+	for _, status := range connectivityStatuses(cp) {
 		switch GetConnectivityStatusType(status) {
 		case ConnStatusUnreachable:
 			return false
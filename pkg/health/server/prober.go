@@ -69,8 +69,10 @@ func (p *prober) copyResultRLocked(ip string) *models.PathStatus {
 		IP: ip,
 	}
 	paths := map[**models.ConnectivityStatus]*models.ConnectivityStatus{
-		&result.Icmp: status.Icmp,
-		&result.HTTP: status.HTTP,
+		&result.Icmp:        status.Icmp,
+		&result.TCP:         status.TCP,
+		&result.HTTP:        status.HTTP,
+		&result.HTTPService: status.HTTPService,
 	}
 	for res, value := range paths {
 		if value != nil {
@@ -201,7 +203,9 @@ func (p *prober) setNodes(added nodeMap, removed nodeMap) {
 				result.Status = "Failed to resolve IP"
 			} else {
 				result.Status = "Connection timed out"
-				p.AddIPAddr(addr)
+				if p.server.probeEnabled(probeICMP) {
+					p.AddIPAddr(addr)
+				}
 				p.nodes[ip] = n
 			}
 
@@ -210,7 +214,9 @@ func (p *prober) setNodes(added nodeMap, removed nodeMap) {
 					IP: elem.IP,
 				}
 			}
-			p.results[ip].Icmp = result
+			if p.server.probeEnabled(probeICMP) {
+				p.results[ip].Icmp = result
+			}
 		}
 	}
 }
@@ -242,6 +248,35 @@ func (p *prober) httpProbe(node string, ip string, port int) *models.Connectivit
 	return result
 }
 
+// tcpProbe checks whether a TCP connection to the given host and port can be
+// established, and measures the time that the connection setup took.
+func (p *prober) tcpProbe(node string, ip string, port int) *models.ConnectivityStatus {
+	result := &models.ConnectivityStatus{}
+
+	hostPort := net.JoinHostPort(ip, strconv.Itoa(port))
+	scopedLog := log.WithFields(logrus.Fields{
+		logfields.NodeName: node,
+		logfields.IPAddr:   ip,
+		"hostPort":         hostPort,
+	})
+
+	scopedLog.Debug("Connecting to host")
+	start := time.Now()
+	conn, err := net.DialTimeout("tcp", hostPort, p.server.ProbeDeadline)
+	rtt := time.Since(start)
+	if err == nil {
+		conn.Close()
+		scopedLog.WithField("rtt", rtt).Debug("Connection successful")
+		result.Status = ""
+		result.Latency = rtt.Nanoseconds()
+	} else {
+		scopedLog.WithError(err).Debug("Connection failed")
+		result.Status = err.Error()
+	}
+
+	return result
+}
+
 func (p *prober) getIPsByNode() map[string][]*net.IPAddr {
 	p.RLock()
 	defer p.RUnlock()
@@ -266,7 +301,9 @@ func (p *prober) getIPsByNode() map[string][]*net.IPAddr {
 	return nodes
 }
 
-func (p *prober) runHTTPProbe() {
+// runProbes sends a TCP and HTTP probe round to all of the known nodes,
+// recording one status per enabled probe type.
+func (p *prober) runProbes() {
 	startTime := time.Now()
 	p.Lock()
 	p.start = startTime
@@ -280,22 +317,37 @@ func (p *prober) runHTTPProbe() {
 			})
 
 			status := &models.PathStatus{}
-			ports := map[int]**models.ConnectivityStatus{
-				defaults.HTTPPathPort: &status.HTTP,
+			ports := map[int]**models.ConnectivityStatus{}
+			if p.server.probeEnabled(probeHTTP) {
+				ports[defaults.HTTPPathPort] = &status.HTTP
+			}
+			if p.server.probeEnabled(probeHTTPService) {
+				ports[defaults.ServicePathPort] = &status.HTTPService
 			}
 			for port, result := range ports {
 				*result = p.httpProbe(name, ip.String(), port)
-				if status.HTTP.Status != "" {
+				if (*result).Status != "" {
 					scopedLog.WithFields(logrus.Fields{
 						logfields.Port: port,
-					}).Debugf("Failed to probe: %s", status.HTTP.Status)
+					}).Debugf("Failed to probe: %s", (*result).Status)
+				}
+			}
+
+			if p.server.probeEnabled(probeTCP) {
+				status.TCP = p.tcpProbe(name, ip.String(), defaults.HTTPPathPort)
+				if status.TCP.Status != "" {
+					scopedLog.WithFields(logrus.Fields{
+						logfields.Port: defaults.HTTPPathPort,
+					}).Debugf("Failed to connect: %s", status.TCP.Status)
 				}
 			}
 
 			peer := ipString(ip.String())
 			p.Lock()
 			if _, ok := p.results[peer]; ok {
+				p.results[peer].TCP = status.TCP
 				p.results[peer].HTTP = status.HTTP
+				p.results[peer].HTTPService = status.HTTPService
 			} else {
 				// While we weren't holding the lock, the
 				// pinger's OnIdle() callback fired and updated
@@ -311,7 +363,7 @@ func (p *prober) runHTTPProbe() {
 // connectivity status for the cluster.
 func (p *prober) Run() error {
 	err := p.Pinger.Run()
-	p.runHTTPProbe()
+	p.runProbes()
 	return err
 }
 
@@ -340,7 +392,7 @@ func (p *prober) RunLoop() {
 			case <-p.stop:
 				break loop
 			case <-tick.C:
-				p.runHTTPProbe()
+				p.runProbes()
 				continue
 			}
 		}
@@ -39,16 +39,43 @@ var (
 	// PortToPaths is a convenience map for access to the ports and their
 	// common string representations
 	PortToPaths = map[int]string{
-		defaults.HTTPPathPort: "Via L3",
+		defaults.HTTPPathPort:    "Via L3",
+		defaults.ServicePathPort: "Via Service",
 	}
 )
 
+// Probe type names accepted in Config.ProbeTypes.
+const (
+	probeICMP        = "icmp"
+	probeTCP         = "tcp"
+	probeHTTP        = "http"
+	probeHTTPService = "http-service"
+)
+
 // Config stores the configuration data for a cilium-health server.
 type Config struct {
 	Debug         bool
 	CiliumURI     string
 	ProbeInterval time.Duration
 	ProbeDeadline time.Duration
+
+	// ProbeTypes is the subset of probe types to run against other nodes.
+	// An empty slice enables all probe types.
+	ProbeTypes []string
+}
+
+// probeEnabled returns true if the probe type is enabled in the server
+// configuration.
+func (s *Server) probeEnabled(name string) bool {
+	if len(s.ProbeTypes) == 0 {
+		return true
+	}
+	for _, t := range s.ProbeTypes {
+		if t == name {
+			return true
+		}
+	}
+	return false
 }
 
 // ipString is an IP address used as a more descriptive type name in maps.
@@ -314,6 +341,14 @@ func (s *Server) newServer(spec *loads.Document) *healthApi.Server {
 
 // NewServer creates a server to handle health requests.
 func NewServer(config Config) (*Server, error) {
+	for _, t := range config.ProbeTypes {
+		switch t {
+		case probeICMP, probeTCP, probeHTTP, probeHTTPService:
+		default:
+			return nil, fmt.Errorf("unknown probe type %q", t)
+		}
+	}
+
 	server := &Server{
 		startTime:    time.Now(),
 		Config:       config,
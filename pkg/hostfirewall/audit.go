@@ -0,0 +1,202 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hostfirewall implements the audit mode support for the host
+// endpoint. In audit mode, packets which would be denied by host policies
+// are allowed by the datapath but flagged in the policy verdict
+// notification. The audit collector aggregates these notifications into a
+// report of distinct (remote identity, port, protocol) tuples which lacked
+// an allow, so that the missing rules can be added before enforcement is
+// enabled.
+package hostfirewall
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cilium/cilium/pkg/byteorder"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/monitor"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+)
+
+var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "hostfirewall")
+
+// AuditReportFile is the file in the state directory where the audit
+// collector persists its report for consumption by the cilium CLI.
+const AuditReportFile = "host_firewall_audit.json"
+
+// reportFlushInterval bounds how often count updates of already known
+// tuples are flushed to the report file. New tuples are flushed right away.
+const reportFlushInterval = 10 * time.Second
+
+// AuditKey identifies a distinct flow tuple observed in audit mode.
+type AuditKey struct {
+	// RemoteIdentity is the numeric security identity of the remote peer.
+	RemoteIdentity identity.NumericIdentity `json:"remote-identity"`
+
+	// DstPort is the layer 4 destination port of the flow.
+	DstPort uint16 `json:"dst-port"`
+
+	// Proto is the layer 4 protocol number of the flow.
+	Proto uint8 `json:"proto"`
+
+	// Ingress is true if the flow was observed at host ingress.
+	Ingress bool `json:"ingress"`
+}
+
+// AuditEntry is the aggregated record of a distinct flow tuple which lacked
+// an allow rule while the host endpoint was in audit mode.
+type AuditEntry struct {
+	AuditKey
+
+	// MatchType describes how the flow matched the policy, typically
+	// "none" as no allow rule matched.
+	MatchType string `json:"match-type"`
+
+	// Count is the number of audited packets observed for this tuple.
+	Count uint64 `json:"count"`
+
+	// FirstSeen is the time the tuple was first observed.
+	FirstSeen time.Time `json:"first-seen"`
+
+	// LastSeen is the time the tuple was last observed.
+	LastSeen time.Time `json:"last-seen"`
+}
+
+// AuditCollector consumes policy verdict notifications from the monitor,
+// retains the audited verdicts of the host endpoint and aggregates them by
+// flow tuple. It implements consumer.MonitorConsumer.
+type AuditCollector struct {
+	mutex lock.Mutex
+
+	// stateDir is the directory the report file is written to.
+	stateDir string
+
+	// hostEndpointID returns the current endpoint ID of the host endpoint,
+	// or 0 if the host endpoint does not exist yet.
+	hostEndpointID func() uint16
+
+	// entries is the set of distinct flow tuples observed so far.
+	entries map[AuditKey]*AuditEntry
+
+	// lastFlush is the time the report file was last written.
+	lastFlush time.Time
+}
+
+// NewAuditCollector returns a collector writing its report to stateDir. The
+// hostEndpointID function must return the current endpoint ID of the host
+// endpoint, or 0 if it does not exist yet.
+func NewAuditCollector(stateDir string, hostEndpointID func() uint16) *AuditCollector {
+	return &AuditCollector{
+		stateDir:       stateDir,
+		hostEndpointID: hostEndpointID,
+		entries:        map[AuditKey]*AuditEntry{},
+	}
+}
+
+// NotifyAgentEvent implements consumer.MonitorConsumer. Agent events carry
+// no policy verdicts and are ignored.
+func (c *AuditCollector) NotifyAgentEvent(typ int, message interface{}) {
+}
+
+// NotifyPerfEvent implements consumer.MonitorConsumer
+func (c *AuditCollector) NotifyPerfEvent(data []byte, cpu int) {
+	if len(data) < monitor.PolicyVerdictNotifyLen ||
+		data[0] != byte(monitorAPI.MessageTypePolicyVerdict) {
+		return
+	}
+
+	pvn := monitor.PolicyVerdictNotify{}
+	if err := binary.Read(bytes.NewReader(data), byteorder.Native, &pvn); err != nil {
+		return
+	}
+
+	if !pvn.IsTrafficAudited() || pvn.Source != c.hostEndpointID() {
+		return
+	}
+
+	c.record(&pvn)
+}
+
+// NotifyPerfEventLost implements consumer.MonitorConsumer. Lost events only
+// reduce the accuracy of the counts, the report remains valid.
+func (c *AuditCollector) NotifyPerfEventLost(numLostEvents uint64, cpu int) {
+}
+
+// record aggregates the audited verdict into the report, deduplicating by
+// flow tuple, and flushes the report file if needed.
+func (c *AuditCollector) record(pvn *monitor.PolicyVerdictNotify) {
+	key := AuditKey{
+		RemoteIdentity: pvn.RemoteLabel,
+		DstPort:        pvn.DstPort,
+		Proto:          pvn.Proto,
+		Ingress:        pvn.IsTrafficIngress(),
+	}
+	now := time.Now()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &AuditEntry{
+			AuditKey:  key,
+			MatchType: pvn.GetPolicyMatchType().String(),
+			FirstSeen: now,
+		}
+		c.entries[key] = entry
+	}
+	entry.Count++
+	entry.LastSeen = now
+
+	// New tuples are what the report exists for, flush them right away.
+	// Count updates are flushed at most once per interval.
+	if !ok || now.Sub(c.lastFlush) >= reportFlushInterval {
+		c.flushLocked()
+		c.lastFlush = now
+	}
+}
+
+// flushLocked writes the report file. Must be called with c.mutex held.
+func (c *AuditCollector) flushLocked() {
+	report := make([]*AuditEntry, 0, len(c.entries))
+	for _, entry := range c.entries {
+		report = append(report, entry)
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		log.WithError(err).Warning("Unable to encode host firewall audit report")
+		return
+	}
+
+	path := filepath.Join(c.stateDir, AuditReportFile)
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		log.WithError(err).Warning("Unable to write host firewall audit report")
+		return
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		log.WithError(err).Warning("Unable to write host firewall audit report")
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package hostfirewall
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cilium/cilium/pkg/byteorder"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/monitor"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+
+	. "gopkg.in/check.v1"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type AuditSuite struct{}
+
+var _ = Suite(&AuditSuite{})
+
+const testHostEndpointID = uint16(2281)
+
+func encodeVerdict(c *C, source uint16, remote identity.NumericIdentity, port uint16, proto uint8, flags uint8) []byte {
+	pvn := monitor.PolicyVerdictNotify{
+		Type:        byte(monitorAPI.MessageTypePolicyVerdict),
+		Source:      source,
+		RemoteLabel: remote,
+		Verdict:     -151, // EPOLICY_DENIED
+		DstPort:     port,
+		Proto:       proto,
+		Flags:       flags,
+	}
+	buf := &bytes.Buffer{}
+	c.Assert(binary.Write(buf, byteorder.Native, &pvn), IsNil)
+	return buf.Bytes()
+}
+
+func (s *AuditSuite) TestAuditCollector(c *C) {
+	stateDir, err := ioutil.TempDir("", "cilium-audit-test")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(stateDir)
+
+	collector := NewAuditCollector(stateDir, func() uint16 {
+		return testHostEndpointID
+	})
+
+	audited := uint8(monitor.PolicyVerdictNotifyFlagIsAudited | monitorAPI.PolicyIngress)
+
+	// Repeated verdicts for the same tuple are deduplicated.
+	for i := 0; i < 3; i++ {
+		collector.NotifyPerfEvent(encodeVerdict(c, testHostEndpointID, 12345, 22, 6, audited), 0)
+	}
+	// A second distinct tuple.
+	collector.NotifyPerfEvent(encodeVerdict(c, testHostEndpointID, 12345, 53, 17, audited), 0)
+	// Non-audited verdicts are not recorded.
+	collector.NotifyPerfEvent(encodeVerdict(c, testHostEndpointID, 12345, 80, 6, 0), 0)
+	// Verdicts of other endpoints are not recorded.
+	collector.NotifyPerfEvent(encodeVerdict(c, 42, 12345, 443, 6, audited), 0)
+
+	c.Assert(collector.entries, HasLen, 2)
+
+	sshKey := AuditKey{RemoteIdentity: 12345, DstPort: 22, Proto: 6, Ingress: true}
+	entry, ok := collector.entries[sshKey]
+	c.Assert(ok, Equals, true)
+	c.Assert(entry.Count, Equals, uint64(3))
+
+	dnsKey := AuditKey{RemoteIdentity: 12345, DstPort: 53, Proto: 17, Ingress: true}
+	entry, ok = collector.entries[dnsKey]
+	c.Assert(ok, Equals, true)
+	c.Assert(entry.Count, Equals, uint64(1))
+
+	// The report file contains both tuples.
+	data, err := ioutil.ReadFile(filepath.Join(stateDir, AuditReportFile))
+	c.Assert(err, IsNil)
+	var report []*AuditEntry
+	c.Assert(json.Unmarshal(data, &report), IsNil)
+	c.Assert(report, HasLen, 2)
+}
+
+func (s *AuditSuite) TestAuditCollectorIgnoresOtherEvents(c *C) {
+	stateDir, err := ioutil.TempDir("", "cilium-audit-test")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(stateDir)
+
+	collector := NewAuditCollector(stateDir, func() uint16 {
+		return testHostEndpointID
+	})
+
+	// Too short and non-verdict payloads are ignored.
+	collector.NotifyPerfEvent(nil, 0)
+	collector.NotifyPerfEvent([]byte{byte(monitorAPI.MessageTypeDrop)}, 0)
+	collector.NotifyPerfEvent(make([]byte, monitor.PolicyVerdictNotifyLen), 0)
+
+	c.Assert(collector.entries, HasLen, 0)
+	_, err = os.Stat(filepath.Join(stateDir, AuditReportFile))
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
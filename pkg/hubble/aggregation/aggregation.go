@@ -0,0 +1,271 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aggregation implements connection-level aggregation of Hubble
+// flows. Long-lived connections produce a trace event for every observed
+// packet direction change; once a connection has produced more than a
+// configurable number of events, further per-packet events are suppressed
+// and replaced by periodic summary flows.
+package aggregation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	observerTypes "github.com/cilium/cilium/pkg/hubble/observer/types"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/monitor"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+)
+
+const (
+	// maxConnections bounds the number of connections tracked by the
+	// aggregator. Connections beyond the bound are not aggregated.
+	maxConnections = 65536
+
+	// staleConnectionFactor is the multiple of the summary interval after
+	// which an idle connection is evicted from the aggregator.
+	staleConnectionFactor = 4
+)
+
+// Config configures the flow aggregator.
+type Config struct {
+	// Threshold is the number of events a connection may produce before
+	// its per-packet trace events are aggregated into summaries.
+	Threshold uint64
+
+	// Interval is the interval at which a summary flow is emitted for an
+	// aggregated connection.
+	Interval time.Duration
+
+	// Verdicts is the set of flow verdicts subject to aggregation. Flows
+	// with the DROPPED or ERROR verdict are never aggregated, regardless
+	// of this setting.
+	Verdicts []flowpb.Verdict
+}
+
+// connKey identifies the connection a trace flow belongs to.
+type connKey struct {
+	srcIP, dstIP     string
+	srcPort, dstPort uint32
+	protocol         uint8
+	verdict          flowpb.Verdict
+}
+
+const (
+	protoTCP = iota + 1
+	protoUDP
+	protoICMP
+)
+
+// connStats is the aggregation state of a single connection.
+type connStats struct {
+	// events is the total number of events observed for the connection
+	events uint64
+
+	// suppressed is the number of events suppressed since the last
+	// summary was emitted
+	suppressed uint64
+
+	// packets is the number of packets observed since the last summary
+	// was emitted
+	packets uint64
+
+	// bytes is the number of bytes observed since the last summary was
+	// emitted
+	bytes uint64
+
+	// lastEmit is the time the last summary was emitted, initialized to
+	// the time the threshold was crossed
+	lastEmit time.Time
+
+	// lastSeen is the time the last event was observed
+	lastSeen time.Time
+}
+
+// Aggregator suppresses per-packet trace flows of long-lived connections and
+// emits periodic summary flows instead. It implements
+// observeroption.OnMonitorEvent and observeroption.OnDecodedFlow.
+type Aggregator struct {
+	mutex    lock.Mutex
+	conf     Config
+	verdicts map[flowpb.Verdict]bool
+	conns    map[connKey]*connStats
+	// pendingBytes is the original packet length of the trace event
+	// currently being decoded, recorded by OnMonitorEvent and consumed by
+	// the OnDecodedFlow invocation for the same event
+	pendingBytes uint64
+	// now returns the current time, overridable for testing
+	now func() time.Time
+}
+
+// NewAggregator returns a new flow aggregator for the given configuration.
+func NewAggregator(conf Config) *Aggregator {
+	verdicts := make(map[flowpb.Verdict]bool, len(conf.Verdicts))
+	for _, v := range conf.Verdicts {
+		// Drops carry distinct diagnostic value, never aggregate them.
+		if v == flowpb.Verdict_DROPPED || v == flowpb.Verdict_ERROR {
+			continue
+		}
+		verdicts[v] = true
+	}
+
+	return &Aggregator{
+		conf:     conf,
+		verdicts: verdicts,
+		conns:    map[connKey]*connStats{},
+		now:      time.Now,
+	}
+}
+
+// key derives the connection key of a flow. Returns false if the flow cannot
+// be attributed to a connection.
+func key(flow *flowpb.Flow) (connKey, bool) {
+	ip := flow.GetIP()
+	l4 := flow.GetL4()
+	if ip == nil || l4 == nil {
+		return connKey{}, false
+	}
+
+	k := connKey{
+		srcIP:   ip.GetSource(),
+		dstIP:   ip.GetDestination(),
+		verdict: flow.GetVerdict(),
+	}
+
+	switch l4 := l4.GetProtocol().(type) {
+	case *flowpb.Layer4_TCP:
+		k.protocol = protoTCP
+		k.srcPort = l4.TCP.GetSourcePort()
+		k.dstPort = l4.TCP.GetDestinationPort()
+	case *flowpb.Layer4_UDP:
+		k.protocol = protoUDP
+		k.srcPort = l4.UDP.GetSourcePort()
+		k.dstPort = l4.UDP.GetDestinationPort()
+	case *flowpb.Layer4_ICMPv4, *flowpb.Layer4_ICMPv6:
+		k.protocol = protoICMP
+	default:
+		return connKey{}, false
+	}
+
+	return k, true
+}
+
+// OnMonitorEvent records the original packet length of trace events before
+// they are decoded, as the decoded flow itself does not carry packet sizes.
+// The observer invokes OnMonitorEvent and OnDecodedFlow for the same event
+// sequentially from a single goroutine.
+func (a *Aggregator) OnMonitorEvent(_ context.Context, event *observerTypes.MonitorEvent) (bool, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.pendingBytes = 0
+
+	pe, ok := event.Payload.(*observerTypes.PerfEvent)
+	if !ok || len(pe.Data) == 0 || pe.Data[0] != monitorAPI.MessageTypeTrace {
+		return false, nil
+	}
+
+	tn := &monitor.TraceNotify{}
+	if err := monitor.DecodeTraceNotify(pe.Data, tn); err != nil {
+		return false, nil
+	}
+	a.pendingBytes = uint64(tn.OrigLen)
+
+	return false, nil
+}
+
+// OnDecodedFlow suppresses the flow if its connection is being aggregated and
+// no summary is due. When a summary is due, the flow passes through annotated
+// with the number of flows it summarizes and the packet and byte deltas since
+// the previous summary.
+func (a *Aggregator) OnDecodedFlow(_ context.Context, flow *flowpb.Flow) (bool, error) {
+	// Only aggregate per-packet trace events of configured verdicts.
+	// Drops, policy verdicts, L7 and agent events always pass through.
+	if flow.GetEventType().GetType() != monitorAPI.MessageTypeTrace ||
+		!a.verdicts[flow.GetVerdict()] {
+		return false, nil
+	}
+
+	k, ok := key(flow)
+	if !ok {
+		return false, nil
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	now := a.now()
+
+	stats, tracked := a.conns[k]
+	if !tracked {
+		if len(a.conns) >= maxConnections {
+			a.evictStale(now)
+		}
+		if len(a.conns) >= maxConnections {
+			// Do not aggregate beyond the bound.
+			return false, nil
+		}
+		stats = &connStats{}
+		a.conns[k] = stats
+	}
+
+	stats.events++
+	stats.lastSeen = now
+
+	if stats.events <= a.conf.Threshold {
+		return false, nil
+	}
+
+	if stats.events == a.conf.Threshold+1 {
+		// Threshold crossed, start a fresh summary interval.
+		stats.lastEmit = now
+	}
+
+	stats.packets++
+	stats.bytes += a.pendingBytes
+
+	if now.Sub(stats.lastEmit) < a.conf.Interval {
+		stats.suppressed++
+		return true, nil
+	}
+
+	// A summary is due: let this flow through, annotated with the flows
+	// it stands for and the packet and byte deltas since the previous
+	// summary.
+	flow.AggregatedFlowCount = stats.suppressed + 1
+	flow.AggregatedPackets = stats.packets
+	flow.AggregatedBytes = stats.bytes
+	flow.Summary = fmt.Sprintf("[aggregated, %d flows in last %s] %s",
+		stats.suppressed+1, now.Sub(stats.lastEmit).Round(time.Second), flow.GetSummary())
+	stats.suppressed = 0
+	stats.packets = 0
+	stats.bytes = 0
+	stats.lastEmit = now
+
+	return false, nil
+}
+
+// evictStale removes connections which have been idle for several summary
+// intervals. Must be called with a.mutex held.
+func (a *Aggregator) evictStale(now time.Time) {
+	deadline := now.Add(-staleConnectionFactor * a.conf.Interval)
+	for k, stats := range a.conns {
+		if stats.lastSeen.Before(deadline) {
+			delete(a.conns, k)
+		}
+	}
+}
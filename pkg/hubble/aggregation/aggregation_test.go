@@ -0,0 +1,201 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package aggregation
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/pkg/byteorder"
+	observerTypes "github.com/cilium/cilium/pkg/hubble/observer/types"
+	"github.com/cilium/cilium/pkg/monitor"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+)
+
+func traceFlow(verdict flowpb.Verdict) *flowpb.Flow {
+	return &flowpb.Flow{
+		Verdict:   verdict,
+		EventType: &flowpb.CiliumEventType{Type: monitorAPI.MessageTypeTrace},
+		IP: &flowpb.IP{
+			Source:      "1.1.1.1",
+			Destination: "2.2.2.2",
+		},
+		L4: &flowpb.Layer4{
+			Protocol: &flowpb.Layer4_TCP{
+				TCP: &flowpb.TCP{
+					SourcePort:      32678,
+					DestinationPort: 80,
+				},
+			},
+		},
+		Summary: "TCP Flags: ACK",
+	}
+}
+
+func traceEvent(t *testing.T, origLen uint32) *observerTypes.MonitorEvent {
+	tn := monitor.TraceNotifyV0{
+		Type:    monitorAPI.MessageTypeTrace,
+		OrigLen: origLen,
+		Version: monitor.TraceNotifyVersion0,
+	}
+	buf := &bytes.Buffer{}
+	require.NoError(t, binary.Write(buf, byteorder.Native, tn))
+	return &observerTypes.MonitorEvent{
+		Payload: &observerTypes.PerfEvent{Data: buf.Bytes()},
+	}
+}
+
+func TestAggregatorBelowThreshold(t *testing.T) {
+	a := NewAggregator(Config{
+		Threshold: 5,
+		Interval:  30 * time.Second,
+		Verdicts:  []flowpb.Verdict{flowpb.Verdict_FORWARDED},
+	})
+
+	for i := 0; i < 5; i++ {
+		stop, err := a.OnDecodedFlow(context.Background(), traceFlow(flowpb.Verdict_FORWARDED))
+		require.NoError(t, err)
+		assert.False(t, stop)
+	}
+}
+
+func TestAggregatorSuppressesAndSummarizes(t *testing.T) {
+	now := time.Now()
+	a := NewAggregator(Config{
+		Threshold: 1,
+		Interval:  30 * time.Second,
+		Verdicts:  []flowpb.Verdict{flowpb.Verdict_FORWARDED},
+	})
+	a.now = func() time.Time { return now }
+
+	// First event is below the threshold and passes through unannotated.
+	_, err := a.OnMonitorEvent(context.Background(), traceEvent(t, 100))
+	require.NoError(t, err)
+	flow := traceFlow(flowpb.Verdict_FORWARDED)
+	stop, err := a.OnDecodedFlow(context.Background(), flow)
+	require.NoError(t, err)
+	assert.False(t, stop)
+	assert.Zero(t, flow.GetAggregatedFlowCount())
+
+	// Subsequent events within the interval are suppressed.
+	for i := 0; i < 3; i++ {
+		now = now.Add(time.Second)
+		_, err = a.OnMonitorEvent(context.Background(), traceEvent(t, 100))
+		require.NoError(t, err)
+		stop, err = a.OnDecodedFlow(context.Background(), traceFlow(flowpb.Verdict_FORWARDED))
+		require.NoError(t, err)
+		assert.True(t, stop)
+	}
+
+	// Once the interval has elapsed, a summary flow is emitted carrying the
+	// flow count and packet and byte deltas since the threshold was crossed.
+	now = now.Add(30 * time.Second)
+	_, err = a.OnMonitorEvent(context.Background(), traceEvent(t, 100))
+	require.NoError(t, err)
+	flow = traceFlow(flowpb.Verdict_FORWARDED)
+	stop, err = a.OnDecodedFlow(context.Background(), flow)
+	require.NoError(t, err)
+	assert.False(t, stop)
+	assert.Contains(t, flow.GetSummary(), "[aggregated, 4 flows in last")
+	assert.Contains(t, flow.GetSummary(), "TCP Flags: ACK")
+	assert.Equal(t, uint64(4), flow.GetAggregatedFlowCount())
+	assert.Equal(t, uint64(4), flow.GetAggregatedPackets())
+	assert.Equal(t, uint64(400), flow.GetAggregatedBytes())
+
+	// The summary resets the counters, the next event is suppressed again.
+	now = now.Add(time.Second)
+	stop, err = a.OnDecodedFlow(context.Background(), traceFlow(flowpb.Verdict_FORWARDED))
+	require.NoError(t, err)
+	assert.True(t, stop)
+}
+
+func TestAggregatorIgnoresNonTracePayloads(t *testing.T) {
+	a := NewAggregator(Config{
+		Threshold: 1,
+		Interval:  30 * time.Second,
+		Verdicts:  []flowpb.Verdict{flowpb.Verdict_FORWARDED},
+	})
+
+	event := traceEvent(t, 100)
+	event.Payload.(*observerTypes.PerfEvent).Data[0] = monitorAPI.MessageTypeDrop
+	_, err := a.OnMonitorEvent(context.Background(), event)
+	require.NoError(t, err)
+	assert.Zero(t, a.pendingBytes)
+
+	_, err = a.OnMonitorEvent(context.Background(), &observerTypes.MonitorEvent{
+		Payload: &observerTypes.AgentEvent{},
+	})
+	require.NoError(t, err)
+	assert.Zero(t, a.pendingBytes)
+}
+
+func TestAggregatorNeverAggregatesDrops(t *testing.T) {
+	a := NewAggregator(Config{
+		Threshold: 1,
+		Interval:  30 * time.Second,
+		Verdicts:  []flowpb.Verdict{flowpb.Verdict_FORWARDED, flowpb.Verdict_DROPPED, flowpb.Verdict_ERROR},
+	})
+
+	for i := 0; i < 10; i++ {
+		stop, err := a.OnDecodedFlow(context.Background(), traceFlow(flowpb.Verdict_DROPPED))
+		require.NoError(t, err)
+		assert.False(t, stop)
+	}
+}
+
+func TestAggregatorIgnoresNonTraceEvents(t *testing.T) {
+	a := NewAggregator(Config{
+		Threshold: 1,
+		Interval:  30 * time.Second,
+		Verdicts:  []flowpb.Verdict{flowpb.Verdict_FORWARDED},
+	})
+
+	for i := 0; i < 10; i++ {
+		flow := traceFlow(flowpb.Verdict_FORWARDED)
+		flow.EventType.Type = monitorAPI.MessageTypePolicyVerdict
+		stop, err := a.OnDecodedFlow(context.Background(), flow)
+		require.NoError(t, err)
+		assert.False(t, stop)
+	}
+}
+
+func TestAggregatorEvictsStaleConnections(t *testing.T) {
+	now := time.Now()
+	a := NewAggregator(Config{
+		Threshold: 1,
+		Interval:  30 * time.Second,
+		Verdicts:  []flowpb.Verdict{flowpb.Verdict_FORWARDED},
+	})
+	a.now = func() time.Time { return now }
+
+	_, err := a.OnDecodedFlow(context.Background(), traceFlow(flowpb.Verdict_FORWARDED))
+	require.NoError(t, err)
+	require.Len(t, a.conns, 1)
+
+	now = now.Add(staleConnectionFactor*30*time.Second + time.Second)
+	a.mutex.Lock()
+	a.evictStale(now)
+	a.mutex.Unlock()
+	assert.Len(t, a.conns, 0)
+}
@@ -0,0 +1,274 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/cilium/cilium/pkg/hubble/exporter/exporteroption"
+	"github.com/cilium/cilium/pkg/lock"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// DynamicExportersConfig is the configuration of the dynamic Hubble exporter.
+// It is read from a file which may be updated at runtime.
+type DynamicExportersConfig struct {
+	Exporters []ExporterConfig `json:"exporters" yaml:"exporters"`
+}
+
+// ExporterConfig is the configuration of a single exporter managed by the
+// dynamic Hubble exporter.
+type ExporterConfig struct {
+	// Name identifies the exporter within the configuration file.
+	Name string `json:"name" yaml:"name"`
+	// FilePath is the filepath of the file the exporter writes to.
+	FilePath string `json:"filePath" yaml:"filePath"`
+	// FieldMask is the list of flow fields to include in the export. An
+	// empty list exports complete flows.
+	FieldMask []string `json:"fieldMask" yaml:"fieldMask"`
+	// MaxSizeMB is the size in MB at which to rotate the file. If zero,
+	// the default of the static Hubble exporter applies.
+	MaxSizeMB int `json:"maxSizeMB" yaml:"maxSizeMB"`
+	// MaxBackups is the number of rotated files to keep. If zero, the
+	// default of the static Hubble exporter applies.
+	MaxBackups int `json:"maxBackups" yaml:"maxBackups"`
+	// Compress specifies whether rotated files are gzip compressed.
+	Compress bool `json:"compress" yaml:"compress"`
+	// OTLP exports flows as OTLP log records instead of writing them to a
+	// file. It is mutually exclusive with FilePath.
+	OTLP *OTLPConfig `json:"otlp" yaml:"otlp"`
+}
+
+// flowLogExporter is the interface implemented by the file and OTLP flow
+// exporters.
+type flowLogExporter interface {
+	OnDecodedEvent(ctx context.Context, ev *v1.Event) (bool, error)
+	Stop() error
+}
+
+// DynamicExporter is an implementation of the OnDecodedEvent interface that
+// fans out Hubble events to a set of exporters described by a configuration
+// file. The configuration is reloaded when the file changes, without events
+// being dropped: events observed during a reload are written by either the
+// old or the new exporter instance.
+type DynamicExporter struct {
+	logger     logrus.FieldLogger
+	configPath string
+
+	mutex     lock.RWMutex
+	exporters map[string]flowLogExporter
+	configs   map[string]ExporterConfig
+}
+
+// NewDynamicExporter initializes a dynamic exporter from the configuration
+// file at configPath.
+func NewDynamicExporter(logger logrus.FieldLogger, configPath string) (*DynamicExporter, error) {
+	d := &DynamicExporter{
+		logger:     logger,
+		configPath: configPath,
+		exporters:  map[string]flowLogExporter{},
+		configs:    map[string]ExporterConfig{},
+	}
+	if err := d.Reload(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// OnDecodedEvent distributes events across all configured exporters.
+func (d *DynamicExporter) OnDecodedEvent(ctx context.Context, ev *v1.Event) (bool, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	var errs error
+	for _, e := range d.exporters {
+		if _, err := e.OnDecodedEvent(ctx, ev); err != nil && errs == nil {
+			errs = err
+		}
+	}
+	return false, errs
+}
+
+// Reload re-reads the configuration file and reconfigures the exporters
+// accordingly. Exporters whose configuration is unchanged are left untouched,
+// all others are replaced and their old export files closed. If the new
+// configuration is invalid, the current exporters remain in place.
+func (d *DynamicExporter) Reload() error {
+	config, err := d.readConfig()
+	if err != nil {
+		return err
+	}
+
+	// Validate all exporter options upfront so that an invalid
+	// configuration is rejected as a whole before any exporter is swapped.
+	newConfigs := make(map[string]ExporterConfig, len(config.Exporters))
+	for _, c := range config.Exporters {
+		if c.Name == "" {
+			return fmt.Errorf("exporter in %s has no name", d.configPath)
+		}
+		switch {
+		case c.FilePath == "" && c.OTLP == nil:
+			return fmt.Errorf("exporter %q has neither filePath nor otlp", c.Name)
+		case c.FilePath != "" && c.OTLP != nil:
+			return fmt.Errorf("exporter %q has both filePath and otlp", c.Name)
+		case c.OTLP != nil:
+			if _, err := c.OTLP.validate(); err != nil {
+				return fmt.Errorf("exporter %q: %w", c.Name, err)
+			}
+		}
+		if _, ok := newConfigs[c.Name]; ok {
+			return fmt.Errorf("duplicate exporter name %q", c.Name)
+		}
+		var opts exporteroption.Options
+		if err := exporteroption.WithFieldMask(c.FieldMask)(&opts); err != nil {
+			return fmt.Errorf("exporter %q: %w", c.Name, err)
+		}
+		newConfigs[c.Name] = c
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	var stale []flowLogExporter
+	for name, c := range newConfigs {
+		if old, ok := d.configs[name]; ok && reflect.DeepEqual(old, c) {
+			continue
+		}
+		var e flowLogExporter
+		var err error
+		if c.OTLP != nil {
+			e, err = NewOTLPExporter(d.logger.WithField("exporter", name), *c.OTLP, c.FieldMask)
+		} else {
+			e, err = NewExporter(d.logger.WithField("exporter", name), c.exporterOptions()...)
+		}
+		if err != nil {
+			// Cannot happen, the options were validated above.
+			return fmt.Errorf("exporter %q: %w", name, err)
+		}
+		if old, ok := d.exporters[name]; ok {
+			stale = append(stale, old)
+		}
+		d.exporters[name] = e
+		d.configs[name] = c
+	}
+	for name := range d.configs {
+		if _, ok := newConfigs[name]; !ok {
+			stale = append(stale, d.exporters[name])
+			delete(d.exporters, name)
+			delete(d.configs, name)
+		}
+	}
+
+	for _, e := range stale {
+		if err := e.Stop(); err != nil {
+			d.logger.WithError(err).Warn("Failed to close stale Hubble export file")
+		}
+	}
+	return nil
+}
+
+// Stop stops all configured exporters, flushing any buffered data. The
+// dynamic exporter cannot be reused afterwards.
+func (d *DynamicExporter) Stop() error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	var lastErr error
+	for name, e := range d.exporters {
+		if err := e.Stop(); err != nil {
+			d.logger.WithError(err).WithField("exporter", name).
+				Warn("Failed to stop Hubble exporter")
+			lastErr = err
+		}
+		delete(d.exporters, name)
+		delete(d.configs, name)
+	}
+	return lastErr
+}
+
+// Watch reloads the exporter configuration whenever the configuration file
+// changes. It blocks until ctx is cancelled.
+func (d *DynamicExporter) Watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create fsnotify watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// Watch the directory of the config file rather than the file itself,
+	// configuration files are commonly replaced via rename which would
+	// invalidate a watch on the file.
+	configDir := filepath.Dir(d.configPath)
+	if err := watcher.Add(configDir); err != nil {
+		return fmt.Errorf("failed to add %q dir to fsnotify watcher: %w", configDir, err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-watcher.Events:
+			if event.Name != d.configPath {
+				continue
+			}
+			if event.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Chmod|fsnotify.Rename) == 0 {
+				continue
+			}
+			if err := d.Reload(); err != nil {
+				d.logger.WithError(err).Warn("Failed to reload Hubble exporter configuration")
+			}
+		case err := <-watcher.Errors:
+			d.logger.WithError(err).Warn("Hubble exporter configuration watcher error")
+		}
+	}
+}
+
+func (d *DynamicExporter) readConfig() (*DynamicExportersConfig, error) {
+	raw, err := ioutil.ReadFile(d.configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", d.configPath, err)
+	}
+	config := &DynamicExportersConfig{}
+	if err := yaml.Unmarshal(raw, config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", d.configPath, err)
+	}
+	return config, nil
+}
+
+// exporterOptions translates the exporter configuration into the options of
+// the static Hubble exporter. Zero values fall back to the exporter defaults.
+func (c ExporterConfig) exporterOptions() []exporteroption.Option {
+	opts := []exporteroption.Option{
+		exporteroption.WithPath(c.FilePath),
+		exporteroption.WithFieldMask(c.FieldMask),
+	}
+	if c.MaxSizeMB > 0 {
+		opts = append(opts, exporteroption.WithMaxSizeMB(c.MaxSizeMB))
+	}
+	if c.MaxBackups > 0 {
+		opts = append(opts, exporteroption.WithMaxBackups(c.MaxBackups))
+	}
+	if c.Compress {
+		opts = append(opts, exporteroption.WithCompress())
+	}
+	return opts
+}
@@ -0,0 +1,132 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package exporter
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	observerpb "github.com/cilium/cilium/api/v1/observer"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicExporterReload(t *testing.T) {
+	tmp := t.TempDir()
+	configPath := filepath.Join(tmp, "export.yaml")
+	exportPath := filepath.Join(tmp, "events.log")
+	writeConfig := func(fieldMask string) {
+		config := `exporters:
+- name: test
+  filePath: ` + exportPath + `
+  fieldMask: ` + fieldMask + `
+`
+		require.NoError(t, ioutil.WriteFile(configPath, []byte(config), 0644))
+	}
+	writeConfig("[time, verdict]")
+
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	d, err := NewDynamicExporter(log, configPath)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	newEvent := func(sec int64) *v1.Event {
+		return &v1.Event{
+			Event: &observerpb.Flow{
+				Time:    &timestamp.Timestamp{Seconds: sec},
+				Verdict: flowpb.Verdict_FORWARDED,
+				Source:  &flowpb.Endpoint{Labels: []string{"src"}},
+			},
+		}
+	}
+	_, err = d.OnDecodedEvent(ctx, newEvent(1))
+	assert.NoError(t, err)
+
+	// Switch the field mask at runtime.
+	writeConfig("[time, source]")
+	require.NoError(t, d.Reload())
+	_, err = d.OnDecodedEvent(ctx, newEvent(2))
+	assert.NoError(t, err)
+
+	// An invalid configuration must leave the current exporters in place.
+	writeConfig("[no_such_field]")
+	assert.Error(t, d.Reload())
+	_, err = d.OnDecodedEvent(ctx, newEvent(3))
+	assert.NoError(t, err)
+
+	raw, err := ioutil.ReadFile(exportPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	// No flow may be lost across the reloads.
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[0], `"verdict"`)
+	assert.NotContains(t, lines[0], `"source"`)
+	for _, line := range lines[1:] {
+		assert.NotContains(t, line, `"verdict"`)
+		assert.Contains(t, line, `"source"`)
+	}
+}
+
+func TestDynamicExporterAddRemove(t *testing.T) {
+	tmp := t.TempDir()
+	configPath := filepath.Join(tmp, "export.yaml")
+	firstPath := filepath.Join(tmp, "first.log")
+	secondPath := filepath.Join(tmp, "second.log")
+	writeConfig := func(config string) {
+		require.NoError(t, ioutil.WriteFile(configPath, []byte(config), 0644))
+	}
+	writeConfig(`exporters:
+- name: first
+  filePath: ` + firstPath + `
+`)
+
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	d, err := NewDynamicExporter(log, configPath)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ev := &v1.Event{Event: &observerpb.Flow{Time: &timestamp.Timestamp{Seconds: 1}}}
+	_, err = d.OnDecodedEvent(ctx, ev)
+	assert.NoError(t, err)
+
+	// Replace the first exporter with a second one.
+	writeConfig(`exporters:
+- name: second
+  filePath: ` + secondPath + `
+`)
+	require.NoError(t, d.Reload())
+	_, err = d.OnDecodedEvent(ctx, ev)
+	assert.NoError(t, err)
+
+	first, err := ioutil.ReadFile(firstPath)
+	require.NoError(t, err)
+	second, err := ioutil.ReadFile(secondPath)
+	require.NoError(t, err)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(first)), "\n"), 1)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(second)), "\n"), 1)
+}
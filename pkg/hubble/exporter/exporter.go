@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 
 	flowpb "github.com/cilium/cilium/api/v1/flow"
 	observerpb "github.com/cilium/cilium/api/v1/observer"
@@ -26,13 +27,17 @@ import (
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 // exporter is an implementation of OnDecodedEvent interface that writes Hubble events to a file.
 type exporter struct {
-	logger  logrus.FieldLogger
-	encoder *json.Encoder
+	logger    logrus.FieldLogger
+	encoder   *json.Encoder
+	writer    io.Closer
+	fieldMask map[string]struct{}
 }
 
 // NewExporter initializes an exporter.
@@ -46,13 +51,16 @@ func NewExporter(
 		}
 	}
 	logger.WithField("options", opts).Info("Configuring Hubble event exporter")
-	encoder := json.NewEncoder(&lumberjack.Logger{
+	writer := &lumberjack.Logger{
 		Filename:   opts.Path,
 		MaxSize:    opts.MaxSizeMB,
 		MaxBackups: opts.MaxBackups,
 		Compress:   opts.Compress,
-	})
-	return newExporter(logger, encoder), nil
+	}
+	e := newExporter(logger, json.NewEncoder(writer))
+	e.writer = writer
+	e.fieldMask = fieldMaskSet(opts.FieldMask)
+	return e, nil
 }
 
 func newExporter(logger logrus.FieldLogger, encoder *json.Encoder) *exporter {
@@ -62,6 +70,19 @@ func newExporter(logger logrus.FieldLogger, encoder *json.Encoder) *exporter {
 	}
 }
 
+// fieldMaskSet converts a list of flow field names into a set for fast
+// lookups. It returns nil for an empty list, which disables masking.
+func fieldMaskSet(fields []string) map[string]struct{} {
+	if len(fields) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		set[field] = struct{}{}
+	}
+	return set
+}
+
 // eventToExportEvent converts Event to ExportEvent.
 func eventToExportEvent(e *v1.Event) *observerpb.ExportEvent {
 	switch ev := e.Event.(type) {
@@ -102,11 +123,39 @@ func eventToExportEvent(e *v1.Event) *observerpb.ExportEvent {
 	}
 }
 
+// maskFlow returns a copy of the flow with all fields cleared that are not
+// part of the field mask. The flow itself is left untouched as it is shared
+// with the other consumers of the event.
+func maskFlow(fieldMask map[string]struct{}, flow *flowpb.Flow) *flowpb.Flow {
+	masked := proto.Clone(flow).(*flowpb.Flow)
+	msg := masked.ProtoReflect()
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if _, ok := fieldMask[string(fd.Name())]; !ok {
+			msg.Clear(fd)
+		}
+		return true
+	})
+	return masked
+}
+
 // Start calls GetFlows and writes responses to a file.
 func (e *exporter) OnDecodedEvent(_ context.Context, ev *v1.Event) (bool, error) {
 	res := eventToExportEvent(ev)
 	if res == nil {
 		return false, nil
 	}
+	if len(e.fieldMask) > 0 {
+		if f, ok := res.ResponseTypes.(*observerpb.ExportEvent_Flow); ok {
+			res.ResponseTypes = &observerpb.ExportEvent_Flow{Flow: maskFlow(e.fieldMask, f.Flow)}
+		}
+	}
 	return false, e.encoder.Encode(res)
 }
+
+// Stop closes the underlying writer of the exporter, if any.
+func (e *exporter) Stop() error {
+	if e.writer == nil {
+		return nil
+	}
+	return e.writer.Close()
+}
@@ -71,6 +71,29 @@ func TestExporter(t *testing.T) {
 `, buf.String())
 }
 
+func TestExporterWithFieldMask(t *testing.T) {
+	flow := &observerpb.Flow{
+		NodeName: "my-node",
+		Time:     &timestamp.Timestamp{Seconds: 1},
+		Verdict:  flowpb.Verdict_FORWARDED,
+		Source:   &flowpb.Endpoint{Labels: []string{"src"}},
+	}
+	var buf bytes.Buffer
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	exporter := newExporter(log, json.NewEncoder(&buf))
+	exporter.fieldMask = fieldMaskSet([]string{"time", "verdict"})
+	stop, err := exporter.OnDecodedEvent(context.Background(), &v1.Event{Event: flow})
+	assert.False(t, stop)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"flow":{"time":"1970-01-01T00:00:01Z","verdict":"FORWARDED"},"node_name":"my-node","time":"1970-01-01T00:00:01Z"}
+`, buf.String())
+	// the original flow must be left untouched, it is shared with the other
+	// consumers of the event.
+	assert.Equal(t, "my-node", flow.NodeName)
+	assert.Equal(t, []string{"src"}, flow.GetSource().GetLabels())
+}
+
 func TestEventToExportEvent(t *testing.T) {
 	// override node name for unit test.
 	nodeName := nodeTypes.GetName()
@@ -14,12 +14,21 @@
 
 package exporteroption
 
+import (
+	"fmt"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
 // Options stores all the configurations values for Hubble exporter.
 type Options struct {
 	Path       string
 	MaxSizeMB  int
 	MaxBackups int
 	Compress   bool
+	FieldMask  []string
 }
 
 // Option customizes the configuration of the hubble server.
@@ -57,3 +66,19 @@ func WithCompress() Option {
 		return nil
 	}
 }
+
+// WithFieldMask sets the list of flow fields to include in the export. Flow
+// fields that are not part of the mask are cleared before a flow is encoded.
+// An empty mask exports complete flows.
+func WithFieldMask(fields []string) Option {
+	return func(o *Options) error {
+		flowFields := (&flowpb.Flow{}).ProtoReflect().Descriptor().Fields()
+		for _, field := range fields {
+			if flowFields.ByName(protoreflect.Name(field)) == nil {
+				return fmt.Errorf("unknown flow field %q in field mask", field)
+			}
+		}
+		o.FieldMask = fields
+		return nil
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package exporteroption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithFieldMask(t *testing.T) {
+	var opts Options
+	assert.NoError(t, WithFieldMask([]string{"time", "verdict", "source"})(&opts))
+	assert.Equal(t, []string{"time", "verdict", "source"}, opts.FieldMask)
+	assert.Error(t, WithFieldMask([]string{"no_such_field"})(&Options{}))
+}
@@ -0,0 +1,409 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/cilium/cilium/pkg/inctimer"
+	nodeTypes "github.com/cilium/cilium/pkg/node/types"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// OTLPProtocolGRPC exports log records over OTLP/gRPC.
+	OTLPProtocolGRPC = "grpc"
+	// OTLPProtocolHTTP exports log records over OTLP/HTTP.
+	OTLPProtocolHTTP = "http"
+
+	// otlpLogsExportMethod is the gRPC method of the OTLP logs service.
+	otlpLogsExportMethod = "/opentelemetry.proto.collector.logs.v1.LogsService/Export"
+	// otlpLogsExportPath is the HTTP path of the OTLP logs service.
+	otlpLogsExportPath = "/v1/logs"
+
+	defaultOTLPBatchSize     = 128
+	defaultOTLPQueueSize     = 1024
+	defaultOTLPFlushInterval = 5 * time.Second
+	otlpExportTimeout        = 10 * time.Second
+)
+
+// OTLPConfig is the configuration of an exporter that sends flows to an
+// OpenTelemetry collector as OTLP log records.
+type OTLPConfig struct {
+	// Endpoint is the address of the OTLP receiver, e.g. "collector:4317"
+	// for gRPC or "http://collector:4318" for HTTP.
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	// Protocol selects the transport, either "grpc" (default) or "http".
+	Protocol string `json:"protocol" yaml:"protocol"`
+	// ClusterName is exported as the "k8s.cluster.name" resource attribute.
+	ClusterName string `json:"clusterName" yaml:"clusterName"`
+	// BatchSize is the maximum number of log records per export request.
+	BatchSize int `json:"batchSize" yaml:"batchSize"`
+	// QueueSize bounds the number of flows buffered for export. Flows
+	// received while the queue is full are dropped and counted.
+	QueueSize int `json:"queueSize" yaml:"queueSize"`
+	// FlushInterval is the duration after which a partial batch is
+	// exported, e.g. "5s".
+	FlushInterval string `json:"flushInterval" yaml:"flushInterval"`
+}
+
+// validate checks the configuration values and returns the flush interval in
+// parsed form.
+func (c OTLPConfig) validate() (time.Duration, error) {
+	if c.Endpoint == "" {
+		return 0, fmt.Errorf("OTLP exporter has no endpoint")
+	}
+	switch c.Protocol {
+	case "", OTLPProtocolGRPC, OTLPProtocolHTTP:
+	default:
+		return 0, fmt.Errorf("unknown OTLP protocol %q", c.Protocol)
+	}
+	if c.FlushInterval == "" {
+		return defaultOTLPFlushInterval, nil
+	}
+	flushInterval, err := time.ParseDuration(c.FlushInterval)
+	if err != nil || flushInterval <= 0 {
+		return 0, fmt.Errorf("invalid OTLP flush interval %q", c.FlushInterval)
+	}
+	return flushInterval, nil
+}
+
+// otlpTransport abstracts the OTLP/gRPC and OTLP/HTTP transports. The payload
+// is a serialized ExportLogsServiceRequest message.
+type otlpTransport interface {
+	send(ctx context.Context, payload []byte) error
+	close() error
+}
+
+// OTLPExporter is an implementation of the OnDecodedEvent interface that
+// sends Hubble flows to an OpenTelemetry collector as OTLP log records.
+type OTLPExporter struct {
+	logger        logrus.FieldLogger
+	transport     otlpTransport
+	fieldMask     map[string]struct{}
+	resource      []byte
+	batchSize     int
+	flushInterval time.Duration
+
+	queue   chan *flowpb.Flow
+	dropped uint64
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewOTLPExporter initializes an OTLP exporter and starts its export loop.
+func NewOTLPExporter(logger logrus.FieldLogger, config OTLPConfig, fieldMask []string) (*OTLPExporter, error) {
+	if _, err := config.validate(); err != nil {
+		return nil, err
+	}
+	var transport otlpTransport
+	switch config.Protocol {
+	case "", OTLPProtocolGRPC:
+		conn, err := grpc.Dial(config.Endpoint, grpc.WithInsecure())
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial OTLP endpoint %q: %w", config.Endpoint, err)
+		}
+		transport = &otlpGRPCTransport{conn: conn}
+	case OTLPProtocolHTTP:
+		transport = &otlpHTTPTransport{
+			client: &http.Client{Timeout: otlpExportTimeout},
+			url:    config.Endpoint + otlpLogsExportPath,
+		}
+	}
+	logger.WithField("config", config).Info("Configuring Hubble OTLP exporter")
+	e := newOTLPExporter(logger, transport, config, fieldMask)
+	go e.run()
+	return e, nil
+}
+
+// newOTLPExporter creates an OTLP exporter without starting its export loop.
+func newOTLPExporter(logger logrus.FieldLogger, transport otlpTransport, config OTLPConfig, fieldMask []string) *OTLPExporter {
+	flushInterval, err := config.validate()
+	if err != nil {
+		flushInterval = defaultOTLPFlushInterval
+	}
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultOTLPBatchSize
+	}
+	queueSize := config.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultOTLPQueueSize
+	}
+	return &OTLPExporter{
+		logger:        logger,
+		transport:     transport,
+		fieldMask:     fieldMaskSet(fieldMask),
+		resource:      encodeOTLPResource(config.ClusterName),
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		queue:         make(chan *flowpb.Flow, queueSize),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+}
+
+// OnDecodedEvent enqueues flows for export. When the queue is full, the flow
+// is dropped and counted instead of blocking the Hubble event path.
+func (e *OTLPExporter) OnDecodedEvent(_ context.Context, ev *v1.Event) (bool, error) {
+	flow := ev.GetFlow()
+	if flow == nil {
+		return false, nil
+	}
+	select {
+	case e.queue <- flow:
+	default:
+		atomic.AddUint64(&e.dropped, 1)
+	}
+	return false, nil
+}
+
+// DroppedFlows returns the number of flows dropped due to a full queue.
+func (e *OTLPExporter) DroppedFlows() uint64 {
+	return atomic.LoadUint64(&e.dropped)
+}
+
+// Stop flushes any pending batch and closes the transport.
+func (e *OTLPExporter) Stop() error {
+	close(e.stop)
+	<-e.done
+	return e.transport.close()
+}
+
+func (e *OTLPExporter) run() {
+	defer close(e.done)
+	batch := make([]*flowpb.Flow, 0, e.batchSize)
+	flushTimer, flushTimerDone := inctimer.New()
+	defer flushTimerDone()
+	for {
+		select {
+		case flow := <-e.queue:
+			batch = append(batch, flow)
+			if len(batch) == e.batchSize {
+				e.export(batch)
+				batch = batch[:0]
+			}
+		case <-flushTimer.After(e.flushInterval):
+			e.export(batch)
+			batch = batch[:0]
+		case <-e.stop:
+			// drain the queue before flushing the last batch
+			for {
+				select {
+				case flow := <-e.queue:
+					batch = append(batch, flow)
+					if len(batch) == e.batchSize {
+						e.export(batch)
+						batch = batch[:0]
+					}
+					continue
+				default:
+				}
+				break
+			}
+			e.export(batch)
+			return
+		}
+	}
+}
+
+func (e *OTLPExporter) export(batch []*flowpb.Flow) {
+	if dropped := atomic.SwapUint64(&e.dropped, 0); dropped > 0 {
+		e.logger.WithField("numFlows", dropped).Warn("Dropped flows from full OTLP export queue")
+	}
+	if len(batch) == 0 {
+		return
+	}
+	records := make([][]byte, 0, len(batch))
+	for _, flow := range batch {
+		record, err := e.encodeLogRecord(flow)
+		if err != nil {
+			e.logger.WithError(err).Debug("Failed to encode flow as OTLP log record")
+			continue
+		}
+		records = append(records, record)
+	}
+	payload := encodeOTLPExportRequest(e.resource, records)
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+	if err := e.transport.send(ctx, payload); err != nil {
+		e.logger.WithError(err).Warn("Failed to export flows to OTLP endpoint")
+	}
+}
+
+// encodeLogRecord encodes a flow as an opentelemetry.proto.logs.v1.LogRecord
+// message with the JSON representation of the flow as its body.
+func (e *OTLPExporter) encodeLogRecord(flow *flowpb.Flow) ([]byte, error) {
+	if len(e.fieldMask) > 0 {
+		flow = maskFlow(e.fieldMask, flow)
+	}
+	body, err := json.Marshal(flow)
+	if err != nil {
+		return nil, err
+	}
+	var record []byte
+	// time_unix_nano = 1
+	record = protowire.AppendTag(record, 1, protowire.Fixed64Type)
+	record = protowire.AppendFixed64(record, uint64(flow.GetTime().AsTime().UnixNano()))
+	// severity_number = 2, SEVERITY_NUMBER_INFO
+	record = protowire.AppendTag(record, 2, protowire.VarintType)
+	record = protowire.AppendVarint(record, 9)
+	// severity_text = 3
+	record = protowire.AppendTag(record, 3, protowire.BytesType)
+	record = protowire.AppendString(record, "INFO")
+	// body = 5, AnyValue with string_value = 1
+	record = protowire.AppendTag(record, 5, protowire.BytesType)
+	record = protowire.AppendBytes(record, appendOTLPStringValue(nil, body))
+	return record, nil
+}
+
+// encodeOTLPResource encodes the opentelemetry.proto.resource.v1.Resource
+// message carrying the node and cluster attributes.
+func encodeOTLPResource(clusterName string) []byte {
+	var resource []byte
+	// attributes = 1, repeated KeyValue
+	resource = protowire.AppendTag(resource, 1, protowire.BytesType)
+	resource = protowire.AppendBytes(resource, encodeOTLPKeyValue("k8s.node.name", nodeTypes.GetName()))
+	if clusterName != "" {
+		resource = protowire.AppendTag(resource, 1, protowire.BytesType)
+		resource = protowire.AppendBytes(resource, encodeOTLPKeyValue("k8s.cluster.name", clusterName))
+	}
+	return resource
+}
+
+// encodeOTLPKeyValue encodes an opentelemetry.proto.common.v1.KeyValue
+// message with a string value.
+func encodeOTLPKeyValue(key, value string) []byte {
+	var kv []byte
+	// key = 1
+	kv = protowire.AppendTag(kv, 1, protowire.BytesType)
+	kv = protowire.AppendString(kv, key)
+	// value = 2, AnyValue with string_value = 1
+	kv = protowire.AppendTag(kv, 2, protowire.BytesType)
+	kv = protowire.AppendBytes(kv, appendOTLPStringValue(nil, []byte(value)))
+	return kv
+}
+
+// appendOTLPStringValue encodes an opentelemetry.proto.common.v1.AnyValue
+// message holding a string.
+func appendOTLPStringValue(b, value []byte) []byte {
+	// string_value = 1
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	return protowire.AppendBytes(b, value)
+}
+
+// encodeOTLPExportRequest encodes the ExportLogsServiceRequest message of the
+// opentelemetry.proto.collector.logs.v1.LogsService.
+func encodeOTLPExportRequest(resource []byte, records [][]byte) []byte {
+	// InstrumentationLibraryLogs with log_records = 2
+	var libLogs []byte
+	for _, record := range records {
+		libLogs = protowire.AppendTag(libLogs, 2, protowire.BytesType)
+		libLogs = protowire.AppendBytes(libLogs, record)
+	}
+	// ResourceLogs with resource = 1, instrumentation_library_logs = 2
+	var resourceLogs []byte
+	resourceLogs = protowire.AppendTag(resourceLogs, 1, protowire.BytesType)
+	resourceLogs = protowire.AppendBytes(resourceLogs, resource)
+	resourceLogs = protowire.AppendTag(resourceLogs, 2, protowire.BytesType)
+	resourceLogs = protowire.AppendBytes(resourceLogs, libLogs)
+	// ExportLogsServiceRequest with resource_logs = 1
+	var request []byte
+	request = protowire.AppendTag(request, 1, protowire.BytesType)
+	request = protowire.AppendBytes(request, resourceLogs)
+	return request
+}
+
+// otlpGRPCTransport sends export requests over OTLP/gRPC. The payload is
+// already serialized, so a pass-through codec is used for the call.
+type otlpGRPCTransport struct {
+	conn *grpc.ClientConn
+}
+
+func (t *otlpGRPCTransport) send(ctx context.Context, payload []byte) error {
+	var resp []byte
+	return t.conn.Invoke(ctx, otlpLogsExportMethod, payload, &resp, grpc.ForceCodec(rawCodec{}))
+}
+
+func (t *otlpGRPCTransport) close() error {
+	return t.conn.Close()
+}
+
+// rawCodec is a gRPC codec that passes serialized messages through untouched.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	payload, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("rawCodec: expected []byte, got %T", v)
+	}
+	return payload, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	payload, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("rawCodec: expected *[]byte, got %T", v)
+	}
+	*payload = data
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+// otlpHTTPTransport sends export requests over OTLP/HTTP in binary protobuf
+// encoding.
+type otlpHTTPTransport struct {
+	client *http.Client
+	url    string
+}
+
+func (t *otlpHTTPTransport) send(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("OTLP endpoint returned status %s", resp.Status)
+	}
+	return nil
+}
+
+func (t *otlpHTTPTransport) close() error {
+	t.client.CloseIdleConnections()
+	return nil
+}
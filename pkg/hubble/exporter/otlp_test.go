@@ -0,0 +1,128 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	observerpb "github.com/cilium/cilium/api/v1/observer"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	nodeTypes "github.com/cilium/cilium/pkg/node/types"
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOTLPConfigValidate(t *testing.T) {
+	_, err := OTLPConfig{}.validate()
+	assert.Error(t, err)
+	_, err = OTLPConfig{Endpoint: "collector:4317", Protocol: "invalid"}.validate()
+	assert.Error(t, err)
+	_, err = OTLPConfig{Endpoint: "collector:4317", FlushInterval: "bogus"}.validate()
+	assert.Error(t, err)
+	flushInterval, err := OTLPConfig{Endpoint: "collector:4317"}.validate()
+	assert.NoError(t, err)
+	assert.Equal(t, defaultOTLPFlushInterval, flushInterval)
+	flushInterval, err = OTLPConfig{Endpoint: "http://collector:4318", Protocol: OTLPProtocolHTTP, FlushInterval: "1s"}.validate()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, flushInterval)
+}
+
+func TestOTLPExporterHTTP(t *testing.T) {
+	// override node name for unit test.
+	nodeName := nodeTypes.GetName()
+	newNodeName := "my-node"
+	nodeTypes.SetName(newNodeName)
+	defer func() {
+		nodeTypes.SetName(nodeName)
+	}()
+
+	var mutex sync.Mutex
+	var payloads [][]byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, otlpLogsExportPath, r.URL.Path)
+		require.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		mutex.Lock()
+		payloads = append(payloads, payload)
+		mutex.Unlock()
+	}))
+	defer srv.Close()
+
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	exporter, err := NewOTLPExporter(log, OTLPConfig{
+		Endpoint:      srv.URL,
+		Protocol:      OTLPProtocolHTTP,
+		ClusterName:   "test-cluster",
+		BatchSize:     2,
+		FlushInterval: "1h",
+	}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		stop, err := exporter.OnDecodedEvent(ctx, &v1.Event{
+			Event: &observerpb.Flow{
+				NodeName: newNodeName,
+				Time:     &timestamp.Timestamp{Seconds: int64(i + 1)},
+			},
+		})
+		assert.False(t, stop)
+		assert.NoError(t, err)
+	}
+	// Stop drains the queue and flushes the partial batch.
+	require.NoError(t, exporter.Stop())
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	// 3 flows with a batch size of 2 result in one full and one partial batch.
+	require.Len(t, payloads, 2)
+	all := bytes.Join(payloads, nil)
+	// the flow is carried as JSON in the log record body
+	assert.Contains(t, string(all), `"node_name":"my-node"`)
+	// node and cluster are exported as resource attributes
+	assert.Contains(t, string(all), "k8s.node.name")
+	assert.Contains(t, string(all), "k8s.cluster.name")
+	assert.Contains(t, string(all), "test-cluster")
+}
+
+func TestOTLPExporterQueueFull(t *testing.T) {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	// the export loop is not started, so the queue fills up immediately
+	exporter := newOTLPExporter(log, nil, OTLPConfig{Endpoint: "collector:4317", QueueSize: 1}, nil)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		stop, err := exporter.OnDecodedEvent(ctx, &v1.Event{Event: &observerpb.Flow{}})
+		assert.False(t, stop)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, uint64(2), exporter.DroppedFlows())
+}
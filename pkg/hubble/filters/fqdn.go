@@ -79,6 +79,23 @@ func filterByDNSQueries(queryPatterns []string) (FilterFunc, error) {
 	}, nil
 }
 
+// filterByDNSResponseCodes returns a FilterFunc that filters L7 DNS response
+// flows by their return code, e.g. 3 for NXDomain.
+func filterByDNSResponseCodes(rcodes []uint32) FilterFunc {
+	return func(ev *v1.Event) bool {
+		l7 := ev.GetFlow().GetL7()
+		if l7.GetDns() == nil || l7.GetType() != flowpb.L7FlowType_RESPONSE {
+			return false
+		}
+		for _, rcode := range rcodes {
+			if l7.GetDns().GetRcode() == rcode {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 // FQDNFilter implements filtering based on FQDN information
 type FQDNFilter struct{}
 
@@ -110,5 +127,9 @@ func (f *FQDNFilter) OnBuildFilter(ctx context.Context, ff *flowpb.FlowFilter) (
 		fs = append(fs, dnsFilters)
 	}
 
+	if ff.GetDnsResponseCode() != nil {
+		fs = append(fs, filterByDNSResponseCodes(ff.GetDnsResponseCode()))
+	}
+
 	return fs, nil
 }
@@ -318,3 +318,85 @@ func Test_filterByDNSQuery(t *testing.T) {
 		})
 	}
 }
+
+func Test_filterByDNSResponseCode(t *testing.T) {
+	dnsResponse := func(rcode uint32) *v1.Event {
+		return &v1.Event{Event: &flowpb.Flow{
+			L7: &flowpb.Layer7{
+				Type: flowpb.L7FlowType_RESPONSE,
+				Record: &flowpb.Layer7_Dns{
+					Dns: &flowpb.DNS{
+						Query: "cilium.io",
+						Rcode: rcode,
+					},
+				},
+			},
+		}}
+	}
+	type args struct {
+		f  []*flowpb.FlowFilter
+		ev *v1.Event
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "not-dns",
+			args: args{
+				f:  []*flowpb.FlowFilter{{DnsResponseCode: []uint32{3}}},
+				ev: &v1.Event{Event: &flowpb.Flow{}},
+			},
+			want: false,
+		},
+		{
+			name: "dns-request",
+			args: args{
+				f: []*flowpb.FlowFilter{{DnsResponseCode: []uint32{0}}},
+				ev: &v1.Event{Event: &flowpb.Flow{
+					L7: &flowpb.Layer7{
+						Type: flowpb.L7FlowType_REQUEST,
+						Record: &flowpb.Layer7_Dns{
+							Dns: &flowpb.DNS{
+								Query: "cilium.io",
+							},
+						},
+					},
+				}},
+			},
+			want: false,
+		},
+		{
+			name: "nxdomain",
+			args: args{
+				f:  []*flowpb.FlowFilter{{DnsResponseCode: []uint32{3, 5}}},
+				ev: dnsResponse(3),
+			},
+			want: true,
+		},
+		{
+			name: "noerror",
+			args: args{
+				f:  []*flowpb.FlowFilter{{DnsResponseCode: []uint32{0}}},
+				ev: dnsResponse(0),
+			},
+			want: true,
+		},
+		{
+			name: "negative",
+			args: args{
+				f:  []*flowpb.FlowFilter{{DnsResponseCode: []uint32{3, 5}}},
+				ev: dnsResponse(0),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fl, err := BuildFilterList(context.Background(), tt.args.f, []OnBuildFilter{&FQDNFilter{}})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, fl.MatchOne(tt.args.ev))
+		})
+	}
+}
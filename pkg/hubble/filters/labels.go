@@ -35,6 +35,11 @@ func destinationLabels(ev *v1.Event) k8sLabels.Labels {
 	return ciliumLabels.ParseLabelArrayFromArray(labels)
 }
 
+func ruleLabels(ev *v1.Event) k8sLabels.Labels {
+	labels := ev.GetFlow().GetRuleLabels()
+	return ciliumLabels.ParseLabelArrayFromArray(labels)
+}
+
 var (
 	labelSelectorWithColon = regexp.MustCompile(`([^,]\s*[a-z0-9-]+):([a-z0-9-]+)`)
 )
@@ -101,5 +106,13 @@ func (l *LabelsFilter) OnBuildFilter(ctx context.Context, ff *flowpb.FlowFilter)
 		fs = append(fs, dlf)
 	}
 
+	if ff.GetRuleLabel() != nil {
+		rlf, err := FilterByLabelSelectors(ff.GetRuleLabel(), ruleLabels)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule label filter: %v", err)
+		}
+		fs = append(fs, rlf)
+	}
+
 	return fs, nil
 }
@@ -388,6 +388,32 @@ func TestLabelSelectorFilter(t *testing.T) {
 				false,
 			},
 		},
+		{
+			name: "rule label filter",
+			args: args{
+				f: []*flowpb.FlowFilter{{RuleLabel: []string{"k8s:io.cilium.k8s.policy.name=rule1"}}},
+				ev: []*v1.Event{
+					{
+						Event: &flowpb.Flow{
+							RuleLabels: []string{"k8s:io.cilium.k8s.policy.name=rule1", "k8s:io.cilium.k8s.policy.namespace=default"},
+						},
+					},
+					{
+						Event: &flowpb.Flow{
+							RuleLabels: []string{"k8s:io.cilium.k8s.policy.name=rule2"},
+						},
+					},
+					{
+						Event: &flowpb.Flow{},
+					},
+				},
+			},
+			want: []bool{
+				true,
+				false,
+				false,
+			},
+		},
 		{
 			name: "invalid source filter",
 			args: args{
@@ -0,0 +1,159 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httplatency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/pkg/hubble/metrics/api"
+	"github.com/cilium/cilium/pkg/lock"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// requestTimeout is the duration after which a request without an
+	// observed response is counted as timed out instead of contributing
+	// to the latency histogram.
+	requestTimeout = 2 * time.Minute
+	// sweepInterval is the minimum duration between two scans for timed
+	// out requests.
+	sweepInterval = 30 * time.Second
+)
+
+// pendingRequest is a request flow awaiting its response flow.
+type pendingRequest struct {
+	seenAt time.Time
+	// labels are the metric label values derived from the request flow.
+	// They are reused for the response so that the metrics are always
+	// labeled in the request direction.
+	labels []string
+}
+
+type httpLatencyHandler struct {
+	latency  *prometheus.HistogramVec
+	timeouts *prometheus.CounterVec
+	context  *api.ContextOptions
+
+	mutex     lock.Mutex
+	pending   map[string]*pendingRequest
+	lastSweep time.Time
+}
+
+func (h *httpLatencyHandler) Init(registry *prometheus.Registry, options api.Options) error {
+	c, err := api.ParseContextOptions(options)
+	if err != nil {
+		return err
+	}
+	h.context = c
+	h.latency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: api.DefaultPrometheusNamespace,
+		Name:      "http_latency_seconds",
+		Help:      "Quantiles of latency between HTTP requests and their responses",
+	}, append(h.context.GetLabelNames(), "method"))
+	h.timeouts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: api.DefaultPrometheusNamespace,
+		Name:      "http_request_timeouts_total",
+		Help:      "Count of HTTP requests for which no response was observed",
+	}, append(h.context.GetLabelNames(), "method"))
+	h.pending = map[string]*pendingRequest{}
+	registry.MustRegister(h.latency)
+	registry.MustRegister(h.timeouts)
+	return nil
+}
+
+func (h *httpLatencyHandler) Status() string {
+	if h.context == nil {
+		return ""
+	}
+	return h.context.Status()
+}
+
+func (h *httpLatencyHandler) ProcessFlow(ctx context.Context, flow *flowpb.Flow) {
+	l7 := flow.GetL7()
+	if l7 == nil {
+		return
+	}
+	http := l7.GetHttp()
+	if http == nil {
+		return
+	}
+	key := flowKey(flow, l7.Type, http)
+	if key == "" {
+		return
+	}
+	now := flow.GetTime().AsTime()
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	switch l7.Type {
+	case flowpb.L7FlowType_REQUEST:
+		// A new request on the same connection for the same method and
+		// URL means the response of the previous one was never
+		// observed.
+		if prev, ok := h.pending[key]; ok {
+			h.timeouts.WithLabelValues(prev.labels...).Inc()
+		}
+		h.pending[key] = &pendingRequest{
+			seenAt: now,
+			labels: append(h.context.GetLabelValues(flow), http.Method),
+		}
+	case flowpb.L7FlowType_RESPONSE:
+		req, ok := h.pending[key]
+		if !ok {
+			// The request was not observed, the latency is unknown.
+			return
+		}
+		delete(h.pending, key)
+		h.latency.WithLabelValues(req.labels...).Observe(now.Sub(req.seenAt).Seconds())
+	}
+	h.sweep(now)
+}
+
+// sweep counts pending requests whose response was not observed within
+// requestTimeout. It is driven by flow timestamps rather than the wall clock
+// so that delayed or replayed flows age consistently.
+func (h *httpLatencyHandler) sweep(now time.Time) {
+	if now.Sub(h.lastSweep) < sweepInterval {
+		return
+	}
+	h.lastSweep = now
+	for key, req := range h.pending {
+		if now.Sub(req.seenAt) > requestTimeout {
+			delete(h.pending, key)
+			h.timeouts.WithLabelValues(req.labels...).Inc()
+		}
+	}
+}
+
+// flowKey identifies the connection and request a flow belongs to. Response
+// flows carry source and destination in reverse order, so the key is
+// normalized to the request direction.
+func flowKey(flow *flowpb.Flow, flowType flowpb.L7FlowType, http *flowpb.HTTP) string {
+	ip := flow.GetIP()
+	tcp := flow.GetL4().GetTCP()
+	if ip == nil || tcp == nil {
+		return ""
+	}
+	client := fmt.Sprintf("%s:%d", ip.Source, tcp.SourcePort)
+	server := fmt.Sprintf("%s:%d", ip.Destination, tcp.DestinationPort)
+	if flowType == flowpb.L7FlowType_RESPONSE {
+		client, server = server, client
+	}
+	return client + ">" + server + "|" + http.Method + "|" + http.Url
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package httplatency
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/cilium/cilium/api/v1/flow"
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func httpFlow(flowType pb.L7FlowType, seconds int64, nanos int32, srcIP string, srcPort uint32, dstIP string, dstPort uint32, method, url string) *pb.Flow {
+	return &pb.Flow{
+		Time: &timestamp.Timestamp{Seconds: seconds, Nanos: nanos},
+		IP:   &pb.IP{Source: srcIP, Destination: dstIP},
+		L4: &pb.Layer4{Protocol: &pb.Layer4_TCP{TCP: &pb.TCP{
+			SourcePort:      srcPort,
+			DestinationPort: dstPort,
+		}}},
+		L7: &pb.Layer7{
+			Type: flowType,
+			Record: &pb.Layer7_Http{Http: &pb.HTTP{
+				Method: method,
+				Url:    url,
+			}},
+		},
+	}
+}
+
+func Test_httpLatencyHandler_Status(t *testing.T) {
+	plugin := httpLatencyPlugin{}
+	handler := plugin.NewHandler()
+	assert.Equal(t, handler.Status(), "")
+	options := map[string]string{"sourceContext": "namespace", "destinationContext": "namespace"}
+	require.NoError(t, handler.Init(prometheus.NewRegistry(), options))
+	assert.Equal(t, handler.Status(), "destination=namespace,source=namespace")
+}
+
+func Test_httpLatencyHandler_ProcessFlow(t *testing.T) {
+	ctx := context.TODO()
+	plugin := httpLatencyPlugin{}
+	handler := plugin.NewHandler()
+	require.Error(t, handler.Init(prometheus.NewRegistry(), map[string]string{"destinationContext": "invalid"}))
+	require.NoError(t, handler.Init(prometheus.NewRegistry(), nil))
+	// shouldn't count
+	handler.ProcessFlow(ctx, &pb.Flow{})
+	// shouldn't count, response without an observed request
+	handler.ProcessFlow(ctx, httpFlow(pb.L7FlowType_RESPONSE, 1, 0, "2.2.2.2", 80, "1.1.1.1", 1111, "GET", "/unknown"))
+	// request and matching response, 0.5s apart
+	handler.ProcessFlow(ctx, httpFlow(pb.L7FlowType_REQUEST, 1, 0, "1.1.1.1", 1111, "2.2.2.2", 80, "GET", "/"))
+	handler.ProcessFlow(ctx, httpFlow(pb.L7FlowType_RESPONSE, 1, 500000000, "2.2.2.2", 80, "1.1.1.1", 1111, "GET", "/"))
+
+	latencyExpected := `
+        # HELP hubble_http_latency_seconds Quantiles of latency between HTTP requests and their responses
+        # TYPE hubble_http_latency_seconds histogram
+        hubble_http_latency_seconds_bucket{method="GET",le="0.005"} 0
+        hubble_http_latency_seconds_bucket{method="GET",le="0.01"} 0
+        hubble_http_latency_seconds_bucket{method="GET",le="0.025"} 0
+        hubble_http_latency_seconds_bucket{method="GET",le="0.05"} 0
+        hubble_http_latency_seconds_bucket{method="GET",le="0.1"} 0
+        hubble_http_latency_seconds_bucket{method="GET",le="0.25"} 0
+        hubble_http_latency_seconds_bucket{method="GET",le="0.5"} 1
+        hubble_http_latency_seconds_bucket{method="GET",le="1"} 1
+        hubble_http_latency_seconds_bucket{method="GET",le="2.5"} 1
+        hubble_http_latency_seconds_bucket{method="GET",le="5"} 1
+        hubble_http_latency_seconds_bucket{method="GET",le="10"} 1
+        hubble_http_latency_seconds_bucket{method="GET",le="+Inf"} 1
+        hubble_http_latency_seconds_sum{method="GET"} 0.5
+        hubble_http_latency_seconds_count{method="GET"} 1
+	`
+	require.NoError(t, testutil.CollectAndCompare(handler.(*httpLatencyHandler).latency, strings.NewReader(latencyExpected)))
+
+	// a request without a response must be counted as timed out once it is
+	// older than the request timeout, without contributing to the histogram
+	handler.ProcessFlow(ctx, httpFlow(pb.L7FlowType_REQUEST, 200, 0, "1.1.1.1", 1111, "2.2.2.2", 80, "GET", "/timeout"))
+	handler.ProcessFlow(ctx, httpFlow(pb.L7FlowType_REQUEST, 400, 0, "3.3.3.3", 3333, "2.2.2.2", 80, "GET", "/other"))
+
+	timeoutsExpected := `
+        # HELP hubble_http_request_timeouts_total Count of HTTP requests for which no response was observed
+        # TYPE hubble_http_request_timeouts_total counter
+        hubble_http_request_timeouts_total{method="GET"} 1
+	`
+	require.NoError(t, testutil.CollectAndCompare(handler.(*httpLatencyHandler).timeouts, strings.NewReader(timeoutsExpected)))
+	require.NoError(t, testutil.CollectAndCompare(handler.(*httpLatencyHandler).latency, strings.NewReader(latencyExpected)))
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httplatency
+
+import (
+	"github.com/cilium/cilium/pkg/hubble/metrics/api"
+)
+
+type httpLatencyPlugin struct{}
+
+func (p *httpLatencyPlugin) NewHandler() api.Handler {
+	return &httpLatencyHandler{}
+}
+
+func (p *httpLatencyPlugin) HelpText() string {
+	return `http-latency - HTTP latency metrics
+Metrics related to the latency between HTTP requests and their responses
+
+Metrics:
+  http_latency_seconds        - Histogram of latency between HTTP requests and their responses.
+  http_request_timeouts_total - Count of HTTP requests for which no response was observed.
+
+Options:` +
+		api.ContextOptionsHelp
+}
+
+func init() {
+	api.DefaultRegistry().Register("http-latency", &httpLatencyPlugin{})
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package httplatency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_httpLatencyPlugin_HelpText(t *testing.T) {
+	plugin := &httpLatencyPlugin{}
+	expected := `http-latency - HTTP latency metrics
+Metrics related to the latency between HTTP requests and their responses
+
+Metrics:
+  http_latency_seconds        - Histogram of latency between HTTP requests and their responses.
+  http_request_timeouts_total - Count of HTTP requests for which no response was observed.
+
+Options:
+ sourceContext          := identifier , { "|", identifier }
+ destinationContext     := identifier , { "|", identifier }
+ identifier             := identity | namespace | pod | pod-short | dns | ip
+`
+	assert.Equal(t, expected, plugin.HelpText())
+}
@@ -25,6 +25,7 @@ import (
 	_ "github.com/cilium/cilium/pkg/hubble/metrics/drop"              // invoke init
 	_ "github.com/cilium/cilium/pkg/hubble/metrics/flow"              // invoke init
 	_ "github.com/cilium/cilium/pkg/hubble/metrics/http"              // invoke init
+	_ "github.com/cilium/cilium/pkg/hubble/metrics/http-latency"      // invoke init
 	_ "github.com/cilium/cilium/pkg/hubble/metrics/icmp"              // invoke init
 	_ "github.com/cilium/cilium/pkg/hubble/metrics/port-distribution" // invoke init
 	_ "github.com/cilium/cilium/pkg/hubble/metrics/tcp"               // invoke init
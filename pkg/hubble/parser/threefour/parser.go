@@ -33,6 +33,9 @@ import (
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/monitor"
 	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+	"github.com/cilium/cilium/pkg/policy"
+	"github.com/cilium/cilium/pkg/policy/trafficdirection"
+	"github.com/cilium/cilium/pkg/u8proto"
 
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
@@ -113,12 +116,12 @@ func (p *Parser) Decode(data []byte, decoded *pb.Flow) error {
 	var eventSubType uint8
 	switch eventType {
 	case monitorAPI.MessageTypeDrop:
-		packetOffset = monitor.DropNotifyLen
 		dn = &monitor.DropNotify{}
-		if err := binary.Read(bytes.NewReader(data), byteorder.Native, dn); err != nil {
+		if err := monitor.DecodeDropNotify(data, dn); err != nil {
 			return fmt.Errorf("failed to parse drop: %v", err)
 		}
 		eventSubType = dn.SubType
+		packetOffset = (int)(dn.DataOffset())
 	case monitorAPI.MessageTypeTrace:
 		tn = &monitor.TraceNotify{}
 		if err := monitor.DecodeTraceNotify(data, tn); err != nil {
@@ -219,8 +222,9 @@ func (p *Parser) Decode(data []byte, decoded *pb.Flow) error {
 	decoded.SourceService = sourceService
 	decoded.DestinationService = destinationService
 	decoded.PolicyMatchType = decodePolicyMatchType(pvn)
+	decoded.RuleLabels = p.resolveRuleLabels(dn, pvn, decoded.TrafficDirection, srcEndpoint, dstEndpoint, dstPort, decodeLayer4Protocol(l4))
 	decoded.DebugCapturePoint = decodeDebugCapturePoint(dbg)
-	decoded.Interface = decodeNetworkInterface(tn, dbg)
+	decoded.Interface = decodeNetworkInterface(dn, tn, dbg)
 	decoded.ProxyPort = decodeProxyPort(dbg)
 	decoded.Summary = summary
 
@@ -363,6 +367,85 @@ func (p *Parser) resolveEndpoint(ip net.IP, datapathSecurityIdentity uint32) *pb
 	}
 }
 
+// resolveRuleLabels looks up the labels of the policy rules from which the
+// realized policy map entry matching the flow was derived. Only drop and
+// policy verdict events report the policy decision of a local endpoint, and
+// the rule labels are only retained when policy map provenance tracking is
+// enabled.
+func (p *Parser) resolveRuleLabels(
+	dn *monitor.DropNotify, pvn *monitor.PolicyVerdictNotify,
+	direction pb.TrafficDirection, srcEP, dstEP *pb.Endpoint,
+	dstPort uint16, proto u8proto.U8proto,
+) []string {
+	if p.endpointGetter == nil {
+		return nil
+	}
+
+	var epID uint16
+	switch {
+	case dn != nil:
+		epID = dn.Source
+	case pvn != nil:
+		epID = pvn.Source
+	default:
+		return nil
+	}
+
+	var dir trafficdirection.TrafficDirection
+	var remoteIdentity uint32
+	switch direction {
+	case pb.TrafficDirection_INGRESS:
+		dir = trafficdirection.Ingress
+		remoteIdentity = srcEP.GetIdentity()
+	case pb.TrafficDirection_EGRESS:
+		dir = trafficdirection.Egress
+		remoteIdentity = dstEP.GetIdentity()
+	default:
+		return nil
+	}
+
+	ep, ok := p.endpointGetter.GetEndpointInfoByID(epID)
+	if !ok {
+		return nil
+	}
+
+	// Mirror the datapath lookup order: L3+L4, L4-only, L3-only and finally
+	// the allow-all key.
+	keys := []policy.Key{
+		{Identity: remoteIdentity, DestPort: dstPort, Nexthdr: uint8(proto), TrafficDirection: dir.Uint8()},
+		{Identity: 0, DestPort: dstPort, Nexthdr: uint8(proto), TrafficDirection: dir.Uint8()},
+		{Identity: remoteIdentity, DestPort: 0, Nexthdr: 0, TrafficDirection: dir.Uint8()},
+		{Identity: 0, DestPort: 0, Nexthdr: 0, TrafficDirection: dir.Uint8()},
+	}
+	for _, key := range keys {
+		derivedFrom, _, ok := ep.GetRealizedPolicyRuleLabelsForKey(key)
+		if !ok {
+			continue
+		}
+		var ruleLabels []string
+		for _, lbls := range derivedFrom {
+			ruleLabels = append(ruleLabels, lbls.GetModel()...)
+		}
+		sort.Strings(ruleLabels)
+		return ruleLabels
+	}
+	return nil
+}
+
+func decodeLayer4Protocol(l4 *pb.Layer4) u8proto.U8proto {
+	switch l4.GetProtocol().(type) {
+	case *pb.Layer4_TCP:
+		return u8proto.TCP
+	case *pb.Layer4_UDP:
+		return u8proto.UDP
+	case *pb.Layer4_ICMPv4:
+		return u8proto.ICMP
+	case *pb.Layer4_ICMPv6:
+		return u8proto.ICMPv6
+	}
+	return 0
+}
+
 func decodeLayers(packet *packet) (
 	ethernet *pb.Ethernet,
 	ip *pb.IP,
@@ -417,6 +500,13 @@ func decodeVerdict(dn *monitor.DropNotify, tn *monitor.TraceNotify, pvn *monitor
 func decodeDropReason(dn *monitor.DropNotify, pvn *monitor.PolicyVerdictNotify) uint32 {
 	switch {
 	case dn != nil:
+		// Version 1 drop notifications carry an extended sub-reason
+		// for policy drops. The flow API has no dedicated code for
+		// deny rule drops, so map them to POLICY_DENIED rather than
+		// leaving the reason unknown to API consumers.
+		if dn.SubReason == monitorAPI.DropExtDenyRule {
+			return uint32(pb.DropReason_POLICY_DENIED)
+		}
 		return uint32(dn.SubType)
 	case pvn != nil && pvn.Verdict < 0:
 		// if the flow was dropped, verdict equals the negative of the drop reason
@@ -653,9 +743,11 @@ func decodeDebugCapturePoint(dbg *monitor.DebugCapture) pb.DebugCapturePoint {
 	return pb.DebugCapturePoint(dbg.SubType)
 }
 
-func decodeNetworkInterface(tn *monitor.TraceNotify, dbg *monitor.DebugCapture) *pb.NetworkInterface {
+func decodeNetworkInterface(dn *monitor.DropNotify, tn *monitor.TraceNotify, dbg *monitor.DebugCapture) *pb.NetworkInterface {
 	ifIndex := uint32(0)
-	if tn != nil {
+	if dn != nil && dn.Version >= monitor.DropNotifyVersion2 {
+		ifIndex = dn.Ifindex
+	} else if tn != nil {
 		ifIndex = tn.Ifindex
 	} else if dbg != nil {
 		switch dbg.SubType {
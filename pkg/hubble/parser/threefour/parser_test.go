@@ -312,7 +312,7 @@ func TestDecodeTraceNotify(t *testing.T) {
 
 func TestDecodeDropNotify(t *testing.T) {
 	buf := &bytes.Buffer{}
-	dn := monitor.DropNotify{
+	dn := monitor.DropNotifyV0{
 		Type:     byte(api.MessageTypeDrop),
 		SrcLabel: 123,
 		DstLabel: 456,
@@ -355,6 +355,45 @@ func TestDecodeDropNotify(t *testing.T) {
 	assert.Equal(t, []string{"dst=label"}, f.GetDestination().GetLabels())
 }
 
+func TestDecodeDropNotifyV2(t *testing.T) {
+	buf := &bytes.Buffer{}
+	dn := monitor.DropNotify{
+		DropNotifyV1: monitor.DropNotifyV1{
+			DropNotifyV0: monitor.DropNotifyV0{
+				Type:    byte(api.MessageTypeDrop),
+				Version: monitor.DropNotifyVersion2,
+			},
+		},
+		Mark:    0xe00,
+		Ifindex: 42,
+		TTL:     1,
+	}
+	err := binary.Write(buf, byteorder.Native, &dn)
+	require.NoError(t, err)
+	buffer := gopacket.NewSerializeBuffer()
+	err = gopacket.SerializeLayers(buffer,
+		gopacket.SerializeOptions{},
+		&layers.Ethernet{
+			SrcMAC: net.HardwareAddr{1, 2, 3, 4, 5, 6},
+			DstMAC: net.HardwareAddr{1, 2, 3, 4, 5, 6},
+		},
+		&layers.IPv4{
+			SrcIP: net.IPv4(1, 2, 3, 4),
+			DstIP: net.IPv4(1, 2, 3, 4),
+		},
+	)
+	require.NoError(t, err)
+	buf.Write(buffer.Bytes())
+
+	parser, err := New(log, &testutils.NoopEndpointGetter, &testutils.NoopIdentityGetter, &testutils.NoopDNSGetter, &testutils.NoopIPGetter, &testutils.NoopServiceGetter)
+	require.NoError(t, err)
+
+	f := &flowpb.Flow{}
+	err = parser.Decode(buf.Bytes(), f)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), f.GetInterface().GetIndex())
+}
+
 func TestDecodePolicyVerdictNotify(t *testing.T) {
 	var remoteLabel identity.NumericIdentity = 123
 	identityGetter := &testutils.FakeIdentityGetter{
@@ -419,7 +458,7 @@ func TestDecodePolicyVerdictNotify(t *testing.T) {
 
 func TestDecodeDropReason(t *testing.T) {
 	reason := uint8(130)
-	dn := monitor.DropNotify{
+	dn := monitor.DropNotifyV0{
 		Type:    byte(api.MessageTypeDrop),
 		SubType: reason,
 	}
@@ -493,16 +532,23 @@ func TestDecodeTrafficDirection(t *testing.T) {
 		TrafficDirection: trafficdirection.Egress.Uint8(),
 	}
 
+	localEPInfo := &testutils.FakeEndpointInfo{
+		ID: uint64(localEP),
+		PolicyMap: map[policy.Key]labels.LabelArrayList{
+			policyKey: policyLabel,
+		},
+		PolicyRevision: 1,
+	}
 	endpointGetter := &testutils.FakeEndpointGetter{
 		OnGetEndpointInfo: func(ip net.IP) (endpoint v1.EndpointInfo, ok bool) {
 			if ip.Equal(localIP) {
-				return &testutils.FakeEndpointInfo{
-					ID: uint64(localEP),
-					PolicyMap: map[policy.Key]labels.LabelArrayList{
-						policyKey: policyLabel,
-					},
-					PolicyRevision: 1,
-				}, true
+				return localEPInfo, true
+			}
+			return nil, false
+		},
+		OnGetEndpointInfoByID: func(id uint16) (endpoint v1.EndpointInfo, ok bool) {
+			if id == localEP {
+				return localEPInfo, true
 			}
 			return nil, false
 		},
@@ -526,7 +572,7 @@ func TestDecodeTrafficDirection(t *testing.T) {
 	}
 
 	// DROP at unknown endpoint
-	dn := monitor.DropNotify{
+	dn := monitor.DropNotifyV0{
 		Type: byte(api.MessageTypeDrop),
 	}
 	f := parseFlow(dn, localIP, remoteIP)
@@ -534,7 +580,7 @@ func TestDecodeTrafficDirection(t *testing.T) {
 	assert.Equal(t, uint32(localEP), f.GetSource().GetID())
 
 	// DROP Egress
-	dn = monitor.DropNotify{
+	dn = monitor.DropNotifyV0{
 		Type:   byte(api.MessageTypeDrop),
 		Source: localEP,
 	}
@@ -543,7 +589,7 @@ func TestDecodeTrafficDirection(t *testing.T) {
 	assert.Equal(t, uint32(localEP), f.GetSource().GetID())
 
 	// DROP Ingress
-	dn = monitor.DropNotify{
+	dn = monitor.DropNotifyV0{
 		Type:   byte(api.MessageTypeDrop),
 		Source: localEP,
 	}
@@ -632,6 +678,7 @@ func TestDecodeTrafficDirection(t *testing.T) {
 	assert.Equal(t, true, ok)
 	assert.Equal(t, lbls, policyLabel)
 	assert.Equal(t, uint64(1), rev)
+	assert.Equal(t, []string{"unspec:foo=bar"}, f.GetRuleLabels())
 
 	// PolicyVerdictNotify Ingress
 	pvn = monitor.PolicyVerdictNotify{
@@ -715,7 +762,7 @@ func TestDecodeIsReply(t *testing.T) {
 	assert.Equal(t, false, f.GetReply())
 
 	// DropNotify statically assumes is_reply=unknown
-	dn := monitor.DropNotify{
+	dn := monitor.DropNotifyV0{
 		Type: byte(api.MessageTypeDrop),
 	}
 	f = parseFlow(dn, localIP, remoteIP)
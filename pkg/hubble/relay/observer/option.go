@@ -19,7 +19,9 @@ import (
 	"time"
 
 	observerpb "github.com/cilium/cilium/api/v1/observer"
+	"github.com/cilium/cilium/pkg/backoff"
 	"github.com/cilium/cilium/pkg/hubble/relay/defaults"
+	"github.com/cilium/cilium/pkg/hubble/relay/pool"
 	poolTypes "github.com/cilium/cilium/pkg/hubble/relay/pool/types"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
@@ -49,8 +51,13 @@ var defaultOptions = options{
 	sortBufferMaxLen:       defaults.SortBufferMaxLen,
 	sortBufferDrainTimeout: defaults.SortBufferDrainTimeout,
 	errorAggregationWindow: defaults.ErrorAggregationWindow,
-	log:                    logging.DefaultLogger.WithField(logfields.LogSubsys, "hubble-relay"),
-	ocb:                    defaultObserverClientBuilder{},
+	peerRetryBackoff: &backoff.Exponential{
+		Min:    1 * time.Second,
+		Max:    defaults.RetryTimeout,
+		Factor: 2.0,
+	},
+	log: logging.DefaultLogger.WithField(logfields.LogSubsys, "hubble-relay"),
+	ocb: defaultObserverClientBuilder{},
 }
 
 // Option customizes the configuration of the Manager.
@@ -61,6 +68,7 @@ type options struct {
 	sortBufferMaxLen       int
 	sortBufferDrainTimeout time.Duration
 	errorAggregationWindow time.Duration
+	peerRetryBackoff       pool.BackoffDuration
 	log                    logrus.FieldLogger
 
 	// this is not meant to be user configurable as it's only useful to
@@ -114,6 +122,17 @@ func WithErrorAggregationWindow(d time.Duration) Option {
 	}
 }
 
+// WithPeerRetryBackoff sets the backoff between attempts to resume streaming
+// from a peer that is unavailable or whose stream was interrupted. Retries
+// are only performed for flows requests in follow mode and last for as long
+// as the request is being served.
+func WithPeerRetryBackoff(b pool.BackoffDuration) Option {
+	return func(o *options) error {
+		o.peerRetryBackoff = b
+		return nil
+	}
+}
+
 // WithLogger sets the logger to use for logging.
 func WithLogger(l logrus.FieldLogger) Option {
 	return func(o *options) error {
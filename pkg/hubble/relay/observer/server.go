@@ -22,6 +22,7 @@ import (
 	relaypb "github.com/cilium/cilium/api/v1/relay"
 	"github.com/cilium/cilium/pkg/hubble/build"
 	poolTypes "github.com/cilium/cilium/pkg/hubble/relay/pool/types"
+	"github.com/cilium/cilium/pkg/inctimer"
 
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
@@ -110,6 +111,12 @@ func (s *Server) GetFlows(req *observerpb.GetFlowsRequest, stream observerpb.Obs
 			)
 			s.peers.ReportOffline(p.Name)
 			unavailableNodes = append(unavailableNodes, p.Name)
+			if req.GetFollow() {
+				p := p
+				g.Go(func() error {
+					return s.retryPeer(gctx, p.Name, req, flows)
+				})
+			}
 			continue
 		}
 		connectedNodes = append(connectedNodes, p.Name)
@@ -127,6 +134,10 @@ func (s *Server) GetFlows(req *observerpb.GetFlowsRequest, stream observerpb.Obs
 				select {
 				case flows <- nodeStatusError(err, p.Name):
 				case <-gctx.Done():
+					return nil
+				}
+				if req.GetFollow() {
+					return s.retryPeer(gctx, p.Name, req, flows)
 				}
 			}
 			return nil
@@ -171,6 +182,58 @@ sortedFlowsLoop:
 	return g.Wait()
 }
 
+// retryPeer repeatedly attempts to resume streaming flows from a peer that is
+// unavailable or whose stream was interrupted, waiting between attempts
+// according to the configured backoff. A NODE_CONNECTED status is sent to the
+// client whenever streaming from the peer resumes. The retry loop lasts for
+// as long as the request is being served and only ends early when the peer
+// finishes the request or is removed from the pool.
+func (s *Server) retryPeer(ctx context.Context, name string, req *observerpb.GetFlowsRequest, flows chan<- *observerpb.GetFlowsResponse) error {
+	retryTimer, retryTimerDone := inctimer.New()
+	defer retryTimerDone()
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-retryTimer.After(s.opts.peerRetryBackoff.Duration(attempt)):
+		}
+		var peer *poolTypes.Peer
+		for _, p := range s.peers.List() {
+			if p.Name == name {
+				p := p
+				peer = &p
+				break
+			}
+		}
+		if peer == nil {
+			// The peer was removed from the pool, stop retrying.
+			return nil
+		}
+		if !isAvailable(peer.Conn) {
+			s.peers.ReportOffline(name)
+			continue
+		}
+		select {
+		case flows <- nodeStatusEvent(relaypb.NodeState_NODE_CONNECTED, name):
+		case <-ctx.Done():
+			return nil
+		}
+		err := retrieveFlowsFromPeer(ctx, s.opts.ocb.observerClient(peer), req, flows)
+		if err == nil {
+			return nil
+		}
+		s.opts.log.WithFields(logrus.Fields{
+			"error": err,
+			"peer":  peer,
+		}).Warning("Failed to retrieve flows from peer")
+		select {
+		case flows <- nodeStatusError(err, name):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
 // GetAgentEvents implements observerpb.ObserverServer.GetAgentEvents by proxying requests to
 // the hubble instance the proxy is connected to.
 func (s *Server) GetAgentEvents(req *observerpb.GetAgentEventsRequest, stream observerpb.Observer_GetAgentEventsServer) error {
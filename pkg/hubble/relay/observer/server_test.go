@@ -23,6 +23,7 @@ import (
 	"io"
 	"net"
 	"testing"
+	"time"
 
 	flowpb "github.com/cilium/cilium/api/v1/flow"
 	observerpb "github.com/cilium/cilium/api/v1/observer"
@@ -64,6 +65,7 @@ func TestGetFlows(t *testing.T) {
 		name   string
 		plr    PeerListReporter
 		ocb    observerClientBuilder
+		opts   []Option
 		req    *observerpb.GetFlowsRequest
 		stream observerpb.Observer_GetFlowsServer
 		want   want
@@ -313,6 +315,108 @@ func TestGetFlows(t *testing.T) {
 					`level=info msg="No connection to peer two, skipping" address="192.0.2.2:4244"`,
 				},
 			},
+		}, {
+			name: "Observe flows from 1 peer that disappears mid-stream and comes back",
+			plr: &testutils.FakePeerListReporter{
+				OnList: func() []poolTypes.Peer {
+					return []poolTypes.Peer{
+						{
+							Peer: peerTypes.Peer{
+								Name: "one",
+								Address: &net.TCPAddr{
+									IP:   net.ParseIP("192.0.2.1"),
+									Port: defaults.ServerPort,
+								},
+							},
+							Conn: &testutils.FakeClientConn{
+								OnGetState: func() connectivity.State {
+									return connectivity.Ready
+								},
+							},
+						},
+					}
+				},
+			},
+			ocb: func() observerClientBuilder {
+				var numCalls int
+				return fakeObserverClientBuilder{
+					onObserverClient: func(p *poolTypes.Peer) observerpb.ObserverClient {
+						return &testutils.FakeObserverClient{
+							OnGetFlows: func(_ context.Context, in *observerpb.GetFlowsRequest, _ ...grpc.CallOption) (observerpb.Observer_GetFlowsClient, error) {
+								numCalls++
+								call := numCalls
+								var numRecv int
+								return &testutils.FakeGetFlowsClient{
+									OnRecv: func() (*observerpb.GetFlowsResponse, error) {
+										if numRecv > 0 {
+											if call == 1 {
+												// the peer disappears mid-stream
+												return nil, status.Error(codes.Unavailable, "connection refused")
+											}
+											return nil, io.EOF
+										}
+										numRecv++
+										return &observerpb.GetFlowsResponse{
+											NodeName: p.Name,
+											ResponseTypes: &observerpb.GetFlowsResponse_Flow{
+												Flow: &flowpb.Flow{
+													NodeName: p.Name,
+												},
+											},
+										}, nil
+									},
+								}, nil
+							},
+						}
+					},
+				}
+			}(),
+			opts: []Option{
+				WithPeerRetryBackoff(testBackoff(time.Millisecond)),
+			},
+			req: &observerpb.GetFlowsRequest{Follow: true},
+			stream: &testutils.FakeGetFlowsServer{
+				FakeGRPCServerStream: fss,
+				OnSend: func(resp *observerpb.GetFlowsResponse) error {
+					if resp == nil {
+						return nil
+					}
+					switch resp.GetResponseTypes().(type) {
+					case *observerpb.GetFlowsResponse_Flow:
+						got.numFlows++
+						got.flows[resp.GetNodeName()] = append(got.flows[resp.GetNodeName()], resp.GetFlow())
+					case *observerpb.GetFlowsResponse_NodeStatus:
+						got.statusEvents = append(got.statusEvents, resp.GetNodeStatus())
+					}
+					if got.numFlows == 2 && len(got.statusEvents) == 3 {
+						close(done)
+						return io.EOF
+					}
+					return nil
+				},
+			},
+			want: want{
+				flows: map[string][]*flowpb.Flow{
+					"one": {&flowpb.Flow{NodeName: "one"}, &flowpb.Flow{NodeName: "one"}},
+				},
+				statusEvents: []*relaypb.NodeStatusEvent{
+					{
+						StateChange: relaypb.NodeState_NODE_CONNECTED,
+						NodeNames:   []string{"one"},
+					}, {
+						StateChange: relaypb.NodeState_NODE_ERROR,
+						NodeNames:   []string{"one"},
+						Message:     "rpc error: code = Unavailable desc = connection refused",
+					}, {
+						StateChange: relaypb.NodeState_NODE_CONNECTED,
+						NodeNames:   []string{"one"},
+					},
+				},
+				err: io.EOF,
+				log: []string{
+					`Failed to retrieve flows from peer`,
+				},
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -333,8 +437,10 @@ func TestGetFlows(t *testing.T) {
 
 			srv, err := NewServer(
 				tt.plr,
-				WithLogger(logger),
-				withObserverClientBuilder(tt.ocb),
+				append([]Option{
+					WithLogger(logger),
+					withObserverClientBuilder(tt.ocb),
+				}, tt.opts...)...,
 			)
 			assert.NoError(t, err)
 			err = srv.GetFlows(tt.req, tt.stream)
@@ -1123,3 +1229,10 @@ func (b fakeObserverClientBuilder) observerClient(p *poolTypes.Peer) observerpb.
 	}
 	panic("OnObserverClient not set")
 }
+
+// testBackoff is a fixed backoff duration to speed up peer retries in tests.
+type testBackoff time.Duration
+
+func (b testBackoff) Duration(attempt int) time.Duration {
+	return time.Duration(b)
+}
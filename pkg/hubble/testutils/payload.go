@@ -33,6 +33,9 @@ func CreateL3L4Payload(message interface{}, layers ...gopacket.SerializableLayer
 	switch messageType := message.(type) {
 	case monitor.DebugCapture,
 		monitor.DropNotify,
+		monitor.DropNotifyV0,
+		monitor.DropNotifyV1,
+		monitor.DropNotifyV2,
 		monitor.PolicyVerdictNotify,
 		monitor.TraceNotify,
 		monitor.TraceNotifyV0,
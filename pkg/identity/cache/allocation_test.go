@@ -18,6 +18,9 @@ package cache
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
 	"time"
 
 	"github.com/cilium/cilium/pkg/allocator"
@@ -28,6 +31,7 @@ import (
 	"github.com/cilium/cilium/pkg/kvstore"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/option"
 	fakeConfig "github.com/cilium/cilium/pkg/option/fake"
 
 	. "gopkg.in/check.v1"
@@ -88,6 +92,202 @@ func (s *IdentityCacheTestSuite) TestAllocateIdentityReserved(c *C) {
 	c.Assert(isNew, Equals, false)
 }
 
+func (s *IdentityCacheTestSuite) TestAllocateIdentities(c *C) {
+	lbls1 := labels.NewLabelsFromSortedList("cidr:192.0.2.3/32")
+	lbls2 := labels.NewLabelsFromSortedList("cidr:192.0.2.4/32")
+	lblsHost := labels.Labels{
+		labels.IDNameHost: labels.NewLabel(labels.IDNameHost, "", labels.LabelSourceReserved),
+	}
+
+	owner := newDummyOwner()
+	mgr := NewCachingIdentityAllocator(owner)
+	<-mgr.InitIdentityAllocator(nil, nil)
+
+	ids, isNew, err := mgr.AllocateIdentities(context.Background(), nil, false)
+	c.Assert(err, IsNil)
+	c.Assert(ids, HasLen, 0)
+	c.Assert(isNew, HasLen, 0)
+
+	// Duplicate label sets in the batch are reference counted once per
+	// occurrence, just like repeated AllocateIdentity calls.
+	ids, isNew, err = mgr.AllocateIdentities(context.Background(),
+		[]labels.Labels{lbls1, lbls2, lbls1, lblsHost}, true)
+	c.Assert(err, IsNil)
+	c.Assert(ids, HasLen, 4)
+	c.Assert(isNew, HasLen, 4)
+
+	c.Assert(ids[0].ID, Equals, ids[2].ID)
+	c.Assert(ids[0].ID.HasLocalScope(), Equals, true)
+	c.Assert(ids[1].ID.HasLocalScope(), Equals, true)
+	c.Assert(ids[0].ID, Not(Equals), ids[1].ID)
+	c.Assert(isNew[0] != isNew[2], Equals, true)
+	c.Assert(isNew[1], Equals, true)
+
+	c.Assert(ids[3].ID, Equals, identity.ReservedIdentityHost)
+	c.Assert(isNew[3], Equals, false)
+
+	c.Assert(owner.WaitUntilID(ids[1].ID), Not(Equals), 0)
+	c.Assert(owner.GetIdentity(ids[0].ID), checker.DeepEquals, lbls1.LabelArray())
+	c.Assert(owner.GetIdentity(ids[1].ID), checker.DeepEquals, lbls2.LabelArray())
+
+	// Release once per occurrence; lbls1 is freed by the second release only.
+	released, err := mgr.Release(context.Background(), ids[0])
+	c.Assert(err, IsNil)
+	c.Assert(released, Equals, false)
+	released, err = mgr.Release(context.Background(), ids[2])
+	c.Assert(err, IsNil)
+	c.Assert(released, Equals, true)
+	released, err = mgr.Release(context.Background(), ids[1])
+	c.Assert(err, IsNil)
+	c.Assert(released, Equals, true)
+
+	c.Assert(owner.WaitUntilID(ids[1].ID), Not(Equals), 0)
+	c.Assert(owner.GetIdentity(ids[0].ID), IsNil)
+	c.Assert(owner.GetIdentity(ids[1].ID), IsNil)
+}
+
+func (s *IdentityCacheTestSuite) TestCheckpointRestore(c *C) {
+	lbls1 := labels.NewLabelsFromSortedList("cidr:192.0.2.3/32")
+	lbls2 := labels.NewLabelsFromSortedList("cidr:192.0.2.4/32")
+
+	oldStateDir := option.Config.StateDir
+	option.Config.StateDir = c.MkDir()
+	defer func() { option.Config.StateDir = oldStateDir }()
+
+	mgr := NewCachingIdentityAllocator(newDummyOwner())
+	<-mgr.InitIdentityAllocator(nil, nil)
+
+	id1, _, err := mgr.AllocateIdentity(context.Background(), lbls1, false)
+	c.Assert(err, IsNil)
+	id2, _, err := mgr.AllocateIdentity(context.Background(), lbls2, false)
+	c.Assert(err, IsNil)
+	c.Assert(mgr.checkpoint(context.Background()), IsNil)
+
+	// Simulate a restarted agent with a fresh allocator.
+	owner := newDummyOwner()
+	mgr2 := NewCachingIdentityAllocator(owner)
+	<-mgr2.InitIdentityAllocator(nil, nil)
+
+	restored, err := mgr2.RestoreLocalIdentities()
+	c.Assert(err, IsNil)
+	c.Assert(restored, HasLen, 2)
+
+	// The same labels must resolve to their previous numeric identities.
+	id, isNew, err := mgr2.AllocateIdentity(context.Background(), lbls1, false)
+	c.Assert(err, IsNil)
+	c.Assert(isNew, Equals, false)
+	c.Assert(id.ID, Equals, id1.ID)
+	c.Assert(owner.WaitUntilID(id1.ID), Not(Equals), 0)
+	c.Assert(owner.GetIdentity(id1.ID), checker.DeepEquals, lbls1.LabelArray())
+
+	// Releasing the restored references deallocates identities which were
+	// not re-allocated since the restart.
+	mgr2.ReleaseRestoredIdentities()
+	c.Assert(mgr2.LookupIdentityByID(context.TODO(), id1.ID), Not(IsNil))
+	c.Assert(mgr2.LookupIdentityByID(context.TODO(), id2.ID), IsNil)
+
+	// A corrupted checkpoint file is detected and ignored.
+	err = ioutil.WriteFile(checkpointPath(), []byte("{invalid"), 0600)
+	c.Assert(err, IsNil)
+	mgr3 := NewCachingIdentityAllocator(newDummyOwner())
+	<-mgr3.InitIdentityAllocator(nil, nil)
+	restored, err = mgr3.RestoreLocalIdentities()
+	c.Assert(err, Not(IsNil))
+	c.Assert(restored, HasLen, 0)
+}
+
+func (s *IdentityCacheTestSuite) TestAuditLocalIdentities(c *C) {
+	lbls1 := labels.NewLabelsFromSortedList("cidr:192.0.2.3/32")
+	lbls2 := labels.NewLabelsFromSortedList("cidr:192.0.2.4/32")
+
+	mgr := NewCachingIdentityAllocator(newDummyOwner())
+	<-mgr.InitIdentityAllocator(nil, nil)
+
+	// id1 is referenced by a user, id2 leaks: its endpoint create failed
+	// after identity allocation and the error path never released it.
+	id1, _, err := mgr.AllocateIdentity(context.Background(), lbls1, false)
+	c.Assert(err, IsNil)
+	id2, _, err := mgr.AllocateIdentity(context.Background(), lbls2, false)
+	c.Assert(err, IsNil)
+	_, _, err = mgr.AllocateIdentity(context.Background(), lbls2, false)
+	c.Assert(err, IsNil)
+
+	inUse := func(id identity.NumericIdentity) bool { return id == id1.ID }
+
+	// Without repair the leak is only reported.
+	leaked, err := mgr.AuditLocalIdentities(context.Background(), inUse, false)
+	c.Assert(err, IsNil)
+	c.Assert(leaked, HasLen, 1)
+	c.Assert(leaked[0].ID, Equals, id2.ID)
+	c.Assert(mgr.LookupIdentityByID(context.TODO(), id2.ID), Not(IsNil))
+
+	// With repair all leaked references are released, including multiple
+	// references to the same identity.
+	leaked, err = mgr.AuditLocalIdentities(context.Background(), inUse, true)
+	c.Assert(err, IsNil)
+	c.Assert(leaked, HasLen, 1)
+	c.Assert(mgr.LookupIdentityByID(context.TODO(), id2.ID), IsNil)
+	c.Assert(mgr.LookupIdentityByID(context.TODO(), id1.ID), Not(IsNil))
+
+	// A clean allocator reports no leaks.
+	leaked, err = mgr.AuditLocalIdentities(context.Background(), inUse, false)
+	c.Assert(err, IsNil)
+	c.Assert(leaked, HasLen, 0)
+
+	_, err = mgr.Release(context.Background(), id1)
+	c.Assert(err, IsNil)
+}
+
+// benchmarkCIDRLabels returns 'n' distinct label sets which are allocated
+// from the local identity scope, so that the benchmarks below do not require
+// a kvstore.
+func benchmarkCIDRLabels(n int) []labels.Labels {
+	labelsList := make([]labels.Labels, 0, n)
+	for i := 0; i < n; i++ {
+		labelsList = append(labelsList,
+			labels.NewLabelsFromSortedList(fmt.Sprintf("cidr:10.%d.%d.0/24", i/256, i%256)))
+	}
+	return labelsList
+}
+
+func BenchmarkAllocateIdentity(b *testing.B) {
+	mgr := NewCachingIdentityAllocator(newDummyOwner())
+	<-mgr.InitIdentityAllocator(nil, nil)
+	labelsList := benchmarkCIDRLabels(512)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ids := make([]*identity.Identity, 0, len(labelsList))
+		for _, lbls := range labelsList {
+			id, _, err := mgr.AllocateIdentity(context.Background(), lbls, false)
+			if err != nil {
+				b.Fatal(err)
+			}
+			ids = append(ids, id)
+		}
+		b.StopTimer()
+		mgr.ReleaseSlice(context.Background(), nil, ids)
+		b.StartTimer()
+	}
+}
+
+func BenchmarkAllocateIdentities(b *testing.B) {
+	mgr := NewCachingIdentityAllocator(newDummyOwner())
+	<-mgr.InitIdentityAllocator(nil, nil)
+	labelsList := benchmarkCIDRLabels(512)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ids, _, err := mgr.AllocateIdentities(context.Background(), labelsList, false)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StopTimer()
+		mgr.ReleaseSlice(context.Background(), nil, ids)
+		b.StartTimer()
+	}
+}
+
 type IdentityAllocatorSuite struct{}
 
 type IdentityAllocatorEtcdSuite struct {
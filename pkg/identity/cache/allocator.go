@@ -18,8 +18,10 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sync"
 
 	"github.com/cilium/cilium/pkg/allocator"
+	"github.com/cilium/cilium/pkg/controller"
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/idpool"
 	clientset "github.com/cilium/cilium/pkg/k8s/client/clientset/versioned"
@@ -93,6 +95,16 @@ type CachingIdentityAllocator struct {
 
 	identitiesPath string
 
+	// restoredIdentities are the local identities restored from the
+	// checkpoint file, each holding one reference until the agent has
+	// finished restoring its state and ReleaseRestoredIdentities is
+	// called.
+	restoredIdentities map[identity.NumericIdentity]*identity.Identity
+
+	// controllers runs the controller persisting the local identity
+	// allocator state whenever local identities change.
+	controllers *controller.Manager
+
 	// setupMutex synchronizes InitIdentityAllocator() and Close()
 	setupMutex lock.Mutex
 
@@ -247,6 +259,7 @@ func NewCachingIdentityAllocator(owner IdentityAllocatorOwner) *CachingIdentityA
 		owner:                              owner,
 		identitiesPath:                     IdentitiesPath,
 		watcher:                            watcher,
+		controllers:                        controller.NewManager(),
 	}
 	return mgr
 }
@@ -319,6 +332,16 @@ func (m *CachingIdentityAllocator) AllocateIdentity(ctx context.Context, lbls la
 			}
 		}
 	}()
+
+	id, allocated, isNewLocally, err = m.allocateIdentity(ctx, lbls)
+	return id, allocated, err
+}
+
+// allocateIdentity resolves or allocates a single identity without updating
+// any metrics and without notifying the owner. It is shared by
+// AllocateIdentity and AllocateIdentities, which perform that accounting
+// themselves.
+func (m *CachingIdentityAllocator) allocateIdentity(ctx context.Context, lbls labels.Labels) (id *identity.Identity, allocated, isNewLocally bool, err error) {
 	if option.Config.Debug {
 		log.WithFields(logrus.Fields{
 			logfields.IdentityLabels: lbls.String(),
@@ -335,28 +358,32 @@ func (m *CachingIdentityAllocator) AllocateIdentity(ctx context.Context, lbls la
 				"isNew":                  false,
 			}).Debug("Resolved reserved identity")
 		}
-		return reservedIdentity, false, nil
+		return reservedIdentity, false, false, nil
 	}
 
 	if !identity.RequiresGlobalIdentity(lbls) {
 		<-m.localIdentityAllocatorInitialized
-		return m.localIdentities.lookupOrCreate(lbls)
+		id, allocated, err = m.localIdentities.lookupOrCreate(lbls)
+		if allocated {
+			m.triggerCheckpoint()
+		}
+		return id, allocated, false, err
 	}
 
 	// This will block until the kvstore can be accessed and all identities
 	// were successfully synced
 	err = m.WaitForInitialGlobalIdentities(ctx)
 	if err != nil {
-		return nil, false, err
+		return nil, false, false, err
 	}
 
 	if m.IdentityAllocator == nil {
-		return nil, false, fmt.Errorf("allocator not initialized")
+		return nil, false, false, fmt.Errorf("allocator not initialized")
 	}
 
 	idp, isNew, isNewLocally, err := m.IdentityAllocator.Allocate(ctx, GlobalIdentity{lbls.LabelArray()})
 	if err != nil {
-		return nil, false, err
+		return nil, false, false, err
 	}
 
 	if option.Config.Debug {
@@ -368,7 +395,83 @@ func (m *CachingIdentityAllocator) AllocateIdentity(ctx context.Context, lbls la
 		}).Debug("Resolved identity")
 	}
 
-	return identity.NewIdentity(identity.NumericIdentity(idp), lbls), isNew, nil
+	return identity.NewIdentity(identity.NumericIdentity(idp), lbls), isNew, isNewLocally, nil
+}
+
+// identityAllocationBatchWorkers bounds the number of concurrent backend
+// round trips performed by AllocateIdentities. Each global identity
+// allocation is a kvstore transaction or a CRD write, so a burst of new
+// identities is limited by this many round trip times instead of one per
+// identity.
+const identityAllocationBatchWorkers = 16
+
+// AllocateIdentities allocates an identity for each of the given label sets.
+// It is equivalent to calling AllocateIdentity once per element of
+// 'labelsList', including the reference counting for duplicate label sets,
+// but performs the allocator round trips concurrently so that e.g. a DNS
+// response with hundreds of new IPs does not pay the backend latency once
+// per identity.
+//
+// The returned slices are indexed like 'labelsList'; the boolean slice
+// reports which identities were newly allocated. If any allocation fails,
+// all identities allocated for the batch are released again and only the
+// error is returned. When 'notifyOwner' is true, the owner is notified of
+// all newly allocated identities in a single update.
+func (m *CachingIdentityAllocator) AllocateIdentities(ctx context.Context, labelsList []labels.Labels, notifyOwner bool) ([]*identity.Identity, []bool, error) {
+	ids := make([]*identity.Identity, len(labelsList))
+	allocated := make([]bool, len(labelsList))
+	isNewLocally := make([]bool, len(labelsList))
+	errs := make([]error, len(labelsList))
+
+	workers := identityAllocationBatchWorkers
+	if len(labelsList) < workers {
+		workers = len(labelsList)
+	}
+
+	indices := make(chan int, len(labelsList))
+	for i := range labelsList {
+		indices <- i
+	}
+	close(indices)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				ids[i], allocated[i], isNewLocally[i], errs[i] = m.allocateIdentity(ctx, labelsList[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Account for the successful allocations before a possible rollback so
+	// that the releases below balance the metric out again.
+	for i := range ids {
+		if errs[i] == nil && (allocated[i] || isNewLocally[i]) {
+			metrics.Identity.Inc()
+		}
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			m.ReleaseSlice(context.Background(), nil, ids)
+			return nil, nil, err
+		}
+	}
+
+	added := IdentityCache{}
+	for i, id := range ids {
+		if allocated[i] {
+			added[id.ID] = id.LabelArray
+		}
+	}
+	if notifyOwner && len(added) > 0 {
+		m.owner.UpdateIdentities(added, nil)
+	}
+
+	return ids, allocated, nil
 }
 
 // Release is the reverse operation of AllocateIdentity() and releases the
@@ -396,6 +499,9 @@ func (m *CachingIdentityAllocator) Release(ctx context.Context, id *identity.Ide
 			}
 			m.owner.UpdateIdentities(nil, deleted)
 		}
+		if lastUse {
+			m.triggerCheckpoint()
+		}
 		return lastUse, nil
 	}
 
@@ -0,0 +1,84 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
+
+	"github.com/sirupsen/logrus"
+)
+
+// AuditLocalIdentities cross-checks all allocated local identities against
+// the set of identities reported in use by inUse and returns the identities
+// which are held by the allocator but have no known user, sorted by numeric
+// identity. Such identities are the result of reference count leaks on error
+// paths, e.g. a failed endpoint creation which never released the identity it
+// allocated.
+//
+// Identities restored from a checkpoint are deliberately held without a user
+// until endpoint restoration has completed and are not reported.
+//
+// If repair is true, all references to the leaked identities are released.
+// Only local identities are audited as the reference counts of identities
+// allocated in the kvstore are shared with remote nodes and cannot be
+// cross-checked against local users.
+func (m *CachingIdentityAllocator) AuditLocalIdentities(ctx context.Context, inUse func(identity.NumericIdentity) bool, repair bool) ([]*identity.Identity, error) {
+	leaked := []*identity.Identity{}
+	for numericIdentity, id := range m.localIdentities.GetIdentities() {
+		if inUse(numericIdentity) {
+			continue
+		}
+		if _, restored := m.restoredIdentities[numericIdentity]; restored {
+			continue
+		}
+		leaked = append(leaked, id)
+	}
+	sort.Slice(leaked, func(i, j int) bool { return leaked[i].ID < leaked[j].ID })
+
+	metrics.IdentityUnreferenced.Set(float64(len(leaked)))
+
+	for _, id := range leaked {
+		log.WithFields(logrus.Fields{
+			logfields.Identity:       id.ID,
+			logfields.IdentityLabels: id.Labels,
+		}).Warning("Identity allocated but no longer referenced by any endpoint, ipcache entry or selector")
+	}
+
+	if !repair {
+		return leaked, nil
+	}
+
+	for _, id := range leaked {
+		// Release all references held for the identity. The lookup
+		// guards against releasing an identity which has been released
+		// or re-allocated concurrently.
+		for m.localIdentities.lookupByID(id.ID) != nil {
+			released, err := m.Release(ctx, id)
+			if err != nil {
+				return leaked, err
+			}
+			if released {
+				break
+			}
+		}
+	}
+
+	return leaked, nil
+}
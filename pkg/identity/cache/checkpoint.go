@@ -0,0 +1,176 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/identity"
+	identitymodel "github.com/cilium/cilium/pkg/identity/model"
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const (
+	// CheckpointFile is the name of the file in the state directory in
+	// which the state of the local identity allocator is persisted across
+	// agent restarts.
+	CheckpointFile = "local_identities.json"
+
+	checkpointControllerName = "local-identity-checkpoint"
+)
+
+// checkpointPath returns the path of the local identity checkpoint file.
+func checkpointPath() string {
+	return filepath.Join(option.Config.StateDir, CheckpointFile)
+}
+
+// triggerCheckpoint schedules a write of the local identity allocator state
+// to the checkpoint file. Consecutive triggers are coalesced by the
+// controller.
+func (m *CachingIdentityAllocator) triggerCheckpoint() {
+	if option.Config.StateDir == "" {
+		return
+	}
+
+	m.controllers.UpdateController(checkpointControllerName, controller.ControllerParams{
+		DoFunc: m.checkpoint,
+	})
+}
+
+// checkpoint writes the state of the local identity allocator to the
+// checkpoint file, so that locally scoped identities, e.g. for CIDRs, keep
+// their numeric identity across an agent restart.
+func (m *CachingIdentityAllocator) checkpoint(ctx context.Context) error {
+	if m.localIdentities == nil {
+		return nil
+	}
+
+	ids := m.localIdentities.GetIdentities()
+	model := make([]*models.Identity, 0, len(ids))
+	for _, id := range ids {
+		model = append(model, identitymodel.CreateModel(id))
+	}
+	sort.Slice(model, func(i, j int) bool { return model[i].ID < model[j].ID })
+
+	jsonData, err := json.Marshal(model)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename it into place so that a crash
+	// while writing never leaves a truncated checkpoint behind.
+	path := checkpointPath()
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, jsonData, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// RestoreLocalIdentities reads the checkpoint file written by a previous run
+// of the agent and re-allocates the local identities recorded in it under
+// their previous numeric identity. It must be called before local identities
+// are allocated for other purposes, e.g. before the ipcache is populated, so
+// that those allocations observe the restored numeric values and policy maps
+// of long-lived connections remain stable across the restart.
+//
+// Checkpoint entries which are no longer valid, e.g. because the labels now
+// resolve to a reserved or global identity, are skipped, as is a checkpoint
+// file that cannot be parsed; both are accounted for in the
+// identity_checkpoint_restore_errors_total metric.
+//
+// Each restored identity is held with one reference until
+// ReleaseRestoredIdentities is called.
+func (m *CachingIdentityAllocator) RestoreLocalIdentities() (map[identity.NumericIdentity]*identity.Identity, error) {
+	if option.Config.StateDir == "" {
+		return nil, nil
+	}
+
+	<-m.localIdentityAllocatorInitialized
+
+	path := checkpointPath()
+	jsonData, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		log.Debug("No local identity checkpoint file found, skipping restore")
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var model []*models.Identity
+	if err := json.Unmarshal(jsonData, &model); err != nil {
+		metrics.IdentityCheckpointRestoreErrors.Inc()
+		return nil, fmt.Errorf("corrupted local identity checkpoint %s: %s", path, err)
+	}
+
+	restored := make(map[identity.NumericIdentity]*identity.Identity, len(model))
+	skipped := 0
+	for _, mdl := range model {
+		nid := identity.NumericIdentity(mdl.ID)
+		lbls := labels.NewLabelsFromModel(mdl.Labels)
+
+		// Validate the entry against the current labels: only labels
+		// which still allocate from the local scope may be restored.
+		if !nid.HasLocalScope() ||
+			identity.RequiresGlobalIdentity(lbls) ||
+			identity.LookupReservedIdentityByLabels(lbls) != nil {
+			skipped++
+			continue
+		}
+
+		id := identity.NewIdentity(nid, lbls)
+		if !m.localIdentities.restore(id) {
+			skipped++
+			continue
+		}
+
+		metrics.Identity.Inc()
+		restored[nid] = id
+	}
+
+	if skipped > 0 {
+		metrics.IdentityCheckpointRestoreErrors.Add(float64(skipped))
+		log.WithField("skipped", skipped).Warning("Ignored invalid entries in the local identity checkpoint")
+	}
+	log.WithField("restored", len(restored)).Info("Restored local identities from checkpoint")
+
+	m.restoredIdentities = restored
+	return restored, nil
+}
+
+// ReleaseRestoredIdentities releases the references held on the identities
+// restored by RestoreLocalIdentities. It must be called once the agent has
+// finished restoring its state, so that restored identities which are no
+// longer referenced are deallocated again.
+func (m *CachingIdentityAllocator) ReleaseRestoredIdentities() {
+	for _, id := range m.restoredIdentities {
+		if _, err := m.Release(context.Background(), id); err != nil {
+			log.WithError(err).WithField(logfields.Identity, id.ID).
+				Warning("Unable to release restored identity")
+		}
+	}
+	m.restoredIdentities = nil
+}
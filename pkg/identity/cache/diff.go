@@ -0,0 +1,72 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"github.com/cilium/cilium/pkg/labels"
+)
+
+// Diff compares the incoming identity state against the current cache and
+// returns the increments to forward to consumers such as the SelectorCache:
+// identities only present in 'incoming' are reported as added, identities
+// only present in 'c' are reported as deleted, and identities present in
+// both but with a different label set are reported as added with their new
+// labels. Identities whose normalized label sets are equal are elided, so a
+// full state replace no longer causes recomputation for unchanged
+// identities. The returned sets are disjoint as UpdateIdentities expects.
+func (c IdentityCache) Diff(incoming IdentityCache) (added, deleted IdentityCache) {
+	added = IdentityCache{}
+	deleted = IdentityCache{}
+
+	for id, lbls := range incoming {
+		current, exists := c[id]
+		if !exists || !sameLabels(current, lbls) {
+			added[id] = lbls
+		}
+	}
+	for id, lbls := range c {
+		if _, exists := incoming[id]; !exists {
+			deleted[id] = lbls
+		}
+	}
+
+	return added, deleted
+}
+
+// sameLabels compares two label arrays on their normalized, i.e. sorted,
+// label sets. Identity labels are sorted for the kvstore, so the cheap
+// order-sensitive comparison settles almost all cases; the sorted comparison
+// only runs for arrays differing solely in order.
+func sameLabels(a, b labels.LabelArray) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	if a.Equals(b) {
+		return true
+	}
+	return a.DeepCopy().Sort().Equals(b.DeepCopy().Sort())
+}
+
+// ReplaceIdentityState diffs the incoming full identity state against the
+// current identity cache and notifies the owner of the resulting increments
+// only. It is intended for watch paths which receive complete snapshots,
+// where replacing the cache wholesale would trigger downstream recomputation
+// even for identities that did not change.
+func (m *CachingIdentityAllocator) ReplaceIdentityState(incoming IdentityCache) {
+	added, deleted := m.GetIdentityCache().Diff(incoming)
+	if len(added)+len(deleted) > 0 {
+		m.owner.UpdateIdentities(added, deleted)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package cache
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cilium/cilium/pkg/checker"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/labels"
+
+	. "gopkg.in/check.v1"
+)
+
+func testLabelArray(v string) labels.LabelArray {
+	return labels.NewLabelsFromModel([]string{
+		"k8s:app=" + v,
+		"k8s:io.kubernetes.pod.namespace=default",
+	}).LabelArray()
+}
+
+func (s *IdentityCacheTestSuite) TestDiff(c *C) {
+	current := IdentityCache{
+		1000: testLabelArray("unchanged"),
+		1001: testLabelArray("changed"),
+		1002: testLabelArray("removed"),
+	}
+	incoming := IdentityCache{
+		1000: testLabelArray("unchanged"),
+		1001: testLabelArray("changed-new"),
+		1003: testLabelArray("added"),
+	}
+
+	added, deleted := current.Diff(incoming)
+
+	c.Assert(added, HasLen, 2)
+	c.Assert(added[1001], checker.DeepEquals, incoming[1001])
+	c.Assert(added[1003], checker.DeepEquals, incoming[1003])
+	c.Assert(deleted, HasLen, 1)
+	c.Assert(deleted[1002], checker.DeepEquals, current[1002])
+}
+
+func (s *IdentityCacheTestSuite) TestDiffNoChanges(c *C) {
+	current := IdentityCache{
+		1000: testLabelArray("foo"),
+		1001: testLabelArray("bar"),
+	}
+	incoming := IdentityCache{
+		1000: testLabelArray("foo"),
+		1001: testLabelArray("bar"),
+	}
+
+	added, deleted := current.Diff(incoming)
+	c.Assert(added, HasLen, 0)
+	c.Assert(deleted, HasLen, 0)
+}
+
+func (s *IdentityCacheTestSuite) TestDiffLabelOrder(c *C) {
+	lbls := testLabelArray("foo")
+	reversed := make(labels.LabelArray, 0, len(lbls))
+	for i := len(lbls) - 1; i >= 0; i-- {
+		reversed = append(reversed, lbls[i])
+	}
+
+	current := IdentityCache{1000: lbls}
+	incoming := IdentityCache{1000: reversed}
+
+	// The same label set in a different order is not a change.
+	added, deleted := current.Diff(incoming)
+	c.Assert(added, HasLen, 0)
+	c.Assert(deleted, HasLen, 0)
+}
+
+func BenchmarkDiff100k(b *testing.B) {
+	const numIdentities = 100000
+
+	current := make(IdentityCache, numIdentities)
+	for i := 0; i < numIdentities; i++ {
+		current[identity.NumericIdentity(i)] = testLabelArray(fmt.Sprintf("app-%d", i))
+	}
+
+	// Incoming state differs in 10 identities: 5 changed label sets and
+	// 5 new identities.
+	incoming := make(IdentityCache, numIdentities+5)
+	for id, lbls := range current {
+		incoming[id] = lbls
+	}
+	for i := 0; i < 5; i++ {
+		incoming[identity.NumericIdentity(i)] = testLabelArray(fmt.Sprintf("app-%d-new", i))
+	}
+	for i := numIdentities; i < numIdentities+5; i++ {
+		incoming[identity.NumericIdentity(i)] = testLabelArray(fmt.Sprintf("app-%d", i))
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		added, deleted := current.Diff(incoming)
+		if len(added) != 10 || len(deleted) != 0 {
+			b.Fatalf("unexpected diff: %d added, %d deleted", len(added), len(deleted))
+		}
+	}
+}
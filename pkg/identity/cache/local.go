@@ -113,6 +113,43 @@ func (l *localIdentityCache) lookupOrCreate(lbls labels.Labels) (*identity.Ident
 	return id, true, nil
 }
 
+// restore adds an identity restored from a checkpoint to the cache, with a
+// single reference. It fails when the numeric identity is outside of the
+// local allocation range, or when either the numeric identity or the labels
+// are already in use, so that a checkpoint entry can never displace an
+// identity allocated in the meantime. Returns true if the identity was added.
+func (l *localIdentityCache) restore(id *identity.Identity) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	unscopedID := id.ID &^ identity.LocalIdentityFlag
+	if !id.ID.HasLocalScope() || unscopedID < l.minID || unscopedID > l.maxID {
+		return false
+	}
+
+	stringRepresentation := string(id.Labels.SortedList())
+	if _, ok := l.identitiesByLabels[stringRepresentation]; ok {
+		return false
+	}
+	if _, ok := l.identitiesByID[id.ID]; ok {
+		return false
+	}
+
+	id.ReferenceCount = 1
+	l.identitiesByLabels[stringRepresentation] = id
+	l.identitiesByID[id.ID] = id
+
+	if l.events != nil {
+		l.events <- allocator.AllocatorEvent{
+			Typ: kvstore.EventTypeCreate,
+			ID:  idpool.ID(id.ID),
+			Key: GlobalIdentity{id.LabelArray},
+		}
+	}
+
+	return true
+}
+
 // release releases a local identity from the cache. true is returned when the
 // last use of the identity has been released and the identity has been
 // forgotten.
@@ -15,6 +15,8 @@
 package identitymanager
 
 import (
+	"sort"
+
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/identity/model"
@@ -202,6 +204,18 @@ func (idm *IdentityManager) subscribe(o Observer) {
 	idm.mutex.Lock()
 	defer idm.mutex.Unlock()
 	idm.observers[o] = struct{}{}
+
+	// Replay the identities that are already in use to the new observer,
+	// in ascending numeric order for determinism, so that observers
+	// subscribing late do not have to rebuild that state separately.
+	ids := make([]identity.NumericIdentity, 0, len(idm.identities))
+	for nid := range idm.identities {
+		ids = append(ids, nid)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, nid := range ids {
+		o.LocalEndpointIdentityAdded(idm.identities[nid].identity)
+	}
 }
 
 // GetIdentityModels returns the API model of all identities in the
@@ -221,7 +235,9 @@ func (s IdentitiesModel) Less(i, j int) bool {
 }
 
 // Subscribe adds the specified Observer to the global identity manager, to be
-// notified upon changes to local identity usage.
+// notified upon changes to local identity usage. The identities currently in
+// use are synchronously replayed to the observer before any further events
+// are delivered.
 func Subscribe(o Observer) {
 	GlobalIdentityManager.subscribe(o)
 }
@@ -182,6 +182,33 @@ func (s *IdentityManagerTestSuite) TestLocalEndpointIdentityAdded(c *C) {
 	c.Assert(observer, checker.DeepEquals, expectedObserver)
 }
 
+func (s *IdentityManagerTestSuite) TestSubscribeReplay(c *C) {
+	idm := NewIdentityManager()
+	idm.Add(fooIdentity)
+	idm.Add(fooIdentity)
+	idm.Add(barIdentity)
+
+	// A late subscriber synchronously receives the identities already in
+	// use, in ascending numeric order and once each regardless of their
+	// reference count.
+	observer := newIdentityManagerObserver([]identity.NumericIdentity{}, []identity.NumericIdentity{})
+	idm.subscribe(observer)
+	expectedObserver := newIdentityManagerObserver([]identity.NumericIdentity{fooIdentity.ID, barIdentity.ID}, []identity.NumericIdentity{})
+	c.Assert(observer, checker.DeepEquals, expectedObserver)
+
+	// Later events are delivered as usual.
+	idm.Remove(barIdentity)
+	expectedObserver = newIdentityManagerObserver([]identity.NumericIdentity{fooIdentity.ID, barIdentity.ID}, []identity.NumericIdentity{barIdentity.ID})
+	c.Assert(observer, checker.DeepEquals, expectedObserver)
+
+	// An empty manager replays nothing.
+	idm = NewIdentityManager()
+	observer = newIdentityManagerObserver([]identity.NumericIdentity{}, []identity.NumericIdentity{})
+	idm.subscribe(observer)
+	expectedObserver = newIdentityManagerObserver([]identity.NumericIdentity{}, []identity.NumericIdentity{})
+	c.Assert(observer, checker.DeepEquals, expectedObserver)
+}
+
 func (s *IdentityManagerTestSuite) TestLocalEndpointIdentityRemoved(c *C) {
 	idm := NewIdentityManager()
 	c.Assert(idm.identities, NotNil)
@@ -21,8 +21,10 @@ import (
 // Observer can sign up to receive events whenever local identities are removed.
 type Observer interface {
 	// LocalEndpointIdentityAdded is called when an identity first becomes
-	// used on the node. Implementations must ensure that the callback
-	// returns within a reasonable period.
+	// used on the node, and for each identity already in use when the
+	// observer subscribes. Implementations must be idempotent to adds for
+	// identities they already know about, and must ensure that the
+	// callback returns within a reasonable period.
 	LocalEndpointIdentityAdded(*identity.Identity)
 
 	// LocalEndpointIdentityRemoved is called when an identity is no longer
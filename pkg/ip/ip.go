@@ -899,3 +899,69 @@ func GetIPFromListByFamily(ipList []net.IP, v4Family bool) net.IP {
 
 	return nil
 }
+
+// prefixContains returns true if 'inner' is fully contained within 'outer',
+// including the case of both prefixes being equal.
+func prefixContains(outer, inner *net.IPNet) bool {
+	outerOnes, outerBits := outer.Mask.Size()
+	innerOnes, innerBits := inner.Mask.Size()
+	return outerBits == innerBits && outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+// AggregatePrefixes coalesces the given prefixes into the minimal equivalent
+// set of covering prefixes, merging adjacent prefixes and removing prefixes
+// contained in others. It returns the covering prefixes together with, for
+// each of them, the input prefixes it aggregates. Duplicate input prefixes
+// are aggregated into the same covering prefix only once.
+//
+// The output is sorted by address family, network address and prefix length,
+// so it is deterministic for any ordering of the same input set.
+func AggregatePrefixes(prefixes []*net.IPNet) ([]*net.IPNet, [][]*net.IPNet) {
+	// Deduplicate the input so that each constituent is reported once.
+	seen := make(map[string]struct{}, len(prefixes))
+	uniquePrefixes := make([]*net.IPNet, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if prefix == nil {
+			continue
+		}
+		prefixStr := prefix.String()
+		if _, ok := seen[prefixStr]; ok {
+			continue
+		}
+		seen[prefixStr] = struct{}{}
+		uniquePrefixes = append(uniquePrefixes, prefix)
+	}
+
+	sortPrefixes := func(nets []*net.IPNet) {
+		sort.Slice(nets, func(i, j int) bool {
+			iOnes, iBits := nets[i].Mask.Size()
+			jOnes, jBits := nets[j].Mask.Size()
+			if iBits != jBits {
+				return iBits < jBits
+			}
+			if cmp := bytes.Compare(nets[i].IP.To16(), nets[j].IP.To16()); cmp != 0 {
+				return cmp < 0
+			}
+			return iOnes < jOnes
+		})
+	}
+
+	v4, v6 := CoalesceCIDRs(uniquePrefixes)
+	aggregated := append(v4, v6...)
+	sortPrefixes(aggregated)
+
+	// The covering prefixes are disjoint, so each input prefix is
+	// aggregated by exactly one of them.
+	constituents := make([][]*net.IPNet, len(aggregated))
+	sortPrefixes(uniquePrefixes)
+	for _, prefix := range uniquePrefixes {
+		for i, cover := range aggregated {
+			if prefixContains(cover, prefix) {
+				constituents[i] = append(constituents[i], prefix)
+				break
+			}
+		}
+	}
+
+	return aggregated, constituents
+}
@@ -449,6 +449,55 @@ func (s *IPTestSuite) TestCoalesceCIDRs(c *C) {
 	s.testIPNetsEqual(mergedV6CIDRs, expected, c)
 }
 
+func (s *IPTestSuite) TestAggregatePrefixes(c *C) {
+	cidrs := []*net.IPNet{
+		createIPNet("192.0.2.3", 32, int(ipv4BitLen)),
+		createIPNet("192.0.2.1", 32, int(ipv4BitLen)),
+		createIPNet("192.0.2.0", 32, int(ipv4BitLen)),
+		createIPNet("192.0.2.2", 32, int(ipv4BitLen)),
+		createIPNet("192.0.2.2", 32, int(ipv4BitLen)), // duplicate
+		createIPNet("192.0.3.1", 32, int(ipv4BitLen)),
+		createIPNet("fd00::1", 128, int(ipv6BitLen)),
+	}
+	aggregated, constituents := AggregatePrefixes(cidrs)
+	expected := []*net.IPNet{
+		createIPNet("192.0.2.0", 30, int(ipv4BitLen)),
+		createIPNet("192.0.3.1", 32, int(ipv4BitLen)),
+		createIPNet("fd00::1", 128, int(ipv6BitLen)),
+	}
+	s.testIPNetsEqual(aggregated, expected, c)
+	c.Assert(constituents, HasLen, 3)
+	s.testIPNetsEqual(constituents[0], []*net.IPNet{
+		createIPNet("192.0.2.0", 32, int(ipv4BitLen)),
+		createIPNet("192.0.2.1", 32, int(ipv4BitLen)),
+		createIPNet("192.0.2.2", 32, int(ipv4BitLen)),
+		createIPNet("192.0.2.3", 32, int(ipv4BitLen)),
+	}, c)
+	s.testIPNetsEqual(constituents[1], []*net.IPNet{createIPNet("192.0.3.1", 32, int(ipv4BitLen))}, c)
+	s.testIPNetsEqual(constituents[2], []*net.IPNet{createIPNet("fd00::1", 128, int(ipv6BitLen))}, c)
+
+	// Contained prefixes are aggregated into the containing one.
+	cidrs = []*net.IPNet{
+		createIPNet("192.0.2.16", 32, int(ipv4BitLen)),
+		createIPNet("192.0.2.0", 24, int(ipv4BitLen)),
+	}
+	aggregated, constituents = AggregatePrefixes(cidrs)
+	s.testIPNetsEqual(aggregated, []*net.IPNet{createIPNet("192.0.2.0", 24, int(ipv4BitLen))}, c)
+	c.Assert(constituents, HasLen, 1)
+	s.testIPNetsEqual(constituents[0], []*net.IPNet{
+		createIPNet("192.0.2.0", 24, int(ipv4BitLen)),
+		createIPNet("192.0.2.16", 32, int(ipv4BitLen)),
+	}, c)
+
+	// The output is deterministic regardless of the input ordering.
+	reversed := make([]*net.IPNet, 0, len(cidrs))
+	for i := len(cidrs) - 1; i >= 0; i-- {
+		reversed = append(reversed, cidrs[i])
+	}
+	aggregatedAgain, _ := AggregatePrefixes(reversed)
+	s.testIPNetsEqual(aggregatedAgain, aggregated, c)
+}
+
 func (s *IPTestSuite) TestRangeToCIDRs(c *C) {
 	// IPv4 worst case.
 	ipNets := rangeToCIDRs(net.ParseIP("0.0.0.1"), net.ParseIP("255.255.255.254"))
@@ -581,6 +581,55 @@ func (n *NodesPodCIDRManager) releaseIPNets(nodeName string) bool {
 	return true
 }
 
+// ReleaseSurplusCIDRs releases all but the first podCIDR of each address
+// family allocated to the given node back to the pool and syncs the shrunk
+// podCIDR list into kubernetes. The first CIDR of each family is never
+// released so that pods scheduled onto the node while the release is in
+// progress can still be allocated an IP from it.
+// Returns the number of CIDRs released and the number of CIDRs the node
+// keeps holding.
+func (n *NodesPodCIDRManager) ReleaseSurplusCIDRs(node *v2.CiliumNode) (released, held int) {
+	n.Mutex.Lock()
+	defer n.Mutex.Unlock()
+
+	cidrs, ok := n.nodes[node.GetName()]
+	if !ok {
+		return 0, 0
+	}
+	held = len(cidrs.v4PodCIDRs) + len(cidrs.v6PodCIDRs)
+	if len(cidrs.v4PodCIDRs) <= 1 && len(cidrs.v6PodCIDRs) <= 1 {
+		return 0, held
+	}
+
+	log = log.WithFields(logrus.Fields{
+		"node-name": node.GetName(),
+	})
+
+	if len(cidrs.v4PodCIDRs) > 1 {
+		releaseCIDRs(n.v4CIDRAllocators, cidrs.v4PodCIDRs[1:])
+		released += len(cidrs.v4PodCIDRs) - 1
+		cidrs.v4PodCIDRs = cidrs.v4PodCIDRs[:1]
+	}
+	if len(cidrs.v6PodCIDRs) > 1 {
+		releaseCIDRs(n.v6CIDRAllocators, cidrs.v6PodCIDRs[1:])
+		released += len(cidrs.v6PodCIDRs) - 1
+		cidrs.v6PodCIDRs = cidrs.v6PodCIDRs[:1]
+	}
+	held -= released
+
+	cn := node.DeepCopy()
+	cn.Spec.IPAM.PodCIDRs = make([]string, 0, held)
+	for _, v4CIDR := range cidrs.v4PodCIDRs {
+		cn.Spec.IPAM.PodCIDRs = append(cn.Spec.IPAM.PodCIDRs, v4CIDR.String())
+	}
+	for _, v6CIDR := range cidrs.v6PodCIDRs {
+		cn.Spec.IPAM.PodCIDRs = append(cn.Spec.IPAM.PodCIDRs, v6CIDR.String())
+	}
+	n.syncNode(k8sOpUpdate, cn)
+
+	return released, held
+}
+
 func releaseCIDRs(cidrAllocators []CIDRAllocator, cidrsToRelease []*net.IPNet) {
 	if len(cidrAllocators) == 0 {
 		return
@@ -2098,3 +2098,185 @@ func (s *PodCIDRSuite) TestNewNodesPodCIDRManager(c *C) {
 	time.Sleep(2 * time.Second)
 	c.Assert(onDeleteCalls, Equals, 2)
 }
+
+func (s *PodCIDRSuite) TestNodesPodCIDRManager_ReleaseSurplusCIDRs(c *C) {
+	var reSyncCalls int32
+	type fields struct {
+		k8sReSync        *trigger.Trigger
+		v4ClusterCIDRs   []CIDRAllocator
+		v6ClusterCIDRs   []CIDRAllocator
+		nodes            map[string]*nodeCIDRs
+		ciliumNodesToK8s map[string]*ciliumNodeK8sOp
+	}
+	type args struct {
+		node *v2.CiliumNode
+	}
+	tests := []struct {
+		testSetup    func() *fields
+		testPostRun  func(fields *fields)
+		name         string
+		fields       *fields
+		args         args
+		wantReleased int
+		wantHeld     int
+	}{
+		{
+			name:         "test-1 - should release all but the first CIDR of each family",
+			wantReleased: 2,
+			wantHeld:     2,
+			testSetup: func() *fields {
+				atomic.StoreInt32(&reSyncCalls, 0)
+				return &fields{
+					v4ClusterCIDRs: []CIDRAllocator{
+						&mockCIDRAllocator{
+							OnRelease: func(cidr *net.IPNet) error {
+								c.Assert(cidr, checker.DeepEquals, mustNewCIDRs("10.10.1.0/24")[0])
+								return nil
+							},
+							OnInRange: func(cidr *net.IPNet) bool {
+								return true
+							},
+						},
+					},
+					v6ClusterCIDRs: []CIDRAllocator{
+						&mockCIDRAllocator{
+							OnRelease: func(cidr *net.IPNet) error {
+								c.Assert(cidr, checker.DeepEquals, mustNewCIDRs("fd00:1::/96")[0])
+								return nil
+							},
+							OnInRange: func(cidr *net.IPNet) bool {
+								return true
+							},
+						},
+					},
+					nodes: map[string]*nodeCIDRs{
+						"node-1": {
+							v4PodCIDRs: mustNewCIDRs("10.10.0.0/24", "10.10.1.0/24"),
+							v6PodCIDRs: mustNewCIDRs("fd00::/96", "fd00:1::/96"),
+						},
+					},
+					ciliumNodesToK8s: map[string]*ciliumNodeK8sOp{},
+					k8sReSync: mustNewTrigger(func() {
+						atomic.AddInt32(&reSyncCalls, 1)
+						return
+					}, time.Millisecond),
+				}
+			},
+			testPostRun: func(fields *fields) {
+				time.Sleep(2 * time.Millisecond)
+				c.Assert(fields.nodes, checker.DeepEquals, map[string]*nodeCIDRs{
+					"node-1": {
+						v4PodCIDRs: mustNewCIDRs("10.10.0.0/24"),
+						v6PodCIDRs: mustNewCIDRs("fd00::/96"),
+					},
+				})
+				c.Assert(fields.ciliumNodesToK8s, checker.DeepEquals, map[string]*ciliumNodeK8sOp{
+					"node-1": {
+						ciliumNode: &v2.CiliumNode{
+							ObjectMeta: v1.ObjectMeta{
+								Name: "node-1",
+							},
+							Spec: v2.NodeSpec{
+								IPAM: ipamTypes.IPAMSpec{
+									PodCIDRs: []string{
+										"10.10.0.0/24",
+										"fd00::/96",
+									},
+								},
+							},
+						},
+						op: k8sOpUpdate,
+					},
+				})
+				c.Assert(atomic.LoadInt32(&reSyncCalls), Equals, int32(1))
+			},
+			args: args{
+				node: &v2.CiliumNode{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "node-1",
+					},
+				},
+			},
+		},
+		{
+			name:         "test-2 - should be a no op if the node only holds one CIDR per family",
+			wantReleased: 0,
+			wantHeld:     2,
+			testSetup: func() *fields {
+				atomic.StoreInt32(&reSyncCalls, 0)
+				return &fields{
+					nodes: map[string]*nodeCIDRs{
+						"node-1": {
+							v4PodCIDRs: mustNewCIDRs("10.10.0.0/24"),
+							v6PodCIDRs: mustNewCIDRs("fd00::/96"),
+						},
+					},
+					ciliumNodesToK8s: map[string]*ciliumNodeK8sOp{},
+					k8sReSync: mustNewTrigger(func() {
+						atomic.AddInt32(&reSyncCalls, 1)
+						return
+					}, time.Millisecond),
+				}
+			},
+			testPostRun: func(fields *fields) {
+				time.Sleep(2 * time.Millisecond)
+				c.Assert(fields.nodes, checker.DeepEquals, map[string]*nodeCIDRs{
+					"node-1": {
+						v4PodCIDRs: mustNewCIDRs("10.10.0.0/24"),
+						v6PodCIDRs: mustNewCIDRs("fd00::/96"),
+					},
+				})
+				c.Assert(fields.ciliumNodesToK8s, checker.DeepEquals, map[string]*ciliumNodeK8sOp{})
+				c.Assert(atomic.LoadInt32(&reSyncCalls), Equals, int32(0))
+			},
+			args: args{
+				node: &v2.CiliumNode{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "node-1",
+					},
+				},
+			},
+		},
+		{
+			name:         "test-3 - should be a no op if the node is not allocated",
+			wantReleased: 0,
+			wantHeld:     0,
+			testSetup: func() *fields {
+				atomic.StoreInt32(&reSyncCalls, 0)
+				return &fields{
+					nodes:            map[string]*nodeCIDRs{},
+					ciliumNodesToK8s: map[string]*ciliumNodeK8sOp{},
+				}
+			},
+			testPostRun: func(fields *fields) {
+				c.Assert(fields.ciliumNodesToK8s, checker.DeepEquals, map[string]*ciliumNodeK8sOp{})
+				c.Assert(atomic.LoadInt32(&reSyncCalls), Equals, int32(0))
+			},
+			args: args{
+				node: &v2.CiliumNode{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "node-1",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt.fields = tt.testSetup()
+		n := &NodesPodCIDRManager{
+			k8sReSync:        tt.fields.k8sReSync,
+			v4CIDRAllocators: tt.fields.v4ClusterCIDRs,
+			v6CIDRAllocators: tt.fields.v6ClusterCIDRs,
+			nodes:            tt.fields.nodes,
+			ciliumNodesToK8s: tt.fields.ciliumNodesToK8s,
+		}
+		released, held := n.ReleaseSurplusCIDRs(tt.args.node)
+		c.Assert(released, checker.Equals, tt.wantReleased, Commentf("Test Name: %s", tt.name))
+		c.Assert(held, checker.Equals, tt.wantHeld, Commentf("Test Name: %s", tt.name))
+
+		if tt.testPostRun != nil {
+			tt.testPostRun(tt.fields)
+		}
+	}
+}
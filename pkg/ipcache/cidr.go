@@ -56,6 +56,42 @@ func AllocateCIDRsForIPs(prefixes []net.IP, newlyAllocatedIdentities map[string]
 	return allocateCIDRs(ip.GetCIDRPrefixesFromIPs(prefixes), newlyAllocatedIdentities)
 }
 
+// AllocateAggregatedCIDRsForIPs is like AllocateCIDRsForIPs, but aggregates
+// adjacent and contained prefixes into covering prefixes before allocating
+// identities, so that e.g. a DNS response listing an adjacent range of IPs
+// allocates a single identity instead of one per address.
+//
+// A covering prefix is not used when the ipcache holds an entry which is more
+// specific than it, as a finer-grained policy distinction exists inside the
+// aggregate; the constituent prefixes are allocated individually instead.
+// Since callers re-run this allocation whenever the IPs behind a selector
+// change, an aggregate is expanded back into its constituents at the next
+// update after such a more specific prefix appears.
+//
+// This must only be used when the resulting identities are propagated by
+// their numeric value, as is the case for FQDN selectors. CIDRs referenced
+// directly by policy rules must be allocated unaggregated, as the rule
+// selectors match the exact prefix labels.
+func AllocateAggregatedCIDRsForIPs(ips []net.IP, newlyAllocatedIdentities map[string]*identity.Identity) ([]*identity.Identity, error) {
+	return allocateCIDRs(aggregatePrefixes(ip.GetCIDRPrefixesFromIPs(ips)), newlyAllocatedIdentities)
+}
+
+// aggregatePrefixes coalesces the given prefixes into covering prefixes,
+// falling back to the constituent prefixes of any aggregate within which the
+// ipcache holds a more specific entry.
+func aggregatePrefixes(prefixes []*net.IPNet) []*net.IPNet {
+	aggregated, constituents := ip.AggregatePrefixes(prefixes)
+	result := make([]*net.IPNet, 0, len(aggregated))
+	for i, cover := range aggregated {
+		if len(constituents[i]) > 1 && IPIdentityCache.ContainsMoreSpecificPrefix(cover) {
+			result = append(result, constituents[i]...)
+		} else {
+			result = append(result, cover)
+		}
+	}
+	return result
+}
+
 func UpsertGeneratedIdentities(newlyAllocatedIdentities map[string]*identity.Identity) {
 	for prefixString, id := range newlyAllocatedIdentities {
 		IPIdentityCache.Upsert(prefixString, nil, 0, nil, Identity{
@@ -66,10 +102,6 @@ func UpsertGeneratedIdentities(newlyAllocatedIdentities map[string]*identity.Ide
 }
 
 func allocateCIDRs(prefixes []*net.IPNet, newlyAllocatedIdentities map[string]*identity.Identity) ([]*identity.Identity, error) {
-	// maintain list of used identities to undo on error
-	usedIdentities := make([]*identity.Identity, 0, len(prefixes))
-
-	allocatedIdentities := make(map[string]*identity.Identity, len(prefixes))
 	// Maintain list of newly allocated identities to update ipcache,
 	// but upsert them to ipcache only if no map was given by the caller.
 	upsert := false
@@ -78,34 +110,42 @@ func allocateCIDRs(prefixes []*net.IPNet, newlyAllocatedIdentities map[string]*i
 		newlyAllocatedIdentities = map[string]*identity.Identity{}
 	}
 
+	prefixStrings := make([]string, 0, len(prefixes))
+	labelsList := make([]labels.Labels, 0, len(prefixes))
 	for _, prefix := range prefixes {
 		if prefix == nil {
 			continue
 		}
 
-		prefixStr := prefix.String()
+		prefixStrings = append(prefixStrings, prefix.String())
+		labelsList = append(labelsList, cidr.GetCIDRLabels(prefix))
+	}
 
-		// Figure out if this call needs to be able to update the selector cache synchronously.
-		allocateCtx, cancel := context.WithTimeout(context.Background(), option.Config.IPAllocationTimeout)
-		defer cancel()
+	if len(labelsList) > 0 && IdentityAllocator == nil {
+		return nil, fmt.Errorf("IdentityAllocator not initialized!")
+	}
 
-		if IdentityAllocator == nil {
-			return nil, fmt.Errorf("IdentityAllocator not initialized!")
-		}
-		id, isNew, err := IdentityAllocator.AllocateIdentity(allocateCtx, cidr.GetCIDRLabels(prefix), false)
-		if err != nil {
-			IdentityAllocator.ReleaseSlice(context.Background(), nil, usedIdentities)
-			return nil, fmt.Errorf("failed to allocate identity for cidr %s: %s", prefixStr, err)
-		}
+	// Figure out if this call needs to be able to update the selector cache synchronously.
+	allocateCtx, cancel := context.WithTimeout(context.Background(), option.Config.IPAllocationTimeout)
+	defer cancel()
+
+	// Allocate the identities for all prefixes as one batch; on failure the
+	// allocator has already released any identities allocated for the batch.
+	ids, isNew, err := IdentityAllocator.AllocateIdentities(allocateCtx, labelsList, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate identities for CIDRs: %s", err)
+	}
+
+	allocatedIdentities := make(map[string]*identity.Identity, len(ids))
+	for i, id := range ids {
+		prefixStr := prefixStrings[i]
 
 		id.CIDRLabel = labels.NewLabelsFromModel([]string{labels.LabelSourceCIDR + ":" + prefixStr})
 
-		usedIdentities = append(usedIdentities, id)
 		allocatedIdentities[prefixStr] = id
-		if isNew {
+		if isNew[i] {
 			newlyAllocatedIdentities[prefixStr] = id
 		}
-
 	}
 
 	allocatedIdentitiesSlice := make([]*identity.Identity, 0, len(allocatedIdentities))
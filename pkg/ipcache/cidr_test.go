@@ -0,0 +1,92 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package ipcache
+
+import (
+	"net"
+
+	identityPkg "github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/source"
+
+	. "gopkg.in/check.v1"
+)
+
+func mustParsePrefix(c *C, prefix string) *net.IPNet {
+	_, cidr, err := net.ParseCIDR(prefix)
+	c.Assert(err, IsNil)
+	return cidr
+}
+
+func prefixStrings(prefixes []*net.IPNet) []string {
+	strs := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		strs = append(strs, prefix.String())
+	}
+	return strs
+}
+
+func (s *IPCacheTestSuite) TestAggregatePrefixes(c *C) {
+	prefixes := []*net.IPNet{
+		mustParsePrefix(c, "10.0.0.0/32"),
+		mustParsePrefix(c, "10.0.0.1/32"),
+		mustParsePrefix(c, "10.0.0.2/32"),
+		mustParsePrefix(c, "10.0.0.3/32"),
+		mustParsePrefix(c, "10.0.1.0/32"),
+		mustParsePrefix(c, "10.0.1.1/32"),
+	}
+
+	// Adjacent prefixes are merged into covering prefixes.
+	result := aggregatePrefixes(prefixes)
+	c.Assert(prefixStrings(result), DeepEquals, []string{"10.0.0.0/30", "10.0.1.0/31"})
+
+	// With a more specific entry in the ipcache, e.g. the identity of a
+	// denied CIDR overlapping with this allowed set, the conflicting
+	// aggregate is expanded back into its constituent prefixes. The other
+	// aggregate remains merged.
+	IPIdentityCache.Upsert("10.0.0.2/32", nil, 0, nil, Identity{
+		ID:     identityPkg.NumericIdentity(6048),
+		Source: source.Generated,
+	})
+	defer IPIdentityCache.Delete("10.0.0.2/32", source.Generated)
+
+	result = aggregatePrefixes(prefixes)
+	c.Assert(prefixStrings(result), DeepEquals, []string{
+		"10.0.0.0/32", "10.0.0.1/32", "10.0.0.2/32", "10.0.0.3/32",
+		"10.0.1.0/31",
+	})
+}
+
+func (s *IPCacheTestSuite) TestContainsMoreSpecificPrefix(c *C) {
+	// Endpoint entries are keyed by bare IP and must be taken into
+	// account as well.
+	IPIdentityCache.Upsert("10.1.0.1", nil, 0, nil, Identity{
+		ID:     identityPkg.NumericIdentity(6049),
+		Source: source.Local,
+	})
+	defer IPIdentityCache.Delete("10.1.0.1", source.Local)
+	IPIdentityCache.Upsert("10.2.0.0/24", nil, 0, nil, Identity{
+		ID:     identityPkg.NumericIdentity(6050),
+		Source: source.Generated,
+	})
+	defer IPIdentityCache.Delete("10.2.0.0/24", source.Generated)
+
+	c.Assert(IPIdentityCache.ContainsMoreSpecificPrefix(mustParsePrefix(c, "10.1.0.0/24")), Equals, true)
+	c.Assert(IPIdentityCache.ContainsMoreSpecificPrefix(mustParsePrefix(c, "10.2.0.0/16")), Equals, true)
+	// An equal prefix is not more specific.
+	c.Assert(IPIdentityCache.ContainsMoreSpecificPrefix(mustParsePrefix(c, "10.2.0.0/24")), Equals, false)
+	c.Assert(IPIdentityCache.ContainsMoreSpecificPrefix(mustParsePrefix(c, "10.3.0.0/24")), Equals, false)
+}
@@ -0,0 +1,128 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipcache
+
+import (
+	"net"
+
+	"github.com/cilium/cilium/pkg/metrics"
+)
+
+// LPMRegistry is the subset of the datapath LPM prefix length configuration
+// which the ipcache consistency checker validates against. It is implemented
+// by counter.PrefixLengthCounter.
+type LPMRegistry interface {
+	// Add registers the prefix lengths of the given prefixes.
+	Add(prefixes []*net.IPNet) (bool, error)
+
+	// ToBPFData returns the IPv6 and IPv4 prefix lengths currently
+	// registered with the datapath.
+	ToBPFData() (s6, s4 []int)
+}
+
+// SetLPMRegistry sets the datapath LPM registry which the cache validates
+// its prefix lengths against on each upsert and delete.
+func (ipc *IPCache) SetLPMRegistry(registry LPMRegistry) {
+	ipc.mutex.Lock()
+	ipc.lpmRegistry = registry
+	ipc.checkPrefixLengthsLocked()
+	ipc.mutex.Unlock()
+}
+
+// refPrefixLength increments the reference count for the given prefix
+// length.
+func refPrefixLength(prefixLengths map[int]int, length int) {
+	prefixLengths[length]++
+}
+
+// unrefPrefixLength decrements the reference count for the given prefix
+// length, removing it once the last reference is gone.
+func unrefPrefixLength(prefixLengths map[int]int, length int) {
+	if prefixLengths[length] <= 1 {
+		delete(prefixLengths, length)
+	} else {
+		prefixLengths[length]--
+	}
+}
+
+// refPrefixLocked accounts a new cache entry with the given prefix in the
+// per-protocol prefix length reference counts.
+func (ipc *IPCache) refPrefixLocked(cidr *net.IPNet) {
+	ones, bits := cidr.Mask.Size()
+	if bits == net.IPv4len*8 {
+		refPrefixLength(ipc.v4PrefixLengths, ones)
+	} else {
+		refPrefixLength(ipc.v6PrefixLengths, ones)
+	}
+}
+
+// unrefPrefixLocked releases a deleted cache entry with the given prefix
+// from the per-protocol prefix length reference counts.
+func (ipc *IPCache) unrefPrefixLocked(cidr *net.IPNet) {
+	ones, bits := cidr.Mask.Size()
+	if bits == net.IPv4len*8 {
+		unrefPrefixLength(ipc.v4PrefixLengths, ones)
+	} else {
+		unrefPrefixLength(ipc.v6PrefixLengths, ones)
+	}
+}
+
+// checkPrefixLengthsLocked validates that every prefix length referenced by
+// the cache is registered with the datapath LPM configuration and repairs
+// any discrepancy by registering the missing lengths. A missed registration
+// makes longest-prefix-match fall back to a shorter prefix, e.g. a /16 CIDR
+// identity shadowing a more specific /32 node entry. Lengths registered
+// with the datapath but no longer present in the cache are harmless for
+// LPM and are left alone. Exports a metric when a repair was needed.
+func (ipc *IPCache) checkPrefixLengthsLocked() {
+	if ipc.lpmRegistry == nil {
+		return
+	}
+
+	registered6, registered4 := ipc.lpmRegistry.ToBPFData()
+
+	var missing []*net.IPNet
+	for length := range ipc.v4PrefixLengths {
+		if !containsLength(registered4, length) {
+			missing = append(missing, &net.IPNet{Mask: net.CIDRMask(length, net.IPv4len*8)})
+		}
+	}
+	for length := range ipc.v6PrefixLengths {
+		if !containsLength(registered6, length) {
+			missing = append(missing, &net.IPNet{Mask: net.CIDRMask(length, net.IPv6len*8)})
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	log.WithField("prefixLengths", missing).
+		Warning("Datapath LPM configuration is missing prefix lengths present in the ipcache, repairing")
+	metrics.IPCacheLPMRepairs.Add(float64(len(missing)))
+
+	if _, err := ipc.lpmRegistry.Add(missing); err != nil {
+		log.WithError(err).
+			Error("Unable to repair datapath LPM prefix length registration")
+	}
+}
+
+func containsLength(lengths []int, length int) bool {
+	for _, l := range lengths {
+		if l == length {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package ipcache
+
+import (
+	"net"
+
+	"github.com/cilium/cilium/pkg/counter"
+	identityPkg "github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/source"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *IPCacheTestSuite) TestLPMConsistencyRepair(c *C) {
+	ipc := NewIPCache()
+	registry := counter.NewPrefixLengthCounter(net.IPv6len*8+1, net.IPv4len*8+1)
+
+	// Only the /16 is registered with the datapath, the /32 registration
+	// was missed.
+	_, cidr16, err := net.ParseCIDR("10.10.0.0/16")
+	c.Assert(err, IsNil)
+	_, err = registry.Add([]*net.IPNet{cidr16})
+	c.Assert(err, IsNil)
+
+	ipc.SetLPMRegistry(registry)
+
+	// The /16 CIDR identity is consistent with the datapath.
+	_, err = ipc.Upsert("10.10.0.0/16", nil, 0, nil, Identity{
+		ID:     identityPkg.NumericIdentity(100),
+		Source: source.Generated,
+	})
+	c.Assert(err, IsNil)
+	_, registered4 := registry.ToBPFData()
+	c.Assert(containsLength(registered4, 16), Equals, true)
+	c.Assert(containsLength(registered4, 32), Equals, false)
+
+	// A node entry within the /16. Without the /32 length registered, LPM
+	// would fall back to the /16 and the CIDR identity would shadow the
+	// more specific node mapping. The checker must register the missing
+	// length during the upsert.
+	_, err = ipc.Upsert("10.10.1.1", nil, 0, nil, Identity{
+		ID:     identityPkg.ReservedIdentityRemoteNode,
+		Source: source.KVStore,
+	})
+	c.Assert(err, IsNil)
+	_, registered4 = registry.ToBPFData()
+	c.Assert(containsLength(registered4, 32), Equals, true)
+
+	// Deletes keep the reference counts and the registry consistent.
+	ipc.Delete("10.10.1.1", source.KVStore)
+	c.Assert(len(ipc.v4PrefixLengths), Equals, 1)
+	ipc.Delete("10.10.0.0/16", source.Generated)
+	c.Assert(len(ipc.v4PrefixLengths), Equals, 0)
+}
+
+func (s *IPCacheTestSuite) TestLPMConsistencyOnRegistrySet(c *C) {
+	ipc := NewIPCache()
+
+	// Entries inserted before the registry is known, e.g. during restore.
+	_, err := ipc.Upsert("10.10.0.0/16", nil, 0, nil, Identity{
+		ID:     identityPkg.NumericIdentity(100),
+		Source: source.Generated,
+	})
+	c.Assert(err, IsNil)
+	_, err = ipc.Upsert("f00d::1", nil, 0, nil, Identity{
+		ID:     identityPkg.NumericIdentity(200),
+		Source: source.KVStore,
+	})
+	c.Assert(err, IsNil)
+
+	// Setting the registry validates the existing cache contents.
+	registry := counter.NewPrefixLengthCounter(net.IPv6len*8+1, net.IPv4len*8+1)
+	ipc.SetLPMRegistry(registry)
+
+	registered6, registered4 := registry.ToBPFData()
+	c.Assert(containsLength(registered4, 16), Equals, true)
+	c.Assert(containsLength(registered6, 128), Equals, true)
+}
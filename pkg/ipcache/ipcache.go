@@ -81,11 +81,21 @@ type IPCache struct {
 	v4PrefixLengths map[int]int
 	v6PrefixLengths map[int]int
 
+	// lpmRegistry is the datapath LPM configuration which the prefix
+	// lengths in the cache are validated against on each upsert and
+	// delete. May be nil, e.g. in unit tests. Protected by 'mutex'.
+	lpmRegistry LPMRegistry
+
 	listeners []IPIdentityMappingListener
 
 	// controllers manages the async controllers for this IPCache
 	controllers *controller.Manager
 
+	// metadata tracks the contributions of individual owners per prefix
+	// for entries managed via UpsertOwned and DeleteOwned. It has its own
+	// mutex as the merged entries are applied through Upsert and Delete.
+	metadata *metadata
+
 	// needNamedPorts is initially 'false', but will be changd to 'true' when the
 	// clusterwide named port mappings are needed for network policy computation
 	// for the first time. This avoids the overhead of maintaining 'namedPorts' map
@@ -112,6 +122,7 @@ func NewIPCache() *IPCache {
 		v4PrefixLengths:   map[int]int{},
 		v6PrefixLengths:   map[int]int{},
 		controllers:       controller.NewManager(),
+		metadata:          newMetadata(),
 		namedPorts:        nil,
 	}
 }
@@ -343,6 +354,11 @@ func (ipc *IPCache) Upsert(ip string, hostIP net.IP, hostKey uint8, k8sMeta *K8s
 	}
 	ipc.identityToIPCache[newIdentity.ID][ip] = struct{}{}
 
+	if !found {
+		ipc.refPrefixLocked(cidr)
+	}
+	ipc.checkPrefixLengthsLocked()
+
 	if hostIP == nil {
 		delete(ipc.ipToHostIPCache, ip)
 	} else {
@@ -480,6 +496,9 @@ func (ipc *IPCache) deleteLocked(ip string, source source.Source) (namedPortsCha
 	delete(ipc.ipToHostIPCache, ip)
 	delete(ipc.ipToK8sMetadata, ip)
 
+	ipc.unrefPrefixLocked(cidr)
+	ipc.checkPrefixLengthsLocked()
+
 	// Update named ports
 	namedPortsChanged = false
 	if oldK8sMeta != nil && len(oldK8sMeta.NamedPorts) > 0 {
@@ -580,6 +599,46 @@ func (ipc *IPCache) LookupByIdentity(id identity.NumericIdentity) (ips []string)
 	return ips
 }
 
+// ContainsMoreSpecificPrefix returns true if the cache holds an entry which
+// is strictly more specific than 'prefix', i.e. when part of the traffic
+// within 'prefix' maps to a different identity than the prefix itself would.
+func (ipc *IPCache) ContainsMoreSpecificPrefix(prefix *net.IPNet) bool {
+	prefixOnes, prefixBits := prefix.Mask.Size()
+
+	ipc.mutex.RLock()
+	defer ipc.mutex.RUnlock()
+	for ip := range ipc.ipToIdentityCache {
+		_, cidr, err := net.ParseCIDR(ip)
+		if err != nil {
+			endpointIP := net.ParseIP(ip)
+			cidr = endpointIPToCIDR(endpointIP)
+		}
+		ones, bits := cidr.Mask.Size()
+		if bits == prefixBits && ones > prefixOnes && prefix.Contains(cidr.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// UsedIdentities returns the set of unique identities referenced by entries
+// in the cache.
+func (ipc *IPCache) UsedIdentities() []identity.NumericIdentity {
+	ipc.mutex.RLock()
+	defer ipc.mutex.RUnlock()
+
+	set := map[identity.NumericIdentity]struct{}{}
+	for _, id := range ipc.ipToIdentityCache {
+		set[id.ID] = struct{}{}
+	}
+
+	identities := make([]identity.NumericIdentity, 0, len(set))
+	for id := range set {
+		identities = append(identities, id)
+	}
+	return identities
+}
+
 // LookupByHostRLocked returns the list of IPs returns the set of IPs
 // (endpoint or CIDR prefix) that have hostIPv4 or hostIPv6 associated as the
 // host of the entry. Requires the caller to hold the RLock.
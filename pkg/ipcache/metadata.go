@@ -0,0 +1,194 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipcache
+
+import (
+	"net"
+	"sort"
+
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/source"
+)
+
+// resourceInfo is the contribution of a single owner for a prefix.
+type resourceInfo struct {
+	identity Identity
+	hostIP   net.IP
+	hostKey  uint8
+	k8sMeta  *K8sMetadata
+}
+
+// prefixInfo collects the contributions of all owners for a prefix, keyed by
+// the owner identifier.
+type prefixInfo map[string]*resourceInfo
+
+// metadata tracks which owners contributed which metadata for each prefix, so
+// that the removal of one owner can restore the contribution of the others.
+// It is maintained separately from the IPCache mutex as the merged result is
+// applied through the regular Upsert and Delete calls.
+type metadata struct {
+	lock.Mutex
+
+	// m maps a prefix to the contributions of all of its owners
+	m map[string]prefixInfo
+}
+
+func newMetadata() *metadata {
+	return &metadata{
+		m: map[string]prefixInfo{},
+	}
+}
+
+// sourcePrecedence returns the rank of a source when selecting between the
+// contributions of multiple owners for the same prefix. Entries derived from
+// the local node take precedence over remote node entries, which in turn take
+// precedence over generated CIDR entries. The order is consistent with the
+// pairwise rules implemented by source.AllowOverwrite.
+func sourcePrecedence(s source.Source) int {
+	switch s {
+	case source.Local:
+		return 5
+	case source.KVStore:
+		return 4
+	case source.CustomResource:
+		return 3
+	case source.Kubernetes:
+		return 2
+	case source.Generated:
+		return 1
+	}
+	return 0
+}
+
+// merge computes the effective entry from the contributions of all owners.
+// The identity and source are taken from the owner with the highest source
+// precedence, with the owner identifier as a deterministic tie breaker. The
+// host IP, encryption key and Kubernetes metadata are each taken from the
+// highest precedence owner which contributed them.
+func (p prefixInfo) merge() *resourceInfo {
+	owners := make([]string, 0, len(p))
+	for owner := range p {
+		owners = append(owners, owner)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		pi := sourcePrecedence(p[owners[i]].identity.Source)
+		pj := sourcePrecedence(p[owners[j]].identity.Source)
+		if pi != pj {
+			return pi > pj
+		}
+		return owners[i] < owners[j]
+	})
+
+	merged := *p[owners[0]]
+	for _, owner := range owners[1:] {
+		info := p[owner]
+		if merged.hostIP == nil && info.hostIP != nil {
+			merged.hostIP = info.hostIP
+			merged.hostKey = info.hostKey
+		}
+		if merged.k8sMeta == nil && info.k8sMeta != nil {
+			merged.k8sMeta = info.k8sMeta
+		}
+	}
+	return &merged
+}
+
+// ownedKey normalizes fully masked CIDR prefixes to the bare IP
+// representation so that contributions for the same address are merged
+// regardless of whether the owner refers to it as an endpoint IP or as a full
+// CIDR, e.g. when a node IP overlaps with a policy CIDR.
+func ownedKey(ip string) string {
+	if _, cidr, err := net.ParseCIDR(ip); err == nil {
+		if ones, bits := cidr.Mask.Size(); ones == bits {
+			return cidr.IP.String()
+		}
+	}
+	return ip
+}
+
+// UpsertOwned adds or updates the contribution of the given owner for the
+// provided IP (endpoint or CIDR prefix) and recomputes the merged entry from
+// all owners. The merged entry is applied to the cache and propagated to the
+// listeners. Unlike Upsert, the contribution survives overwrites by owners
+// with a higher precedence source and is restored when those owners are
+// removed again via DeleteOwned.
+func (ipc *IPCache) UpsertOwned(owner, ip string, hostIP net.IP, hostKey uint8, k8sMeta *K8sMetadata, newIdentity Identity) (namedPortsChanged bool, err error) {
+	key := ownedKey(ip)
+
+	ipc.metadata.Lock()
+	info := ipc.metadata.m[key]
+	if info == nil {
+		info = prefixInfo{}
+		ipc.metadata.m[key] = info
+	}
+	info[owner] = &resourceInfo{
+		identity: newIdentity,
+		hostIP:   hostIP,
+		hostKey:  hostKey,
+		k8sMeta:  k8sMeta,
+	}
+	merged := info.merge()
+	ipc.metadata.Unlock()
+
+	return ipc.applyMerged(key, merged)
+}
+
+// DeleteOwned removes the contribution of the given owner for the provided IP
+// and recomputes the merged entry from the remaining owners. When the last
+// owner is removed, the entry is deleted from the cache; otherwise the entry
+// is updated to the merged contribution of the remaining owners.
+func (ipc *IPCache) DeleteOwned(owner, ip string) (namedPortsChanged bool, err error) {
+	key := ownedKey(ip)
+
+	ipc.metadata.Lock()
+	info := ipc.metadata.m[key]
+	if _, ok := info[owner]; !ok {
+		ipc.metadata.Unlock()
+		return false, nil
+	}
+	delete(info, owner)
+	var merged *resourceInfo
+	if len(info) == 0 {
+		delete(ipc.metadata.m, key)
+	} else {
+		merged = info.merge()
+	}
+	ipc.metadata.Unlock()
+
+	return ipc.applyMerged(key, merged)
+}
+
+// applyMerged applies the merged entry for the prefix to the cache. A nil
+// merged entry removes the prefix.
+func (ipc *IPCache) applyMerged(ip string, merged *resourceInfo) (namedPortsChanged bool, err error) {
+	if merged == nil {
+		if cached, ok := ipc.LookupByIP(ip); ok {
+			return ipc.Delete(ip, cached.Source), nil
+		}
+		return false, nil
+	}
+
+	// The merged entry may originate from a lower precedence source than
+	// the current entry, e.g. when the node owner of a prefix is removed
+	// and the contribution of a policy CIDR is restored. Upsert refuses to
+	// downgrade the source of an entry, so the stale entry is removed
+	// first.
+	if cached, ok := ipc.LookupByIP(ip); ok && !source.AllowOverwrite(cached.Source, merged.identity.Source) {
+		namedPortsChanged = ipc.Delete(ip, cached.Source)
+	}
+
+	changed, err := ipc.Upsert(ip, merged.hostIP, merged.hostKey, merged.k8sMeta, merged.identity)
+	return namedPortsChanged || changed, err
+}
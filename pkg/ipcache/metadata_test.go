@@ -0,0 +1,146 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package ipcache
+
+import (
+	"net"
+
+	identityPkg "github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/source"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *IPCacheTestSuite) TestUpsertOwnedPrecedence(c *C) {
+	// A policy CIDR and a node IP contribute metadata for the same
+	// address, using the CIDR and endpoint IP representations
+	// respectively.
+	_, err := IPIdentityCache.UpsertOwned("cidr-policy", "10.20.0.1/32", nil, 0, nil, Identity{
+		ID:     identityPkg.NumericIdentity(30100),
+		Source: source.Generated,
+	})
+	c.Assert(err, IsNil)
+
+	cachedIdentity, exists := IPIdentityCache.LookupByIP("10.20.0.1")
+	c.Assert(exists, Equals, true)
+	c.Assert(cachedIdentity.ID, Equals, identityPkg.NumericIdentity(30100))
+
+	// The node entry takes precedence over the CIDR entry.
+	nodeIP := net.ParseIP("192.168.1.10")
+	_, err = IPIdentityCache.UpsertOwned("node/worker-1", "10.20.0.1", nodeIP, 7, nil, Identity{
+		ID:     identityPkg.ReservedIdentityRemoteNode,
+		Source: source.Local,
+	})
+	c.Assert(err, IsNil)
+
+	cachedIdentity, exists = IPIdentityCache.LookupByIP("10.20.0.1")
+	c.Assert(exists, Equals, true)
+	c.Assert(cachedIdentity.ID, Equals, identityPkg.ReservedIdentityRemoteNode)
+	c.Assert(cachedIdentity.Source, Equals, source.Local)
+	hostIP, hostKey := IPIdentityCache.getHostIPCache("10.20.0.1")
+	c.Assert(hostIP.Equal(nodeIP), Equals, true)
+	c.Assert(hostKey, Equals, uint8(7))
+
+	// Removing the node owner restores the CIDR contribution instead of
+	// losing the entry entirely.
+	_, err = IPIdentityCache.DeleteOwned("node/worker-1", "10.20.0.1")
+	c.Assert(err, IsNil)
+
+	cachedIdentity, exists = IPIdentityCache.LookupByIP("10.20.0.1")
+	c.Assert(exists, Equals, true)
+	c.Assert(cachedIdentity.ID, Equals, identityPkg.NumericIdentity(30100))
+	c.Assert(cachedIdentity.Source, Equals, source.Generated)
+	hostIP, _ = IPIdentityCache.getHostIPCache("10.20.0.1")
+	c.Assert(hostIP, IsNil)
+
+	// Removing the last owner removes the entry. The endpoint IP
+	// representation refers to the same entry as the CIDR one.
+	_, err = IPIdentityCache.DeleteOwned("cidr-policy", "10.20.0.1")
+	c.Assert(err, IsNil)
+	_, exists = IPIdentityCache.LookupByIP("10.20.0.1")
+	c.Assert(exists, Equals, false)
+}
+
+func (s *IPCacheTestSuite) TestUpsertOwnedMergedFields(c *C) {
+	// A remote node entry learned from the kvstore takes precedence over
+	// a generated CIDR entry.
+	tunnelIP := net.ParseIP("192.168.1.11")
+	_, err := IPIdentityCache.UpsertOwned("node/worker-2", "10.20.0.2", tunnelIP, 3, nil, Identity{
+		ID:     identityPkg.ReservedIdentityRemoteNode,
+		Source: source.KVStore,
+	})
+	c.Assert(err, IsNil)
+	_, err = IPIdentityCache.UpsertOwned("cidr-policy", "10.20.0.2/32", nil, 0, nil, Identity{
+		ID:     identityPkg.NumericIdentity(30200),
+		Source: source.Generated,
+	})
+	c.Assert(err, IsNil)
+
+	cachedIdentity, exists := IPIdentityCache.LookupByIP("10.20.0.2")
+	c.Assert(exists, Equals, true)
+	c.Assert(cachedIdentity.ID, Equals, identityPkg.ReservedIdentityRemoteNode)
+	c.Assert(cachedIdentity.Source, Equals, source.KVStore)
+
+	// The tunnel IP and encryption key of the node owner are retained in
+	// the merged entry even though the CIDR owner does not provide them.
+	hostIP, hostKey := IPIdentityCache.getHostIPCache("10.20.0.2")
+	c.Assert(hostIP.Equal(tunnelIP), Equals, true)
+	c.Assert(hostKey, Equals, uint8(3))
+
+	_, err = IPIdentityCache.DeleteOwned("node/worker-2", "10.20.0.2")
+	c.Assert(err, IsNil)
+	_, err = IPIdentityCache.DeleteOwned("cidr-policy", "10.20.0.2/32")
+	c.Assert(err, IsNil)
+	_, exists = IPIdentityCache.LookupByIP("10.20.0.2")
+	c.Assert(exists, Equals, false)
+}
+
+func (s *IPCacheTestSuite) TestUpsertOwnedDeterministicTieBreak(c *C) {
+	// Two owners with the same source precedence resolve deterministically
+	// to the lexicographically smallest owner.
+	for _, owner := range []string{"node/b", "node/a"} {
+		id := identityPkg.NumericIdentity(30300)
+		if owner == "node/a" {
+			id = identityPkg.NumericIdentity(30301)
+		}
+		_, err := IPIdentityCache.UpsertOwned(owner, "10.20.0.3", nil, 0, nil, Identity{
+			ID:     id,
+			Source: source.KVStore,
+		})
+		c.Assert(err, IsNil)
+	}
+
+	cachedIdentity, exists := IPIdentityCache.LookupByIP("10.20.0.3")
+	c.Assert(exists, Equals, true)
+	c.Assert(cachedIdentity.ID, Equals, identityPkg.NumericIdentity(30301))
+
+	// Deleting an owner which never contributed is a no-op.
+	changed, err := IPIdentityCache.DeleteOwned("node/c", "10.20.0.3")
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, false)
+
+	_, err = IPIdentityCache.DeleteOwned("node/a", "10.20.0.3")
+	c.Assert(err, IsNil)
+	cachedIdentity, exists = IPIdentityCache.LookupByIP("10.20.0.3")
+	c.Assert(exists, Equals, true)
+	c.Assert(cachedIdentity.ID, Equals, identityPkg.NumericIdentity(30300))
+
+	_, err = IPIdentityCache.DeleteOwned("node/b", "10.20.0.3")
+	c.Assert(err, IsNil)
+	_, exists = IPIdentityCache.LookupByIP("10.20.0.3")
+	c.Assert(exists, Equals, false)
+}
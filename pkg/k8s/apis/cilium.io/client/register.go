@@ -17,6 +17,7 @@ package client
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
 	goerrors "errors"
 	"fmt"
 	"time"
@@ -36,6 +37,7 @@ import (
 	v1client "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/yaml"
 )
@@ -67,6 +69,13 @@ const (
 
 	// CENPCRDName is the full name of the CENP CRD.
 	CENPCRDName = k8sconstv2alpha1.CENPKindDefinition + "/" + k8sconstv2alpha1.CustomResourceDefinitionVersion
+
+	// crdFieldManager is the field manager under which Cilium applies its
+	// CRDs via server-side apply. Only the fields asserted in the apply
+	// request are owned by this manager; fields set by third parties, such
+	// as a conversion webhook configuration injected by certificate
+	// tooling, are left untouched.
+	crdFieldManager = "cilium"
 )
 
 var (
@@ -369,6 +378,25 @@ func constructV1CRD(
 	}
 }
 
+// clusterCRDSchemaIsNewer reports whether the schema version label of the CRD
+// stored in the cluster is newer than the schema version this binary ships.
+// In that case the stored CRD must not be overwritten as that would downgrade
+// the schema, e.g. when an older agent starts next to an already upgraded
+// operator.
+func clusterCRDSchemaIsNewer(clusterLabels map[string]string) bool {
+	v, ok := clusterLabels[k8sconstv2.CustomResourceDefinitionSchemaVersionKey]
+	if !ok {
+		return false
+	}
+
+	clusterVersion, err := versioncheck.Version(v)
+	if err != nil {
+		return false
+	}
+
+	return clusterVersion.GT(comparableCRDSchemaVersion)
+}
+
 func needsUpdateV1(clusterCRD *apiextensionsv1.CustomResourceDefinition) bool {
 	if clusterCRD.Spec.Versions[0].Schema == nil {
 		// no validation detected
@@ -397,6 +425,11 @@ func updateV1CRD(
 ) error {
 	scopedLog.Debug("Checking if CRD (CustomResourceDefinition) needs update...")
 
+	if clusterCRDSchemaIsNewer(clusterCRD.Labels) {
+		scopedLog.Info("Cluster CRD schema is newer than the one shipped with this binary, leaving it as-is")
+		return nil
+	}
+
 	if crd.Spec.Versions[0].Schema != nil && needsUpdateV1(clusterCRD) {
 		scopedLog.Info("Updating CRD (CustomResourceDefinition)...")
 
@@ -411,25 +444,18 @@ func updateV1CRD(
 				return false, err
 			}
 
+			if clusterCRDSchemaIsNewer(clusterCRD.Labels) {
+				scopedLog.Info("Cluster CRD schema is newer than the one shipped with this binary, leaving it as-is")
+				return true, nil
+			}
+
 			// This seems too permissive but we only get here if the version is
 			// different per needsUpdate above. If so, we want to update on any
 			// validation change including adding or removing validation.
 			if needsUpdateV1(clusterCRD) {
 				scopedLog.Debug("CRD validation is different, updating it...")
 
-				clusterCRD.ObjectMeta.Labels = crd.ObjectMeta.Labels
-				clusterCRD.Spec = crd.Spec
-
-				// Even though v1 CRDs omit this field by default (which also
-				// means it's false) it is still carried over from the previous
-				// CRD. Therefore, we must set this to false explicitly because
-				// the apiserver will carry over the old value (true).
-				clusterCRD.Spec.PreserveUnknownFields = false
-
-				_, err := client.CustomResourceDefinitions().Update(
-					context.TODO(),
-					clusterCRD,
-					metav1.UpdateOptions{})
+				err := applyV1CRD(crd, client)
 				switch {
 				case errors.IsConflict(err): // Occurs as Operators race to update CRDs.
 					scopedLog.WithError(err).
@@ -455,6 +481,40 @@ func updateV1CRD(
 	return nil
 }
 
+// applyV1CRD pushes the CRD spec Cilium ships via server-side apply. The
+// apply request only asserts the labels, schema and versions Cilium owns, so
+// fields managed by other parties are preserved. Ownership of conflicting
+// fields is forced as they stem from agents which previously synced the CRD
+// with a plain update.
+func applyV1CRD(
+	crd *apiextensionsv1.CustomResourceDefinition,
+	client v1client.CustomResourceDefinitionsGetter,
+) error {
+	applyCRD := crd.DeepCopy()
+	applyCRD.TypeMeta = metav1.TypeMeta{
+		APIVersion: apiextensionsv1.SchemeGroupVersion.String(),
+		Kind:       "CustomResourceDefinition",
+	}
+
+	data, err := json.Marshal(applyCRD)
+	if err != nil {
+		return fmt.Errorf("unable to encode CRD for server-side apply: %w", err)
+	}
+
+	force := true
+	_, err = client.CustomResourceDefinitions().Patch(
+		context.TODO(),
+		crd.ObjectMeta.Name,
+		types.ApplyPatchType,
+		data,
+		metav1.PatchOptions{
+			FieldManager: crdFieldManager,
+			Force:        &force,
+		})
+
+	return err
+}
+
 func waitForV1CRD(
 	scopedLog *logrus.Entry,
 	crdName string,
@@ -243,6 +243,28 @@ func (s *CiliumV2RegisterSuite) TestNeedsUpdateCorruptedVersion(c *C) {
 	c.Assert(needsUpdateV1beta1(v1beta1CRD), Equals, true)
 }
 
+func (s *CiliumV2RegisterSuite) TestClusterCRDSchemaIsNewer(c *C) {
+	// The stored CRD carries the schema version this binary ships.
+	crd := s.getV1TestCRD()
+	c.Assert(clusterCRDSchemaIsNewer(crd.Labels), Equals, false)
+
+	// The stored CRD was written by a newer binary and must not be
+	// downgraded.
+	crd.Labels[ciliumv2.CustomResourceDefinitionSchemaVersionKey] = "999.0.0"
+	c.Assert(clusterCRDSchemaIsNewer(crd.Labels), Equals, true)
+
+	// Older, missing or corrupted versions are update candidates, not
+	// downgrades.
+	crd.Labels[ciliumv2.CustomResourceDefinitionSchemaVersionKey] = "0.9"
+	c.Assert(clusterCRDSchemaIsNewer(crd.Labels), Equals, false)
+
+	crd.Labels[ciliumv2.CustomResourceDefinitionSchemaVersionKey] = "totally-not-semver"
+	c.Assert(clusterCRDSchemaIsNewer(crd.Labels), Equals, false)
+
+	crd.Labels = nil
+	c.Assert(clusterCRDSchemaIsNewer(crd.Labels), Equals, false)
+}
+
 func (s *CiliumV2RegisterSuite) TestFQDNNameRegex(c *C) {
 	nameRegex := regexp.MustCompile(api.FQDNMatchNameRegexString)
 	patternRegex := regexp.MustCompile(api.FQDNMatchPatternRegexString)
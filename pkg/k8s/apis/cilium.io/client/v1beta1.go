@@ -127,6 +127,14 @@ func updateV1beta1CRD(
 ) error {
 	scopedLog.Debug("Checking if CRD (CustomResourceDefinition) needs update...")
 
+	// Server-side apply is not available on apiservers which only serve
+	// v1beta1 CRDs, so this legacy path keeps the whole-object update but
+	// still refuses to downgrade a newer stored schema.
+	if clusterCRDSchemaIsNewer(clusterCRD.Labels) {
+		scopedLog.Info("Cluster CRD schema is newer than the one shipped with this binary, leaving it as-is")
+		return nil
+	}
+
 	if crd.Spec.Validation != nil && needsUpdateV1beta1(clusterCRD) {
 		scopedLog.Info("Updating CRD (CustomResourceDefinition)...")
 
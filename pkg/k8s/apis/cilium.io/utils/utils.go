@@ -317,6 +317,8 @@ func ParseToCiliumRule(namespace, name string, uid types.UID, r *api.Rule) *api.
 	retRule.Egress = parseToCiliumEgressRule(namespace, r.EndpointSelector, r.Egress)
 	retRule.EgressDeny = parseToCiliumEgressDenyRule(namespace, r.EndpointSelector, r.EgressDeny)
 
+	retRule.EnableDefaultDeny = r.EnableDefaultDeny
+
 	retRule.Labels = ParseToCiliumLabels(namespace, name, uid, r.Labels)
 
 	retRule.Description = r.Description
@@ -89,6 +89,35 @@ type CiliumNetworkPolicyStatus struct {
 	// DerivativePolicies is the status of all policies derived from the Cilium
 	// policy
 	DerivativePolicies map[string]CiliumNetworkPolicyNodeStatus `json:"derivativePolicies,omitempty"`
+
+	// Summary is a bounded roll-up of the per-node policy statuses which
+	// is maintained by the operator instead of the Nodes map when status
+	// aggregation is enabled.
+	Summary *CiliumNetworkPolicyNodeStatusSummary `json:"summary,omitempty"`
+}
+
+// +deepequal-gen=true
+
+// CiliumNetworkPolicyNodeStatusSummary is a bounded roll-up of the status of a
+// Cilium policy rule across all nodes in the cluster. It allows the policy
+// import result to be surfaced on the policy without the status growing with
+// the number of nodes.
+type CiliumNetworkPolicyNodeStatusSummary struct {
+	// OK is the number of nodes which have parsed and imported the policy
+	// successfully into their in-memory policy repository.
+	OK int `json:"ok,omitempty"`
+
+	// Failed is the number of nodes which failed to parse or import the
+	// policy.
+	Failed int `json:"failed,omitempty"`
+
+	// Errors contains the errors reported by the failing nodes, keyed by
+	// node name. It is bounded to a configured number of example nodes,
+	// Failed holds the total count.
+	Errors map[string]string `json:"errors,omitempty"`
+
+	// LastUpdated contains the last time this summary was updated
+	LastUpdated slimv1.Time `json:"lastUpdated,omitempty"`
 }
 
 // +deepequal-gen=true
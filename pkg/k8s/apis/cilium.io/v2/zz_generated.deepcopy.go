@@ -514,6 +514,30 @@ func (in *CiliumNetworkPolicyNodeStatus) DeepCopy() *CiliumNetworkPolicyNodeStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumNetworkPolicyNodeStatusSummary) DeepCopyInto(out *CiliumNetworkPolicyNodeStatusSummary) {
+	*out = *in
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumNetworkPolicyNodeStatusSummary.
+func (in *CiliumNetworkPolicyNodeStatusSummary) DeepCopy() *CiliumNetworkPolicyNodeStatusSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumNetworkPolicyNodeStatusSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CiliumNetworkPolicyStatus) DeepCopyInto(out *CiliumNetworkPolicyStatus) {
 	*out = *in
@@ -531,6 +555,11 @@ func (in *CiliumNetworkPolicyStatus) DeepCopyInto(out *CiliumNetworkPolicyStatus
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(CiliumNetworkPolicyNodeStatusSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
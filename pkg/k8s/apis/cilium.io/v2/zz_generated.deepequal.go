@@ -319,6 +319,47 @@ func (in *CiliumNetworkPolicyNodeStatus) DeepEqual(other *CiliumNetworkPolicyNod
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *CiliumNetworkPolicyNodeStatusSummary) DeepEqual(other *CiliumNetworkPolicyNodeStatusSummary) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.OK != other.OK {
+		return false
+	}
+	if in.Failed != other.Failed {
+		return false
+	}
+	if ((in.Errors != nil) && (other.Errors != nil)) || ((in.Errors == nil) != (other.Errors == nil)) {
+		in, other := &in.Errors, &other.Errors
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for key, inValue := range *in {
+				if otherValue, present := (*other)[key]; !present {
+					return false
+				} else {
+					if inValue != otherValue {
+						return false
+					}
+				}
+			}
+		}
+	}
+
+	if !in.LastUpdated.DeepEqual(&other.LastUpdated) {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *CiliumNetworkPolicyStatus) DeepEqual(other *CiliumNetworkPolicyStatus) bool {
@@ -368,6 +409,14 @@ func (in *CiliumNetworkPolicyStatus) DeepEqual(other *CiliumNetworkPolicyStatus)
 		}
 	}
 
+	if (in.Summary == nil) != (other.Summary == nil) {
+		return false
+	} else if in.Summary != nil {
+		if !in.Summary.DeepEqual(other.Summary) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -98,6 +98,11 @@ func (in *CiliumEgressNATPolicySpec) DeepCopyInto(out *CiliumEgressNATPolicySpec
 		*out = make([]IPv4CIDR, len(*in))
 		copy(*out, *in)
 	}
+	if in.EgressSourceIPs != nil {
+		in, out := &in.EgressSourceIPs, &out.EgressSourceIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
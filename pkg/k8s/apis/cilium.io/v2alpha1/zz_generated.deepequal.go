@@ -77,6 +77,23 @@ func (in *CiliumEgressNATPolicySpec) DeepEqual(other *CiliumEgressNATPolicySpec)
 		return false
 	}
 
+	if ((in.EgressSourceIPs != nil) && (other.EgressSourceIPs != nil)) || ((in.EgressSourceIPs == nil) != (other.EgressSourceIPs == nil)) {
+		in, other := &in.EgressSourceIPs, &other.EgressSourceIPs
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
 	return true
 }
 
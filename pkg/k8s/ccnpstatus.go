@@ -38,8 +38,8 @@ type CCNPStatusEventHandler struct {
 
 // NewCCNPStatusEventHandler returns a new CCNPStatusEventHandler.
 // which is more or less a wrapper around the CNPStatusEventHandler itself.
-func NewCCNPStatusEventHandler(k8sStore cache.Store, updateInterval time.Duration) *CCNPStatusEventHandler {
+func NewCCNPStatusEventHandler(k8sStore cache.Store, updateInterval time.Duration, maxNodeErrors int) *CCNPStatusEventHandler {
 	return &CCNPStatusEventHandler{
-		CNPStatusEventHandler: NewCNPStatusEventHandler(k8sStore, updateInterval),
+		CNPStatusEventHandler: NewCNPStatusEventHandler(k8sStore, updateInterval, maxNodeErrors),
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"sort"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,6 +28,7 @@ import (
 	"github.com/cilium/cilium/pkg/backoff"
 	cilium_v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
 	clientset "github.com/cilium/cilium/pkg/k8s/client/clientset/versioned"
+	slimv1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
 	"github.com/cilium/cilium/pkg/k8s/types"
 	k8sUtils "github.com/cilium/cilium/pkg/k8s/utils"
 	"github.com/cilium/cilium/pkg/kvstore"
@@ -483,3 +485,112 @@ func updateStatusesByCapabilities(client clientset.Interface, ns, name string, n
 	}
 	return nil
 }
+
+// summarizeNodeStatuses rolls the given per-node statuses up into a bounded
+// summary containing the number of nodes which imported the policy
+// successfully, the number of nodes which failed, and the errors of up to
+// maxErrors failing nodes. The example errors are picked from the failing
+// nodes in lexicographical order so that successive summaries over the same
+// statuses are identical.
+func summarizeNodeStatuses(nodeStatuses map[string]cilium_v2.CiliumNetworkPolicyNodeStatus, maxErrors int) *cilium_v2.CiliumNetworkPolicyNodeStatusSummary {
+	summary := &cilium_v2.CiliumNetworkPolicyNodeStatusSummary{}
+	var failedNodes []string
+	for node, status := range nodeStatuses {
+		if status.OK {
+			summary.OK++
+			continue
+		}
+		summary.Failed++
+		failedNodes = append(failedNodes, node)
+	}
+	if len(failedNodes) == 0 {
+		return summary
+	}
+	sort.Strings(failedNodes)
+	if len(failedNodes) > maxErrors {
+		failedNodes = failedNodes[:maxErrors]
+	}
+	summary.Errors = make(map[string]string, len(failedNodes))
+	for _, node := range failedNodes {
+		summary.Errors[node] = nodeStatuses[node].Error
+	}
+	return summary
+}
+
+// updateStatusSummary writes the given summary into the status of the CNP or
+// CCNP with the given namespace and name. The LastUpdated timestamp is set on
+// a copy of the summary so that callers can compare successive summaries
+// without the timestamp making them unequal.
+func updateStatusSummary(client clientset.Interface, ns, name string, summary *cilium_v2.CiliumNetworkPolicyNodeStatusSummary) error {
+	summary = summary.DeepCopy()
+	summary.LastUpdated = slimv1.Now()
+
+	// As in updateStatusesByCapabilities, the `/status` path may not exist
+	// yet in the CNP, in which case replacing `/status/summary` would fail.
+	// Create the whole status with the summary in that case.
+	createStatusSummaryPatch := []JSONPatch{
+		{
+			OP:    "test",
+			Path:  "/status",
+			Value: nil,
+		},
+		{
+			OP:   "add",
+			Path: "/status",
+			Value: cilium_v2.CiliumNetworkPolicyStatus{
+				Summary: summary,
+			},
+		},
+	}
+
+	createStatusSummaryPatchJSON, err := json.Marshal(createStatusSummaryPatch)
+	if err != nil {
+		return err
+	}
+
+	patch := func(patchJSON []byte) error {
+		var err error
+		// If namespace is empty the policy corresponds to a clusterwide
+		// policy, in that case we need to update the status of the
+		// CiliumClusterwideNetworkPolicy resource instead.
+		if ns == "" {
+			_, err = client.CiliumV2().CiliumClusterwideNetworkPolicies().Patch(
+				context.TODO(),
+				name,
+				k8sTypes.JSONPatchType,
+				patchJSON,
+				metav1.PatchOptions{},
+				"status",
+			)
+		} else {
+			_, err = client.CiliumV2().CiliumNetworkPolicies(ns).Patch(
+				context.TODO(),
+				name,
+				k8sTypes.JSONPatchType,
+				patchJSON,
+				metav1.PatchOptions{},
+				"status",
+			)
+		}
+		return err
+	}
+
+	if err = patch(createStatusSummaryPatchJSON); err != nil {
+		// If the patch failed it means the "test" from the previous patch
+		// failed so we can safely replace the summary in the CNP status.
+		replaceSummaryPatch := []JSONPatch{
+			{
+				OP:    "add",
+				Path:  "/status/summary",
+				Value: summary,
+			},
+		}
+		var replaceSummaryPatchJSON []byte
+		replaceSummaryPatchJSON, err = json.Marshal(replaceSummaryPatch)
+		if err != nil {
+			return err
+		}
+		err = patch(replaceSummaryPatchJSON)
+	}
+	return err
+}
@@ -24,3 +24,9 @@ import (
 func (c *CNPStatusUpdateContext) UpdateViaAPIServer(cnp *types.SlimCNP, enforcing, ok bool, cnpError error, rev uint64, cnpAnnotations map[string]string) error {
 	return c.updateViaAPIServer(cnp, enforcing, ok, cnpError, rev, cnpAnnotations)
 }
+
+// Export for testing.
+var (
+	SummarizeNodeStatuses = summarizeNodeStatuses
+	UpdateStatusSummary   = updateStatusSummary
+)
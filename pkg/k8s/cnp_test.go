@@ -737,3 +737,59 @@ func (k *K8sIntegrationSuite) Benchmark_GetNodesJSON(c *C) {
 	c.Logf("Running with %d parallel clients and %d nodes", nClients, nCycles)
 	k.benchmarkGetNodes(os.Getenv("INTEGRATION") != "", nCycles, nClients, false, c)
 }
+
+func (k *K8sIntegrationSuite) Test_summarizeNodeStatuses(c *C) {
+	statuses := map[string]v2.CiliumNetworkPolicyNodeStatus{
+		"node-a": {OK: true},
+		"node-b": {Error: "unsupported L7 parser"},
+		"node-c": {Error: "conflicting rule"},
+		"node-d": {Error: "unsupported L7 parser"},
+	}
+	summary := SummarizeNodeStatuses(statuses, 2)
+	c.Assert(summary.OK, Equals, 1)
+	c.Assert(summary.Failed, Equals, 3)
+	// Example errors are picked in lexicographical node order.
+	c.Assert(summary.Errors, checker.DeepEquals, map[string]string{
+		"node-b": "unsupported L7 parser",
+		"node-c": "conflicting rule",
+	})
+
+	summary = SummarizeNodeStatuses(map[string]v2.CiliumNetworkPolicyNodeStatus{
+		"node-a": {OK: true},
+	}, 2)
+	c.Assert(summary.OK, Equals, 1)
+	c.Assert(summary.Failed, Equals, 0)
+	c.Assert(summary.Errors, IsNil)
+}
+
+func (k *K8sIntegrationSuite) Test_updateStatusSummary(c *C) {
+	cnp := &v2.CiliumNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: "default",
+		},
+	}
+	client := fake.NewSimpleClientset(cnp)
+
+	summary := &v2.CiliumNetworkPolicyNodeStatusSummary{
+		OK:     2,
+		Failed: 1,
+		Errors: map[string]string{
+			"node-b": "unsupported L7 parser",
+		},
+	}
+	err := UpdateStatusSummary(client, "default", "db", summary)
+	c.Assert(err, IsNil)
+
+	updated, err := client.CiliumV2().CiliumNetworkPolicies("default").Get(context.TODO(), "db", metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(updated.Status.Summary, Not(IsNil))
+	c.Assert(updated.Status.Summary.OK, Equals, 2)
+	c.Assert(updated.Status.Summary.Failed, Equals, 1)
+	c.Assert(updated.Status.Summary.Errors, checker.DeepEquals, map[string]string{
+		"node-b": "unsupported L7 parser",
+	})
+	c.Assert(updated.Status.Summary.LastUpdated.Time.IsZero(), Equals, false)
+	// The summary of the caller must not be mutated by the update.
+	c.Assert(summary.LastUpdated.Time.IsZero(), Equals, true)
+}
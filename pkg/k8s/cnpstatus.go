@@ -43,6 +43,7 @@ type CNPStatusEventHandler struct {
 	cnpStore       *store.SharedStore
 	k8sStore       cache.Store
 	updateInterval time.Duration
+	maxNodeErrors  int
 }
 
 // NodeStatusUpdater handles the lifecycle around sending CNP NodeStatus updates.
@@ -97,12 +98,16 @@ func (c *cnpEventMap) delete(cnpKey string) {
 	delete(c.eventMap, cnpKey)
 }
 
-// NewCNPStatusEventHandler returns a new CNPStatusEventHandler.
-func NewCNPStatusEventHandler(k8sStore cache.Store, updateInterval time.Duration) *CNPStatusEventHandler {
+// NewCNPStatusEventHandler returns a new CNPStatusEventHandler. If
+// maxNodeErrors is non-zero, the per-node statuses are aggregated into a
+// bounded summary containing counts and up to maxNodeErrors example node
+// errors instead of being written into the status of the CNP one by one.
+func NewCNPStatusEventHandler(k8sStore cache.Store, updateInterval time.Duration, maxNodeErrors int) *CNPStatusEventHandler {
 	return &CNPStatusEventHandler{
 		eventMap:       newCNPEventMap(),
 		k8sStore:       k8sStore,
 		updateInterval: updateInterval,
+		maxNodeErrors:  maxNodeErrors,
 	}
 }
 
@@ -198,6 +203,16 @@ func (c *CNPStatusEventHandler) runStatusHandler(cnpKey string, cnp *types.SlimC
 	name := cnp.Name
 	nodeStatusMap := make(map[string]cilium_v2.CiliumNetworkPolicyNodeStatus)
 
+	// In aggregation mode the full set of per-node statuses is kept in
+	// memory and only a bounded summary of it is written into the CNP.
+	var (
+		aggregatedStatuses map[string]cilium_v2.CiliumNetworkPolicyNodeStatus
+		lastSummary        *cilium_v2.CiliumNetworkPolicyNodeStatusSummary
+	)
+	if c.maxNodeErrors > 0 {
+		aggregatedStatuses = make(map[string]cilium_v2.CiliumNetworkPolicyNodeStatus)
+	}
+
 	scopedLog := log.WithFields(logrus.Fields{
 		logfields.K8sNamespace:            namespace,
 		logfields.CiliumNetworkPolicyName: name,
@@ -275,6 +290,27 @@ func (c *CNPStatusEventHandler) runStatusHandler(cnpKey string, cnp *types.SlimC
 		// Now that we have collected all events for
 		// the given CNP, update the status for all nodes
 		// which have sent us updates.
+		if c.maxNodeErrors > 0 {
+			for node, status := range nodeStatusMap {
+				aggregatedStatuses[node] = status
+			}
+			summary := summarizeNodeStatuses(aggregatedStatuses, c.maxNodeErrors)
+			if !summary.DeepEqual(lastSummary) {
+				if err := updateStatusSummary(CiliumClient(), namespace, name, summary); err != nil {
+					scopedLog.WithError(err).Error("error updating status summary for CNP")
+					// Keep the collected events around so that the
+					// update is retried on the next interval.
+					continue
+				}
+				lastSummary = summary
+			}
+			// The collected events have been folded into the
+			// aggregated statuses, drop them.
+			for node := range nodeStatusMap {
+				delete(nodeStatusMap, node)
+			}
+			continue
+		}
 		if err := updateStatusesByCapabilities(CiliumClient(), namespace, name, nodeStatusMap); err != nil {
 			scopedLog.WithError(err).Error("error updating status for CNP")
 		}
@@ -65,8 +65,14 @@ func (e *Endpoints) DeepEqual(o *Endpoints) bool {
 // +k8s:deepcopy-gen=true
 // +deepequal-gen=true
 type Backend struct {
-	Ports    serviceStore.PortConfiguration
-	NodeName string
+	Ports       serviceStore.PortConfiguration
+	NodeName    string
+	Terminating bool
+
+	// HintsForZones is the list of zones this backend should be consumed by
+	// according to the topology aware hints of its endpoint slice. An empty
+	// list means the backend has no zone restriction.
+	HintsForZones []string
 }
 
 // String returns the string representation of an endpoints resource, with
@@ -174,13 +180,20 @@ func ParseEndpointSliceV1Beta1(ep *slim_discovery_v1beta1.EndpointSlice) (Endpoi
 		// indicates an unknown state. In most cases consumers should interpret this
 		// unknown state as ready.
 		// More info: vendor/k8s.io/api/discovery/v1beta1/types.go:114
+		terminating := false
 		if sub.Conditions.Ready != nil && !*sub.Conditions.Ready {
-			continue
+			// Terminating backends are kept so that established connections
+			// can drain. The service manager prevents them from being
+			// selected for new connections.
+			if sub.Conditions.Terminating == nil || !*sub.Conditions.Terminating {
+				continue
+			}
+			terminating = true
 		}
 		for _, addr := range sub.Addresses {
 			backend, ok := endpoints.Backends[addr]
 			if !ok {
-				backend = &Backend{Ports: serviceStore.PortConfiguration{}}
+				backend = &Backend{Ports: serviceStore.PortConfiguration{}, Terminating: terminating}
 				endpoints.Backends[addr] = backend
 				if nodeName, ok := sub.Topology["kubernetes.io/hostname"]; ok {
 					backend.NodeName = nodeName
@@ -209,6 +222,8 @@ func parseEndpointPortV1Beta1(port slim_discovery_v1beta1.EndpointPort) (string,
 			proto = loadbalancer.TCP
 		case slim_corev1.ProtocolUDP:
 			proto = loadbalancer.UDP
+		case slim_corev1.ProtocolSCTP:
+			proto = loadbalancer.SCTP
 		default:
 			return "", nil
 		}
@@ -234,13 +249,20 @@ func ParseEndpointSliceV1(ep *slim_discovery_v1.EndpointSlice) (EndpointSliceID,
 		// indicates an unknown state. In most cases consumers should interpret this
 		// unknown state as ready.
 		// More info: vendor/k8s.io/api/discovery/v1/types.go:117
+		terminating := false
 		if sub.Conditions.Ready != nil && !*sub.Conditions.Ready {
-			continue
+			// Terminating backends are kept so that established connections
+			// can drain. The service manager prevents them from being
+			// selected for new connections.
+			if sub.Conditions.Terminating == nil || !*sub.Conditions.Terminating {
+				continue
+			}
+			terminating = true
 		}
 		for _, addr := range sub.Addresses {
 			backend, ok := endpoints.Backends[addr]
 			if !ok {
-				backend = &Backend{Ports: serviceStore.PortConfiguration{}}
+				backend = &Backend{Ports: serviceStore.PortConfiguration{}, Terminating: terminating}
 				endpoints.Backends[addr] = backend
 				if sub.NodeName != nil {
 					backend.NodeName = *sub.NodeName
@@ -249,6 +271,12 @@ func ParseEndpointSliceV1(ep *slim_discovery_v1.EndpointSlice) (EndpointSliceID,
 						backend.NodeName = nodeName
 					}
 				}
+				if sub.Hints != nil && len(sub.Hints.ForZones) > 0 {
+					backend.HintsForZones = make([]string, len(sub.Hints.ForZones))
+					for i, fz := range sub.Hints.ForZones {
+						backend.HintsForZones[i] = fz.Name
+					}
+				}
 			}
 
 			for _, port := range ep.Ports {
@@ -273,6 +301,8 @@ func parseEndpointPortV1(port slim_discovery_v1.EndpointPort) (string, *loadbala
 			proto = loadbalancer.TCP
 		case slim_corev1.ProtocolUDP:
 			proto = loadbalancer.UDP
+		case slim_corev1.ProtocolSCTP:
+			proto = loadbalancer.SCTP
 		default:
 			return "", nil
 		}
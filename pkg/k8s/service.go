@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -37,6 +38,12 @@ import (
 	v1 "k8s.io/api/core/v1"
 )
 
+const (
+	serviceAffinityNone   = ""
+	serviceAffinityLocal  = "local"
+	serviceAffinityRemote = "remote"
+)
+
 func getAnnotationIncludeExternal(svc *slim_corev1.Service) bool {
 	if value, ok := svc.ObjectMeta.Annotations[annotation.GlobalService]; ok {
 		return strings.ToLower(value) == "true"
@@ -53,6 +60,69 @@ func getAnnotationShared(svc *slim_corev1.Service) bool {
 	return getAnnotationIncludeExternal(svc)
 }
 
+func getAnnotationServiceAffinity(svc *slim_corev1.Service) string {
+	if value, ok := svc.ObjectMeta.Annotations[annotation.ServiceAffinity]; ok {
+		return strings.ToLower(value)
+	}
+
+	return serviceAffinityNone
+}
+
+// getAnnotationAffinityTimeout returns the session affinity timeout override
+// from the service annotations, or (0, false) if the annotation is not set or
+// does not contain a valid timeout.
+func getAnnotationAffinityTimeout(svc *slim_corev1.Service) (uint32, bool) {
+	value, ok := svc.ObjectMeta.Annotations[annotation.ServiceAffinityTimeout]
+	if !ok {
+		return 0, false
+	}
+
+	timeout, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || timeout == 0 {
+		log.WithFields(logrus.Fields{
+			logfields.K8sSvcName:   svc.ObjectMeta.Name,
+			logfields.K8sNamespace: svc.ObjectMeta.Namespace,
+			logfields.Annotations:  annotation.ServiceAffinityTimeout,
+		}).Warnf("Ignoring invalid affinity timeout annotation value %q", value)
+		return 0, false
+	}
+
+	return uint32(timeout), true
+}
+
+// getAnnotationHealthProbePath returns the HTTP path used by the active
+// backend health checker from the service annotations, or "" if the
+// annotation is not set or does not contain a valid path.
+func getAnnotationHealthProbePath(svc *slim_corev1.Service) string {
+	value, ok := svc.ObjectMeta.Annotations[annotation.ServiceHealthProbePath]
+	if !ok {
+		return ""
+	}
+
+	if !strings.HasPrefix(value, "/") {
+		log.WithFields(logrus.Fields{
+			logfields.K8sSvcName:   svc.ObjectMeta.Name,
+			logfields.K8sNamespace: svc.ObjectMeta.Namespace,
+			logfields.Annotations:  annotation.ServiceHealthProbePath,
+		}).Warnf("Ignoring invalid health probe path annotation value %q", value)
+		return ""
+	}
+
+	return value
+}
+
+// serviceTopologyAwareHintsAnnotation is the Kubernetes annotation which
+// enables topology aware hints for a service.
+const serviceTopologyAwareHintsAnnotation = "service.kubernetes.io/topology-aware-hints"
+
+// getAnnotationTopologyAwareHints returns true if the service requests
+// topology aware routing of its backends via the upstream Kubernetes
+// topology aware hints annotation.
+func getAnnotationTopologyAwareHints(svc *slim_corev1.Service) bool {
+	value := svc.ObjectMeta.Annotations[serviceTopologyAwareHintsAnnotation]
+	return strings.ToLower(value) == "auto"
+}
+
 // isValidServiceFrontendIP returns true if the provided service frontend IP address type
 // is supported in cilium configuration.
 func isValidServiceFrontendIP(netIP net.IP) bool {
@@ -156,12 +226,18 @@ func ParseService(svc *slim_corev1.Service, nodeAddressing datapath.NodeAddressi
 
 	svcInfo.IncludeExternal = getAnnotationIncludeExternal(svc)
 	svcInfo.Shared = getAnnotationShared(svc)
+	svcInfo.ServiceAffinity = getAnnotationServiceAffinity(svc)
+	svcInfo.HealthProbePath = getAnnotationHealthProbePath(svc)
+	svcInfo.TopologyAware = getAnnotationTopologyAwareHints(svc)
 
 	if svc.Spec.SessionAffinity == slim_corev1.ServiceAffinityClientIP {
 		svcInfo.SessionAffinity = true
 		if cfg := svc.Spec.SessionAffinityConfig; cfg != nil && cfg.ClientIP != nil && cfg.ClientIP.TimeoutSeconds != nil {
 			svcInfo.SessionAffinityTimeoutSec = uint32(*cfg.ClientIP.TimeoutSeconds)
 		}
+		if timeout, ok := getAnnotationAffinityTimeout(svc); ok {
+			svcInfo.SessionAffinityTimeoutSec = timeout
+		}
 		if svcInfo.SessionAffinityTimeoutSec == 0 {
 			svcInfo.SessionAffinityTimeoutSec = uint32(v1.DefaultClientIPServiceAffinitySeconds)
 		}
@@ -292,6 +368,11 @@ type Service struct {
 	// +deepequal-gen=false
 	Shared bool
 
+	// ServiceAffinity determines the preferred endpoint destination of a
+	// global service, e.g. local or remote clusters
+	// +deepequal-gen=false
+	ServiceAffinity string
+
 	// TrafficPolicy controls how backends are selected. If set to "Local", only
 	// node-local backends are chosen
 	TrafficPolicy loadbalancer.SVCTrafficPolicy
@@ -331,6 +412,16 @@ type Service struct {
 	// SessionAffinityTimeoutSeconds denotes session affinity timeout
 	SessionAffinityTimeoutSec uint32
 
+	// HealthProbePath is the HTTP path requested by the active backend
+	// health checker, set via the service health probe path annotation.
+	// If empty, backends are probed with a plain TCP connect.
+	HealthProbePath string
+
+	// TopologyAware is true when the service requests topology aware
+	// routing of its backends via the upstream Kubernetes topology aware
+	// hints annotation.
+	TopologyAware bool
+
 	// Type is the internal service type
 	// +deepequal-gen=false
 	Type loadbalancer.SVCType
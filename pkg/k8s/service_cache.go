@@ -432,7 +432,9 @@ func (s *ServiceCache) UniqueServiceFrontends() FrontendList {
 }
 
 // correlateEndpoints builds a combined Endpoints of the local endpoints and
-// all external endpoints if the service is marked as a global service. Also
+// all external endpoints if the service is marked as a global service. If the
+// service has a local or remote affinity, backends from the non-preferred
+// location are only included while no preferred backend is available. Also
 // returns a boolean that indicates whether the service is ready to be plumbed,
 // this is true if:
 // A local endpoints resource is present. Regardless whether the
@@ -451,22 +453,37 @@ func (s *ServiceCache) correlateEndpoints(id ServiceID) (*Endpoints, bool) {
 
 	svc, hasExternalService := s.services[id]
 	if hasExternalService && svc.IncludeExternal {
-		externalEndpoints, hasExternalEndpoints := s.externalEndpoints[id]
-		if hasExternalEndpoints {
-			// remote cluster endpoints already contain all Endpoints from all
-			// EndpointSlices so no need to search the endpoints of a particular
-			// EndpointSlice.
-			for clusterName, remoteClusterEndpoints := range externalEndpoints.endpoints {
-				for ip, e := range remoteClusterEndpoints.Backends {
-					if _, ok := endpoints.Backends[ip]; ok {
-						log.WithFields(logrus.Fields{
-							logfields.K8sSvcName:   id.Name,
-							logfields.K8sNamespace: id.Namespace,
-							logfields.IPAddr:       ip,
-							"cluster":              clusterName,
-						}).Warning("Conflicting service backend IP")
-					} else {
-						endpoints.Backends[ip] = e
+		// With local service affinity, the remote backends only serve as
+		// fallback while no local backend is available.
+		if svc.ServiceAffinity != serviceAffinityLocal || len(endpoints.Backends) == 0 {
+			externalEndpoints, hasExternalEndpoints := s.externalEndpoints[id]
+			if hasExternalEndpoints {
+				remoteBackends := 0
+				// remote cluster endpoints already contain all Endpoints from all
+				// EndpointSlices so no need to search the endpoints of a particular
+				// EndpointSlice.
+				for clusterName, remoteClusterEndpoints := range externalEndpoints.endpoints {
+					for ip, e := range remoteClusterEndpoints.Backends {
+						if _, ok := endpoints.Backends[ip]; ok {
+							log.WithFields(logrus.Fields{
+								logfields.K8sSvcName:   id.Name,
+								logfields.K8sNamespace: id.Namespace,
+								logfields.IPAddr:       ip,
+								"cluster":              clusterName,
+							}).Warning("Conflicting service backend IP")
+						} else {
+							endpoints.Backends[ip] = e
+							remoteBackends++
+						}
+					}
+				}
+
+				// With remote service affinity, the local backends only serve
+				// as fallback while no remote cluster announces backends for
+				// the service.
+				if svc.ServiceAffinity == serviceAffinityRemote && remoteBackends > 0 && hasLocalEndpoints {
+					for ip := range localEndpoints.Backends {
+						delete(endpoints.Backends, ip)
 					}
 				}
 			}
@@ -595,6 +595,241 @@ func (s *K8sSuite) TestServiceMerging(c *check.C) {
 	}, 2*time.Second), check.IsNil)
 }
 
+func (s *K8sSuite) TestServiceMergingWithLocalAffinity(c *check.C) {
+	svcCache := NewServiceCache(fakeDatapath.NewNodeAddressing())
+
+	k8sSvc := &slim_corev1.Service{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "bar",
+			Annotations: map[string]string{
+				"io.cilium/global-service":   "true",
+				"service.cilium.io/affinity": "local",
+			},
+		},
+		Spec: slim_corev1.ServiceSpec{
+			ClusterIP: "127.0.0.1",
+			Type:      slim_corev1.ServiceTypeClusterIP,
+			Ports: []slim_corev1.ServicePort{
+				{
+					Name:     "foo",
+					Protocol: slim_corev1.ProtocolTCP,
+					Port:     80,
+				},
+			},
+		},
+	}
+
+	swgSvcs := lock.NewStoppableWaitGroup()
+	svcID := svcCache.UpdateService(k8sSvc, swgSvcs)
+
+	k8sEndpoints := &slim_corev1.Endpoints{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "bar",
+		},
+		Subsets: []slim_corev1.EndpointSubset{
+			{
+				Addresses: []slim_corev1.EndpointAddress{{IP: "2.2.2.2"}},
+				Ports: []slim_corev1.EndpointPort{
+					{
+						Name:     "http-test-svc",
+						Port:     8080,
+						Protocol: slim_corev1.ProtocolTCP,
+					},
+				},
+			},
+		},
+	}
+
+	swgEps := lock.NewStoppableWaitGroup()
+	svcCache.UpdateEndpoints(k8sEndpoints, swgEps)
+
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, UpdateService)
+		c.Assert(event.ID, check.Equals, svcID)
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	// While local backends are available, the remote backends must not be
+	// selected
+	svcCache.MergeExternalServiceUpdate(&serviceStore.ClusterService{
+		Cluster:   "cluster1",
+		Namespace: "bar",
+		Name:      "foo",
+		Backends: map[string]serviceStore.PortConfiguration{
+			"3.3.3.3": map[string]*loadbalancer.L4Addr{
+				"port": {Protocol: loadbalancer.TCP, Port: 80},
+			},
+		},
+	},
+		swgSvcs,
+	)
+
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, UpdateService)
+		c.Assert(event.ID, check.Equals, svcID)
+		c.Assert(event.Endpoints.Backends["2.2.2.2"], check.Not(check.IsNil))
+		c.Assert(event.Endpoints.Backends["3.3.3.3"], check.IsNil)
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	// Losing the local backends must fail over to the remote backends
+	// without the service being deleted
+	svcCache.DeleteEndpoints(k8sEndpoints, swgEps)
+
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, UpdateService)
+		c.Assert(event.ID, check.Equals, svcID)
+		c.Assert(event.Endpoints.Backends["2.2.2.2"], check.IsNil)
+		c.Assert(event.Endpoints.Backends["3.3.3.3"], check.Not(check.IsNil))
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	// When the local backends come back, they take precedence again and the
+	// remote backends are withdrawn
+	svcCache.UpdateEndpoints(k8sEndpoints, swgEps)
+
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, UpdateService)
+		c.Assert(event.ID, check.Equals, svcID)
+		c.Assert(event.Endpoints.Backends["2.2.2.2"], check.Not(check.IsNil))
+		c.Assert(event.Endpoints.Backends["3.3.3.3"], check.IsNil)
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	swgSvcs.Stop()
+	c.Assert(testutils.WaitUntil(func() bool {
+		swgSvcs.Wait()
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	swgEps.Stop()
+	c.Assert(testutils.WaitUntil(func() bool {
+		swgEps.Wait()
+		return true
+	}, 2*time.Second), check.IsNil)
+}
+
+func (s *K8sSuite) TestServiceMergingWithRemoteAffinity(c *check.C) {
+	svcCache := NewServiceCache(fakeDatapath.NewNodeAddressing())
+
+	k8sSvc := &slim_corev1.Service{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "bar",
+			Annotations: map[string]string{
+				"io.cilium/global-service":   "true",
+				"service.cilium.io/affinity": "remote",
+			},
+		},
+		Spec: slim_corev1.ServiceSpec{
+			ClusterIP: "127.0.0.1",
+			Type:      slim_corev1.ServiceTypeClusterIP,
+			Ports: []slim_corev1.ServicePort{
+				{
+					Name:     "foo",
+					Protocol: slim_corev1.ProtocolTCP,
+					Port:     80,
+				},
+			},
+		},
+	}
+
+	swgSvcs := lock.NewStoppableWaitGroup()
+	svcID := svcCache.UpdateService(k8sSvc, swgSvcs)
+
+	k8sEndpoints := &slim_corev1.Endpoints{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "bar",
+		},
+		Subsets: []slim_corev1.EndpointSubset{
+			{
+				Addresses: []slim_corev1.EndpointAddress{{IP: "2.2.2.2"}},
+				Ports: []slim_corev1.EndpointPort{
+					{
+						Name:     "http-test-svc",
+						Port:     8080,
+						Protocol: slim_corev1.ProtocolTCP,
+					},
+				},
+			},
+		},
+	}
+
+	swgEps := lock.NewStoppableWaitGroup()
+	svcCache.UpdateEndpoints(k8sEndpoints, swgEps)
+
+	// While no remote cluster announces backends, the local backends are
+	// selected
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, UpdateService)
+		c.Assert(event.ID, check.Equals, svcID)
+		c.Assert(event.Endpoints.Backends["2.2.2.2"], check.Not(check.IsNil))
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	// Remote backends take precedence over the local ones
+	cluster1svc := &serviceStore.ClusterService{
+		Cluster:   "cluster1",
+		Namespace: "bar",
+		Name:      "foo",
+		Backends: map[string]serviceStore.PortConfiguration{
+			"3.3.3.3": map[string]*loadbalancer.L4Addr{
+				"port": {Protocol: loadbalancer.TCP, Port: 80},
+			},
+		},
+	}
+	svcCache.MergeExternalServiceUpdate(cluster1svc, swgSvcs)
+
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, UpdateService)
+		c.Assert(event.ID, check.Equals, svcID)
+		c.Assert(event.Endpoints.Backends["2.2.2.2"], check.IsNil)
+		c.Assert(event.Endpoints.Backends["3.3.3.3"], check.Not(check.IsNil))
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	// Losing the remote backends must fail back to the local backends
+	// without the service being deleted
+	svcCache.MergeExternalServiceDelete(cluster1svc, swgSvcs)
+
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, UpdateService)
+		c.Assert(event.ID, check.Equals, svcID)
+		c.Assert(event.Endpoints.Backends["2.2.2.2"], check.Not(check.IsNil))
+		c.Assert(event.Endpoints.Backends["3.3.3.3"], check.IsNil)
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	swgSvcs.Stop()
+	c.Assert(testutils.WaitUntil(func() bool {
+		swgSvcs.Wait()
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	swgEps.Stop()
+	c.Assert(testutils.WaitUntil(func() bool {
+		swgEps.Wait()
+		return true
+	}, 2*time.Second), check.IsNil)
+}
+
 func (s *K8sSuite) TestNonSharedServie(c *check.C) {
 	svcCache := NewServiceCache(fakeDatapath.NewNodeAddressing())
 
@@ -886,3 +1121,148 @@ func (s *K8sSuite) TestServiceCacheWith2EndpointSlice(c *check.C) {
 		return true
 	}, 2*time.Second), check.IsNil)
 }
+
+func (s *K8sSuite) TestServiceCacheEndpointSliceOutOfOrder(c *check.C) {
+	newEndpointSlice := func(name, svcName, addr string) *slim_discovery_v1.EndpointSlice {
+		return &slim_discovery_v1.EndpointSlice{
+			AddressType: slim_discovery_v1.AddressTypeIPv4,
+			ObjectMeta: slim_metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "bar",
+				Labels: map[string]string{
+					slim_discovery_v1.LabelServiceName: svcName,
+				},
+			},
+			Endpoints: []slim_discovery_v1.Endpoint{
+				{
+					Addresses: []string{
+						addr,
+					},
+				},
+			},
+			Ports: []slim_discovery_v1.EndpointPort{
+				{
+					Name:     func() *string { a := "http-test-svc"; return &a }(),
+					Protocol: func() *slim_corev1.Protocol { a := slim_corev1.ProtocolTCP; return &a }(),
+					Port:     func() *int32 { a := int32(8080); return &a }(),
+				},
+			},
+		}
+	}
+
+	newService := func(name, clusterIP string) *slim_corev1.Service {
+		return &slim_corev1.Service{
+			ObjectMeta: slim_metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "bar",
+			},
+			Spec: slim_corev1.ServiceSpec{
+				ClusterIP: clusterIP,
+				Type:      slim_corev1.ServiceTypeClusterIP,
+			},
+		}
+	}
+
+	svcCache := NewServiceCache(fakeDatapath.NewNodeAddressing())
+	swgSvcs := lock.NewStoppableWaitGroup()
+	swgEps := lock.NewStoppableWaitGroup()
+
+	// Endpoint slices arriving before their service are buffered and do not
+	// emit events.
+	svcCache.UpdateEndpointSlicesV1(newEndpointSlice("foo-yyyyy", "foo", "2.2.2.2"), swgEps)
+	svcCache.UpdateEndpointSlicesV1(newEndpointSlice("foo-xxxxx", "foo", "2.2.2.3"), swgEps)
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case <-svcCache.Events:
+		c.Error("Unexpected service event received before the service has been imported")
+	default:
+	}
+
+	// Once the service appears, a single event with the merged backends of
+	// all buffered slices is emitted.
+	k8sSvc := newService("foo", "127.0.0.1")
+	svcID := svcCache.UpdateService(k8sSvc, swgSvcs)
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, UpdateService)
+		c.Assert(event.ID, check.Equals, svcID)
+		c.Assert(event.Endpoints.String(), check.Equals, "2.2.2.2:8080/TCP,2.2.2.3:8080/TCP")
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	// Slice updates received while the service is briefly deleted are
+	// buffered and applied when the service is recreated.
+	svcCache.DeleteService(k8sSvc, swgSvcs)
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, DeleteService)
+		c.Assert(event.ID, check.Equals, svcID)
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	svcCache.UpdateEndpointSlicesV1(newEndpointSlice("foo-yyyyy", "foo", "2.2.2.4"), swgEps)
+	svcCache.DeleteEndpointSlices(newEndpointSlice("foo-xxxxx", "foo", "2.2.2.3"), swgEps)
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case <-svcCache.Events:
+		c.Error("Unexpected service event received while the service is deleted")
+	default:
+	}
+
+	svcCache.UpdateService(k8sSvc, swgSvcs)
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, UpdateService)
+		c.Assert(event.ID, check.Equals, svcID)
+		c.Assert(event.Endpoints.String(), check.Equals, "2.2.2.4:8080/TCP")
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	// A slice re-pointed to a different service is released from the old
+	// service and correlated with the new one, as done by the endpoint
+	// slice watcher on updates changing the owning service.
+	k8sSvc2 := newService("foo2", "127.0.0.2")
+	svcID2 := svcCache.UpdateService(k8sSvc2, swgSvcs)
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case <-svcCache.Events:
+		c.Error("Unexpected service event received before endpoints have been imported")
+	default:
+	}
+
+	svcCache.DeleteEndpointSlices(newEndpointSlice("foo-yyyyy", "foo", "2.2.2.4"), swgEps)
+	svcCache.UpdateEndpointSlicesV1(newEndpointSlice("foo-yyyyy", "foo2", "2.2.2.4"), swgEps)
+
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, UpdateService)
+		c.Assert(event.ID, check.Equals, svcID)
+		c.Assert(event.Endpoints.String(), check.Equals, "")
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	c.Assert(testutils.WaitUntil(func() bool {
+		event := <-svcCache.Events
+		defer event.SWG.Done()
+		c.Assert(event.Action, check.Equals, UpdateService)
+		c.Assert(event.ID, check.Equals, svcID2)
+		c.Assert(event.Endpoints.String(), check.Equals, "2.2.2.4:8080/TCP")
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	swgSvcs.Stop()
+	c.Assert(testutils.WaitUntil(func() bool {
+		swgSvcs.Wait()
+		return true
+	}, 2*time.Second), check.IsNil)
+
+	swgEps.Stop()
+	c.Assert(testutils.WaitUntil(func() bool {
+		swgEps.Wait()
+		return true
+	}, 2*time.Second), check.IsNil)
+}
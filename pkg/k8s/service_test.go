@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/cilium/cilium/pkg/annotation"
 	"github.com/cilium/cilium/pkg/checker"
 	"github.com/cilium/cilium/pkg/cidr"
 	fakeDatapath "github.com/cilium/cilium/pkg/datapath/fake"
@@ -222,6 +223,46 @@ func (s *K8sSuite) TestParseService(c *check.C) {
 	})
 }
 
+func (s *K8sSuite) TestParseServiceAffinityTimeout(c *check.C) {
+	timeoutSeconds := int32(120)
+	k8sSvc := func(annotations map[string]string) *slim_corev1.Service {
+		return &slim_corev1.Service{
+			ObjectMeta: slim_metav1.ObjectMeta{
+				Name:        "foo",
+				Namespace:   "bar",
+				Annotations: annotations,
+			},
+			Spec: slim_corev1.ServiceSpec{
+				ClusterIP:       "127.0.0.1",
+				Type:            slim_corev1.ServiceTypeClusterIP,
+				SessionAffinity: slim_corev1.ServiceAffinityClientIP,
+				SessionAffinityConfig: &slim_corev1.SessionAffinityConfig{
+					ClientIP: &slim_corev1.ClientIPConfig{
+						TimeoutSeconds: &timeoutSeconds,
+					},
+				},
+			},
+		}
+	}
+
+	// Without the annotation the timeout from the spec is used
+	_, svc := ParseService(k8sSvc(nil), fakeDatapath.NewNodeAddressing())
+	c.Assert(svc.SessionAffinity, check.Equals, true)
+	c.Assert(svc.SessionAffinityTimeoutSec, check.Equals, uint32(120))
+
+	// The annotation takes precedence over the spec
+	_, svc = ParseService(k8sSvc(map[string]string{
+		annotation.ServiceAffinityTimeout: "300",
+	}), fakeDatapath.NewNodeAddressing())
+	c.Assert(svc.SessionAffinityTimeoutSec, check.Equals, uint32(300))
+
+	// An invalid annotation value is ignored
+	_, svc = ParseService(k8sSvc(map[string]string{
+		annotation.ServiceAffinityTimeout: "not-a-number",
+	}), fakeDatapath.NewNodeAddressing())
+	c.Assert(svc.SessionAffinityTimeoutSec, check.Equals, uint32(120))
+}
+
 func (s *K8sSuite) TestIsK8ServiceExternal(c *check.C) {
 	si := Service{}
 
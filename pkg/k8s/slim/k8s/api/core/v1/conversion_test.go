@@ -0,0 +1,158 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
+
+	fuzz "github.com/google/gofuzz"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestPodConversionFromUpstream decodes the protobuf wire format produced by
+// the upstream Pod type into the slim Pod type and verifies that the fields
+// needed to distinguish terminating pods from deleted ones are carried over.
+func TestPodConversionFromUpstream(t *testing.T) {
+	deletionTime := metav1.NewTime(time.Unix(1234567890, 0))
+	transitionTime := metav1.NewTime(time.Unix(1234567800, 0))
+
+	upstreamPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "foo",
+			Namespace:         "bar",
+			DeletionTimestamp: &deletionTime,
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{
+					Type:               corev1.PodReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: transitionTime,
+				},
+			},
+		},
+	}
+
+	data, err := upstreamPod.Marshal()
+	if err != nil {
+		t.Fatalf("failed to marshal upstream Pod: %s", err)
+	}
+
+	slimPod := &Pod{}
+	if err := slimPod.Unmarshal(data); err != nil {
+		t.Fatalf("failed to unmarshal slim Pod: %s", err)
+	}
+
+	if slimPod.ObjectMeta.DeletionTimestamp == nil {
+		t.Fatal("DeletionTimestamp was not carried over")
+	}
+	if !slimPod.ObjectMeta.DeletionTimestamp.Time.Equal(deletionTime.Time) {
+		t.Errorf("DeletionTimestamp mismatch: got %s, want %s",
+			slimPod.ObjectMeta.DeletionTimestamp.Time, deletionTime.Time)
+	}
+	if len(slimPod.Status.Conditions) != 1 {
+		t.Fatalf("expected 1 pod condition, got %d", len(slimPod.Status.Conditions))
+	}
+	cond := slimPod.Status.Conditions[0]
+	if cond.Type != PodReady {
+		t.Errorf("condition type mismatch: got %q, want %q", cond.Type, PodReady)
+	}
+	if !cond.LastTransitionTime.Time.Equal(transitionTime.Time) {
+		t.Errorf("LastTransitionTime mismatch: got %s, want %s",
+			cond.LastTransitionTime.Time, transitionTime.Time)
+	}
+}
+
+// TestObjectMetaConversionFuzz feeds fuzzed upstream ObjectMeta objects
+// through the protobuf wire format into the slim ObjectMeta type and verifies
+// that all fields carried by the slim type survive the conversion.
+func TestObjectMetaConversionFuzz(t *testing.T) {
+	fuzzer := fuzz.New().NilChance(0.5).NumElements(1, 3).Funcs(
+		func(ts *metav1.Time, c fuzz.Continue) {
+			// The protobuf representation of Time has second
+			// granularity.
+			*ts = metav1.NewTime(time.Unix(c.Int63n(1000000000), 0))
+		},
+	)
+
+	for i := 0; i < 100; i++ {
+		upstreamMeta := &metav1.ObjectMeta{}
+		fuzzer.Fuzz(upstreamMeta)
+
+		data, err := upstreamMeta.Marshal()
+		if err != nil {
+			t.Fatalf("failed to marshal upstream ObjectMeta: %s", err)
+		}
+
+		slimMeta := &slim_metav1.ObjectMeta{}
+		if err := slimMeta.Unmarshal(data); err != nil {
+			t.Fatalf("failed to unmarshal slim ObjectMeta: %s", err)
+		}
+
+		if slimMeta.Name != upstreamMeta.Name {
+			t.Errorf("Name mismatch: got %q, want %q", slimMeta.Name, upstreamMeta.Name)
+		}
+		if slimMeta.Namespace != upstreamMeta.Namespace {
+			t.Errorf("Namespace mismatch: got %q, want %q", slimMeta.Namespace, upstreamMeta.Namespace)
+		}
+		if slimMeta.UID != upstreamMeta.UID {
+			t.Errorf("UID mismatch: got %q, want %q", slimMeta.UID, upstreamMeta.UID)
+		}
+		if slimMeta.ResourceVersion != upstreamMeta.ResourceVersion {
+			t.Errorf("ResourceVersion mismatch: got %q, want %q",
+				slimMeta.ResourceVersion, upstreamMeta.ResourceVersion)
+		}
+		if (slimMeta.DeletionTimestamp == nil) != (upstreamMeta.DeletionTimestamp == nil) {
+			t.Errorf("DeletionTimestamp nilness mismatch: got %v, want %v",
+				slimMeta.DeletionTimestamp, upstreamMeta.DeletionTimestamp)
+		} else if slimMeta.DeletionTimestamp != nil &&
+			!slimMeta.DeletionTimestamp.Time.Equal(upstreamMeta.DeletionTimestamp.Time) {
+			t.Errorf("DeletionTimestamp mismatch: got %s, want %s",
+				slimMeta.DeletionTimestamp.Time, upstreamMeta.DeletionTimestamp.Time)
+		}
+		if len(slimMeta.Labels) != len(upstreamMeta.Labels) {
+			t.Errorf("Labels mismatch: got %v, want %v", slimMeta.Labels, upstreamMeta.Labels)
+		}
+		for k, v := range upstreamMeta.Labels {
+			if slimMeta.Labels[k] != v {
+				t.Errorf("Labels mismatch for key %q: got %q, want %q", k, slimMeta.Labels[k], v)
+			}
+		}
+		if len(slimMeta.Annotations) != len(upstreamMeta.Annotations) {
+			t.Errorf("Annotations mismatch: got %v, want %v", slimMeta.Annotations, upstreamMeta.Annotations)
+		}
+		for k, v := range upstreamMeta.Annotations {
+			if slimMeta.Annotations[k] != v {
+				t.Errorf("Annotations mismatch for key %q: got %q, want %q", k, slimMeta.Annotations[k], v)
+			}
+		}
+		if len(slimMeta.OwnerReferences) != len(upstreamMeta.OwnerReferences) {
+			t.Fatalf("OwnerReferences mismatch: got %v, want %v",
+				slimMeta.OwnerReferences, upstreamMeta.OwnerReferences)
+		}
+		for i, ref := range upstreamMeta.OwnerReferences {
+			if slimMeta.OwnerReferences[i].Kind != ref.Kind || slimMeta.OwnerReferences[i].Name != ref.Name {
+				t.Errorf("OwnerReferences mismatch at %d: got %v, want %v",
+					i, slimMeta.OwnerReferences[i], ref)
+			}
+		}
+	}
+}
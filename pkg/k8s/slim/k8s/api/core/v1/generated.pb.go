@@ -2096,6 +2096,11 @@ func (m *PodSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x58
+	i -= len(m.NodeName)
+	copy(dAtA[i:], m.NodeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeName)))
+	i--
+	dAtA[i] = 0x52
 	i -= len(m.ServiceAccountName)
 	copy(dAtA[i:], m.ServiceAccountName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
@@ -2997,6 +3002,8 @@ func (m *PodSpec) Size() (n int) {
 	}
 	l = len(m.ServiceAccountName)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.NodeName)
+	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
 	if len(m.InitContainers) > 0 {
 		for _, e := range m.InitContainers {
@@ -3532,6 +3539,7 @@ func (this *PodSpec) String() string {
 	s := strings.Join([]string{`&PodSpec{`,
 		`Containers:` + repeatedStringForContainers + `,`,
 		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
 		`HostNetwork:` + fmt.Sprintf("%v", this.HostNetwork) + `,`,
 		`InitContainers:` + repeatedStringForInitContainers + `,`,
 		`}`,
@@ -6476,6 +6484,38 @@ func (m *PodSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 11:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field HostNetwork", wireType)
@@ -44,6 +44,11 @@ func (in *Endpoint) DeepCopyInto(out *Endpoint) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Hints != nil {
+		in, out := &in.Hints, &out.Hints
+		*out = new(EndpointHints)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -88,6 +93,27 @@ func (in *EndpointConditions) DeepCopy() *EndpointConditions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointHints) DeepCopyInto(out *EndpointHints) {
+	*out = *in
+	if in.ForZones != nil {
+		in, out := &in.ForZones, &out.ForZones
+		*out = make([]ForZone, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointHints.
+func (in *EndpointHints) DeepCopy() *EndpointHints {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointHints)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointPort) DeepCopyInto(out *EndpointPort) {
 	*out = *in
@@ -191,3 +217,19 @@ func (in *EndpointSliceList) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForZone) DeepCopyInto(out *ForZone) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForZone.
+func (in *ForZone) DeepCopy() *ForZone {
+	if in == nil {
+		return nil
+	}
+	out := new(ForZone)
+	in.DeepCopyInto(out)
+	return out
+}
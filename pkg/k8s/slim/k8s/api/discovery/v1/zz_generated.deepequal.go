@@ -75,6 +75,14 @@ func (in *Endpoint) DeepEqual(other *Endpoint) bool {
 		}
 	}
 
+	if (in.Hints == nil) != (other.Hints == nil) {
+		return false
+	} else if in.Hints != nil {
+		if !in.Hints.DeepEqual(other.Hints) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -112,6 +120,33 @@ func (in *EndpointConditions) DeepEqual(other *EndpointConditions) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *EndpointHints) DeepEqual(other *EndpointHints) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.ForZones != nil) && (other.ForZones != nil)) || ((in.ForZones == nil) != (other.ForZones == nil)) {
+		in, other := &in.ForZones, &other.ForZones
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *EndpointPort) DeepEqual(other *EndpointPort) bool {
@@ -235,3 +270,17 @@ func (in *EndpointSliceList) DeepEqual(other *EndpointSliceList) bool {
 
 	return true
 }
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ForZone) DeepEqual(other *ForZone) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Name != other.Name {
+		return false
+	}
+
+	return true
+}
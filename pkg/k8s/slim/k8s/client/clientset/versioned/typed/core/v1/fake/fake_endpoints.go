@@ -21,6 +21,7 @@ import (
 
 	corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fields "k8s.io/apimachinery/pkg/fields"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
@@ -34,9 +35,9 @@ type FakeEndpoints struct {
 	ns   string
 }
 
-var endpointsResource = schema.GroupVersionResource{Group: "core", Version: "v1", Resource: "endpoints"}
+var endpointsResource = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "endpoints"}
 
-var endpointsKind = schema.GroupVersionKind{Group: "core", Version: "v1", Kind: "Endpoints"}
+var endpointsKind = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Endpoints"}
 
 // Get takes name of the endpoints, and returns the corresponding endpoints object, and an error if there is any.
 func (c *FakeEndpoints) Get(ctx context.Context, name string, options v1.GetOptions) (result *corev1.Endpoints, err error) {
@@ -58,13 +59,16 @@ func (c *FakeEndpoints) List(ctx context.Context, opts v1.ListOptions) (result *
 		return nil, err
 	}
 
-	label, _, _ := testing.ExtractFromListOptions(opts)
+	label, field, _ := testing.ExtractFromListOptions(opts)
 	if label == nil {
 		label = labels.Everything()
 	}
+	if field == nil {
+		field = fields.Everything()
+	}
 	list := &corev1.EndpointsList{ListMeta: obj.(*corev1.EndpointsList).ListMeta}
 	for _, item := range obj.(*corev1.EndpointsList).Items {
-		if label.Matches(labels.Set(item.Labels)) {
+		if label.Matches(labels.Set(item.Labels)) && field.Matches(endpointsFieldSet(&item)) {
 			list.Items = append(list.Items, item)
 		}
 	}
@@ -73,9 +77,12 @@ func (c *FakeEndpoints) List(ctx context.Context, opts v1.ListOptions) (result *
 
 // Watch returns a watch.Interface that watches the requested endpoints.
 func (c *FakeEndpoints) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	return c.Fake.
+	w, err := c.Fake.
 		InvokesWatch(testing.NewWatchAction(endpointsResource, c.ns, opts))
-
+	if err != nil {
+		return nil, err
+	}
+	return filterWatchByFieldSelector(w, opts), nil
 }
 
 // Create takes the representation of a endpoints and creates it.  Returns the server's representation of the endpoints, and an error, if there is any.
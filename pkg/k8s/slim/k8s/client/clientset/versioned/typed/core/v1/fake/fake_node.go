@@ -21,6 +21,7 @@ import (
 
 	corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fields "k8s.io/apimachinery/pkg/fields"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
@@ -33,9 +34,9 @@ type FakeNodes struct {
 	Fake *FakeCoreV1
 }
 
-var nodesResource = schema.GroupVersionResource{Group: "core", Version: "v1", Resource: "nodes"}
+var nodesResource = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}
 
-var nodesKind = schema.GroupVersionKind{Group: "core", Version: "v1", Kind: "Node"}
+var nodesKind = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Node"}
 
 // Get takes name of the node, and returns the corresponding node object, and an error if there is any.
 func (c *FakeNodes) Get(ctx context.Context, name string, options v1.GetOptions) (result *corev1.Node, err error) {
@@ -55,13 +56,16 @@ func (c *FakeNodes) List(ctx context.Context, opts v1.ListOptions) (result *core
 		return nil, err
 	}
 
-	label, _, _ := testing.ExtractFromListOptions(opts)
+	label, field, _ := testing.ExtractFromListOptions(opts)
 	if label == nil {
 		label = labels.Everything()
 	}
+	if field == nil {
+		field = fields.Everything()
+	}
 	list := &corev1.NodeList{ListMeta: obj.(*corev1.NodeList).ListMeta}
 	for _, item := range obj.(*corev1.NodeList).Items {
-		if label.Matches(labels.Set(item.Labels)) {
+		if label.Matches(labels.Set(item.Labels)) && field.Matches(nodeFieldSet(&item)) {
 			list.Items = append(list.Items, item)
 		}
 	}
@@ -70,8 +74,12 @@ func (c *FakeNodes) List(ctx context.Context, opts v1.ListOptions) (result *core
 
 // Watch returns a watch.Interface that watches the requested nodes.
 func (c *FakeNodes) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	return c.Fake.
+	w, err := c.Fake.
 		InvokesWatch(testing.NewRootWatchAction(nodesResource, opts))
+	if err != nil {
+		return nil, err
+	}
+	return filterWatchByFieldSelector(w, opts), nil
 }
 
 // Create takes the representation of a node and creates it.  Returns the server's representation of the node, and an error, if there is any.
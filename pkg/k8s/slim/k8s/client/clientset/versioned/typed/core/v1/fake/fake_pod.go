@@ -21,6 +21,7 @@ import (
 
 	corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fields "k8s.io/apimachinery/pkg/fields"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
@@ -34,9 +35,9 @@ type FakePods struct {
 	ns   string
 }
 
-var podsResource = schema.GroupVersionResource{Group: "core", Version: "v1", Resource: "pods"}
+var podsResource = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
 
-var podsKind = schema.GroupVersionKind{Group: "core", Version: "v1", Kind: "Pod"}
+var podsKind = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
 
 // Get takes name of the pod, and returns the corresponding pod object, and an error if there is any.
 func (c *FakePods) Get(ctx context.Context, name string, options v1.GetOptions) (result *corev1.Pod, err error) {
@@ -58,13 +59,16 @@ func (c *FakePods) List(ctx context.Context, opts v1.ListOptions) (result *corev
 		return nil, err
 	}
 
-	label, _, _ := testing.ExtractFromListOptions(opts)
+	label, field, _ := testing.ExtractFromListOptions(opts)
 	if label == nil {
 		label = labels.Everything()
 	}
+	if field == nil {
+		field = fields.Everything()
+	}
 	list := &corev1.PodList{ListMeta: obj.(*corev1.PodList).ListMeta}
 	for _, item := range obj.(*corev1.PodList).Items {
-		if label.Matches(labels.Set(item.Labels)) {
+		if label.Matches(labels.Set(item.Labels)) && field.Matches(podFieldSet(&item)) {
 			list.Items = append(list.Items, item)
 		}
 	}
@@ -73,9 +77,12 @@ func (c *FakePods) List(ctx context.Context, opts v1.ListOptions) (result *corev
 
 // Watch returns a watch.Interface that watches the requested pods.
 func (c *FakePods) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	return c.Fake.
+	w, err := c.Fake.
 		InvokesWatch(testing.NewWatchAction(podsResource, c.ns, opts))
-
+	if err != nil {
+		return nil, err
+	}
+	return filterWatchByFieldSelector(w, opts), nil
 }
 
 // Create takes the representation of a pod and creates it.  Returns the server's representation of the pod, and an error, if there is any.
@@ -21,6 +21,7 @@ import (
 
 	corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fields "k8s.io/apimachinery/pkg/fields"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
@@ -34,9 +35,9 @@ type FakeServices struct {
 	ns   string
 }
 
-var servicesResource = schema.GroupVersionResource{Group: "core", Version: "v1", Resource: "services"}
+var servicesResource = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"}
 
-var servicesKind = schema.GroupVersionKind{Group: "core", Version: "v1", Kind: "Service"}
+var servicesKind = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Service"}
 
 // Get takes name of the service, and returns the corresponding service object, and an error if there is any.
 func (c *FakeServices) Get(ctx context.Context, name string, options v1.GetOptions) (result *corev1.Service, err error) {
@@ -58,13 +59,16 @@ func (c *FakeServices) List(ctx context.Context, opts v1.ListOptions) (result *c
 		return nil, err
 	}
 
-	label, _, _ := testing.ExtractFromListOptions(opts)
+	label, field, _ := testing.ExtractFromListOptions(opts)
 	if label == nil {
 		label = labels.Everything()
 	}
+	if field == nil {
+		field = fields.Everything()
+	}
 	list := &corev1.ServiceList{ListMeta: obj.(*corev1.ServiceList).ListMeta}
 	for _, item := range obj.(*corev1.ServiceList).Items {
-		if label.Matches(labels.Set(item.Labels)) {
+		if label.Matches(labels.Set(item.Labels)) && field.Matches(serviceFieldSet(&item)) {
 			list.Items = append(list.Items, item)
 		}
 	}
@@ -73,9 +77,12 @@ func (c *FakeServices) List(ctx context.Context, opts v1.ListOptions) (result *c
 
 // Watch returns a watch.Interface that watches the requested services.
 func (c *FakeServices) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	return c.Fake.
+	w, err := c.Fake.
 		InvokesWatch(testing.NewWatchAction(servicesResource, c.ns, opts))
-
+	if err != nil {
+		return nil, err
+	}
+	return filterWatchByFieldSelector(w, opts), nil
 }
 
 // Create takes the representation of a service and creates it.  Returns the server's representation of the service, and an error, if there is any.
@@ -0,0 +1,96 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fields "k8s.io/apimachinery/pkg/fields"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// podFieldSet returns the fields of a pod which may be matched by a field
+// selector. It mirrors the selectable fields the apiserver supports for the
+// upstream pod type, restricted to the fields carried by the slim type.
+func podFieldSet(pod *corev1.Pod) fields.Set {
+	return fields.Set{
+		"metadata.name":      pod.Name,
+		"metadata.namespace": pod.Namespace,
+		"spec.nodeName":      pod.Spec.NodeName,
+		"status.phase":       string(pod.Status.Phase),
+	}
+}
+
+// nodeFieldSet returns the fields of a node which may be matched by a field
+// selector.
+func nodeFieldSet(node *corev1.Node) fields.Set {
+	return fields.Set{
+		"metadata.name": node.Name,
+	}
+}
+
+// endpointsFieldSet returns the fields of an endpoints object which may be
+// matched by a field selector.
+func endpointsFieldSet(ep *corev1.Endpoints) fields.Set {
+	return fields.Set{
+		"metadata.name":      ep.Name,
+		"metadata.namespace": ep.Namespace,
+	}
+}
+
+// serviceFieldSet returns the fields of a service which may be matched by a
+// field selector.
+func serviceFieldSet(svc *corev1.Service) fields.Set {
+	return fields.Set{
+		"metadata.name":      svc.Name,
+		"metadata.namespace": svc.Namespace,
+	}
+}
+
+// objectFieldSet returns the selectable fields of the given object, or nil if
+// the object does not support field selection.
+func objectFieldSet(obj runtime.Object) fields.Set {
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		return podFieldSet(o)
+	case *corev1.Node:
+		return nodeFieldSet(o)
+	case *corev1.Endpoints:
+		return endpointsFieldSet(o)
+	case *corev1.Service:
+		return serviceFieldSet(o)
+	}
+	return nil
+}
+
+// filterWatchByFieldSelector wraps the given watch so that events for objects
+// not matching the field selector in opts are suppressed, mirroring the
+// server-side filtering performed by the apiserver. Objects which do not
+// support field selection are passed through unfiltered.
+func filterWatchByFieldSelector(w watch.Interface, opts v1.ListOptions) watch.Interface {
+	_, field, _ := testing.ExtractFromListOptions(opts)
+	if field == nil || field.Empty() {
+		return w
+	}
+	return watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+		set := objectFieldSet(in.Object)
+		if set == nil {
+			return in, true
+		}
+		return in, field.Matches(set)
+	})
+}
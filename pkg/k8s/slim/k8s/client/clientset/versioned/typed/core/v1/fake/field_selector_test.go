@@ -0,0 +1,110 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package fake_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	slim_corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
+	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
+	"github.com/cilium/cilium/pkg/k8s/slim/k8s/client/clientset/versioned/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func newPodOnNode(name, nodeName string) *slim_corev1.Pod {
+	return &slim_corev1.Pod{
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: slim_corev1.PodSpec{
+			NodeName: nodeName,
+		},
+	}
+}
+
+// TestFakePodsFieldSelectorList verifies that the fake pod client applies the
+// field selector of the list options, as the real apiserver would.
+func TestFakePodsFieldSelectorList(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newPodOnNode("foo", "node1"),
+		newPodOnNode("bar", "node2"),
+	)
+
+	pods, err := client.CoreV1().Pods("default").List(context.TODO(), metav1.ListOptions{
+		FieldSelector: "spec.nodeName=node1",
+	})
+	if err != nil {
+		t.Fatalf("failed to list pods: %s", err)
+	}
+	if len(pods.Items) != 1 {
+		t.Fatalf("expected 1 pod, got %d", len(pods.Items))
+	}
+	if pods.Items[0].Name != "foo" {
+		t.Errorf("expected pod %q, got %q", "foo", pods.Items[0].Name)
+	}
+}
+
+// TestFakePodsFieldSelectorWatch verifies that a node-scoped pod watch against
+// the fake clientset only sees events for pods of that node.
+func TestFakePodsFieldSelectorWatch(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	w, err := client.CoreV1().Pods("default").Watch(context.TODO(), metav1.ListOptions{
+		FieldSelector: "spec.nodeName=node1",
+	})
+	if err != nil {
+		t.Fatalf("failed to watch pods: %s", err)
+	}
+	defer w.Stop()
+
+	for _, pod := range []*slim_corev1.Pod{
+		newPodOnNode("other-node-pod", "node2"),
+		newPodOnNode("local-node-pod", "node1"),
+	} {
+		_, err = client.CoreV1().Pods("default").Create(context.TODO(), pod, metav1.CreateOptions{})
+		if err != nil {
+			t.Fatalf("failed to create pod %s: %s", pod.Name, err)
+		}
+	}
+
+	// Only the pod scheduled onto node1 may be seen by the watch. The pod
+	// of the other node was created first, so receiving the node1 pod also
+	// proves the other event was filtered rather than still in flight.
+	select {
+	case event := <-w.ResultChan():
+		if event.Type != watch.Added {
+			t.Fatalf("expected %s event, got %s", watch.Added, event.Type)
+		}
+		pod := event.Object.(*slim_corev1.Pod)
+		if pod.Name != "local-node-pod" {
+			t.Fatalf("watch delivered pod %q not matching the field selector", pod.Name)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for watch event")
+	}
+
+	select {
+	case event := <-w.ResultChan():
+		t.Fatalf("unexpected watch event: %+v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -72,6 +72,13 @@ func (k *K8sWatcher) endpointSlicesInit(k8sClient kubernetes.Interface, swgEps *
 						return
 					}
 
+					// The service owning an endpoint slice may change, e.g.
+					// when the service name label is rewritten. Release the
+					// slice from the previous service so it does not keep
+					// serving stale backends.
+					if k8s.ParseEndpointSliceID(oldk8sEP) != k8s.ParseEndpointSliceID(newk8sEP) {
+						k.K8sSvcCache.DeleteEndpointSlices(oldk8sEP, swgEps)
+					}
 					k.K8sSvcCache.UpdateEndpointSlicesV1(newk8sEP, swgEps)
 					k.K8sEventProcessed(metricEndpointSlice, metricUpdate, true)
 				}
@@ -117,6 +124,12 @@ func (k *K8sWatcher) endpointSlicesInit(k8sClient kubernetes.Interface, swgEps *
 						return
 					}
 
+					// See the v1 update handler above for why the slice is
+					// released from the previous service when the owning
+					// service changes.
+					if k8s.ParseEndpointSliceID(oldk8sEP) != k8s.ParseEndpointSliceID(newk8sEP) {
+						k.K8sSvcCache.DeleteEndpointSlices(oldk8sEP, swgEps)
+					}
 					k.K8sSvcCache.UpdateEndpointSlicesV1Beta1(newk8sEP, swgEps)
 					k.K8sEventProcessed(metricEndpointSlice, metricUpdate, true)
 				}
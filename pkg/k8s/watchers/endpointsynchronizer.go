@@ -30,7 +30,9 @@ import (
 	v2 "github.com/cilium/cilium/pkg/k8s/client/clientset/versioned/typed/cilium.io/v2"
 	k8sversion "github.com/cilium/cilium/pkg/k8s/version"
 	pkgLabels "github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/rand"
 
 	"github.com/blang/semver/v4"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -41,8 +43,21 @@ import (
 const (
 	// subsysEndpointSync is the value for logfields.LogSubsys
 	subsysEndpointSync = "endpointsynchronizer"
+
+	// cepSyncInterval is the base interval between CEP sync controller runs.
+	// Status changes occurring within one interval are coalesced into a
+	// single write.
+	cepSyncInterval = 10 * time.Second
+
+	// cepSyncJitter is the maximum additional delay applied on top of
+	// cepSyncInterval. It spreads CEP writes of different endpoints over
+	// time to avoid synchronized bursts against the kube-apiserver.
+	cepSyncJitter = 5 * time.Second
 )
 
+// syncRand generates the per-endpoint jitter for the CEP sync interval.
+var syncRand = rand.NewSafeRand(time.Now().UnixNano())
+
 // EndpointSynchronizer currently is an empty type, which wraps around syncing
 // of CiliumEndpoint resources.
 type EndpointSynchronizer struct{}
@@ -84,10 +99,14 @@ func (epSync *EndpointSynchronizer) RunK8sCiliumEndpointSync(e *endpoint.Endpoin
 		needInit = true                    // needInit indicates that we may need to create the CEP
 	)
 
+	// Spread the sync intervals of the individual endpoints so that their
+	// writes do not all hit the kube-apiserver at the same time.
+	syncInterval := cepSyncInterval + time.Duration(syncRand.Int63n(int64(cepSyncJitter)))
+
 	// NOTE: The controller functions do NOT hold the endpoint locks
 	e.UpdateController(controllerName,
 		controller.ControllerParams{
-			RunInterval: 10 * time.Second,
+			RunInterval: syncInterval,
 			DoFunc: func(ctx context.Context) (err error) {
 				// Update logger as scopeLog might not have the podName when it
 				// was created.
@@ -133,6 +152,17 @@ func (epSync *EndpointSynchronizer) RunK8sCiliumEndpointSync(e *endpoint.Endpoin
 					return nil
 				}
 
+				// The model may differ only in volatile fields such as
+				// controller run timestamps. Skip the write when the
+				// semantic content is unchanged, but retain the new model
+				// so the comparison above catches the common case cheaply.
+				if !needInit && endpointStatusEquals(mdl, lastMdl) {
+					scopedLog.Debug("Skipping CiliumEndpoint update because the semantic content has not changed")
+					metrics.KubernetesCEPWritesAvoided.Inc()
+					lastMdl = mdl
+					return nil
+				}
+
 				// Initialize the CEP by deleting the upstream instance and recreating
 				// it. Deleting first allows for upgrade scenarios where the format has
 				// changed but our k8s CEP code cannot read in the upstream value.
@@ -288,6 +318,39 @@ func (epSync *EndpointSynchronizer) RunK8sCiliumEndpointSync(e *endpoint.Endpoin
 		})
 }
 
+// endpointStatusEquals returns true if the two endpoint statuses carry the
+// same semantic content, i.e. they only differ in volatile fields such as
+// controller run counters and timestamps. Writing these out would churn the
+// kube-apiserver without conveying any new information about the endpoint.
+func endpointStatusEquals(a, b *cilium_v2.EndpointStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	aCopy := a.DeepCopy()
+	bCopy := b.DeepCopy()
+	clearVolatileEndpointStatus(aCopy)
+	clearVolatileEndpointStatus(bCopy)
+	return aCopy.DeepEqual(bCopy)
+}
+
+// clearVolatileEndpointStatus zeroes the fields of the endpoint status which
+// change on every controller run without reflecting a change in the state of
+// the endpoint itself.
+func clearVolatileEndpointStatus(s *cilium_v2.EndpointStatus) {
+	for i := range s.Controllers {
+		s.Controllers[i].Status.ConsecutiveFailureCount = 0
+		s.Controllers[i].Status.FailureCount = 0
+		s.Controllers[i].Status.SuccessCount = 0
+		s.Controllers[i].Status.LastFailureTimestamp = ""
+		s.Controllers[i].Status.LastSuccessTimestamp = ""
+	}
+	for _, l := range s.Log {
+		if l != nil {
+			l.Timestamp = ""
+		}
+	}
+}
+
 // DeleteK8sCiliumEndpointSync replaces the endpoint controller to remove the
 // CEP from Kubernetes once the endpoint is stopped / removed from the
 // Cilium agent.
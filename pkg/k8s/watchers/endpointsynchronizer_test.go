@@ -0,0 +1,75 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package watchers
+
+import (
+	"github.com/cilium/cilium/api/v1/models"
+	cilium_v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *K8sWatcherSuite) TestEndpointStatusEquals(c *C) {
+	base := &cilium_v2.EndpointStatus{
+		ID: 42,
+		Controllers: cilium_v2.ControllerList{
+			{
+				Name: "sync-policymap",
+				Status: cilium_v2.ControllerStatusStatus{
+					SuccessCount:         3,
+					LastSuccessTimestamp: "2021-06-17T10:00:00Z",
+				},
+			},
+		},
+		Identity: &cilium_v2.EndpointIdentity{
+			ID:     1234,
+			Labels: []string{"k8s:app=foo"},
+		},
+		Log: []*models.EndpointStatusChange{
+			{
+				Code:      "ok",
+				Message:   "Successfully regenerated endpoint program",
+				Timestamp: "2021-06-17T10:00:00Z",
+			},
+		},
+	}
+
+	// Only volatile fields differ: controller run counters and timestamps.
+	volatile := base.DeepCopy()
+	volatile.Controllers[0].Status.SuccessCount = 4
+	volatile.Controllers[0].Status.LastSuccessTimestamp = "2021-06-17T10:00:10Z"
+	volatile.Log[0].Timestamp = "2021-06-17T10:00:10Z"
+	c.Assert(endpointStatusEquals(base, volatile), Equals, true)
+	c.Assert(base.DeepEqual(volatile), Equals, false)
+
+	// Identity changes are semantic and must not be skipped.
+	identity := base.DeepCopy()
+	identity.Identity.ID = 5678
+	c.Assert(endpointStatusEquals(base, identity), Equals, false)
+
+	// A new controller failure message is semantic.
+	failure := base.DeepCopy()
+	failure.Controllers[0].Status.LastFailureMsg = "policymap sync failed"
+	c.Assert(endpointStatusEquals(base, failure), Equals, false)
+
+	// The helpers must not mutate their inputs.
+	c.Assert(base.Controllers[0].Status.SuccessCount, Equals, int64(3))
+	c.Assert(base.Log[0].Timestamp, Equals, "2021-06-17T10:00:00Z")
+
+	c.Assert(endpointStatusEquals(nil, nil), Equals, true)
+	c.Assert(endpointStatusEquals(base, nil), Equals, false)
+}
@@ -15,9 +15,12 @@
 package watchers
 
 import (
+	"context"
 	"errors"
+	"strings"
 	"sync"
 
+	"github.com/cilium/cilium/pkg/annotation"
 	"github.com/cilium/cilium/pkg/k8s"
 	ciliumio "github.com/cilium/cilium/pkg/k8s/apis/cilium.io"
 	"github.com/cilium/cilium/pkg/k8s/informer"
@@ -44,10 +47,23 @@ func (k *K8sWatcher) namespacesInit(k8sClient kubernetes.Interface, asyncControl
 		&slim_corev1.Namespace{},
 		0,
 		cache.ResourceEventHandlerFuncs{
-			// AddFunc does not matter since the endpoint will fetch
-			// namespace labels when the endpoint is created
-			// DelFunc does not matter since, when a namespace is deleted, all
-			// pods belonging to that namespace are also deleted.
+			// Namespace labels do not need to be handled in AddFunc since
+			// the endpoint will fetch them when the endpoint is created,
+			// but any label filter annotation must be registered before
+			// pods in the namespace are assigned an identity.
+			AddFunc: func(obj interface{}) {
+				if ns := k8s.ObjToV1Namespace(obj); ns != nil {
+					k.updateNamespaceLabelFilter(ns)
+				}
+			},
+			// When a namespace is deleted, all pods belonging to that
+			// namespace are also deleted, only the label filter needs to
+			// be cleaned up.
+			DeleteFunc: func(obj interface{}) {
+				if ns := k8s.ObjToV1Namespace(obj); ns != nil {
+					labelsfilter.SetNamespaceLabelPrefixes(ns.Name, nil)
+				}
+			},
 			UpdateFunc: func(oldObj, newObj interface{}) {
 				var valid, equal bool
 				defer func() { k.K8sEventReceived(metricNS, metricUpdate, valid, equal) }()
@@ -75,7 +91,60 @@ func (k *K8sWatcher) namespacesInit(k8sClient kubernetes.Interface, asyncControl
 	namespaceController.Run(wait.NeverStop)
 }
 
+// namespaceLabelFilterPatterns returns the label filter patterns configured
+// in the label-prefixes annotation of the namespace, if any.
+func namespaceLabelFilterPatterns(ns *slim_corev1.Namespace) []string {
+	var patterns []string
+	for _, p := range strings.Split(ns.GetAnnotations()[annotation.LabelPrefixes], ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+// updateNamespaceLabelFilter applies the label filter patterns annotated on
+// the namespace and returns true if the effective filter configuration for
+// the namespace changed.
+func (k *K8sWatcher) updateNamespaceLabelFilter(ns *slim_corev1.Namespace) bool {
+	changed, err := labelsfilter.SetNamespaceLabelPrefixes(ns.Name, namespaceLabelFilterPatterns(ns))
+	if err != nil {
+		log.WithError(err).WithField(logfields.K8sNamespace, ns.Name).
+			Warning("Ignoring invalid label filter patterns in namespace annotation")
+		return false
+	}
+	return changed
+}
+
+// reevaluateNamespaceIdentityLabels re-applies the label filter to the pod
+// labels of all local endpoints in the namespace. Endpoints whose identity
+// labels change as a result will receive a new identity and be regenerated.
+func (k *K8sWatcher) reevaluateNamespaceIdentityLabels(ns *slim_corev1.Namespace) {
+	for _, ep := range k.endpointManager.GetEndpoints() {
+		if ep.GetK8sNamespace() != ns.Name {
+			continue
+		}
+		pod := ep.GetPod()
+		if pod == nil {
+			continue
+		}
+		_, lbls, _, err := k8s.GetPodMetadata(ns, pod)
+		if err != nil {
+			log.WithError(err).WithField(logfields.EndpointID, ep.ID).
+				Warning("Unable to re-evaluate endpoint labels with updated label filter")
+			continue
+		}
+		k8sLbls := labels.Map2Labels(lbls, labels.LabelSourceK8s)
+		identityLabels, infoLabels := labelsfilter.FilterByNamespace(ns.Name, k8sLbls)
+		ep.UpdateLabels(context.Background(), identityLabels, infoLabels, false)
+	}
+}
+
 func (k *K8sWatcher) updateK8sV1Namespace(oldNS, newNS *slim_corev1.Namespace) error {
+	if k.updateNamespaceLabelFilter(newNS) {
+		k.reevaluateNamespaceIdentityLabels(newNS)
+	}
+
 	oldNSLabels := map[string]string{}
 	newNSLabels := map[string]string{}
 
@@ -103,7 +172,7 @@ func (k *K8sWatcher) updateK8sV1Namespace(oldNS, newNS *slim_corev1.Namespace) e
 	for _, ep := range eps {
 		epNS := ep.GetK8sNamespace()
 		if oldNS.Name == epNS {
-			err := ep.ModifyIdentityLabels(newIdtyLabels, oldIdtyLabels)
+			err := ep.ModifyIdentityLabels(newIdtyLabels, oldIdtyLabels, false)
 			if err != nil {
 				log.WithError(err).WithField(logfields.EndpointID, ep.ID).
 					Warningf("unable to update endpoint with new namespace labels")
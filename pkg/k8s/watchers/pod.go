@@ -289,7 +289,7 @@ func (k *K8sWatcher) updateK8sPodV1(oldK8sPod, newK8sPod *slim_corev1.Pod) error
 	oldAnno := oldK8sPod.ObjectMeta.Annotations
 	newAnno := newK8sPod.ObjectMeta.Annotations
 	annoChangedProxy := !k8s.AnnotationsEqual([]string{annotation.ProxyVisibility}, oldAnno, newAnno)
-	annoChangedBandwidth := !k8s.AnnotationsEqual([]string{bandwidth.EgressBandwidth}, oldAnno, newAnno)
+	annoChangedBandwidth := !k8s.AnnotationsEqual([]string{bandwidth.IngressBandwidth, bandwidth.EgressBandwidth}, oldAnno, newAnno)
 	annoChangedNoTrack := !k8s.AnnotationsEqual([]string{annotation.NoTrack}, oldAnno, newAnno)
 	annotationsChanged := annoChangedProxy || annoChangedBandwidth || annoChangedNoTrack
 
@@ -356,12 +356,13 @@ func (k *K8sWatcher) updateK8sPodV1(oldK8sPod, newK8sPod *slim_corev1.Pod) error
 			})
 		}
 		if annoChangedBandwidth {
-			podEP.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthEgress string, err error) {
+			podEP.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthIngress, bandwidthEgress string, err error) {
 				p, err := k.GetCachedPod(ns, podName)
 				if err != nil {
-					return "", nil
+					return "", "", nil
 				}
-				return p.ObjectMeta.Annotations[bandwidth.EgressBandwidth], nil
+				return p.ObjectMeta.Annotations[bandwidth.IngressBandwidth],
+					p.ObjectMeta.Annotations[bandwidth.EgressBandwidth], nil
 			})
 		}
 		if annoChangedNoTrack {
@@ -443,12 +444,13 @@ func updateCiliumEndpointLabels(ep *endpoint.Endpoint, labels map[string]string)
 }
 
 func updateEndpointLabels(ep *endpoint.Endpoint, oldLbls, newLbls map[string]string) error {
+	namespace := ep.GetK8sNamespace()
 	newLabels := labels.Map2Labels(newLbls, labels.LabelSourceK8s)
-	newIdtyLabels, _ := labelsfilter.Filter(newLabels)
+	newIdtyLabels, _ := labelsfilter.FilterByNamespace(namespace, newLabels)
 	oldLabels := labels.Map2Labels(oldLbls, labels.LabelSourceK8s)
-	oldIdtyLabels, _ := labelsfilter.Filter(oldLabels)
+	oldIdtyLabels, _ := labelsfilter.FilterByNamespace(namespace, oldLabels)
 
-	err := ep.ModifyIdentityLabels(newIdtyLabels, oldIdtyLabels)
+	err := ep.ModifyIdentityLabels(newIdtyLabels, oldIdtyLabels, false)
 	if err != nil {
 		log.WithError(err).Debugf("Error while updating endpoint with new labels")
 		return err
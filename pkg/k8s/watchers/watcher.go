@@ -43,6 +43,7 @@ import (
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/node"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy"
@@ -620,7 +621,8 @@ func genCartesianProduct(
 
 			if backendPort := backend.Ports[string(fePortName)]; backendPort != nil && feFamilyIPv6 == ip.IsIPv6(parsedIP) {
 				besValues = append(besValues, loadbalancer.Backend{
-					NodeName: backend.NodeName,
+					NodeName:    backend.NodeName,
+					Terminating: backend.Terminating,
 					L3n4Addr: loadbalancer.L3n4Addr{
 						IP:     parsedIP,
 						L4Addr: *backendPort,
@@ -670,6 +672,48 @@ func genCartesianProduct(
 	return svcs
 }
 
+// filterTopologyBackends returns the subset of backends which should serve
+// traffic from this node according to the topology aware hints of the
+// service's endpoint slices. The full backend set is returned when topology
+// aware routing is disabled or not requested by the service, or when the zone
+// of the local node is unknown. When no backend is hinted for the local zone,
+// or when the hinted backends make up less than the configured minimum ratio
+// of the full set, the full set is returned as well to preserve traffic
+// continuity. This fallback is logged and accounted in a dedicated metric.
+func filterTopologyBackends(svc *k8s.Service, endpoints *k8s.Endpoints, scopedLog *logrus.Entry) *k8s.Endpoints {
+	if !option.Config.EnableServiceTopology || !svc.TopologyAware {
+		return endpoints
+	}
+
+	zone := node.GetLabels()[corev1.LabelTopologyZone]
+	if zone == "" || len(endpoints.Backends) == 0 {
+		return endpoints
+	}
+
+	filtered := &k8s.Endpoints{Backends: map[string]*k8s.Backend{}}
+	for ip, backend := range endpoints.Backends {
+		for _, hintedZone := range backend.HintsForZones {
+			if hintedZone == zone {
+				filtered.Backends[ip] = backend
+				break
+			}
+		}
+	}
+
+	ratio := float64(len(filtered.Backends)) / float64(len(endpoints.Backends))
+	if len(filtered.Backends) == 0 || ratio < option.Config.ServiceTopologyMinBackendsRatio {
+		scopedLog.WithFields(logrus.Fields{
+			"zone":          zone,
+			"zoneBackends":  len(filtered.Backends),
+			"totalBackends": len(endpoints.Backends),
+		}).Warn("Not enough backends hinted for the local zone, falling back to all backends of the service")
+		metrics.ServicesTopologyFallback.Inc()
+		return endpoints
+	}
+
+	return filtered
+}
+
 // datapathSVCs returns all services that should be set in the datapath.
 func datapathSVCs(svc *k8s.Service, endpoints *k8s.Endpoints) (svcs []loadbalancer.SVC) {
 	uniqPorts := svc.UniquePorts()
@@ -719,6 +763,7 @@ func datapathSVCs(svc *k8s.Service, endpoints *k8s.Endpoints) (svcs []loadbalanc
 		svcs[i].HealthCheckNodePort = svc.HealthCheckNodePort
 		svcs[i].SessionAffinity = svc.SessionAffinity
 		svcs[i].SessionAffinityTimeoutSec = svc.SessionAffinityTimeoutSec
+		svcs[i].HealthProbePath = svc.HealthProbePath
 		if svcs[i].Type == loadbalancer.SVCTypeLoadBalancer {
 			svcs[i].LoadBalancerSourceRanges = lbSrcRanges
 		}
@@ -748,6 +793,8 @@ func (k *K8sWatcher) addK8sSVCs(svcID k8s.ServiceID, oldSvc, svc *k8s.Service, e
 		logfields.K8sNamespace: svcID.Namespace,
 	})
 
+	endpoints = filterTopologyBackends(svc, endpoints, scopedLog)
+
 	svcs := datapathSVCs(svc, endpoints)
 	svcMap := hashSVCMap(svcs)
 
@@ -781,6 +828,7 @@ func (k *K8sWatcher) addK8sSVCs(svcID k8s.ServiceID, oldSvc, svc *k8s.Service, e
 			SessionAffinity:           dpSvc.SessionAffinity,
 			SessionAffinityTimeoutSec: dpSvc.SessionAffinityTimeoutSec,
 			HealthCheckNodePort:       dpSvc.HealthCheckNodePort,
+			HealthProbePath:           dpSvc.HealthProbePath,
 			LoadBalancerSourceRanges:  dpSvc.LoadBalancerSourceRanges,
 			Name:                      svcID.Name,
 			Namespace:                 svcID.Namespace,
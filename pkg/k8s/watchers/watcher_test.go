@@ -19,6 +19,7 @@ package watchers
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"net"
 	"sort"
 	"testing"
@@ -33,12 +34,14 @@ import (
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/loadbalancer"
 	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/node"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy"
 	"github.com/cilium/cilium/pkg/policy/api"
 
 	. "gopkg.in/check.v1"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // Hook up gocheck into the "go test" runner.
@@ -2599,3 +2602,63 @@ func (s *K8sWatcherSuite) Test_addK8sSVCs_ExternalIPs(c *C) {
 	c.Assert(del1st, checker.DeepEquals, del1stWanted)
 	c.Assert(del2nd, checker.DeepEquals, del2ndWanted)
 }
+
+func (s *K8sWatcherSuite) TestFilterTopologyBackends(c *C) {
+	enableTopologyBak := option.Config.EnableServiceTopology
+	minRatioBak := option.Config.ServiceTopologyMinBackendsRatio
+	labelsBak := node.GetLabels()
+	option.Config.EnableServiceTopology = true
+	option.Config.ServiceTopologyMinBackendsRatio = 0.25
+	node.SetLabels(map[string]string{corev1.LabelTopologyZone: "zone-a"})
+	defer func() {
+		option.Config.EnableServiceTopology = enableTopologyBak
+		option.Config.ServiceTopologyMinBackendsRatio = minRatioBak
+		node.SetLabels(labelsBak)
+	}()
+
+	scopedLog := log.WithField("test", "TestFilterTopologyBackends")
+	svc := &k8s.Service{TopologyAware: true}
+	endpoints := &k8s.Endpoints{
+		Backends: map[string]*k8s.Backend{
+			"10.0.0.1": {HintsForZones: []string{"zone-a"}},
+			"10.0.0.2": {HintsForZones: []string{"zone-a"}},
+			"10.0.0.3": {HintsForZones: []string{"zone-b"}},
+			"10.0.0.4": {HintsForZones: []string{"zone-b"}},
+		},
+	}
+
+	// Only the backends hinted for the local zone are selected.
+	filtered := filterTopologyBackends(svc, endpoints, scopedLog)
+	c.Assert(len(filtered.Backends), Equals, 2)
+	_, ok := filtered.Backends["10.0.0.1"]
+	c.Assert(ok, Equals, true)
+	_, ok = filtered.Backends["10.0.0.2"]
+	c.Assert(ok, Equals, true)
+
+	// Removing all backends of the local zone falls back to the full
+	// backend set to keep the service reachable.
+	delete(endpoints.Backends, "10.0.0.1")
+	delete(endpoints.Backends, "10.0.0.2")
+	filtered = filterTopologyBackends(svc, endpoints, scopedLog)
+	c.Assert(filtered, checker.DeepEquals, endpoints)
+
+	// A local zone backend set below the configured minimum ratio falls
+	// back to the full backend set as well.
+	endpoints = &k8s.Endpoints{
+		Backends: map[string]*k8s.Backend{
+			"10.0.0.1": {HintsForZones: []string{"zone-a"}},
+		},
+	}
+	for i := 2; i <= 8; i++ {
+		endpoints.Backends[fmt.Sprintf("10.0.0.%d", i)] = &k8s.Backend{
+			HintsForZones: []string{"zone-b"},
+		}
+	}
+	filtered = filterTopologyBackends(svc, endpoints, scopedLog)
+	c.Assert(filtered, checker.DeepEquals, endpoints)
+
+	// Services not requesting topology aware routing are left untouched.
+	svc.TopologyAware = false
+	filtered = filterTopologyBackends(svc, endpoints, scopedLog)
+	c.Assert(filtered, checker.DeepEquals, endpoints)
+}
@@ -15,6 +15,9 @@
 package kafka
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/cilium/cilium/pkg/flowdebug"
 	api "github.com/cilium/cilium/pkg/policy/api/kafka"
 
@@ -26,6 +29,12 @@ type Rule struct {
 	// are to be allowed
 	APIVersion int16
 
+	// APIVersionMin and APIVersionMax restrict the allowed version to an
+	// inclusive range. The range is only considered when APIVersionMax
+	// is >= 0, in which case APIVersion is ignored.
+	APIVersionMin int16
+	APIVersionMax int16
+
 	// ApiKeys is the set of all numerical apiKeys that are allowed.
 	// If empty, all API keys are allowed.
 	APIKeys map[int16]struct{}
@@ -56,21 +65,40 @@ type Rule struct {
 	// version the length was changed from 255 to 249. For compatibility
 	// reasons we are allowing 255.
 	//
-	// If empty, all topics are allowed.
+	// If empty, all topics are allowed. May contain '*' wildcards
+	// matching any sequence of characters, in which case topicPattern
+	// below is used for matching instead.
 	Topic string
+
+	// topicPattern is the compiled form of a Topic containing '*'
+	// wildcards, nil if Topic is an exact topic name.
+	topicPattern *regexp.Regexp
 }
 
-// NewRule creates a new rule from already sanitized inputs
+// NewRule creates a new rule from already sanitized inputs. 'apiVersion' may
+// carry a version range in the encoding produced by
+// api.EncodeAPIVersionRange.
 func NewRule(apiVersion int32, apiKeys []int32, clientID, topic string) Rule {
 	r := Rule{
-		APIVersion: int16(apiVersion),
-		ClientID:   clientID,
-		Topic:      topic,
-		APIKeys:    make(map[int16]struct{}, len(apiKeys)),
+		APIVersion:    int16(apiVersion),
+		APIVersionMin: 0,
+		APIVersionMax: -1,
+		ClientID:      clientID,
+		Topic:         topic,
+		APIKeys:       make(map[int16]struct{}, len(apiKeys)),
+	}
+	if api.IsAPIVersionRange(apiVersion) {
+		r.APIVersion = -1
+		r.APIVersionMin, r.APIVersionMax = api.DecodeAPIVersionRange(apiVersion)
 	}
 	for _, key := range apiKeys {
 		r.APIKeys[int16(key)] = struct{}{}
 	}
+	if strings.ContainsRune(topic, '*') {
+		// Errors are not expected from sanitized input, fall back to
+		// exact matching in that case.
+		r.topicPattern, _ = api.CompileTopicPattern(topic)
+	}
 	return r
 }
 
@@ -89,6 +117,9 @@ func (r *Rule) CheckAPIKeyRole(kind int16) bool {
 
 // CheckAPIVersion returns true if 'apiVersion' is allowed
 func (r *Rule) CheckAPIVersion(apiVersion int16) bool {
+	if r.APIVersionMax >= 0 {
+		return apiVersion >= r.APIVersionMin && apiVersion <= r.APIVersionMax
+	}
 	return r.APIVersion < 0 || apiVersion == r.APIVersion
 }
 
@@ -162,11 +193,22 @@ func (r Rule) Matches(data interface{}) bool {
 		//
 		// (req.topics is initialized with all the topics in the request
 		// before any rules are matched.)
-		if _, exists := req.topics[r.Topic]; exists {
-			delete(req.topics, r.Topic)
-			if len(req.topics) == 0 {
-				return true // all topics have matched
+		matched := false
+		if r.topicPattern != nil {
+			// Wildcard topic, remove all matching topics from the
+			// request.
+			for topic := range req.topics {
+				if r.topicPattern.MatchString(topic) {
+					delete(req.topics, topic)
+					matched = true
+				}
 			}
+		} else if _, exists := req.topics[r.Topic]; exists {
+			delete(req.topics, r.Topic)
+			matched = true
+		}
+		if matched && len(req.topics) == 0 {
+			return true // all topics have matched
 		}
 		return false // more topic matches needed
 	}
@@ -135,6 +135,64 @@ func (k *kafkaTestSuite) TestProduceRequest(c *C) {
 
 }
 
+func (k *kafkaTestSuite) TestTopicWildcard(c *C) {
+	req := &proto.ProduceReq{
+		CorrelationID: 241,
+		ClientID:      "test",
+		Topics: []proto.ProduceReqTopic{
+			{Name: "logs-frontend"},
+			{Name: "logs-backend"},
+		},
+	}
+	reqMsg := RequestMessage{
+		request: req,
+	}
+
+	// Wildcard topic covers all topics in the request
+	reqMsg.setTopics()
+	c.Assert(reqMsg.MatchesRule([]Rule{
+		NewRule(-1, nil, "", "logs-*"),
+	}), Equals, true)
+
+	// Wildcard topic not covering all topics in the request
+	reqMsg.setTopics()
+	c.Assert(reqMsg.MatchesRule([]Rule{
+		NewRule(-1, nil, "", "metrics-*"),
+	}), Equals, false)
+
+	// Wildcard and exact topics may combine to cover the request
+	reqMsg.setTopics()
+	c.Assert(reqMsg.MatchesRule([]Rule{
+		NewRule(-1, nil, "", "*-backend"), NewRule(-1, nil, "", "logs-frontend"),
+	}), Equals, true)
+
+	// The wildcard must match the full topic name
+	reqMsg.setTopics()
+	c.Assert(reqMsg.MatchesRule([]Rule{
+		NewRule(-1, nil, "", "ogs-*"),
+	}), Equals, false)
+}
+
+func (k *kafkaTestSuite) TestAPIVersionRange(c *C) {
+	rule := NewRule(kafka.EncodeAPIVersionRange(3, 5), nil, "", "")
+	c.Assert(rule.CheckAPIVersion(2), Equals, false)
+	c.Assert(rule.CheckAPIVersion(3), Equals, true)
+	c.Assert(rule.CheckAPIVersion(5), Equals, true)
+	c.Assert(rule.CheckAPIVersion(6), Equals, false)
+
+	// Lower bound only, as produced for APIVersionMin: 3
+	rule = NewRule(kafka.EncodeAPIVersionRange(3, 0x7fff), nil, "", "")
+	c.Assert(rule.CheckAPIVersion(2), Equals, false)
+	c.Assert(rule.CheckAPIVersion(0x7fff), Equals, true)
+
+	// Exact version and wildcard are unaffected by range support
+	rule = NewRule(3, nil, "", "")
+	c.Assert(rule.CheckAPIVersion(2), Equals, false)
+	c.Assert(rule.CheckAPIVersion(3), Equals, true)
+	rule = NewRule(-1, nil, "", "")
+	c.Assert(rule.CheckAPIVersion(2), Equals, true)
+}
+
 func (k *kafkaTestSuite) TestUnknownRequest(c *C) {
 	reqMsg := RequestMessage{kind: 18} // ApiVersions request
 
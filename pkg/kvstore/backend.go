@@ -223,4 +223,14 @@ type BackendOperations interface {
 	// created with the specified sizes. Upon every change observed, a
 	// KeyValueEvent will be sent to the Events channel
 	ListAndWatch(ctx context.Context, name, prefix string, chanSize int) *Watcher
+
+	// RegisterLeaseExpiredObserver registers a function which is executed
+	// for every key matching the given prefix when the lease it was
+	// attached to expires, so that the owner of the key can recreate it.
+	// (Only implemented for etcd)
+	RegisterLeaseExpiredObserver(prefix string, fn func(key string))
+
+	// UnregisterLeaseExpiredObserver removes the observer registered for
+	// the given prefix. (Only implemented for etcd)
+	UnregisterLeaseExpiredObserver(prefix string)
 }
@@ -775,3 +775,9 @@ func (c *consulClient) ListAndWatch(ctx context.Context, name, prefix string, ch
 func (c *consulClient) StatusCheckErrors() <-chan error {
 	return c.statusCheckErrors
 }
+
+// RegisterLeaseExpiredObserver is not implemented for the consul backend
+func (c *consulClient) RegisterLeaseExpiredObserver(prefix string, fn func(key string)) {}
+
+// UnregisterLeaseExpiredObserver is not implemented for the consul backend
+func (c *consulClient) UnregisterLeaseExpiredObserver(prefix string) {}
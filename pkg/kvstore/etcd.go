@@ -66,6 +66,15 @@ const (
 	// consecutiveQuorumErrorsThreshold is the number of acceptable quorum
 	// errors before the agent assumes permanent failure
 	consecutiveQuorumErrorsThreshold = 2
+
+	// etcdMaxKeysPerLease is the maximum number of keys attached to the
+	// same lease before an additional lease is acquired
+	etcdMaxKeysPerLease = 1000
+
+	// degradedWriteQueueSize is the maximum number of writes queued while
+	// the etcd cluster has lost quorum and the client operates in
+	// read-only degraded mode
+	degradedWriteQueueSize = 128
 )
 
 var (
@@ -73,6 +82,11 @@ var (
 	// exist or it was expired.
 	ErrLockLeaseExpired = errors.New("transaction did not succeed: lock lease expired")
 
+	// ErrWriteQueued is returned for writes deferred while the etcd
+	// cluster has lost quorum. The write has not been committed; it will
+	// be replayed once quorum is regained.
+	ErrWriteQueued = errors.New("etcd cluster has lost quorum, write queued but not committed")
+
 	randGen = rand.NewSafeRand(time.Now().UnixNano())
 )
 
@@ -322,11 +336,32 @@ type etcdClient struct {
 	// latestErrorStatus is the latest error condition of the etcd connection
 	latestErrorStatus error
 
+	// degraded is true while the etcd cluster has lost quorum and the
+	// client operates in read-only degraded mode. It is protected by
+	// statusLock.
+	degraded bool
+
 	extraOptions *ExtraOptions
 
 	limiter *rate.Limiter
 
 	lastHeartbeat time.Time
+
+	// writeQueue holds the writes deferred while the client operates in
+	// read-only degraded mode. They are replayed in order once quorum is
+	// regained.
+	writeQueue *writeQueue
+
+	// leaseManager manages the acquisition of the leases attached to the
+	// keys written by this client
+	leaseManager *etcdLeaseManager
+
+	// leaseExpiredObservers are the functions executed when a key matching
+	// the registered prefix disappears because the lease it was attached
+	// to could not be kept alive. They are protected by their own mutex as
+	// they are accessed from the lease manager goroutines.
+	leaseExpiredObserversLock lock.RWMutex
+	leaseExpiredObservers     map[string]func(key string)
 }
 
 func (e *etcdClient) getLogger() *logrus.Entry {
@@ -377,17 +412,6 @@ func (e *etcdClient) GetLockSessionLeaseID() client.LeaseID {
 	return l
 }
 
-// checkSession verifies if the lease is still valid from the return error of
-// an etcd API call. If the error explicitly states that a lease was not found
-// we mark the session has an orphan for this etcd client. If we would not mark
-// it as an Orphan() the session would be considered expired after the leaseTTL
-// By make it orphan we guarantee the session will be marked to be renewed.
-func (e *etcdClient) checkSession(err error, leaseID client.LeaseID) {
-	if errors.Is(err, v3rpcErrors.ErrLeaseNotFound) {
-		e.closeSession(leaseID)
-	}
-}
-
 // checkSession verifies if the lease is still valid from the return error of
 // an etcd API call. If the error explicitly states that a lease was not found
 // we mark the session has an orphan for this etcd client. If we would not mark
@@ -399,17 +423,6 @@ func (e *etcdClient) checkLockSession(err error, leaseID client.LeaseID) {
 	}
 }
 
-// closeSession closes the current session.
-func (e *etcdClient) closeSession(leaseID client.LeaseID) {
-	e.RWMutex.RLock()
-	// only mark a session as orphan if the leaseID is the same as the
-	// session ID to avoid making any other sessions as orphan.
-	if e.session.Lease() == leaseID {
-		e.session.Orphan()
-	}
-	e.RWMutex.RUnlock()
-}
-
 // closeSession closes the current session.
 func (e *etcdClient) closeLockSession(leaseID client.LeaseID) {
 	e.RWMutex.RLock()
@@ -421,6 +434,37 @@ func (e *etcdClient) closeLockSession(leaseID client.LeaseID) {
 	e.RWMutex.RUnlock()
 }
 
+// RegisterLeaseExpiredObserver registers a function which is executed for
+// every key matching the given prefix when the lease it was attached to
+// expires, so that the owner of the key can recreate it.
+func (e *etcdClient) RegisterLeaseExpiredObserver(prefix string, fn func(key string)) {
+	e.leaseExpiredObserversLock.Lock()
+	e.leaseExpiredObservers[prefix] = fn
+	e.leaseExpiredObserversLock.Unlock()
+}
+
+// UnregisterLeaseExpiredObserver removes the observer registered for the
+// given prefix.
+func (e *etcdClient) UnregisterLeaseExpiredObserver(prefix string) {
+	e.leaseExpiredObserversLock.Lock()
+	delete(e.leaseExpiredObservers, prefix)
+	e.leaseExpiredObserversLock.Unlock()
+}
+
+// expiredLeaseObserver is executed by the lease manager for every key
+// attached to an expired lease and relays the expiration to the observer
+// registered for the matching prefix, if any.
+func (e *etcdClient) expiredLeaseObserver(key string) {
+	e.leaseExpiredObserversLock.RLock()
+	defer e.leaseExpiredObserversLock.RUnlock()
+
+	for prefix, fn := range e.leaseExpiredObservers {
+		if strings.HasPrefix(key, prefix) {
+			fn(key)
+		}
+	}
+}
+
 func (e *etcdClient) waitForInitLock(ctx context.Context) <-chan error {
 	initLockSucceeded := make(chan error)
 
@@ -501,6 +545,49 @@ func (e *etcdClient) isConnectedAndHasQuorum(ctx context.Context) error {
 	}
 }
 
+// isDegraded returns true while the etcd cluster has lost quorum and the
+// client operates in read-only degraded mode.
+func (e *etcdClient) isDegraded() bool {
+	e.statusLock.RLock()
+	defer e.statusLock.RUnlock()
+
+	return e.degraded
+}
+
+// drainWriteQueue replays the writes which were queued while the client was
+// operating in read-only degraded mode. The writes are replayed in the order
+// in which their keys were first queued, deduplicated to the most recent
+// operation per key. If quorum is lost again while draining, the remaining
+// writes are queued again by the regular write path.
+func (e *etcdClient) drainWriteQueue() {
+	writes := e.writeQueue.drain()
+	if len(writes) == 0 {
+		return
+	}
+
+	scopedLog := e.getLogger().WithField("queuedWrites", len(writes))
+	scopedLog.Info("Quorum regained, replaying queued kvstore writes")
+
+	for _, w := range writes {
+		ctx, cancel := context.WithTimeout(context.Background(), statusCheckTimeout)
+		var err error
+		if w.delete {
+			err = e.Delete(ctx, w.key)
+		} else {
+			err = e.Update(ctx, w.key, w.value, w.lease)
+		}
+		cancel()
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrWriteQueued):
+			// Quorum was lost again while draining, the write has
+			// been queued again by the regular write path.
+		default:
+			scopedLog.WithError(err).WithField(fieldKey, w.key).Warning("Unable to replay queued kvstore write")
+		}
+	}
+}
+
 // Connected closes the returned channel when the etcd client is connected. If
 // the context is cancelled or if the etcd client is closed, an error is
 // returned on the channel.
@@ -705,19 +792,24 @@ func connectEtcdClient(ctx context.Context, config *client.Config, cfgPath strin
 	errorChan := make(chan error)
 
 	ec := &etcdClient{
-		client:               c,
-		config:               config,
-		configPath:           cfgPath,
-		session:              &s,
-		lockSession:          &ls,
-		firstSession:         make(chan struct{}),
-		controllers:          controller.NewManager(),
-		latestStatusSnapshot: "Waiting for initial connection to be established",
-		stopStatusChecker:    make(chan struct{}),
-		extraOptions:         opts,
-		limiter:              rate.NewLimiter(rate.Limit(clientOptions.RateLimit), clientOptions.RateLimit),
-		statusCheckErrors:    make(chan error, 128),
-	}
+		client:                c,
+		config:                config,
+		configPath:            cfgPath,
+		session:               &s,
+		lockSession:           &ls,
+		firstSession:          make(chan struct{}),
+		controllers:           controller.NewManager(),
+		latestStatusSnapshot:  "Waiting for initial connection to be established",
+		stopStatusChecker:     make(chan struct{}),
+		extraOptions:          opts,
+		limiter:               rate.NewLimiter(rate.Limit(clientOptions.RateLimit), clientOptions.RateLimit),
+		statusCheckErrors:     make(chan error, 128),
+		leaseExpiredObservers: map[string]func(key string){},
+		writeQueue:            newWriteQueue(degradedWriteQueueSize),
+	}
+
+	ec.leaseManager = newEtcdLeaseManager(c, option.Config.KVstoreLeaseTTL,
+		etcdMaxKeysPerLease, ec.expiredLeaseObserver, ec.getLogger())
 
 	// create session in parallel as this is a blocking operation
 	go func() {
@@ -925,6 +1017,9 @@ func (e *etcdClient) DeletePrefix(ctx context.Context, path string) (err error)
 	duration := spanstat.Start()
 	e.limiter.Wait(ctx)
 	_, err = e.client.Delete(ctx, path, client.WithPrefix())
+	if err == nil {
+		e.leaseManager.ReleasePrefix(path)
+	}
 	increaseMetric(path, metricDelete, "DeletePrefix", duration.EndError(err).Total(), err)
 	return Hint(err)
 }
@@ -974,7 +1069,16 @@ reList:
 					t = EventTypeModify
 				}
 
-				localCache.MarkInUse(key.Key)
+				// The key has not been modified since its latest
+				// event was emitted, only refresh the liveness
+				// mark without generating a spurious event
+				unchanged := localCache.Unchanged(key.Key, uint64(key.ModRevision))
+
+				localCache.MarkInUse(key.Key, uint64(key.ModRevision))
+				if unchanged {
+					continue
+				}
+
 				scopedLog.Debugf("Emitting list result as %v event for %s=%v", t, key.Key, key.Value)
 
 				queueStart := spanstat.Start()
@@ -1040,19 +1144,29 @@ reList:
 
 				if err := r.Err(); err != nil {
 					// We tried to watch on a compacted
-					// revision that may no longer exist,
-					// recreate the watcher and try to
-					// watch on the next possible revision
+					// revision that no longer exists, the
+					// current state must be recovered with
+					// a new list which emits events only
+					// for the keys that changed since they
+					// were last seen
 					if errors.Is(err, v3rpcErrors.ErrCompacted) {
-						scopedLog.WithError(Hint(err)).Debug("Tried watching on compacted revision")
-					}
+						scopedLog.WithError(Hint(err)).Debug("Tried watching on compacted revision, re-listing the prefix")
 
-					// mark all local keys in state for
-					// deletion unless the upcoming GET
-					// marks them alive
-					localCache.MarkAllForDeletion()
+						// mark all local keys in state for
+						// deletion unless the upcoming GET
+						// marks them alive
+						localCache.MarkAllForDeletion()
 
-					goto reList
+						goto reList
+					}
+
+					// The watch failed transiently, resume
+					// from the revision following the last
+					// received event instead of re-listing
+					// the whole prefix
+					scopedLog.WithError(Hint(err)).Warning("Watch failed, restarting from last seen revision")
+					time.Sleep(50 * time.Millisecond)
+					goto recreateWatcher
 				}
 
 				nextRev = r.Header.Revision + 1
@@ -1070,10 +1184,10 @@ reList:
 						localCache.RemoveKey(ev.Kv.Key)
 					case ev.IsCreate():
 						event.Typ = EventTypeCreate
-						localCache.MarkInUse(ev.Kv.Key)
+						localCache.MarkInUse(ev.Kv.Key, uint64(ev.Kv.ModRevision))
 					default:
 						event.Typ = EventTypeModify
-						localCache.MarkInUse(ev.Kv.Key)
+						localCache.MarkInUse(ev.Kv.Key, uint64(ev.Kv.ModRevision))
 					}
 
 					scopedLog.Debugf("Emitting %v event for %s=%v", event.Typ, event.Key, event.Value)
@@ -1139,6 +1253,9 @@ func (e *etcdClient) statusChecker() {
 		lastHeartbeat := e.lastHeartbeat
 		e.RWMutex.RUnlock()
 
+		// The heartbeat key is written by the operator, so its staleness
+		// indicates that the cluster is no longer able to commit writes
+		// without requiring this client to hold write permissions itself.
 		if heartbeatDelta := time.Since(lastHeartbeat); !lastHeartbeat.IsZero() && heartbeatDelta > 2*HeartbeatWriteInterval {
 			recordQuorumError("no event received")
 			quorumError = fmt.Errorf("%s since last heartbeat update has been received", heartbeatDelta)
@@ -1155,21 +1272,34 @@ func (e *etcdClient) statusChecker() {
 
 		e.statusLock.Lock()
 
+		drainQueue := false
 		switch {
 		case consecutiveQuorumErrors > consecutiveQuorumErrorsThreshold:
 			e.latestErrorStatus = fmt.Errorf("quorum check failed %d times in a row: %s",
 				consecutiveQuorumErrors, quorumError)
-			e.latestStatusSnapshot = e.latestErrorStatus.Error()
+			e.latestStatusSnapshot = fmt.Sprintf("%s, read-only degraded mode, queued-writes=%d",
+				e.latestErrorStatus, e.writeQueue.size())
+			if !e.degraded {
+				e.degraded = true
+				e.getLogger().Warning("Quorum lost, serving from cached state and queueing writes")
+			}
 		case len(endpoints) > 0 && ok == 0:
 			e.latestErrorStatus = fmt.Errorf("not able to connect to any etcd endpoints")
 			e.latestStatusSnapshot = e.latestErrorStatus.Error()
 		default:
 			e.latestErrorStatus = nil
-			e.latestStatusSnapshot = fmt.Sprintf("etcd: %d/%d connected, lease-ID=%x, lock lease-ID=%x, has-quorum=%s: %s",
-				ok, len(endpoints), sessionLeaseID, lockSessionLeaseID, quorumString, strings.Join(newStatus, "; "))
+			e.latestStatusSnapshot = fmt.Sprintf("etcd: %d/%d connected, leases=%d, lease-ID=%x, lock lease-ID=%x, has-quorum=%s: %s",
+				ok, len(endpoints), e.leaseManager.TotalLeases(), sessionLeaseID, lockSessionLeaseID, quorumString, strings.Join(newStatus, "; "))
+			if e.degraded {
+				e.degraded = false
+				drainQueue = true
+			}
 		}
 
 		e.statusLock.Unlock()
+		if drainQueue {
+			go e.drainWriteQueue()
+		}
 		if e.latestErrorStatus != nil {
 			e.statusCheckErrors <- e.latestErrorStatus
 		}
@@ -1302,6 +1432,9 @@ func (e *etcdClient) DeleteIfLocked(ctx context.Context, key string, lock KVLock
 	if err == nil && !txnReply.Succeeded {
 		err = ErrLockLeaseExpired
 	}
+	if err == nil {
+		e.leaseManager.Release(key)
+	}
 	increaseMetric(key, metricDelete, "DeleteLocked", duration.EndError(err).Total(), err)
 	return Hint(err)
 }
@@ -1310,9 +1443,23 @@ func (e *etcdClient) DeleteIfLocked(ctx context.Context, key string, lock KVLock
 func (e *etcdClient) Delete(ctx context.Context, key string) (err error) {
 	defer func() { Trace("Delete", err, logrus.Fields{fieldKey: key}) }()
 
+	// While quorum is lost, queue the deletion to be replayed once quorum
+	// is regained instead of blocking the caller on a write which cannot
+	// be committed. ErrWriteQueued tells the caller that the deletion has
+	// not been committed yet.
+	if e.isDegraded() {
+		if err := e.writeQueue.enqueue(&queuedWrite{key: key, delete: true}); err != nil {
+			return Hint(err)
+		}
+		return Hint(ErrWriteQueued)
+	}
+
 	duration := spanstat.Start()
 	e.limiter.Wait(ctx)
 	_, err = e.client.Delete(ctx, key)
+	if err == nil {
+		e.leaseManager.Release(key)
+	}
 	increaseMetric(key, metricDelete, "Delete", duration.EndError(err).Total(), err)
 	return Hint(err)
 }
@@ -1341,11 +1488,15 @@ func (e *etcdClient) UpdateIfLocked(ctx context.Context, key string, value []byt
 	duration := spanstat.Start()
 	e.limiter.Wait(ctx)
 	if lease {
-		leaseID := e.GetSessionLeaseID()
+		leaseID, lerr := e.leaseManager.GetLeaseID(ctx, key)
+		if lerr != nil {
+			increaseMetric(key, metricSet, "UpdateIfLocked", duration.EndError(lerr).Total(), lerr)
+			return Hint(lerr)
+		}
 		opPut := client.OpPut(key, string(value), client.WithLease(leaseID))
 		cmp := lock.Comparator().(client.Cmp)
 		txnReply, err = e.client.Txn(ctx).If(cmp).Then(opPut).Commit()
-		e.checkSession(err, leaseID)
+		e.leaseManager.CancelIfExpired(err, leaseID)
 	} else {
 		opPut := client.OpPut(key, string(value))
 		cmp := lock.Comparator().(client.Cmp)
@@ -1366,12 +1517,27 @@ func (e *etcdClient) Update(ctx context.Context, key string, value []byte, lease
 		return
 	}
 
+	// While quorum is lost, queue the write to be replayed once quorum is
+	// regained instead of blocking the caller on a write which cannot be
+	// committed. ErrWriteQueued tells the caller that the write has not
+	// been committed yet.
+	if e.isDegraded() {
+		if err := e.writeQueue.enqueue(&queuedWrite{key: key, value: value, lease: lease}); err != nil {
+			return Hint(err)
+		}
+		return Hint(ErrWriteQueued)
+	}
+
 	if lease {
 		duration := spanstat.Start()
-		leaseID := e.GetSessionLeaseID()
+		leaseID, err := e.leaseManager.GetLeaseID(ctx, key)
+		if err != nil {
+			increaseMetric(key, metricSet, "Update", duration.EndError(err).Total(), err)
+			return Hint(err)
+		}
 		e.limiter.Wait(ctx)
-		_, err := e.client.Put(ctx, key, string(value), client.WithLease(leaseID))
-		e.checkSession(err, leaseID)
+		_, err = e.client.Put(ctx, key, string(value), client.WithLease(leaseID))
+		e.leaseManager.CancelIfExpired(err, leaseID)
 		increaseMetric(key, metricSet, "Update", duration.EndError(err).Total(), err)
 		return Hint(err)
 	}
@@ -1414,13 +1580,11 @@ func (e *etcdClient) UpdateIfDifferentIfLocked(ctx context.Context, key string,
 		return true, e.UpdateIfLocked(ctx, key, value, lease, lock)
 	}
 
-	if lease {
-		e.RWMutex.RLock()
-		leaseID := e.session.Lease()
-		e.RWMutex.RUnlock()
-		if getR.Kvs[0].Lease != int64(leaseID) {
-			return true, e.UpdateIfLocked(ctx, key, value, lease, lock)
-		}
+	// The key is no longer attached to one of the leases owned by this
+	// client (e.g. it was written by a previous incarnation of the agent
+	// under a lease which is about to expire), recreate it
+	if lease && !e.leaseManager.KeyHasLease(key, client.LeaseID(getR.Kvs[0].Lease)) {
+		return true, e.UpdateIfLocked(ctx, key, value, lease, lock)
 	}
 	// if value is not equal then update.
 	if !bytes.Equal(getR.Kvs[0].Value, value) {
@@ -1448,13 +1612,11 @@ func (e *etcdClient) UpdateIfDifferent(ctx context.Context, key string, value []
 	if err != nil || getR.Count == 0 {
 		return true, e.Update(ctx, key, value, lease)
 	}
-	if lease {
-		e.RWMutex.RLock()
-		leaseID := e.session.Lease()
-		e.RWMutex.RUnlock()
-		if getR.Kvs[0].Lease != int64(leaseID) {
-			return true, e.Update(ctx, key, value, lease)
-		}
+	// The key is no longer attached to one of the leases owned by this
+	// client (e.g. it was written by a previous incarnation of the agent
+	// under a lease which is about to expire), recreate it
+	if lease && !e.leaseManager.KeyHasLease(key, client.LeaseID(getR.Kvs[0].Lease)) {
+		return true, e.Update(ctx, key, value, lease)
 	}
 	// if value is not equal then update.
 	if !bytes.Equal(getR.Kvs[0].Value, value) {
@@ -1473,7 +1635,11 @@ func (e *etcdClient) CreateOnlyIfLocked(ctx context.Context, key string, value [
 	duration := spanstat.Start()
 	var leaseID client.LeaseID
 	if lease {
-		leaseID = e.GetSessionLeaseID()
+		leaseID, err = e.leaseManager.GetLeaseID(ctx, key)
+		if err != nil {
+			increaseMetric(key, metricSet, "CreateOnlyLocked", duration.EndError(err).Total(), err)
+			return false, Hint(err)
+		}
 	}
 	req := e.createOpPut(key, value, leaseID)
 	cnds := []client.Cmp{
@@ -1491,7 +1657,8 @@ func (e *etcdClient) CreateOnlyIfLocked(ctx context.Context, key string, value [
 	txnresp, err := e.client.Txn(ctx).If(cnds...).Then(*req).Else(opGets...).Commit()
 	increaseMetric(key, metricSet, "CreateOnlyLocked", duration.EndError(err).Total(), err)
 	if err != nil {
-		e.checkSession(err, leaseID)
+		e.leaseManager.CancelIfExpired(err, leaseID)
+		e.leaseManager.Release(key)
 		return false, Hint(err)
 	}
 
@@ -1508,6 +1675,9 @@ func (e *etcdClient) CreateOnlyIfLocked(ctx context.Context, key string, value [
 	//  F - Key does exist, version is != 0 and lock does exist => txn fails but returned is nil!
 
 	if !txnresp.Succeeded {
+		// The key was not created, do not keep it attached to the lease
+		e.leaseManager.Release(key)
+
 		// case F
 		if len(txnresp.Responses[0].GetResponseRange().Kvs) != 0 &&
 			txnresp.Responses[0].GetResponseRange().Kvs[0].Version != 0 {
@@ -1531,7 +1701,11 @@ func (e *etcdClient) CreateOnly(ctx context.Context, key string, value []byte, l
 	duration := spanstat.Start()
 	var leaseID client.LeaseID
 	if lease {
-		leaseID = e.GetSessionLeaseID()
+		leaseID, err = e.leaseManager.GetLeaseID(ctx, key)
+		if err != nil {
+			increaseMetric(key, metricSet, "CreateOnly", duration.EndError(err).Total(), err)
+			return false, Hint(err)
+		}
 	}
 	req := e.createOpPut(key, value, leaseID)
 	cond := client.Compare(client.Version(key), "=", 0)
@@ -1539,8 +1713,12 @@ func (e *etcdClient) CreateOnly(ctx context.Context, key string, value []byte, l
 	e.limiter.Wait(ctx)
 	txnresp, err := e.client.Txn(ctx).If(cond).Then(*req).Commit()
 	increaseMetric(key, metricSet, "CreateOnly", duration.EndError(err).Total(), err)
+	if err != nil || !txnresp.Succeeded {
+		// The key was not created, do not keep it attached to the lease
+		e.leaseManager.CancelIfExpired(err, leaseID)
+		e.leaseManager.Release(key)
+	}
 	if err != nil {
-		e.checkSession(err, leaseID)
 		return false, Hint(err)
 	}
 
@@ -1556,7 +1734,11 @@ func (e *etcdClient) CreateIfExists(ctx context.Context, condKey, key string, va
 	duration := spanstat.Start()
 	var leaseID client.LeaseID
 	if lease {
-		leaseID = e.GetSessionLeaseID()
+		leaseID, err = e.leaseManager.GetLeaseID(ctx, key)
+		if err != nil {
+			increaseMetric(key, metricSet, "CreateIfExists", duration.EndError(err).Total(), err)
+			return Hint(err)
+		}
 	}
 	req := e.createOpPut(key, value, leaseID)
 	cond := client.Compare(client.Version(condKey), "!=", 0)
@@ -1564,8 +1746,12 @@ func (e *etcdClient) CreateIfExists(ctx context.Context, condKey, key string, va
 	e.limiter.Wait(ctx)
 	txnresp, err := e.client.Txn(ctx).If(cond).Then(*req).Commit()
 	increaseMetric(key, metricSet, "CreateIfExists", duration.EndError(err).Total(), err)
+	if err != nil || !txnresp.Succeeded {
+		// The key was not created, do not keep it attached to the lease
+		e.leaseManager.CancelIfExpired(err, leaseID)
+		e.leaseManager.Release(key)
+	}
 	if err != nil {
-		e.checkSession(err, leaseID)
 		return Hint(err)
 	}
 
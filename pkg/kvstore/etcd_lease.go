@@ -0,0 +1,277 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvstore
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/pkg/lock"
+
+	"github.com/sirupsen/logrus"
+	client "go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/clientv3/concurrency"
+	v3rpcErrors "go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
+)
+
+// etcdLeaseInfo tracks the number of keys attached to a lease together with
+// the session which keeps the lease alive.
+type etcdLeaseInfo struct {
+	count   uint32
+	session *concurrency.Session
+}
+
+// etcdLeaseManager manages the acquisition of a bounded set of etcd leases
+// and keeps track of which lease each key is attached to. Sharing a small
+// number of leases among all keys owned by a client drastically reduces the
+// keepalive traffic against the etcd cluster compared to one lease per key,
+// at the cost of multiple keys disappearing together when the shared lease
+// cannot be kept alive. In that case the registered expiration observer is
+// executed for every attached key, so that the owner can recreate it.
+type etcdLeaseManager struct {
+	client *client.Client
+	log    *logrus.Entry
+
+	// ttl is the lifetime of the leases granted by the manager
+	ttl time.Duration
+
+	// limit is the maximum number of keys attached to the same lease
+	limit uint32
+
+	// expired is executed for every key attached to a lease which expired
+	expired func(key string)
+
+	mu        lock.RWMutex
+	leases    map[client.LeaseID]*etcdLeaseInfo
+	keys      map[string]client.LeaseID
+	current   client.LeaseID
+	acquiring chan struct{}
+}
+
+// newEtcdLeaseManager builds a new lease manager. The expired function, if
+// not nil, is executed in a separate goroutine for every key that was
+// attached to a lease which could no longer be kept alive.
+func newEtcdLeaseManager(c *client.Client, ttl time.Duration, limit uint32, expired func(key string), log *logrus.Entry) *etcdLeaseManager {
+	return &etcdLeaseManager{
+		client: c,
+		log:    log,
+
+		ttl:     ttl,
+		limit:   limit,
+		expired: expired,
+
+		leases: map[client.LeaseID]*etcdLeaseInfo{},
+		keys:   map[string]client.LeaseID{},
+	}
+}
+
+// GetLeaseID returns a lease ID to attach the given key to, either reusing
+// the lease already associated with the key, attaching the key to a shared
+// lease with spare capacity or granting a new lease.
+func (elm *etcdLeaseManager) GetLeaseID(ctx context.Context, key string) (client.LeaseID, error) {
+	elm.mu.Lock()
+
+	// This key is already attached to a lease
+	if leaseID, ok := elm.keys[key]; ok {
+		elm.mu.Unlock()
+		return leaseID, nil
+	}
+
+	// The current lease has not yet reached the maximum number of attached
+	// keys, hence it can be reused
+	if info := elm.leases[elm.current]; info != nil && info.count < elm.limit {
+		info.count++
+		elm.keys[key] = elm.current
+		elm.mu.Unlock()
+		return elm.current, nil
+	}
+
+	// Serialize the creation of new sessions so that concurrent callers
+	// join the lease granted by the first one instead of each granting
+	// their own
+	if elm.acquiring != nil {
+		acquiring := elm.acquiring
+		elm.mu.Unlock()
+
+		select {
+		case <-acquiring:
+			return elm.GetLeaseID(ctx, key)
+		case <-ctx.Done():
+			return client.NoLease, ctx.Err()
+		case <-elm.client.Ctx().Done():
+			return client.NoLease, elm.client.Ctx().Err()
+		}
+	}
+
+	elm.acquiring = make(chan struct{})
+	elm.mu.Unlock()
+
+	session, err := elm.newSession(ctx)
+
+	elm.mu.Lock()
+	close(elm.acquiring)
+	elm.acquiring = nil
+
+	if err != nil {
+		elm.mu.Unlock()
+		return client.NoLease, err
+	}
+
+	elm.current = session.Lease()
+	elm.leases[session.Lease()] = &etcdLeaseInfo{count: 1, session: session}
+	elm.keys[key] = session.Lease()
+	setLeaseCountMetric(len(elm.leases))
+	elm.mu.Unlock()
+
+	return session.Lease(), nil
+}
+
+// Release detaches the given key from the lease it is attached to, if any.
+// The lease itself is kept alive until all attached keys have been released,
+// as etcd does not support detaching individual keys.
+func (elm *etcdLeaseManager) Release(key string) {
+	elm.mu.Lock()
+	defer elm.mu.Unlock()
+
+	leaseID, ok := elm.keys[key]
+	if !ok {
+		return
+	}
+
+	delete(elm.keys, key)
+	if info := elm.leases[leaseID]; info != nil && info.count > 0 {
+		info.count--
+	}
+}
+
+// ReleasePrefix detaches all keys matching the given prefix from the leases
+// they are attached to.
+func (elm *etcdLeaseManager) ReleasePrefix(prefix string) {
+	elm.mu.Lock()
+	defer elm.mu.Unlock()
+
+	for key, leaseID := range elm.keys {
+		if strings.HasPrefix(key, prefix) {
+			delete(elm.keys, key)
+			if info := elm.leases[leaseID]; info != nil && info.count > 0 {
+				info.count--
+			}
+		}
+	}
+}
+
+// KeyHasLease returns whether the given key is attached to the specified
+// lease.
+func (elm *etcdLeaseManager) KeyHasLease(key string, leaseID client.LeaseID) bool {
+	elm.mu.RLock()
+	defer elm.mu.RUnlock()
+
+	return elm.keys[key] == leaseID
+}
+
+// CancelIfExpired marks the lease as expired if the error reports that it
+// could not be found on the etcd cluster. This triggers the recreation of
+// all attached keys through the expiration observer.
+func (elm *etcdLeaseManager) CancelIfExpired(err error, leaseID client.LeaseID) {
+	if errors.Is(err, v3rpcErrors.ErrLeaseNotFound) {
+		elm.mu.Lock()
+		if info := elm.leases[leaseID]; info != nil {
+			info.session.Orphan()
+		}
+		elm.mu.Unlock()
+	}
+}
+
+// TotalLeases returns the number of managed leases
+func (elm *etcdLeaseManager) TotalLeases() int {
+	elm.mu.RLock()
+	defer elm.mu.RUnlock()
+
+	return len(elm.leases)
+}
+
+// newSession grants a new lease and starts a session to keep it alive.
+func (elm *etcdLeaseManager) newSession(ctx context.Context) (*concurrency.Session, error) {
+	timedCtx, cancel := context.WithTimeout(ctx, statusCheckTimeout)
+	defer cancel()
+
+	// Jitter the TTL of the individual leases so that the keepalives of
+	// the different leases do not fire in lockstep against the etcd
+	// cluster.
+	ttl := elm.ttl + time.Duration(randGen.Int63n(int64(elm.ttl/4)+1))
+
+	resp, err := elm.client.Grant(timedCtx, int64(ttl.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := concurrency.NewSession(elm.client,
+		concurrency.WithLease(resp.ID),
+		concurrency.WithTTL(int(ttl.Seconds())),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	elm.log.WithField(fieldLeaseID, session.Lease()).Debug("Acquired new lease for kvstore keys")
+
+	go elm.waitForExpiration(session)
+	return session, nil
+}
+
+// waitForExpiration waits until the session can no longer be kept alive,
+// detaches all keys attached to the expired lease and notifies their owners
+// through the expiration observer, so that they can be safely recreated
+// under a different lease.
+func (elm *etcdLeaseManager) waitForExpiration(session *concurrency.Session) {
+	select {
+	case <-elm.client.Ctx().Done():
+		// The client is being closed, no need to recreate the keys
+		return
+	case <-session.Done():
+	}
+
+	elm.mu.Lock()
+	delete(elm.leases, session.Lease())
+	if elm.current == session.Lease() {
+		elm.current = client.NoLease
+	}
+
+	var keys []string
+	for key, leaseID := range elm.keys {
+		if leaseID == session.Lease() {
+			keys = append(keys, key)
+			delete(elm.keys, key)
+		}
+	}
+	setLeaseCountMetric(len(elm.leases))
+	elm.mu.Unlock()
+
+	recordLeaseExpiration()
+	elm.log.WithFields(logrus.Fields{
+		fieldLeaseID: session.Lease(),
+		fieldNumKeys: len(keys),
+	}).Warning("Lease expired, the attached keys need to be recreated")
+
+	if elm.expired != nil {
+		go func() {
+			for _, key := range keys {
+				elm.expired(key)
+			}
+		}()
+	}
+}
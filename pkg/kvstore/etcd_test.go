@@ -31,6 +31,7 @@ import (
 	"github.com/cilium/cilium/pkg/checker"
 
 	etcdAPI "go.etcd.io/etcd/clientv3"
+	v3rpcErrors "go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
 	. "gopkg.in/check.v1"
 )
 
@@ -2017,3 +2018,46 @@ func (e *EtcdRateLimiterSuite) TestRateLimiter(c *C) {
 		}
 	}
 }
+
+func (e *EtcdSuite) TestLeaseManager(c *C) {
+	cl := Client().(*etcdClient)
+
+	expired := make(chan string, 4)
+	mgr := newEtcdLeaseManager(cl.client, 15*time.Second, 2,
+		func(key string) { expired <- key }, cl.getLogger())
+
+	// The same lease is reused until the maximum number of attached keys
+	// is reached
+	id1, err := mgr.GetLeaseID(context.TODO(), "foo/key1")
+	c.Assert(err, IsNil)
+	id2, err := mgr.GetLeaseID(context.TODO(), "foo/key2")
+	c.Assert(err, IsNil)
+	c.Assert(id2, Equals, id1)
+
+	// Requesting a lease for an already attached key returns the same
+	// lease
+	again, err := mgr.GetLeaseID(context.TODO(), "foo/key1")
+	c.Assert(err, IsNil)
+	c.Assert(again, Equals, id1)
+
+	// Once the limit is reached, an additional lease is acquired
+	id3, err := mgr.GetLeaseID(context.TODO(), "foo/key3")
+	c.Assert(err, IsNil)
+	c.Assert(id3, Not(Equals), id1)
+	c.Assert(mgr.TotalLeases(), Equals, 2)
+
+	c.Assert(mgr.KeyHasLease("foo/key1", id1), Equals, true)
+	mgr.Release("foo/key1")
+	c.Assert(mgr.KeyHasLease("foo/key1", id1), Equals, false)
+
+	// When a lease can no longer be kept alive, the registered observer
+	// is executed for all attached keys
+	mgr.CancelIfExpired(v3rpcErrors.ErrLeaseNotFound, id3)
+	select {
+	case key := <-expired:
+		c.Assert(key, Equals, "foo/key3")
+	case <-time.After(5 * time.Second):
+		c.Fatal("timeout while waiting for the lease expiration notification")
+	}
+	c.Assert(mgr.TotalLeases(), Equals, 1)
+}
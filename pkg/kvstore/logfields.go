@@ -49,6 +49,13 @@ const (
 	// fieldNumEntries is the number of entries in the result
 	fieldNumEntries = "numEntries"
 
+	// fieldNumKeys is the number of keys attached to a lease
+	fieldNumKeys = "numKeys"
+
+	// fieldLeaseID is the ID of the etcd lease attached to the keys owned
+	// by this agent
+	fieldLeaseID = "leaseID"
+
 	// fieldAttachLease is true if the key must be attached to a lease
 	fieldAttachLease = "attachLease"
 
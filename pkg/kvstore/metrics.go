@@ -57,6 +57,20 @@ func trackEventQueued(key string, typ EventType, duration time.Duration) {
 	metrics.KVStoreEventsQueueDuration.WithLabelValues(getScopeFromKey(key), typ.String()).Observe(duration.Seconds())
 }
 
+func setLeaseCountMetric(count int) {
+	if !option.Config.MetricsConfig.KVStoreEtcdLeasesEnabled {
+		return
+	}
+	metrics.KVStoreEtcdLeases.Set(float64(count))
+}
+
+func recordLeaseExpiration() {
+	if !option.Config.MetricsConfig.KVStoreEtcdLeaseExpirationsEnabled {
+		return
+	}
+	metrics.KVStoreEtcdLeaseExpirations.Inc()
+}
+
 func recordQuorumError(err string) {
 	if !option.Config.MetricsConfig.KVStoreQuorumErrorsEnabled {
 		return
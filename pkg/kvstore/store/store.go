@@ -230,9 +230,18 @@ func JoinSharedStore(c Configuration) (*SharedStore, error) {
 		},
 	)
 
+	s.backend.RegisterLeaseExpiredObserver(s.conf.Prefix, s.onLeaseExpired)
+
 	return s, nil
 }
 
+// onLeaseExpired is executed when the lease one of the local keys was
+// attached to expired. The key is recreated by triggering an out of schedule
+// run of the synchronization controller.
+func (s *SharedStore) onLeaseExpired(key string) {
+	controllers.TriggerController(s.controllerName)
+}
+
 func (s *SharedStore) onDelete(k NamedKey) {
 	if s.conf.Observer != nil {
 		s.conf.Observer.OnDelete(k)
@@ -257,6 +266,8 @@ func (s *SharedStore) Release() {
 		s.kvstoreWatcher.Stop()
 	}
 
+	s.backend.UnregisterLeaseExpiredObserver(s.conf.Prefix)
+
 	controllers.RemoveController(s.controllerName)
 }
 
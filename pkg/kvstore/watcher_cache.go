@@ -16,6 +16,10 @@ package kvstore
 
 type watchState struct {
 	deletionMark bool
+
+	// modRevision is the etcd mod revision the key had when its latest
+	// event was emitted to the watcher
+	modRevision uint64
 }
 
 type watcherCache map[string]watchState
@@ -28,6 +32,14 @@ func (wc watcherCache) Exists(key []byte) bool {
 	return false
 }
 
+// Unchanged returns true if the given key is tracked and its latest emitted
+// event carried the same mod revision, meaning a new event for it can be
+// suppressed.
+func (wc watcherCache) Unchanged(key []byte, modRevision uint64) bool {
+	state, ok := wc[string(key)]
+	return ok && state.modRevision == modRevision
+}
+
 func (wc watcherCache) RemoveDeleted(f func(string)) {
 	for k, localKey := range wc {
 		if localKey.deletionMark {
@@ -39,12 +51,15 @@ func (wc watcherCache) RemoveDeleted(f func(string)) {
 
 func (wc watcherCache) MarkAllForDeletion() {
 	for k := range wc {
-		wc[k] = watchState{deletionMark: true}
+		wc[k] = watchState{
+			deletionMark: true,
+			modRevision:  wc[k].modRevision,
+		}
 	}
 }
 
-func (wc watcherCache) MarkInUse(key []byte) {
-	wc[string(key)] = watchState{deletionMark: false}
+func (wc watcherCache) MarkInUse(key []byte, modRevision uint64) {
+	wc[string(key)] = watchState{modRevision: modRevision}
 }
 
 func (wc watcherCache) RemoveKey(key []byte) {
@@ -0,0 +1,59 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package kvstore
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *independentSuite) TestWatcherCacheUnchanged(c *C) {
+	key := []byte("foo/key")
+	cache := watcherCache{}
+
+	// An untracked key is never reported as unchanged
+	c.Assert(cache.Unchanged(key, 10), Equals, false)
+
+	cache.MarkInUse(key, 10)
+	c.Assert(cache.Unchanged(key, 10), Equals, true)
+	c.Assert(cache.Unchanged(key, 11), Equals, false)
+
+	// The mark for deletion must retain the mod revision so that a
+	// subsequent list can still suppress events for unchanged keys
+	cache.MarkAllForDeletion()
+	c.Assert(cache.Unchanged(key, 10), Equals, true)
+
+	cache.RemoveKey(key)
+	c.Assert(cache.Unchanged(key, 10), Equals, false)
+}
+
+func (s *independentSuite) TestWatcherCacheListDiff(c *C) {
+	var deleted []string
+	cache := watcherCache{}
+
+	cache.MarkInUse([]byte("foo/alive"), 1)
+	cache.MarkInUse([]byte("foo/gone"), 2)
+
+	// Simulate the fallback list after a compaction error: all keys are
+	// marked for deletion and only the ones still present are revived
+	cache.MarkAllForDeletion()
+	cache.MarkInUse([]byte("foo/alive"), 1)
+
+	cache.RemoveDeleted(func(key string) { deleted = append(deleted, key) })
+	c.Assert(deleted, DeepEquals, []string{"foo/gone"})
+	c.Assert(cache.Exists([]byte("foo/alive")), Equals, true)
+	c.Assert(cache.Exists([]byte("foo/gone")), Equals, false)
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvstore
+
+import (
+	"fmt"
+
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// queuedWrite is a write operation deferred while the kvstore backend is in
+// read-only degraded mode.
+type queuedWrite struct {
+	// key is the key the write applies to
+	key string
+
+	// value is the value to be written, ignored when delete is true
+	value []byte
+
+	// lease is true if the value should be written with an attached lease
+	lease bool
+
+	// delete is true if the key is to be deleted instead of written
+	delete bool
+}
+
+// writeQueue is a bounded, ordered queue of write operations deferred while
+// the kvstore backend has lost quorum. Writes are deduplicated per key: a
+// later write to a queued key replaces the queued operation while preserving
+// the position the key was first queued at, so draining replays the writes in
+// their original order with only the most recent operation per key.
+type writeQueue struct {
+	mutex lock.Mutex
+
+	// limit is the maximum number of keys that may be queued
+	limit int
+
+	// keys remembers the order in which keys were first queued
+	keys []string
+
+	// entries maps each queued key to its most recent write operation
+	entries map[string]*queuedWrite
+}
+
+func newWriteQueue(limit int) *writeQueue {
+	return &writeQueue{
+		limit:   limit,
+		entries: map[string]*queuedWrite{},
+	}
+}
+
+// enqueue queues a write operation, replacing any operation already queued
+// for the same key. An error is returned if the key is not queued yet and the
+// queue has reached its bound.
+func (q *writeQueue) enqueue(w *queuedWrite) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if _, queued := q.entries[w.key]; !queued {
+		if len(q.keys) >= q.limit {
+			return fmt.Errorf("kvstore write queue is full (%d writes queued)", q.limit)
+		}
+		q.keys = append(q.keys, w.key)
+	}
+	q.entries[w.key] = w
+
+	return nil
+}
+
+// drain empties the queue and returns the queued writes in the order in which
+// their keys were first queued.
+func (q *writeQueue) drain() []*queuedWrite {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	writes := make([]*queuedWrite, 0, len(q.keys))
+	for _, key := range q.keys {
+		writes = append(writes, q.entries[key])
+	}
+	q.keys = nil
+	q.entries = map[string]*queuedWrite{}
+
+	return writes
+}
+
+// size returns the number of queued writes.
+func (q *writeQueue) size() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return len(q.keys)
+}
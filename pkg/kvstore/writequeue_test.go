@@ -0,0 +1,62 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package kvstore
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *independentSuite) TestWriteQueueOrderAndDedup(c *C) {
+	q := newWriteQueue(4)
+
+	c.Assert(q.enqueue(&queuedWrite{key: "foo", value: []byte("1")}), IsNil)
+	c.Assert(q.enqueue(&queuedWrite{key: "bar", value: []byte("2")}), IsNil)
+	c.Assert(q.size(), Equals, 2)
+
+	// A later write to a queued key replaces the queued operation but
+	// preserves the position the key was first queued at.
+	c.Assert(q.enqueue(&queuedWrite{key: "foo", value: []byte("3")}), IsNil)
+	c.Assert(q.size(), Equals, 2)
+
+	// A deletion supersedes a queued write for the same key.
+	c.Assert(q.enqueue(&queuedWrite{key: "bar", delete: true}), IsNil)
+
+	writes := q.drain()
+	c.Assert(len(writes), Equals, 2)
+	c.Assert(writes[0].key, Equals, "foo")
+	c.Assert(string(writes[0].value), Equals, "3")
+	c.Assert(writes[1].key, Equals, "bar")
+	c.Assert(writes[1].delete, Equals, true)
+
+	// Draining empties the queue.
+	c.Assert(q.size(), Equals, 0)
+	c.Assert(len(q.drain()), Equals, 0)
+}
+
+func (s *independentSuite) TestWriteQueueBound(c *C) {
+	q := newWriteQueue(2)
+
+	c.Assert(q.enqueue(&queuedWrite{key: "foo"}), IsNil)
+	c.Assert(q.enqueue(&queuedWrite{key: "bar"}), IsNil)
+
+	// The bound applies to new keys only
+	c.Assert(q.enqueue(&queuedWrite{key: "baz"}), NotNil)
+
+	// Queued keys can still be updated when the queue is full
+	c.Assert(q.enqueue(&queuedWrite{key: "foo", value: []byte("1")}), IsNil)
+	c.Assert(q.size(), Equals, 2)
+}
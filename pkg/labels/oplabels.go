@@ -81,15 +81,17 @@ func (o *OpLabels) SplitUserLabelChanges(lbls Labels) (add, del Labels) {
 }
 
 // IdentityLabels returns map of labels that are used when determining a
-// security identity.
+// security identity. If both a custom label and a label derived from the
+// orchestration system exist for the same key, the custom label takes
+// precedence.
 func (o *OpLabels) IdentityLabels() Labels {
 	enabled := make(Labels, len(o.Custom)+len(o.OrchestrationIdentity))
 
-	for k, v := range o.Custom {
+	for k, v := range o.OrchestrationIdentity {
 		enabled[k] = v
 	}
 
-	for k, v := range o.OrchestrationIdentity {
+	for k, v := range o.Custom {
 		enabled[k] = v
 	}
 
@@ -97,10 +99,12 @@ func (o *OpLabels) IdentityLabels() Labels {
 }
 
 // GetIdentityLabel returns the value of the given Key from all IdentityLabels.
+// Custom labels take precedence over labels derived from the orchestration
+// system.
 func (o *OpLabels) GetIdentityLabel(key string) (l Label, found bool) {
-	l, found = o.OrchestrationIdentity[key]
+	l, found = o.Custom[key]
 	if !found {
-		l, found = o.Custom[key]
+		l, found = o.OrchestrationIdentity[key]
 	}
 	return l, found
 }
@@ -198,12 +202,19 @@ func (o *OpLabels) ModifyIdentityLabels(addLabels, delLabels Labels) (changed bo
 			delete(o.Disabled, k)
 			o.OrchestrationIdentity[k] = v
 			changed = true
-		} else if _, found := o.OrchestrationIdentity[k]; found { // Replace label's source and value.
-			o.OrchestrationIdentity[k] = v
-			changed = true
+		} else if cur, found := o.OrchestrationIdentity[k]; found && cur.Source == v.Source {
+			// Same source, replace the label's value in place.
+			if o.OrchestrationIdentity.upsertLabel(v) {
+				changed = true
+			}
 		} else {
-			o.Custom[k] = v
-			changed = true
+			// A differing source does not touch the label derived from
+			// the orchestration system; the custom label shadows it
+			// instead so that the next synchronization from the
+			// orchestration system does not undo the change.
+			if o.Custom.upsertLabel(v) {
+				changed = true
+			}
 		}
 	}
 	return changed, nil
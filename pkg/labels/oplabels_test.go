@@ -0,0 +1,74 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package labels
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *LabelsSuite) TestModifyIdentityLabelsSourcePrecedence(c *C) {
+	o := NewOpLabels()
+	o.OrchestrationIdentity = Map2Labels(map[string]string{"app": "foo"}, LabelSourceK8s)
+
+	// Adding the same key from another source shadows the k8s label
+	// instead of replacing it, so that the next synchronization from the
+	// orchestration system does not undo the change.
+	changed, err := o.ModifyIdentityLabels(Map2Labels(map[string]string{"app": "bar"}, LabelSourceUnspec), nil)
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, true)
+	c.Assert(o.OrchestrationIdentity["app"].Value, Equals, "foo")
+	c.Assert(o.Custom["app"].Value, Equals, "bar")
+
+	// The custom label wins in the security relevant set.
+	c.Assert(o.IdentityLabels()["app"].Value, Equals, "bar")
+	lbl, found := o.GetIdentityLabel("app")
+	c.Assert(found, Equals, true)
+	c.Assert(lbl.Value, Equals, "bar")
+
+	// Re-adding an identical label is not a change and must not trigger
+	// identity re-resolution.
+	changed, err = o.ModifyIdentityLabels(Map2Labels(map[string]string{"app": "bar"}, LabelSourceUnspec), nil)
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, false)
+
+	// An add with the source of the orchestration system replaces the
+	// orchestration label in place.
+	changed, err = o.ModifyIdentityLabels(Map2Labels(map[string]string{"app": "baz"}, LabelSourceK8s), nil)
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, true)
+	c.Assert(o.OrchestrationIdentity["app"].Value, Equals, "baz")
+
+	// Deleting the key removes the custom label and disables the
+	// orchestration label.
+	changed, err = o.ModifyIdentityLabels(nil, Map2Labels(map[string]string{"app": ""}, LabelSourceAny))
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, true)
+	_, found = o.Custom["app"]
+	c.Assert(found, Equals, false)
+	c.Assert(o.Disabled["app"].Value, Equals, "baz")
+	c.Assert(len(o.IdentityLabels()), Equals, 0)
+}
+
+func (s *LabelsSuite) TestModifyIdentityLabelsUnknownDelete(c *C) {
+	o := NewOpLabels()
+	o.OrchestrationIdentity = Map2Labels(map[string]string{"app": "foo"}, LabelSourceK8s)
+
+	changed, err := o.ModifyIdentityLabels(nil, Map2Labels(map[string]string{"unknown": ""}, LabelSourceAny))
+	c.Assert(err, NotNil)
+	c.Assert(changed, Equals, false)
+	c.Assert(o.OrchestrationIdentity["app"].Value, Equals, "foo")
+}
@@ -15,6 +15,7 @@
 package labelsfilter
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -32,6 +33,11 @@ var (
 	log                  = logging.DefaultLogger.WithField(logfields.LogSubsys, "labels-filter")
 	validLabelPrefixesMU lock.RWMutex
 	validLabelPrefixes   *labelPrefixCfg // Label prefixes used to filter from all labels
+
+	// namespaceLabelPrefixes are additional label prefixes applied on top
+	// of validLabelPrefixes for labels of pods in a specific namespace.
+	// Protected by validLabelPrefixesMU.
+	namespaceLabelPrefixes = map[string]*labelPrefixCfg{}
 )
 
 const (
@@ -112,6 +118,22 @@ func parseLabelPrefix(label string) (*LabelPrefix, error) {
 	return &labelPrefix, nil
 }
 
+// ConfigDigest returns a digest of the currently active label prefix
+// configuration. It can be compared against the digest of a previous agent
+// run to detect whether the set of identity-relevant labels has changed.
+func ConfigDigest() string {
+	validLabelPrefixesMU.RLock()
+	defer validLabelPrefixesMU.RUnlock()
+
+	h := sha256.New()
+	if validLabelPrefixes != nil {
+		for _, p := range validLabelPrefixes.LabelPrefixes {
+			fmt.Fprintln(h, p.String())
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
 // ParseLabelPrefixCfg parses valid label prefixes from a file and from a slice
 // of valid prefixes. Both are optional. If both are provided, both list are
 // appended together.
@@ -173,6 +195,54 @@ func ParseLabelPrefixCfg(prefixes []string, file string) error {
 	return nil
 }
 
+// SetNamespaceLabelPrefixes sets additional label prefixes to be applied to
+// labels of pods in the given namespace, on top of the globally configured
+// prefixes. The prefixes use the same syntax as the ones passed to
+// ParseLabelPrefixCfg. An empty list removes the namespace specific
+// configuration. It returns true if the effective configuration for the
+// namespace changed.
+func SetNamespaceLabelPrefixes(namespace string, prefixes []string) (bool, error) {
+	cfg := &labelPrefixCfg{Version: LPCfgFileVersion}
+	for _, label := range prefixes {
+		p, err := parseLabelPrefix(label)
+		if err != nil {
+			return false, err
+		}
+
+		if !p.Ignore {
+			cfg.whitelist = true
+		}
+
+		cfg.LabelPrefixes = append(cfg.LabelPrefixes, p)
+	}
+
+	validLabelPrefixesMU.Lock()
+	defer validLabelPrefixesMU.Unlock()
+
+	old := namespaceLabelPrefixes[namespace]
+	if len(cfg.LabelPrefixes) == 0 {
+		delete(namespaceLabelPrefixes, namespace)
+		return old != nil, nil
+	}
+
+	namespaceLabelPrefixes[namespace] = cfg
+	return !cfg.equals(old), nil
+}
+
+// equals returns true if both configurations contain the same label prefixes
+// in the same order.
+func (cfg *labelPrefixCfg) equals(other *labelPrefixCfg) bool {
+	if other == nil || len(cfg.LabelPrefixes) != len(other.LabelPrefixes) {
+		return false
+	}
+	for i, p := range cfg.LabelPrefixes {
+		if p.String() != other.LabelPrefixes[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
 // labelPrefixCfg is the label prefix configuration to filter labels of started
 // containers.
 // +k8s:openapi-gen=false
@@ -255,6 +325,10 @@ func readLabelPrefixCfgFrom(fileName string) (*labelPrefixCfg, error) {
 }
 
 func (cfg *labelPrefixCfg) filterLabels(lbls labels.Labels) (identityLabels, informationLabels labels.Labels) {
+	return cfg.filterLabelsByNamespace("", lbls)
+}
+
+func (cfg *labelPrefixCfg) filterLabelsByNamespace(namespace string, lbls labels.Labels) (identityLabels, informationLabels labels.Labels) {
 	if len(lbls) == 0 {
 		return nil, nil
 	}
@@ -262,12 +336,20 @@ func (cfg *labelPrefixCfg) filterLabels(lbls labels.Labels) (identityLabels, inf
 	validLabelPrefixesMU.RLock()
 	defer validLabelPrefixesMU.RUnlock()
 
+	prefixes := cfg.LabelPrefixes
+	whitelist := cfg.whitelist
+	if nsCfg := namespaceLabelPrefixes[namespace]; nsCfg != nil {
+		prefixes = make([]*LabelPrefix, 0, len(cfg.LabelPrefixes)+len(nsCfg.LabelPrefixes))
+		prefixes = append(append(prefixes, cfg.LabelPrefixes...), nsCfg.LabelPrefixes...)
+		whitelist = whitelist || nsCfg.whitelist
+	}
+
 	identityLabels = labels.Labels{}
 	informationLabels = labels.Labels{}
 	for k, v := range lbls {
 		included, ignored := 0, 0
 
-		for _, p := range cfg.LabelPrefixes {
+		for _, p := range prefixes {
 			if m, len := p.matches(v); m {
 				if p.Ignore {
 					// save length of shortest matching ignore
@@ -293,7 +375,7 @@ func (cfg *labelPrefixCfg) filterLabels(lbls labels.Labels) (identityLabels, inf
 		//   e.g. when evaluating the label foo.bar, the prefix rules
 		//   {!foo, foo.bar} will cause the label to be accepted
 		//   because the inclusive prefix matches over a longer section.
-		if (!cfg.whitelist && ignored == 0) || included > ignored {
+		if (!whitelist && ignored == 0) || included > ignored {
 			// Just want to make sure we don't have labels deleted in
 			// on side and disappearing in the other side...
 			identityLabels[k] = v
@@ -310,3 +392,9 @@ func (cfg *labelPrefixCfg) filterLabels(lbls labels.Labels) (identityLabels, inf
 func Filter(lbls labels.Labels) (identityLabels, informationLabels labels.Labels) {
 	return validLabelPrefixes.filterLabels(lbls)
 }
+
+// FilterByNamespace behaves like Filter but additionally applies any label
+// prefixes configured for the given namespace via SetNamespaceLabelPrefixes.
+func FilterByNamespace(namespace string, lbls labels.Labels) (identityLabels, informationLabels labels.Labels) {
+	return validLabelPrefixes.filterLabelsByNamespace(namespace, lbls)
+}
@@ -133,6 +133,51 @@ func (s *LabelsPrefCfgSuite) TestDefaultFilterLabels(c *C) {
 	c.Assert(filtered, checker.DeepEquals, wanted)
 }
 
+func (s *LabelsPrefCfgSuite) TestNamespaceFilterLabels(c *C) {
+	err := ParseLabelPrefixCfg([]string{}, "")
+	c.Assert(err, IsNil)
+
+	changed, err := SetNamespaceLabelPrefixes("ci", []string{"!build-id"})
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, true)
+	defer SetNamespaceLabelPrefixes("ci", nil)
+
+	// Two pods differing only in the filtered label must end up with the
+	// same identity labels and thus share an identity.
+	pod1 := labels.Map2Labels(map[string]string{"app": "web", "build-id": "a1b2c3"}, labels.LabelSourceK8s)
+	pod2 := labels.Map2Labels(map[string]string{"app": "web", "build-id": "d4e5f6"}, labels.LabelSourceK8s)
+
+	idtyLabels1, infoLabels1 := FilterByNamespace("ci", pod1)
+	idtyLabels2, _ := FilterByNamespace("ci", pod2)
+	c.Assert(idtyLabels1, checker.DeepEquals, idtyLabels2)
+	_, ok := idtyLabels1["build-id"]
+	c.Assert(ok, Equals, false)
+	_, ok = infoLabels1["build-id"]
+	c.Assert(ok, Equals, true)
+
+	// The filter only applies to the annotated namespace.
+	idtyLabels1, _ = FilterByNamespace("prod", pod1)
+	idtyLabels2, _ = FilterByNamespace("prod", pod2)
+	c.Assert(idtyLabels1["build-id"].Value, Equals, "a1b2c3")
+	c.Assert(idtyLabels2["build-id"].Value, Equals, "d4e5f6")
+
+	// Setting an identical filter configuration is not a change.
+	changed, err = SetNamespaceLabelPrefixes("ci", []string{"!build-id"})
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, false)
+
+	// Removing the filter restores the global behavior.
+	changed, err = SetNamespaceLabelPrefixes("ci", nil)
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, true)
+	idtyLabels1, _ = FilterByNamespace("ci", pod1)
+	c.Assert(idtyLabels1["build-id"].Value, Equals, "a1b2c3")
+
+	// Invalid patterns are rejected without modifying the configuration.
+	_, err = SetNamespaceLabelPrefixes("ci", []string{"!["})
+	c.Assert(err, NotNil)
+}
+
 func (s *LabelsPrefCfgSuite) TestFilterLabelsDocExample(c *C) {
 	wanted := labels.Labels{
 		"io.cilium.k8s.namespace.labels": labels.NewLabel("io.cilium.k8s.namespace.labels", "foo", labels.LabelSourceK8s),
@@ -172,3 +217,20 @@ func (s *LabelsPrefCfgSuite) TestFilterLabelsDocExample(c *C) {
 	c.Assert(len(filtered), Equals, 6)
 	c.Assert(filtered, checker.DeepEquals, wanted)
 }
+
+func (s *LabelsPrefCfgSuite) TestConfigDigest(c *C) {
+	err := ParseLabelPrefixCfg([]string{"id.*", "foo"}, "")
+	c.Assert(err, IsNil)
+	digest := ConfigDigest()
+	c.Assert(digest, Not(Equals), "")
+
+	// The digest is stable for an identical configuration.
+	err = ParseLabelPrefixCfg([]string{"id.*", "foo"}, "")
+	c.Assert(err, IsNil)
+	c.Assert(ConfigDigest(), Equals, digest)
+
+	// Changing the identity relevant labels changes the digest.
+	err = ParseLabelPrefixCfg([]string{"id.*", "foo", "bar"}, "")
+	c.Assert(err, IsNil)
+	c.Assert(ConfigDigest(), Not(Equals), digest)
+}
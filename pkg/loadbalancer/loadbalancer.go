@@ -164,6 +164,8 @@ const (
 	TCP = L4Type("TCP")
 	// UDP type.
 	UDP = L4Type("UDP")
+	// SCTP type.
+	SCTP = L4Type("SCTP")
 )
 
 const (
@@ -175,7 +177,7 @@ const (
 
 var (
 	// AllProtocols is the list of all supported L4 protocols
-	AllProtocols = []L4Type{TCP, UDP}
+	AllProtocols = []L4Type{TCP, UDP, SCTP}
 )
 
 // L4Type name.
@@ -200,6 +202,15 @@ type Backend struct {
 	// Node hosting this backend. This is used to determine backends local to
 	// a node.
 	NodeName string
+	// Terminating indicates that the backend is being drained: it is kept in
+	// the BPF backend map so that established connections can finish, but it
+	// is not selectable for new connections.
+	Terminating bool
+	// Quarantined indicates that the backend failed active health probes:
+	// it is kept in the BPF backend map so that established connections
+	// can finish, but it is not selectable for new connections until the
+	// probes succeed again.
+	Quarantined bool
 	L3n4Addr
 }
 
@@ -218,6 +229,7 @@ type SVC struct {
 	HealthCheckNodePort       uint16 // Service health check node port
 	Name                      string // Service name
 	Namespace                 string // Service namespace
+	HealthProbePath           string // HTTP path for active backend health probes
 	LoadBalancerSourceRanges  []*cidr.CIDR
 }
 
@@ -270,6 +282,8 @@ func NewL4Type(name string) (L4Type, error) {
 		return TCP, nil
 	case "udp":
 		return UDP, nil
+	case "sctp":
+		return SCTP, nil
 	default:
 		return "", fmt.Errorf("unknown L4 protocol")
 	}
@@ -428,9 +442,10 @@ func (b *Backend) GetBackendModel() *models.BackendAddress {
 
 	ip := b.IP.String()
 	return &models.BackendAddress{
-		IP:       &ip,
-		Port:     b.Port,
-		NodeName: b.NodeName,
+		IP:          &ip,
+		Port:        b.Port,
+		NodeName:    b.NodeName,
+		Quarantined: b.Quarantined,
 	}
 }
 
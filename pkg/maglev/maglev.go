@@ -37,8 +37,10 @@ var (
 	SeedJhash0 uint32
 	SeedJhash1 uint32
 
-	// permutation is the slice containing the Maglev permutation calculations.
-	permutation []uint64
+	// permutationPool caches slices for the Maglev permutation calculations,
+	// so that concurrent lookup table computations do not share a single
+	// buffer and repeated expensive allocations are avoided.
+	permutationPool sync.Pool
 )
 
 // Init initializes the Maglev subsystem with the seed and the backend table
@@ -59,7 +61,7 @@ func Init(seed string, m uint64) error {
 
 	// Allocate this ahead of time to avoid expensive allocations inside
 	// getPermutation().
-	permutation = make([]uint64, derivePermutationSliceLen(m))
+	permutationPool.Put(make([]uint64, derivePermutationSliceLen(m)))
 
 	return nil
 }
@@ -82,6 +84,10 @@ func getPermutation(backends []string, m uint64, numCPU int) []uint64 {
 	// would result into an overhead (allocation of stackframes, stress on
 	// scheduling, etc) instead of a performance gain.
 
+	permutation, _ := permutationPool.Get().([]uint64)
+	if permutation == nil {
+		permutation = make([]uint64, derivePermutationSliceLen(m))
+	}
 	bCount := len(backends)
 	if size := uint64(bCount) * m; size > uint64(len(permutation)) {
 		// Reallocate slice so we don't have to allocate again on the next
@@ -116,14 +122,23 @@ func getPermutation(backends []string, m uint64, numCPU int) []uint64 {
 	return permutation[:bCount*int(m)]
 }
 
+// putPermutation returns a slice retrieved by getPermutation() to the pool,
+// once the caller is done with it.
+func putPermutation(perm []uint64) {
+	permutationPool.Put(perm[:cap(perm)])
+}
+
 // GetLookupTable returns the Maglev lookup table of the size "m" for the given
 // backends. The lookup table contains the indices of the given backends.
+//
+// The function is safe for concurrent use.
 func GetLookupTable(backends []string, m uint64) []int {
 	if len(backends) == 0 {
 		return nil
 	}
 
 	perm := getPermutation(backends, m, runtime.NumCPU())
+	defer putPermutation(perm)
 	next := make([]int, len(backends))
 	entry := make([]int, m)
 
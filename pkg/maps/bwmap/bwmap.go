@@ -33,6 +33,11 @@ const (
 	// from user space this is a limit to prevent buggy applications
 	// to fill the FQ qdisc.
 	DefaultDropHorizon = 2 * time.Second
+
+	// EdtIdIngress is set in the id of ingress aggregates so that they
+	// do not collide with the egress aggregate of the same endpoint.
+	// Must be kept in sync with EDT_ID_INGRESS in bpf/lib/edt.h.
+	EdtIdIngress = uint64(1) << 16
 )
 
 type EdtId struct {
@@ -77,3 +82,14 @@ func Delete(Id uint16) error {
 	return ThrottleMap.Delete(
 		&EdtId{Id: uint64(Id)})
 }
+
+func UpdateIngress(Id uint16, Bps uint64) error {
+	return ThrottleMap.Update(
+		&EdtId{Id: uint64(Id) | EdtIdIngress},
+		&EdtInfo{Bps: Bps, TimeHorizonDrop: uint64(DefaultDropHorizon)})
+}
+
+func DeleteIngress(Id uint16) error {
+	return ThrottleMap.Delete(
+		&EdtId{Id: uint64(Id) | EdtIdIngress})
+}
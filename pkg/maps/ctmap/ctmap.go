@@ -28,6 +28,7 @@ import (
 
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/byteorder"
 	"github.com/cilium/cilium/pkg/defaults"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging"
@@ -314,6 +315,57 @@ func (m *Map) DumpEntries() (string, error) {
 	return DoDumpEntries(m)
 }
 
+// CountBackendConnections walks the given CT maps and counts the tracked
+// service connections of the service identified with revNATID per backend.
+// The entries are aggregated while streaming the dump, so the memory usage is
+// bounded by the number of distinct backends rather than by the map size.
+// Connections which both sides have closed are skipped, as they are merely
+// awaiting garbage collection. The backend IDs are not validated against the
+// backend map; entries whose backend has already been removed are counted
+// too.
+func CountBackendConnections(maps []*Map, revNATID uint16) (map[uint64]uint64, error) {
+	counts := map[uint64]uint64{}
+	revNAT := byteorder.HostToNetwork16(revNATID)
+
+	cb := func(key bpf.MapKey, value bpf.MapValue) {
+		ctKey, ok := key.(CtKey)
+		if !ok {
+			return
+		}
+		if ctKey.GetFlags()&TUPLE_F_SERVICE == 0 {
+			return
+		}
+		entry := value.(*CtEntry)
+		if entry.RevNAT != revNAT {
+			return
+		}
+		if entry.Flags&RxClosing != 0 && entry.Flags&TxClosing != 0 {
+			return
+		}
+		counts[entry.BackendID()]++
+	}
+
+	for _, m := range maps {
+		path, err := m.Path()
+		if err == nil {
+			err = m.Open()
+		}
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("Unable to open %s: %s", path, err)
+		}
+		defer m.Close()
+		stats := bpf.NewDumpStats(&m.Map)
+		if err := m.DumpReliablyWithCallback(cb, stats); err != nil {
+			return nil, fmt.Errorf("Unable to dump %s: %s", path, err)
+		}
+	}
+
+	return counts, nil
+}
+
 // newMap creates a new CT map of the specified type with the specified name.
 func newMap(mapName string, m mapType) *Map {
 	result := &Map{
@@ -333,14 +385,6 @@ func newMap(mapName string, m mapType) *Map {
 	return result
 }
 
-func purgeCtEntry6(m *Map, key CtKey, natMap *nat.Map) error {
-	err := m.Delete(key)
-	if err == nil && natMap != nil {
-		natMap.DeleteMapping(key.GetTupleKey())
-	}
-	return err
-}
-
 // doGC6 iterates through a CTv6 map and drops entries based on the given
 // filter.
 func doGC6(m *Map, filter *GCFilter) gcStats {
@@ -357,6 +401,8 @@ func doGC6(m *Map, filter *GCFilter) gcStats {
 		}
 	}
 
+	var toDelete []bpf.MapKey
+
 	filterCallback := func(key bpf.MapKey, value bpf.MapValue) {
 		entry := value.(*CtEntry)
 
@@ -372,12 +418,9 @@ func doGC6(m *Map, filter *GCFilter) gcStats {
 
 			switch action {
 			case deleteEntry:
-				err := purgeCtEntry6(m, currentKey6Global, natMap)
-				if err != nil {
-					log.WithError(err).WithField(logfields.Key, currentKey6Global.String()).Error("Unable to delete CT entry")
-				} else {
-					stats.deleted++
-				}
+				// The key is owned by the dump and overwritten by the
+				// next entry, take a copy.
+				toDelete = append(toDelete, currentKey6Global.DeepCopyMapKey())
 			default:
 				stats.aliveEntries++
 			}
@@ -392,12 +435,7 @@ func doGC6(m *Map, filter *GCFilter) gcStats {
 
 			switch action {
 			case deleteEntry:
-				err := purgeCtEntry6(m, currentKey6, natMap)
-				if err != nil {
-					log.WithError(err).WithField(logfields.Key, currentKey6.String()).Error("Unable to delete CT entry")
-				} else {
-					stats.deleted++
-				}
+				toDelete = append(toDelete, currentKey6.DeepCopyMapKey())
 			default:
 				stats.aliveEntries++
 			}
@@ -409,16 +447,31 @@ func doGC6(m *Map, filter *GCFilter) gcStats {
 	// See doGC4() comment.
 	globalDeleteLock[m.mapType].Lock()
 	stats.dumpError = m.DumpReliablyWithCallback(filterCallback, stats.DumpStats)
+	stats.deleted += purgeCtEntries(m, toDelete, natMap)
 	globalDeleteLock[m.mapType].Unlock()
 	return stats
 }
 
-func purgeCtEntry4(m *Map, key CtKey, natMap *nat.Map) error {
-	err := m.Delete(key)
-	if err == nil && natMap != nil {
-		natMap.DeleteMapping(key.GetTupleKey())
+// purgeCtEntries removes the given CT keys and their NAT mappings from the
+// map. The CT entries are deleted with a single syscall per batch where the
+// kernel supports it. It returns the number of deleted entries.
+func purgeCtEntries(m *Map, keys []bpf.MapKey, natMap *nat.Map) uint32 {
+	if len(keys) == 0 {
+		return 0
+	}
+	if err := m.DeleteBatch(keys); err != nil {
+		// Some of the keys may have been deleted before the error
+		// occurred, but there is no way of telling how many. Leave
+		// their NAT mappings alone, the orphan NAT GC cleans them up.
+		log.WithError(err).Error("Unable to delete CT entries")
+		return 0
+	}
+	if natMap != nil {
+		for _, key := range keys {
+			natMap.DeleteMapping(key.(CtKey).GetTupleKey())
+		}
 	}
-	return err
+	return uint32(len(keys))
 }
 
 // doGC4 iterates through a CTv4 map and drops entries based on the given
@@ -436,6 +489,8 @@ func doGC4(m *Map, filter *GCFilter) gcStats {
 		}
 	}
 
+	var toDelete []bpf.MapKey
+
 	filterCallback := func(key bpf.MapKey, value bpf.MapValue) {
 		entry := value.(*CtEntry)
 
@@ -451,12 +506,9 @@ func doGC4(m *Map, filter *GCFilter) gcStats {
 
 			switch action {
 			case deleteEntry:
-				err := purgeCtEntry4(m, currentKey4Global, natMap)
-				if err != nil {
-					log.WithError(err).WithField(logfields.Key, currentKey4Global.String()).Error("Unable to delete CT entry")
-				} else {
-					stats.deleted++
-				}
+				// The key is owned by the dump and overwritten by the
+				// next entry, take a copy.
+				toDelete = append(toDelete, currentKey4Global.DeepCopyMapKey())
 			default:
 				stats.aliveEntries++
 			}
@@ -471,12 +523,7 @@ func doGC4(m *Map, filter *GCFilter) gcStats {
 
 			switch action {
 			case deleteEntry:
-				err := purgeCtEntry4(m, currentKey4, natMap)
-				if err != nil {
-					log.WithError(err).WithField(logfields.Key, currentKey4.String()).Error("Unable to delete CT entry")
-				} else {
-					stats.deleted++
-				}
+				toDelete = append(toDelete, currentKey4.DeepCopyMapKey())
 			default:
 				stats.aliveEntries++
 			}
@@ -489,6 +536,7 @@ func doGC4(m *Map, filter *GCFilter) gcStats {
 	// when keys are being evicted underneath us from concurrent go routines.
 	globalDeleteLock[m.mapType].Lock()
 	stats.dumpError = m.DumpReliablyWithCallback(filterCallback, stats.DumpStats)
+	stats.deleted += purgeCtEntries(m, toDelete, natMap)
 	globalDeleteLock[m.mapType].Unlock()
 	return stats
 }
@@ -521,19 +569,20 @@ func (f *GCFilter) doFiltering(srcIP, dstIP net.IP, srcPort, dstPort uint16, nex
 	return noAction
 }
 
-func doGC(m *Map, filter *GCFilter) int {
+func doGC(m *Map, filter *GCFilter) gcStats {
 	if m.mapType.isIPv6() {
-		return int(doGC6(m, filter).deleted)
+		return doGC6(m, filter)
 	} else if m.mapType.isIPv4() {
-		return int(doGC4(m, filter).deleted)
+		return doGC4(m, filter)
 	}
 	log.Fatalf("Unsupported ct map type: %s", m.mapType.String())
-	return 0
+	return gcStats{}
 }
 
 // GC runs garbage collection for map m with name mapType with the given filter.
-// It returns how many items were deleted from m.
-func GC(m *Map, filter *GCFilter) int {
+// It returns how many items were deleted from m and how many entries are
+// still alive.
+func GC(m *Map, filter *GCFilter) (deleted, alive int) {
 	if filter.RemoveExpired {
 		var t uint64
 		if option.Config.ClockSource == option.ClockSourceKtime {
@@ -545,7 +594,8 @@ func GC(m *Map, filter *GCFilter) int {
 		filter.Time = uint32(t)
 	}
 
-	return doGC(m, filter)
+	stats := doGC(m, filter)
+	return int(stats.deleted), int(stats.aliveEntries)
 }
 
 // PurgeOrphanNATEntries removes orphan SNAT entries. We call an SNAT entry
@@ -628,10 +678,10 @@ func PurgeOrphanNATEntries(ctMapTCP, ctMapAny *Map) *NatGCStats {
 // Flush runs garbage collection for map m with the name mapType, deleting all
 // entries. The specified map must be already opened using bpf.OpenMap().
 func (m *Map) Flush() int {
-	return doGC(m, &GCFilter{
+	return int(doGC(m, &GCFilter{
 		RemoveExpired: true,
 		Time:          MaxTime,
-	})
+	}).deleted)
 }
 
 // DeleteIfUpgradeNeeded attempts to open the conntrack maps associated with
@@ -768,9 +818,17 @@ func Exists(e CtEndpoint, ipv4, ipv6 bool) bool {
 
 var cachedGCInterval time.Duration
 
-// GetInterval returns the interval adjusted based on the deletion ratio of the
-// last run
-func GetInterval(mapType bpf.MapType, maxDeleteRatio float64) (interval time.Duration) {
+// gcMapPressureThreshold is the map utilization ratio above which the GC
+// interval is shortened, regardless of the deletion ratio of the last run.
+// A burst of short-lived connections can fill the map well before the next
+// scheduled scan would notice a high deletion ratio.
+const gcMapPressureThreshold = 0.6
+
+// GetInterval returns the interval adjusted based on the deletion ratio and
+// the map pressure of the last run
+func GetInterval(mapType bpf.MapType, maxDeleteRatio, maxPressure float64) (interval time.Duration) {
+	defer func() { metrics.ConntrackGCInterval.Set(interval.Seconds()) }()
+
 	if val := option.Config.ConntrackGCInterval; val != time.Duration(0) {
 		interval = val
 		return
@@ -780,17 +838,28 @@ func GetInterval(mapType bpf.MapType, maxDeleteRatio float64) (interval time.Dur
 		interval = defaults.ConntrackGCStartingInterval
 	}
 
-	return calculateInterval(mapType, interval, maxDeleteRatio)
+	return calculateInterval(mapType, interval, maxDeleteRatio, maxPressure)
 }
 
-func calculateInterval(mapType bpf.MapType, prevInterval time.Duration, maxDeleteRatio float64) (interval time.Duration) {
+func calculateInterval(mapType bpf.MapType, prevInterval time.Duration, maxDeleteRatio, maxPressure float64) (interval time.Duration) {
 	interval = prevInterval
 
-	if maxDeleteRatio == 0.0 {
+	if maxDeleteRatio == 0.0 && maxPressure <= gcMapPressureThreshold {
 		return
 	}
 
 	switch {
+	case maxPressure > gcMapPressureThreshold:
+		if maxPressure > 0.9 {
+			maxPressure = 0.9
+		}
+		// 60%..90% full => 2.5x..10x shorter
+		interval = time.Duration(float64(interval) * (1.0 - maxPressure)).Round(time.Second)
+
+		if interval < defaults.ConntrackGCMinInterval {
+			interval = defaults.ConntrackGCMinInterval
+		}
+
 	case maxDeleteRatio > 0.25:
 		if maxDeleteRatio > 0.9 {
 			maxDeleteRatio = 0.9
@@ -825,6 +894,7 @@ func calculateInterval(mapType bpf.MapType, prevInterval time.Duration, maxDelet
 		log.WithFields(logrus.Fields{
 			"newInterval": interval,
 			"deleteRatio": maxDeleteRatio,
+			"mapPressure": maxPressure,
 		}).Info("Conntrack garbage collector interval recalculated")
 	}
 
@@ -74,21 +74,33 @@ func (t *CTMapTestSuite) TestInit(c *C) {
 }
 
 func (t *CTMapTestSuite) TestCalculateInterval(c *C) {
-	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.1), Equals, time.Minute)  // no change
-	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.2), Equals, time.Minute)  // no change
-	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.25), Equals, time.Minute) // no change
-
-	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.40), Equals, 36*time.Second)
-	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.60), Equals, 24*time.Second)
-
-	c.Assert(calculateInterval(bpf.MapTypeLRUHash, 10*time.Second, 0.01), Equals, 15*time.Second)
-	c.Assert(calculateInterval(bpf.MapTypeLRUHash, 10*time.Second, 0.04), Equals, 15*time.Second)
-
-	c.Assert(calculateInterval(bpf.MapTypeLRUHash, 1*time.Second, 0.9), Equals, defaults.ConntrackGCMinInterval)
-	c.Assert(calculateInterval(bpf.MapTypeHash, 1*time.Second, 0.9), Equals, defaults.ConntrackGCMinInterval)
-
-	c.Assert(calculateInterval(bpf.MapTypeLRUHash, 24*time.Hour, 0.01), Equals, defaults.ConntrackGCMaxLRUInterval)
-	c.Assert(calculateInterval(bpf.MapTypeHash, 24*time.Hour, 0.01), Equals, defaults.ConntrackGCMaxInterval)
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.1, 0.0), Equals, time.Minute)  // no change
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.2, 0.0), Equals, time.Minute)  // no change
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.25, 0.0), Equals, time.Minute) // no change
+
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.40, 0.0), Equals, 36*time.Second)
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.60, 0.0), Equals, 24*time.Second)
+
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, 10*time.Second, 0.01, 0.0), Equals, 15*time.Second)
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, 10*time.Second, 0.04, 0.0), Equals, 15*time.Second)
+
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, 1*time.Second, 0.9, 0.0), Equals, defaults.ConntrackGCMinInterval)
+	c.Assert(calculateInterval(bpf.MapTypeHash, 1*time.Second, 0.9, 0.0), Equals, defaults.ConntrackGCMinInterval)
+
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, 24*time.Hour, 0.01, 0.0), Equals, defaults.ConntrackGCMaxLRUInterval)
+	c.Assert(calculateInterval(bpf.MapTypeHash, 24*time.Hour, 0.01, 0.0), Equals, defaults.ConntrackGCMaxInterval)
+
+	// Map pressure below the threshold has no influence on the interval.
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.1, 0.5), Equals, time.Minute)
+	// A nearly full map shortens the interval even when nothing was deleted.
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.0, 0.7), Equals, 18*time.Second)
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.0, 0.8), Equals, 12*time.Second)
+	// The interval never drops below the minimum bound, even at the 10x
+	// speedup that pressure above 90% is capped at.
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.0, 0.99), Equals, defaults.ConntrackGCMinInterval)
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, 1*time.Second, 0.0, 0.99), Equals, defaults.ConntrackGCMinInterval)
+	// High pressure takes precedence over the low delete ratio backoff.
+	c.Assert(calculateInterval(bpf.MapTypeLRUHash, time.Minute, 0.01, 0.8), Equals, 12*time.Second)
 }
 
 func (t *CTMapTestSuite) TestFilterMapsByProto(c *C) {
@@ -57,6 +57,7 @@ func Enable(ipv4, ipv6 bool, restoredEndpoints []*endpoint.Endpoint, mgr Endpoin
 		for {
 			var (
 				maxDeleteRatio float64
+				maxPressure    float64
 
 				// epsMap contains an IP -> EP mapping. It is used by EmitCTEntryCB to
 				// avoid doing mgr.LookupIP, which is more expensive.
@@ -97,7 +98,7 @@ func Enable(ipv4, ipv6 bool, restoredEndpoints []*endpoint.Endpoint, mgr Endpoin
 			}
 
 			if len(eps) > 0 || initialScan {
-				mapType, maxDeleteRatio = runGC(nil, ipv4, ipv6, triggeredBySignal, createGCFilter(initialScan, restoredEndpoints, emitEntryCB))
+				mapType, maxDeleteRatio, maxPressure = runGC(nil, ipv4, ipv6, triggeredBySignal, createGCFilter(initialScan, restoredEndpoints, emitEntryCB))
 			}
 			for _, e := range eps {
 				if !e.ConntrackLocal() {
@@ -142,7 +143,7 @@ func Enable(ipv4, ipv6 bool, restoredEndpoints []*endpoint.Endpoint, mgr Endpoin
 						ipv6 = true
 					}
 				}
-			case <-ctTimer.After(ctmap.GetInterval(mapType, maxDeleteRatio)):
+			case <-ctTimer.After(ctmap.GetInterval(mapType, maxDeleteRatio, maxPressure)):
 				ipv4 = ipv4Orig
 				ipv6 = ipv6Orig
 			}
@@ -166,7 +167,7 @@ func Enable(ipv4, ipv6 bool, restoredEndpoints []*endpoint.Endpoint, mgr Endpoin
 // The provided endpoint is optional; if it is provided, then its map will be
 // garbage collected and any failures will be logged to the endpoint log.
 // Otherwise it will garbage-collect the global map and use the global log.
-func runGC(e *endpoint.Endpoint, ipv4, ipv6, triggeredBySignal bool, filter *ctmap.GCFilter) (mapType bpf.MapType, maxDeleteRatio float64) {
+func runGC(e *endpoint.Endpoint, ipv4, ipv6, triggeredBySignal bool, filter *ctmap.GCFilter) (mapType bpf.MapType, maxDeleteRatio, maxPressure float64) {
 	var maps []*ctmap.Map
 
 	if e == nil {
@@ -196,7 +197,7 @@ func runGC(e *endpoint.Endpoint, ipv4, ipv6, triggeredBySignal bool, filter *ctm
 
 		mapType = m.MapInfo.MapType
 
-		deleted := ctmap.GC(m, filter)
+		deleted, alive := ctmap.GC(m, filter)
 
 		if deleted > 0 {
 			ratio := float64(deleted) / float64(m.MapInfo.MaxEntries)
@@ -208,6 +209,12 @@ func runGC(e *endpoint.Endpoint, ipv4, ipv6, triggeredBySignal bool, filter *ctm
 				"count":        deleted,
 			}).Debug("Deleted filtered entries from map")
 		}
+
+		// The remaining alive entries reflect the map pressure the next
+		// scan will start out with.
+		if pressure := float64(alive) / float64(m.MapInfo.MaxEntries); pressure > maxPressure {
+			maxPressure = pressure
+		}
 	}
 
 	if e == nil && triggeredBySignal {
@@ -122,6 +122,7 @@ func (s *gcStats) finish() {
 	metrics.ConntrackGCRuns.WithLabelValues(family, proto, status).Inc()
 	metrics.ConntrackGCDuration.WithLabelValues(family, proto, status).Observe(duration.Seconds())
 	metrics.ConntrackGCKeyFallbacks.WithLabelValues(family, proto).Add(float64(s.KeyFallback))
+	metrics.ConntrackGCEvictions.WithLabelValues(family, proto).Add(float64(s.deleted))
 }
 
 type NatGCStats struct {
@@ -521,6 +521,13 @@ const SizeofCtEntry = int(unsafe.Sizeof(CtEntry{}))
 // GetValuePtr returns the unsafe.Pointer for s.
 func (c *CtEntry) GetValuePtr() unsafe.Pointer { return unsafe.Pointer(c) }
 
+// BackendID returns the backend ID of the entry. Only entries of service type
+// store a backend ID; it shares the union with RxBytes, which is unused for
+// them (see GH#7060).
+func (c *CtEntry) BackendID() uint64 {
+	return c.RxBytes
+}
+
 const (
 	RxClosing = 1 << iota
 	TxClosing
@@ -22,6 +22,7 @@ import (
 	"unsafe"
 
 	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/types"
@@ -93,6 +94,21 @@ func (k Key) String() string {
 	return "<unknown>"
 }
 
+// IPNet returns the IP prefix represented by the key.
+func (k *Key) IPNet() *net.IPNet {
+	cidr := &net.IPNet{}
+	prefixLen := k.Prefixlen - getStaticPrefixBits()
+	switch k.Family {
+	case bpf.EndpointKeyIPv4:
+		cidr.IP = net.IP(k.IP[:net.IPv4len])
+		cidr.Mask = net.CIDRMask(int(prefixLen), 8*net.IPv4len)
+	case bpf.EndpointKeyIPv6:
+		cidr.IP = net.IP(k.IP[:])
+		cidr.Mask = net.CIDRMask(int(prefixLen), 8*net.IPv6len)
+	}
+	return cidr
+}
+
 // getPrefixLen determines the length that should be set inside the Key so that
 // the lookup prefix is correct in the BPF map key. The specified 'prefixBits'
 // indicates the number of bits in the IP that must match to match the entry in
@@ -175,6 +191,58 @@ func NewMap(name string) *Map {
 	}
 }
 
+// DumpFilter restricts the entries yielded by DumpEntriesWithFilter. The zero
+// value matches all entries.
+type DumpFilter struct {
+	// Prefix, if non-nil, only matches entries whose prefix is contained
+	// within the given prefix.
+	Prefix *net.IPNet
+
+	// Identity, if non-zero, only matches entries with the given numeric
+	// security identity.
+	Identity uint32
+
+	// ClusterID, if non-nil, only matches entries whose security identity
+	// belongs to the cluster with the given ID.
+	ClusterID *uint32
+}
+
+// matches returns true if the entry passes all filters set in f.
+func (f *DumpFilter) matches(key *Key, value *RemoteEndpointInfo) bool {
+	if f == nil {
+		return true
+	}
+	if f.Prefix != nil {
+		cidr := key.IPNet()
+		filterOnes, _ := f.Prefix.Mask.Size()
+		entryOnes, _ := cidr.Mask.Size()
+		if entryOnes < filterOnes || !f.Prefix.Contains(cidr.IP) {
+			return false
+		}
+	}
+	if f.Identity != 0 && value.SecurityIdentity != f.Identity {
+		return false
+	}
+	if f.ClusterID != nil && uint32(identity.NumericIdentity(value.SecurityIdentity).ClusterID()) != *f.ClusterID {
+		return false
+	}
+	return true
+}
+
+// DumpEntriesWithFilter iterates over the entries of the ipcache BPF map
+// which match the given filter, invoking cb for each entry. The entries are
+// streamed one at a time so that dumping a large map does not require memory
+// proportional to the map size.
+func (m *Map) DumpEntriesWithFilter(filter *DumpFilter, cb func(key *Key, value *RemoteEndpointInfo)) error {
+	return m.DumpWithCallback(func(k bpf.MapKey, v bpf.MapValue) {
+		key := k.(*Key)
+		value := v.(*RemoteEndpointInfo)
+		if filter.matches(key, value) {
+			cb(key, value)
+		}
+	})
+}
+
 // delete removes a key from the ipcache BPF map, and returns whether the
 // kernel supports the delete operation (true) or not (false), and any error
 // that may have occurred while attempting to delete the entry.
@@ -0,0 +1,80 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package ipcache
+
+import (
+	"net"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) { TestingT(t) }
+
+type IPCacheMapTestSuite struct{}
+
+var _ = Suite(&IPCacheMapTestSuite{})
+
+func mustKey(c *C, cidr string) *Key {
+	ip, prefix, err := net.ParseCIDR(cidr)
+	c.Assert(err, IsNil)
+	key := NewKey(ip, prefix.Mask)
+	return &key
+}
+
+func (s *IPCacheMapTestSuite) TestKeyIPNet(c *C) {
+	for _, cidr := range []string{"10.0.0.0/8", "10.1.2.3/32", "f00d::/64"} {
+		c.Assert(mustKey(c, cidr).IPNet().String(), Equals, cidr)
+	}
+}
+
+func (s *IPCacheMapTestSuite) TestDumpFilterMatches(c *C) {
+	key := mustKey(c, "10.1.2.0/24")
+	value := &RemoteEndpointInfo{SecurityIdentity: 3<<16 | 1000}
+
+	mustParse := func(cidr string) *net.IPNet {
+		_, prefix, err := net.ParseCIDR(cidr)
+		c.Assert(err, IsNil)
+		return prefix
+	}
+	clusterID := func(id uint32) *uint32 { return &id }
+
+	tests := []struct {
+		filter  *DumpFilter
+		matches bool
+	}{
+		{nil, true},
+		{&DumpFilter{}, true},
+		{&DumpFilter{Prefix: mustParse("10.0.0.0/8")}, true},
+		{&DumpFilter{Prefix: mustParse("10.1.2.0/24")}, true},
+		// More specific than the entry.
+		{&DumpFilter{Prefix: mustParse("10.1.2.0/25")}, false},
+		{&DumpFilter{Prefix: mustParse("192.168.0.0/16")}, false},
+		{&DumpFilter{Prefix: mustParse("f00d::/16")}, false},
+		{&DumpFilter{Identity: 3<<16 | 1000}, true},
+		{&DumpFilter{Identity: 1000}, false},
+		{&DumpFilter{ClusterID: clusterID(3)}, true},
+		{&DumpFilter{ClusterID: clusterID(0)}, false},
+		{&DumpFilter{Prefix: mustParse("10.0.0.0/8"), Identity: 3<<16 | 1000, ClusterID: clusterID(3)}, true},
+	}
+
+	for i, tt := range tests {
+		c.Check(tt.filter.matches(key, value), Equals, tt.matches,
+			Commentf("test case %d", i))
+	}
+}
@@ -239,18 +239,19 @@ func (k *Service4Key) String() string {
 	return addr
 }
 
-func (k *Service4Key) GetKeyPtr() unsafe.Pointer { return unsafe.Pointer(k) }
-func (k *Service4Key) NewValue() bpf.MapValue    { return &Service4Value{} }
-func (k *Service4Key) IsIPv6() bool              { return false }
-func (k *Service4Key) IsSurrogate() bool         { return k.GetAddress().IsUnspecified() }
-func (k *Service4Key) Map() *bpf.Map             { return Service4MapV2 }
-func (k *Service4Key) SetBackendSlot(slot int)   { k.BackendSlot = uint16(slot) }
-func (k *Service4Key) GetBackendSlot() int       { return int(k.BackendSlot) }
-func (k *Service4Key) SetScope(scope uint8)      { k.Scope = scope }
-func (k *Service4Key) GetScope() uint8           { return k.Scope }
-func (k *Service4Key) GetAddress() net.IP        { return k.Address.IP() }
-func (k *Service4Key) GetPort() uint16           { return k.Port }
-func (k *Service4Key) MapDelete() error          { return k.Map().Delete(k.ToNetwork()) }
+func (k *Service4Key) GetKeyPtr() unsafe.Pointer    { return unsafe.Pointer(k) }
+func (k *Service4Key) NewValue() bpf.MapValue       { return &Service4Value{} }
+func (k *Service4Key) IsIPv6() bool                 { return false }
+func (k *Service4Key) IsSurrogate() bool            { return k.GetAddress().IsUnspecified() }
+func (k *Service4Key) Map() *bpf.Map                { return Service4MapV2 }
+func (k *Service4Key) SetBackendSlot(slot int)      { k.BackendSlot = uint16(slot) }
+func (k *Service4Key) GetBackendSlot() int          { return int(k.BackendSlot) }
+func (k *Service4Key) SetScope(scope uint8)         { k.Scope = scope }
+func (k *Service4Key) GetScope() uint8              { return k.Scope }
+func (k *Service4Key) GetAddress() net.IP           { return k.Address.IP() }
+func (k *Service4Key) GetPort() uint16              { return k.Port }
+func (k *Service4Key) GetProtocol() u8proto.U8proto { return u8proto.U8proto(k.Proto) }
+func (k *Service4Key) MapDelete() error             { return k.Map().Delete(k.ToNetwork()) }
 
 func (k *Service4Key) RevNatValue() RevNatValue {
 	return &RevNat4Value{
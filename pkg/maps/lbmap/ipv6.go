@@ -160,18 +160,19 @@ func (k *Service6Key) String() string {
 	}
 }
 
-func (k *Service6Key) GetKeyPtr() unsafe.Pointer { return unsafe.Pointer(k) }
-func (k *Service6Key) NewValue() bpf.MapValue    { return &Service6Value{} }
-func (k *Service6Key) IsIPv6() bool              { return true }
-func (k *Service6Key) IsSurrogate() bool         { return k.GetAddress().IsUnspecified() }
-func (k *Service6Key) Map() *bpf.Map             { return Service6MapV2 }
-func (k *Service6Key) SetBackendSlot(slot int)   { k.BackendSlot = uint16(slot) }
-func (k *Service6Key) GetBackendSlot() int       { return int(k.BackendSlot) }
-func (k *Service6Key) SetScope(scope uint8)      { k.Scope = scope }
-func (k *Service6Key) GetScope() uint8           { return k.Scope }
-func (k *Service6Key) GetAddress() net.IP        { return k.Address.IP() }
-func (k *Service6Key) GetPort() uint16           { return k.Port }
-func (k *Service6Key) MapDelete() error          { return k.Map().Delete(k.ToNetwork()) }
+func (k *Service6Key) GetKeyPtr() unsafe.Pointer    { return unsafe.Pointer(k) }
+func (k *Service6Key) NewValue() bpf.MapValue       { return &Service6Value{} }
+func (k *Service6Key) IsIPv6() bool                 { return true }
+func (k *Service6Key) IsSurrogate() bool            { return k.GetAddress().IsUnspecified() }
+func (k *Service6Key) Map() *bpf.Map                { return Service6MapV2 }
+func (k *Service6Key) SetBackendSlot(slot int)      { k.BackendSlot = uint16(slot) }
+func (k *Service6Key) GetBackendSlot() int          { return int(k.BackendSlot) }
+func (k *Service6Key) SetScope(scope uint8)         { k.Scope = scope }
+func (k *Service6Key) GetScope() uint8              { return k.Scope }
+func (k *Service6Key) GetAddress() net.IP           { return k.Address.IP() }
+func (k *Service6Key) GetPort() uint16              { return k.Port }
+func (k *Service6Key) GetProtocol() u8proto.U8proto { return u8proto.U8proto(k.Proto) }
+func (k *Service6Key) MapDelete() error             { return k.Map().Delete(k.ToNetwork()) }
 
 func (k *Service6Key) RevNatValue() RevNatValue {
 	return &RevNat6Value{
@@ -15,14 +15,19 @@
 package lbmap
 
 import (
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
+	"io"
 	"net"
 	"sort"
 	"strconv"
 
 	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/byteorder"
 	"github.com/cilium/cilium/pkg/cidr"
 	"github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/maglev"
@@ -42,11 +47,20 @@ var (
 
 // LBBPFMap is an implementation of the LBMap interface.
 type LBBPFMap struct {
-	// Buffer used to avoid excessive allocations to temporarily store backend
-	// IDs. Concurrent access is protected by the
+	// maglevMu protects the fields below. UpsertMaglevLookupTable() may be
+	// called concurrently (e.g. by the restoration worker pool), in opposite
+	// to the remaining methods which are serialized by the
 	// pkg/service.go:(Service).UpsertService() lock.
+	maglevMu lock.Mutex
+	// Buffer used to avoid excessive allocations to temporarily store backend
+	// IDs.
 	maglevBackendIDsBuffer []uint16
 	maglevTableSize        uint64
+	// maglevBackendsHash maps a service ID to a hash of the backend names and
+	// IDs its Maglev lookup table was last derived from, so that the
+	// expensive table recomputation can be skipped when the backends of a
+	// service have not changed.
+	maglevBackendsHash map[uint16]uint64
 }
 
 func New(maglev bool, maglevTableSize int) *LBBPFMap {
@@ -55,6 +69,7 @@ func New(maglev bool, maglevTableSize int) *LBBPFMap {
 	if maglev {
 		m.maglevBackendIDsBuffer = make([]uint16, maglevTableSize)
 		m.maglevTableSize = uint64(maglevTableSize)
+		m.maglevBackendsHash = map[uint16]uint64{}
 	}
 
 	return m
@@ -64,6 +79,7 @@ type UpsertServiceParams struct {
 	ID                        uint16
 	IP                        net.IP
 	Port                      uint16
+	Protocol                  loadbalancer.L4Type
 	Backends                  map[string]uint16
 	PrevBackendCount          int
 	IPv6                      bool
@@ -76,6 +92,19 @@ type UpsertServiceParams struct {
 	UseMaglev                 bool
 }
 
+// serviceKeyProto returns the protocol to set in a service BPF map key for
+// the given frontend protocol. TCP and UDP services share the wildcard
+// protocol 0 for backwards compatibility with the datapath, which sets the
+// key protocol to 0 for these before the lookup. SCTP services get their own
+// key space so that they can coexist with a TCP or UDP service on the same
+// port.
+func serviceKeyProto(proto loadbalancer.L4Type) u8proto.U8proto {
+	if proto == loadbalancer.SCTP {
+		return u8proto.SCTP
+	}
+	return u8proto.ANY
+}
+
 // UpsertService inserts or updates the given service in a BPF map.
 //
 // The corresponding backend entries (identified with the given backendIDs)
@@ -90,10 +119,11 @@ func (lbmap *LBBPFMap) UpsertService(p *UpsertServiceParams) error {
 		return fmt.Errorf("Invalid svc ID 0")
 	}
 
+	proto := serviceKeyProto(p.Protocol)
 	if p.IPv6 {
-		svcKey = NewService6Key(p.IP, p.Port, u8proto.ANY, p.Scope, 0)
+		svcKey = NewService6Key(p.IP, p.Port, proto, p.Scope, 0)
 	} else {
-		svcKey = NewService4Key(p.IP, p.Port, u8proto.ANY, p.Scope, 0)
+		svcKey = NewService4Key(p.IP, p.Port, proto, p.Scope, 0)
 	}
 
 	slot := 1
@@ -153,6 +183,10 @@ func (lbmap *LBBPFMap) UpsertService(p *UpsertServiceParams) error {
 
 // UpsertMaglevLookupTable calculates Maglev lookup table for given backends, and
 // inserts into the Maglev BPF map.
+//
+// The recomputation is skipped if the given backends are identical to the ones
+// the table of the service was previously derived from. The function is safe
+// for concurrent use.
 func (lbmap *LBBPFMap) UpsertMaglevLookupTable(svcID uint16, backends map[string]uint16, ipv6 bool) error {
 	backendNames := make([]string, 0, len(backends))
 	for name := range backends {
@@ -163,7 +197,19 @@ func (lbmap *LBBPFMap) UpsertMaglevLookupTable(svcID uint16, backends map[string
 	// backends by name, as the names are the same on all nodes (in opposite
 	// to backend IDs which are node-local).
 	sort.Strings(backendNames)
+
+	hash := hashMaglevBackends(backendNames, backends)
+	lbmap.maglevMu.Lock()
+	if prev, found := lbmap.maglevBackendsHash[svcID]; found && prev == hash {
+		lbmap.maglevMu.Unlock()
+		return nil
+	}
+	lbmap.maglevMu.Unlock()
+
 	table := maglev.GetLookupTable(backendNames, lbmap.maglevTableSize)
+
+	lbmap.maglevMu.Lock()
+	defer lbmap.maglevMu.Unlock()
 	for i, pos := range table {
 		lbmap.maglevBackendIDsBuffer[i] = backends[backendNames[pos]]
 	}
@@ -171,12 +217,27 @@ func (lbmap *LBBPFMap) UpsertMaglevLookupTable(svcID uint16, backends map[string
 	if err := updateMaglevTable(ipv6, svcID, lbmap.maglevBackendIDsBuffer); err != nil {
 		return err
 	}
+	lbmap.maglevBackendsHash[svcID] = hash
 
 	return nil
 }
 
+// hashMaglevBackends returns a hash of the given backend names and IDs. The
+// names must be sorted, so that the hash does not depend on the map iteration
+// order.
+func hashMaglevBackends(sortedBackendNames []string, backends map[string]uint16) uint64 {
+	h := fnv.New64a()
+	var id [2]byte
+	for _, name := range sortedBackendNames {
+		io.WriteString(h, name)
+		binary.LittleEndian.PutUint16(id[:], backends[name])
+		h.Write(id[:])
+	}
+	return h.Sum64()
+}
+
 // DeleteService removes given service from a BPF map.
-func (*LBBPFMap) DeleteService(svc loadbalancer.L3n4AddrID, backendCount int, useMaglev bool) error {
+func (lbmap *LBBPFMap) DeleteService(svc loadbalancer.L3n4AddrID, backendCount int, useMaglev bool) error {
 	var (
 		svcKey    ServiceKey
 		revNATKey RevNatKey
@@ -187,11 +248,12 @@ func (*LBBPFMap) DeleteService(svc loadbalancer.L3n4AddrID, backendCount int, us
 	}
 
 	ipv6 := svc.IsIPv6()
+	proto := serviceKeyProto(svc.Protocol)
 	if ipv6 {
-		svcKey = NewService6Key(svc.IP, svc.Port, u8proto.ANY, svc.Scope, 0)
+		svcKey = NewService6Key(svc.IP, svc.Port, proto, svc.Scope, 0)
 		revNATKey = NewRevNat6Key(uint16(svc.ID))
 	} else {
-		svcKey = NewService4Key(svc.IP, svc.Port, u8proto.ANY, svc.Scope, 0)
+		svcKey = NewService4Key(svc.IP, svc.Port, proto, svc.Scope, 0)
 		revNATKey = NewRevNat4Key(uint16(svc.ID))
 	}
 
@@ -206,6 +268,9 @@ func (*LBBPFMap) DeleteService(svc loadbalancer.L3n4AddrID, backendCount int, us
 		if err := deleteMaglevTable(ipv6, uint16(svc.ID)); err != nil {
 			return fmt.Errorf("Unable to delete maglev lookup table %d: %s", svc.ID, err)
 		}
+		lbmap.maglevMu.Lock()
+		delete(lbmap.maglevBackendsHash, uint16(svc.ID))
+		lbmap.maglevMu.Unlock()
 	}
 
 	if err := deleteRevNatLocked(revNATKey); err != nil {
@@ -302,6 +367,53 @@ func (*LBBPFMap) DumpAffinityMatches() (BackendIDByServiceIDSet, error) {
 	return matches, nil
 }
 
+// PurgeAffinity removes all session affinity entries which belong to the
+// given service, causing its clients to re-select a backend. The affinity
+// match entries and the affinity entries of other services are left intact.
+func (*LBBPFMap) PurgeAffinity(revNATID uint16) error {
+	if revNATID == 0 {
+		return fmt.Errorf("invalid svc ID 0")
+	}
+
+	for _, m := range []*bpf.Map{Affinity4Map, Affinity6Map} {
+		if m == nil {
+			// Affinity map for the protocol is not enabled
+			continue
+		}
+		if err := purgeAffinity(m, revNATID); err != nil {
+			return fmt.Errorf("Unable to purge affinity entries of svc %d from %s: %s",
+				revNATID, m.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+func purgeAffinity(m *bpf.Map, revNATID uint16) error {
+	// rev_nat_id is stored in network byte order in the affinity maps
+	// (see AffinityMatchKey.ToNetwork)
+	id := byteorder.HostToNetwork16(revNATID)
+
+	toDelete := []bpf.MapKey{}
+	parse := func(key bpf.MapKey, _ bpf.MapValue) {
+		switch k := key.(type) {
+		case *Affinity4Key:
+			if k.RevNATID == id {
+				toDelete = append(toDelete, k.DeepCopyMapKey())
+			}
+		case *Affinity6Key:
+			if k.RevNATID == id {
+				toDelete = append(toDelete, k.DeepCopyMapKey())
+			}
+		}
+	}
+	if err := m.DumpWithCallback(parse); err != nil {
+		return err
+	}
+
+	return m.DeleteBatch(toDelete)
+}
+
 func (*LBBPFMap) DumpSourceRanges(ipv6 bool) (SourceRangeSetByServiceID, error) {
 	ret := SourceRangeSetByServiceID{}
 	parser := func(key bpf.MapKey, value bpf.MapValue) {
@@ -114,3 +114,53 @@ func (s *MaglevSuite) TestInitMaps(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(deleted, Equals, false)
 }
+
+// BenchmarkUpsertMaglevLookupTableBackendFlap simulates a single backend flap
+// with 5k services: only the service owning the flapping backend needs its
+// lookup table to be recomputed, while all other services hit the backends
+// hash cache.
+func (s *MaglevSuite) BenchmarkUpsertMaglevLookupTableBackendFlap(c *C) {
+	const svcCount = 5000
+
+	option.Config.MaglevTableSize = 251
+	err := InitMaglevMaps(true, false, uint32(option.Config.MaglevTableSize))
+	c.Assert(err, IsNil)
+
+	lbm := New(true, option.Config.MaglevTableSize)
+	stable := map[string]uint16{
+		"backend-1": 1,
+		"backend-2": 2,
+		"backend-3": 3,
+	}
+	flapping := map[string]uint16{
+		"backend-1": 1,
+		"backend-2": 2,
+		"backend-3": 3,
+		"backend-4": 4,
+	}
+
+	err = lbm.UpsertMaglevLookupTable(1, flapping, false)
+	c.Assert(err, IsNil)
+	for id := 2; id <= svcCount; id++ {
+		err := lbm.UpsertMaglevLookupTable(uint16(id), stable, false)
+		c.Assert(err, IsNil)
+	}
+
+	c.ResetTimer()
+	for i := 0; i < c.N; i++ {
+		// Flap a backend of the first service and resync all services, as a
+		// backend change event does.
+		if i%2 == 0 {
+			delete(flapping, "backend-4")
+		} else {
+			flapping["backend-4"] = 4
+		}
+		err := lbm.UpsertMaglevLookupTable(1, flapping, false)
+		c.Assert(err, IsNil)
+		for id := 2; id <= svcCount; id++ {
+			err := lbm.UpsertMaglevLookupTable(uint16(id), stable, false)
+			c.Assert(err, IsNil)
+		}
+	}
+	c.StopTimer()
+}
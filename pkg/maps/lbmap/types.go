@@ -20,6 +20,7 @@ import (
 	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/cidr"
 	"github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/u8proto"
 )
 
 // ServiceKey is the interface describing protocol independent key for services map v2.
@@ -53,6 +54,9 @@ type ServiceKey interface {
 	// Get frontend port
 	GetPort() uint16
 
+	// Get frontend protocol
+	GetProtocol() u8proto.U8proto
+
 	// Returns a RevNatValue matching a ServiceKey
 	RevNatValue() RevNatValue
 
@@ -183,7 +187,13 @@ type BackendIDByServiceIDSet map[uint16]map[uint16]struct{} // svc ID => backend
 type SourceRangeSetByServiceID map[uint16][]*cidr.CIDR // svc ID => src range CIDRs
 
 func svcFrontend(svcKey ServiceKey, svcValue ServiceValue) *loadbalancer.L3n4AddrID {
-	feL3n4Addr := loadbalancer.NewL3n4Addr(loadbalancer.NONE, svcKey.GetAddress(), svcKey.GetPort(), svcKey.GetScope())
+	feProto := loadbalancer.NONE
+	// TCP and UDP share the wildcard protocol 0 in the service map key, only
+	// SCTP frontends carry their protocol.
+	if svcKey.GetProtocol() == u8proto.SCTP {
+		feProto = loadbalancer.SCTP
+	}
+	feL3n4Addr := loadbalancer.NewL3n4Addr(feProto, svcKey.GetAddress(), svcKey.GetPort(), svcKey.GetScope())
 	feL3n4AddrID := &loadbalancer.L3n4AddrID{
 		L3n4Addr: *feL3n4Addr,
 		ID:       loadbalancer.ID(svcValue.GetRevNat()),
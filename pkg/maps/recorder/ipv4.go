@@ -34,6 +34,8 @@ type CaptureWcard4 struct {
 	SrcMask  uint8      `align:"smask"`
 	DestMask uint8      `align:"dmask"`
 	Flags    uint8      `align:"flags"`
+	SrcSecID uint32     `align:"sec_id_src"`
+	DstSecID uint32     `align:"sec_id_dst"`
 }
 
 type CaptureRule4 CaptureRule
@@ -52,6 +54,8 @@ func (k *CaptureWcard4) DeepCopyMapKey() bpf.MapKey {
 		DestMask: k.DestMask,
 		SrcMask:  k.SrcMask,
 		Flags:    k.Flags,
+		SrcSecID: k.SrcSecID,
+		DstSecID: k.DstSecID,
 	}
 }
 
@@ -64,6 +68,11 @@ func (k *CaptureWcard4) Dump(sb *strings.Builder) {
 		int(k.DestMask),
 		k.DestPort,
 		u8proto.U8proto(k.NextHdr)))
+	if k.SrcSecID != 0 || k.DstSecID != 0 {
+		sb.WriteString(fmt.Sprintf("SecID:%d -> %d ",
+			int(k.SrcSecID),
+			int(k.DstSecID)))
+	}
 }
 
 func (k *CaptureWcard4) String() string {
@@ -0,0 +1,109 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strings"
+
+	"github.com/cilium/cilium/pkg/lock"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// OverflowLabelValue replaces each label value of a label set that is
+// aggregated into the overflow bucket of a CardinalityGuard.
+const OverflowLabelValue = "overflow"
+
+// CardinalityGuard bounds the number of distinct label sets a metric may
+// emit. Metric owners declare a cap when creating the guard and pass every
+// label set through Guard before handing it to the metric. The first cap
+// distinct label sets pass through unchanged and keep doing so; once the cap
+// is reached, label sets not seen before are aggregated into a single
+// overflow label set so that the metric's cardinality stays bounded while
+// counts remain accurate in aggregate.
+type CardinalityGuard struct {
+	mutex lock.Mutex
+
+	// metric is the name of the guarded metric, used in the log message
+	// emitted when the cap is first exceeded.
+	metric string
+
+	// cap is the maximum number of distinct label sets passed through.
+	// A cap of 0 disables the guard.
+	cap int
+
+	// seen is the set of label sets admitted so far, keyed by their joined
+	// label values.
+	seen map[string]struct{}
+
+	// overflowed records whether the cap has been exceeded, so that the
+	// warning is only logged once.
+	overflowed bool
+}
+
+// NewCardinalityGuard returns a guard for the named metric admitting at most
+// cap distinct label sets. A cap of 0 disables the guard and passes all
+// label sets through unchanged.
+func NewCardinalityGuard(metric string, cap int) *CardinalityGuard {
+	return &CardinalityGuard{
+		metric: metric,
+		cap:    cap,
+		seen:   make(map[string]struct{}),
+	}
+}
+
+// Guard returns the label values to use for the metric. Label sets admitted
+// before the cap was reached are returned unchanged, also on subsequent
+// calls; any label set first seen after the cap is reached is replaced by
+// the overflow label set, with every label value set to OverflowLabelValue.
+func (g *CardinalityGuard) Guard(labelValues ...string) []string {
+	if g.cap <= 0 {
+		return labelValues
+	}
+
+	key := strings.Join(labelValues, "\x00")
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if _, ok := g.seen[key]; ok {
+		return labelValues
+	}
+
+	if len(g.seen) < g.cap {
+		g.seen[key] = struct{}{}
+		return labelValues
+	}
+
+	if !g.overflowed {
+		g.overflowed = true
+		log.WithField("metric", g.metric).
+			Warningf("Metric exceeded the configured cardinality cap of %d distinct label sets, aggregating new label sets into the %q bucket", g.cap, OverflowLabelValue)
+	}
+
+	overflow := make([]string, len(labelValues))
+	for i := range overflow {
+		overflow[i] = OverflowLabelValue
+	}
+	return overflow
+}
+
+// DistinctLabelSets returns the number of distinct label sets admitted so
+// far, not counting the overflow bucket.
+func (g *CardinalityGuard) DistinctLabelSets() int {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return len(g.seen)
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package metrics
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MetricsSuite) TestCardinalityGuardDisabled(c *C) {
+	g := NewCardinalityGuard("test_metric", 0)
+
+	for i := 0; i < 10; i++ {
+		labels := []string{"ns", string(rune('a' + i))}
+		c.Assert(g.Guard(labels...), DeepEquals, labels)
+	}
+	c.Assert(g.DistinctLabelSets(), Equals, 0)
+}
+
+func (s *MetricsSuite) TestCardinalityGuardOverflow(c *C) {
+	g := NewCardinalityGuard("test_metric", 2)
+
+	// The first two distinct label sets are admitted.
+	c.Assert(g.Guard("ns1", "pod1"), DeepEquals, []string{"ns1", "pod1"})
+	c.Assert(g.Guard("ns2", "pod2"), DeepEquals, []string{"ns2", "pod2"})
+	c.Assert(g.DistinctLabelSets(), Equals, 2)
+
+	// New label sets overflow once the cap is reached.
+	overflow := []string{OverflowLabelValue, OverflowLabelValue}
+	c.Assert(g.Guard("ns3", "pod3"), DeepEquals, overflow)
+	c.Assert(g.Guard("ns4", "pod4"), DeepEquals, overflow)
+	c.Assert(g.DistinctLabelSets(), Equals, 2)
+
+	// Admitted label sets keep passing through unchanged.
+	c.Assert(g.Guard("ns1", "pod1"), DeepEquals, []string{"ns1", "pod1"})
+	c.Assert(g.Guard("ns2", "pod2"), DeepEquals, []string{"ns2", "pod2"})
+
+	// A previously overflowed label set stays in the overflow bucket.
+	c.Assert(g.Guard("ns3", "pod3"), DeepEquals, overflow)
+}
+
+func (s *MetricsSuite) TestCardinalityGuardKeying(c *C) {
+	g := NewCardinalityGuard("test_metric", 2)
+
+	// Label sets are distinguished by value position, not concatenation.
+	c.Assert(g.Guard("ab", "c"), DeepEquals, []string{"ab", "c"})
+	c.Assert(g.Guard("a", "bc"), DeepEquals, []string{"a", "bc"})
+	c.Assert(g.DistinctLabelSets(), Equals, 2)
+}
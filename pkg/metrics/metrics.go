@@ -180,6 +180,9 @@ const (
 
 	// LabelDirection is the label for traffic direction
 	LabelDirection = "direction"
+
+	// LabelEndpoint is the label for the endpoint ID
+	LabelEndpoint = "endpoint"
 )
 
 var (
@@ -199,6 +202,15 @@ var (
 	// has been regenerated and success/fail outcome
 	EndpointRegenerationTotal = NoOpCounterVec
 
+	// EndpointRegenerationQueueDepth is the number of endpoint regenerations
+	// currently waiting in the bulk regeneration queue.
+	EndpointRegenerationQueueDepth = NoOpGauge
+
+	// EndpointRegenerationQueuedTotal is a count of all endpoint
+	// regenerations queued by the regeneration scheduler, tagged by the
+	// reason which triggered them.
+	EndpointRegenerationQueuedTotal = NoOpCounterVec
+
 	// EndpointStateCount is the total count of the endpoints in various states.
 	EndpointStateCount = NoOpGaugeVec
 
@@ -233,11 +245,35 @@ var (
 	// time taken to fully deploy an endpoint.
 	PolicyImplementationDelay = NoOpObserverVec
 
+	// PolicySelectorNotificationBatchSize is a distribution of the number of
+	// identity additions and deletions delivered to a selector cache user in
+	// a single batched notification.
+	PolicySelectorNotificationBatchSize prometheus.Observer = NoOpObserver
+
 	// Identity
 
 	// Identity is the number of identities currently in use on the node
 	Identity = NoOpGauge
 
+	// IdentityMigrationRemaining is the number of local endpoints whose
+	// identity still needs to be recomputed after a labels filter change
+	IdentityMigrationRemaining = NoOpGauge
+
+	// IdentityCheckpointRestoreErrors is the number of local identity
+	// checkpoint entries that could not be restored after an agent restart
+	IdentityCheckpointRestoreErrors = NoOpCounter
+
+	// IdentityUnreferenced is the number of allocated local identities for
+	// which the identity audit found no remaining user
+	IdentityUnreferenced = NoOpGauge
+
+	// IPCache
+
+	// IPCacheLPMRepairs is the number of prefix lengths which were found
+	// missing from the datapath LPM configuration and re-registered by the
+	// ipcache consistency checker
+	IPCacheLPMRepairs = NoOpCounter
+
 	// Events
 
 	// EventTS*is the time in seconds since epoch that we last received an
@@ -320,9 +356,26 @@ var (
 	// ConntrackGCDuration the duration of the conntrack GC process in milliseconds.
 	ConntrackGCDuration = NoOpObserverVec
 
+	// ConntrackGCInterval the interval between conntrack GC runs in seconds,
+	// as chosen by the garbage collector pacing
+	ConntrackGCInterval = NoOpGauge
+
+	// ConntrackGCEvictions the total number of conntrack entries evicted by
+	// the garbage collector
+	ConntrackGCEvictions = NoOpCounterVec
+
 	// ConntrackDumpReset marks the count for conntrack dump resets
 	ConntrackDumpResets = NoOpCounterVec
 
+	// DatapathCompilationCache is the number of endpoint datapath compilation
+	// requests, labeled by whether they were served from the compile cache.
+	DatapathCompilationCache = NoOpCounterVec
+
+	// DatapathReconciliationRepairs is the number of BPF map entries found
+	// diverging from the agent's desired state and repaired by the periodic
+	// map reconciliation, labeled by map name
+	DatapathReconciliationRepairs = NoOpCounterVec
+
 	// Signals
 
 	// SignalsHandled is the number of signals received.
@@ -333,6 +386,10 @@ var (
 	// ServicesCount number of services
 	ServicesCount = NoOpCounterVec
 
+	// ServicesTopologyFallback is the number of times the topology aware
+	// backend selection of a service fell back to the full backend set
+	ServicesTopologyFallback = NoOpCounter
+
 	// Errors and warnings
 
 	// ErrorsWarnings is the number of errors and warnings in cilium-agent instances
@@ -371,12 +428,24 @@ var (
 	// complete a CNP status update
 	KubernetesCNPStatusCompletion = NoOpObserverVec
 
+	// KubernetesCEPWritesAvoided is the number of CiliumEndpoint status
+	// updates skipped because the semantic content did not change
+	KubernetesCEPWritesAvoided = NoOpCounter
+
 	// IPAM events
 
 	// IpamEvent is the number of IPAM events received labeled by action and
 	// datapath family type
 	IpamEvent = NoOpCounterVec
 
+	// IpamOrphanedIPs is the number of allocated IPs for which the IPAM
+	// garbage collection found no owning endpoint
+	IpamOrphanedIPs = NoOpGauge
+
+	// IpamReclaimedIPs is the number of orphaned IPs released by the IPAM
+	// garbage collection
+	IpamReclaimedIPs = NoOpCounter
+
 	// KVstore events
 
 	// KVStoreOperationsDuration records the duration of kvstore operations
@@ -389,10 +458,45 @@ var (
 	// KVStoreQuorumErrors records the number of kvstore quorum errors
 	KVStoreQuorumErrors = NoOpCounterVec
 
+	// KVStoreEtcdLeases records the number of etcd leases currently used to
+	// attach the keys owned by this agent
+	KVStoreEtcdLeases = NoOpGauge
+
+	// KVStoreEtcdLeaseExpirations records the number of times an etcd lease
+	// used to attach kvstore keys expired because its keepalives failed
+	KVStoreEtcdLeaseExpirations = NoOpCounter
+
+	// EgressGatewayFailovers records the number of times egress gateway map
+	// entries were reprogrammed to a different gateway following a gateway
+	// health state change
+	EgressGatewayFailovers = NoOpCounter
+
+	// WireguardPeersPendingKeyRotation records the number of Wireguard
+	// peers which have not re-established a handshake since the last local
+	// key rotation, i.e. may still encrypt to the old key
+	WireguardPeersPendingKeyRotation = NoOpGauge
+
 	// FQDNGarbageCollectorCleanedTotal is the number of domains cleaned by the
 	// GC job.
 	FQDNGarbageCollectorCleanedTotal = NoOpCounter
 
+	// FQDNRateLimitRejectionsTotal is the number of DNS queries rejected by
+	// the DNS proxy rate limiter, labeled by endpoint ID.
+	FQDNRateLimitRejectionsTotal = NoOpCounterVec
+
+	// FQDNProxyRestartsTotal is the number of times the DNS proxy
+	// supervisor replaced a wedged DNS proxy instance.
+	FQDNProxyRestartsTotal = NoOpCounter
+
+	// FQDNProxyTakeoverDuration is a distribution of the time taken by the
+	// DNS proxy supervisor to swap a wedged instance for a replacement.
+	FQDNProxyTakeoverDuration prometheus.Observer = NoOpObserver
+
+	// FQDNProxyResponseDelay is a distribution of the time a DNS response
+	// was delayed in the DNS proxy while waiting for the corresponding
+	// datapath updates to complete.
+	FQDNProxyResponseDelay prometheus.Observer = NoOpObserver
+
 	// BPFSyscallDuration is the metric for bpf syscalls duration.
 	BPFSyscallDuration = NoOpObserverVec
 
@@ -449,75 +553,100 @@ var (
 )
 
 type Configuration struct {
-	APIInteractionsEnabled                  bool
-	EndpointRegenerationCountEnabled        bool
-	EndpointStateCountEnabled               bool
-	EndpointRegenerationTimeStatsEnabled    bool
-	PolicyCountEnabled                      bool
-	PolicyRegenerationCountEnabled          bool
-	PolicyRegenerationTimeStatsEnabled      bool
-	PolicyRevisionEnabled                   bool
-	PolicyImportErrorsEnabled               bool
-	PolicyEndpointStatusEnabled             bool
-	PolicyImplementationDelayEnabled        bool
-	IdentityCountEnabled                    bool
-	EventTSK8sEnabled                       bool
-	EventLagK8sEnabled                      bool
-	EventTSContainerdEnabled                bool
-	EventTSAPIEnabled                       bool
-	ProxyRedirectsEnabled                   bool
-	ProxyPolicyL7Enabled                    bool
-	ProxyParseErrorsEnabled                 bool
-	ProxyForwardedEnabled                   bool
-	ProxyDeniedEnabled                      bool
-	ProxyReceivedEnabled                    bool
-	NoOpObserverVecEnabled                  bool
-	DropCountEnabled                        bool
-	DropBytesEnabled                        bool
-	NoOpCounterVecEnabled                   bool
-	ForwardBytesEnabled                     bool
-	ConntrackGCRunsEnabled                  bool
-	ConntrackGCKeyFallbacksEnabled          bool
-	ConntrackGCSizeEnabled                  bool
-	ConntrackGCDurationEnabled              bool
-	ConntrackDumpResetsEnabled              bool
-	SignalsHandledEnabled                   bool
-	ServicesCountEnabled                    bool
-	ErrorsWarningsEnabled                   bool
-	ControllerRunsEnabled                   bool
-	ControllerRunsDurationEnabled           bool
-	SubprocessStartEnabled                  bool
-	KubernetesEventProcessedEnabled         bool
-	KubernetesEventReceivedEnabled          bool
-	KubernetesAPIInteractionsEnabled        bool
-	KubernetesAPICallsEnabled               bool
-	KubernetesCNPStatusCompletionEnabled    bool
-	IpamEventEnabled                        bool
-	KVStoreOperationsDurationEnabled        bool
-	KVStoreEventsQueueDurationEnabled       bool
-	KVStoreQuorumErrorsEnabled              bool
-	FQDNGarbageCollectorCleanedTotalEnabled bool
-	BPFSyscallDurationEnabled               bool
-	BPFMapOps                               bool
-	BPFMapPressure                          bool
-	TriggerPolicyUpdateTotal                bool
-	TriggerPolicyUpdateFolds                bool
-	TriggerPolicyUpdateCallDuration         bool
-	VersionMetric                           bool
-	APILimiterWaitHistoryDuration           bool
-	APILimiterWaitDuration                  bool
-	APILimiterProcessingDuration            bool
-	APILimiterRequestsInFlight              bool
-	APILimiterRateLimit                     bool
-	APILimiterAdjustmentFactor              bool
-	APILimiterProcessedRequests             bool
-	ArpingRequestsTotalEnabled              bool
+	APIInteractionsEnabled                     bool
+	EndpointRegenerationCountEnabled           bool
+	EndpointRegenerationQueueDepthEnabled      bool
+	EndpointRegenerationQueuedTotalEnabled     bool
+	EndpointStateCountEnabled                  bool
+	EndpointRegenerationTimeStatsEnabled       bool
+	PolicyCountEnabled                         bool
+	PolicyRegenerationCountEnabled             bool
+	PolicyRegenerationTimeStatsEnabled         bool
+	PolicyRevisionEnabled                      bool
+	PolicyImportErrorsEnabled                  bool
+	PolicyEndpointStatusEnabled                bool
+	PolicyImplementationDelayEnabled           bool
+	PolicySelectorNotificationBatchSizeEnabled bool
+	IdentityCountEnabled                       bool
+	IdentityMigrationRemainingEnabled          bool
+	IdentityCheckpointRestoreErrorsEnabled     bool
+	IdentityUnreferencedEnabled                bool
+	IPCacheLPMRepairsEnabled                   bool
+	EventTSK8sEnabled                          bool
+	EventLagK8sEnabled                         bool
+	EventTSContainerdEnabled                   bool
+	EventTSAPIEnabled                          bool
+	ProxyRedirectsEnabled                      bool
+	ProxyPolicyL7Enabled                       bool
+	ProxyParseErrorsEnabled                    bool
+	ProxyForwardedEnabled                      bool
+	ProxyDeniedEnabled                         bool
+	ProxyReceivedEnabled                       bool
+	NoOpObserverVecEnabled                     bool
+	DropCountEnabled                           bool
+	DropBytesEnabled                           bool
+	NoOpCounterVecEnabled                      bool
+	ForwardBytesEnabled                        bool
+	ConntrackGCRunsEnabled                     bool
+	ConntrackGCKeyFallbacksEnabled             bool
+	ConntrackGCSizeEnabled                     bool
+	ConntrackGCDurationEnabled                 bool
+	ConntrackGCIntervalEnabled                 bool
+	ConntrackGCEvictionsEnabled                bool
+	ConntrackDumpResetsEnabled                 bool
+	DatapathCompilationCacheEnabled            bool
+	DatapathReconciliationRepairsEnabled       bool
+	SignalsHandledEnabled                      bool
+	ServicesCountEnabled                       bool
+	ServicesTopologyFallbackEnabled            bool
+	ErrorsWarningsEnabled                      bool
+	ControllerRunsEnabled                      bool
+	ControllerRunsDurationEnabled              bool
+	SubprocessStartEnabled                     bool
+	KubernetesEventProcessedEnabled            bool
+	KubernetesEventReceivedEnabled             bool
+	KubernetesAPIInteractionsEnabled           bool
+	KubernetesAPICallsEnabled                  bool
+	KubernetesCNPStatusCompletionEnabled       bool
+	KubernetesCEPWritesAvoidedEnabled          bool
+	IpamEventEnabled                           bool
+	IpamOrphanedIPsEnabled                     bool
+	IpamReclaimedIPsEnabled                    bool
+	KVStoreOperationsDurationEnabled           bool
+	KVStoreEventsQueueDurationEnabled          bool
+	KVStoreQuorumErrorsEnabled                 bool
+	KVStoreEtcdLeasesEnabled                   bool
+	KVStoreEtcdLeaseExpirationsEnabled         bool
+	EgressGatewayFailoversEnabled              bool
+	WireguardPeersPendingKeyRotationEnabled    bool
+	FQDNGarbageCollectorCleanedTotalEnabled    bool
+	FQDNRateLimitRejectionsTotalEnabled        bool
+	FQDNProxyRestartsTotalEnabled              bool
+	FQDNProxyTakeoverDurationEnabled           bool
+	FQDNProxyResponseDelayEnabled              bool
+	BPFSyscallDurationEnabled                  bool
+	BPFMapOps                                  bool
+	BPFMapPressure                             bool
+	TriggerPolicyUpdateTotal                   bool
+	TriggerPolicyUpdateFolds                   bool
+	TriggerPolicyUpdateCallDuration            bool
+	VersionMetric                              bool
+	APILimiterWaitHistoryDuration              bool
+	APILimiterWaitDuration                     bool
+	APILimiterProcessingDuration               bool
+	APILimiterRequestsInFlight                 bool
+	APILimiterRateLimit                        bool
+	APILimiterAdjustmentFactor                 bool
+	APILimiterProcessedRequests                bool
+	ArpingRequestsTotalEnabled                 bool
 }
 
 func DefaultMetrics() map[string]struct{} {
 	return map[string]struct{}{
 		Namespace + "_" + SubsystemAgent + "_api_process_time_seconds":               {},
 		Namespace + "_endpoint_regenerations_total":                                  {},
+		Namespace + "_endpoint_regeneration_queue_depth":                             {},
+		Namespace + "_endpoint_regenerations_queued_total":                           {},
 		Namespace + "_endpoint_state":                                                {},
 		Namespace + "_endpoint_regeneration_time_stats_seconds":                      {},
 		Namespace + "_policy":                                                        {},
@@ -527,7 +656,12 @@ func DefaultMetrics() map[string]struct{} {
 		Namespace + "_policy_import_errors_total":                                    {},
 		Namespace + "_policy_endpoint_enforcement_status":                            {},
 		Namespace + "_policy_implementation_delay":                                   {},
+		Namespace + "_policy_selector_notification_batch_size":                       {},
 		Namespace + "_identity":                                                      {},
+		Namespace + "_identity_migration_remaining":                                  {},
+		Namespace + "_identity_checkpoint_restore_errors_total":                      {},
+		Namespace + "_identity_unreferenced":                                         {},
+		Namespace + "_ipcache_lpm_repairs_total":                                     {},
 		Namespace + "_event_ts":                                                      {},
 		Namespace + "_proxy_redirects":                                               {},
 		Namespace + "_policy_l7_total":                                               {},
@@ -545,8 +679,13 @@ func DefaultMetrics() map[string]struct{} {
 		Namespace + "_" + SubsystemDatapath + "_conntrack_gc_key_fallbacks_total":    {},
 		Namespace + "_" + SubsystemDatapath + "_conntrack_gc_entries":                {},
 		Namespace + "_" + SubsystemDatapath + "_conntrack_gc_duration_seconds":       {},
+		Namespace + "_" + SubsystemDatapath + "_conntrack_gc_interval_seconds":       {},
+		Namespace + "_" + SubsystemDatapath + "_conntrack_gc_evictions_total":        {},
+		Namespace + "_" + SubsystemDatapath + "_compilation_cache_total":             {},
+		Namespace + "_" + SubsystemDatapath + "_reconciliation_repairs_total":        {},
 		Namespace + "_" + SubsystemDatapath + "_signals_handled_total":               {},
 		Namespace + "_services_events_total":                                         {},
+		Namespace + "_services_topology_fallbacks_total":                             {},
 		Namespace + "_errors_warnings_total":                                         {},
 		Namespace + "_controllers_runs_total":                                        {},
 		Namespace + "_controllers_runs_duration_seconds":                             {},
@@ -556,11 +695,22 @@ func DefaultMetrics() map[string]struct{} {
 		Namespace + "_" + SubsystemK8sClient + "_api_latency_time_seconds":           {},
 		Namespace + "_" + SubsystemK8sClient + "_api_calls_total":                    {},
 		Namespace + "_" + SubsystemK8s + "_cnp_status_completion_seconds":            {},
+		Namespace + "_" + SubsystemK8s + "_cep_writes_avoided_total":                 {},
 		Namespace + "_ipam_events_total":                                             {},
+		Namespace + "_ipam_orphaned_ips":                                             {},
+		Namespace + "_ipam_reclaimed_ips_total":                                      {},
 		Namespace + "_" + SubsystemKVStore + "_operations_duration_seconds":          {},
 		Namespace + "_" + SubsystemKVStore + "_events_queue_seconds":                 {},
 		Namespace + "_" + SubsystemKVStore + "_quorum_errors_total":                  {},
+		Namespace + "_" + SubsystemKVStore + "_etcd_leases":                          {},
+		Namespace + "_" + SubsystemKVStore + "_etcd_lease_expirations_total":         {},
+		Namespace + "_egress_gateway_failovers_total":                                {},
+		Namespace + "_wireguard_peers_pending_key_rotation":                          {},
 		Namespace + "_fqdn_gc_deletions_total":                                       {},
+		Namespace + "_fqdn_ratelimit_rejections_total":                               {},
+		Namespace + "_fqdn_proxy_restarts_total":                                     {},
+		Namespace + "_fqdn_proxy_takeover_duration_seconds":                          {},
+		Namespace + "_fqdn_proxy_response_delay_seconds":                             {},
 		Namespace + "_" + SubsystemBPF + "_map_ops_total":                            {},
 		Namespace + "_" + SubsystemTriggers + "_policy_update_total":                 {},
 		Namespace + "_" + SubsystemTriggers + "_policy_update_folds":                 {},
@@ -607,6 +757,26 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, EndpointRegenerationTotal)
 			c.EndpointRegenerationCountEnabled = true
 
+		case Namespace + "_endpoint_regeneration_queue_depth":
+			EndpointRegenerationQueueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Name:      "endpoint_regeneration_queue_depth",
+				Help:      "Number of endpoint regenerations waiting in the bulk regeneration queue",
+			})
+
+			collectors = append(collectors, EndpointRegenerationQueueDepth)
+			c.EndpointRegenerationQueueDepthEnabled = true
+
+		case Namespace + "_endpoint_regenerations_queued_total":
+			EndpointRegenerationQueuedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "endpoint_regenerations_queued_total",
+				Help:      "Count of all endpoint regenerations queued by the regeneration scheduler, tagged by trigger reason",
+			}, []string{"reason"})
+
+			collectors = append(collectors, EndpointRegenerationQueuedTotal)
+			c.EndpointRegenerationQueuedTotalEnabled = true
+
 		case Namespace + "_endpoint_state":
 			EndpointStateCount = prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
@@ -700,6 +870,18 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, PolicyImplementationDelay)
 			c.PolicyImplementationDelayEnabled = true
 
+		case Namespace + "_policy_selector_notification_batch_size":
+			policySelectorNotificationBatchSize := prometheus.NewHistogram(prometheus.HistogramOpts{
+				Namespace: Namespace,
+				Name:      "policy_selector_notification_batch_size",
+				Help:      "Number of identity changes delivered in a single selector cache user notification",
+				Buckets:   prometheus.ExponentialBuckets(1, 4, 8),
+			})
+			PolicySelectorNotificationBatchSize = policySelectorNotificationBatchSize
+
+			collectors = append(collectors, policySelectorNotificationBatchSize)
+			c.PolicySelectorNotificationBatchSizeEnabled = true
+
 		case Namespace + "_identity":
 			Identity = prometheus.NewGauge(prometheus.GaugeOpts{
 				Namespace: Namespace,
@@ -710,6 +892,46 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, Identity)
 			c.IdentityCountEnabled = true
 
+		case Namespace + "_identity_migration_remaining":
+			IdentityMigrationRemaining = prometheus.NewGauge(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Name:      "identity_migration_remaining",
+				Help:      "Number of local endpoints whose identity still needs to be recomputed after a labels filter change",
+			})
+
+			collectors = append(collectors, IdentityMigrationRemaining)
+			c.IdentityMigrationRemainingEnabled = true
+
+		case Namespace + "_identity_checkpoint_restore_errors_total":
+			IdentityCheckpointRestoreErrors = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "identity_checkpoint_restore_errors_total",
+				Help:      "Number of local identity checkpoint entries that could not be restored",
+			})
+
+			collectors = append(collectors, IdentityCheckpointRestoreErrors)
+			c.IdentityCheckpointRestoreErrorsEnabled = true
+
+		case Namespace + "_identity_unreferenced":
+			IdentityUnreferenced = prometheus.NewGauge(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Name:      "identity_unreferenced",
+				Help:      "Number of allocated local identities with no known user found by the identity audit",
+			})
+
+			collectors = append(collectors, IdentityUnreferenced)
+			c.IdentityUnreferencedEnabled = true
+
+		case Namespace + "_ipcache_lpm_repairs_total":
+			IPCacheLPMRepairs = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "ipcache_lpm_repairs_total",
+				Help:      "Number of prefix lengths re-registered with the datapath LPM configuration by the ipcache consistency checker",
+			})
+
+			collectors = append(collectors, IPCacheLPMRepairs)
+			c.IPCacheLPMRepairsEnabled = true
+
 		case Namespace + "_event_ts":
 			EventTSK8s = prometheus.NewGauge(prometheus.GaugeOpts{
 				Namespace:   Namespace,
@@ -922,6 +1144,30 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, ConntrackGCDuration)
 			c.ConntrackGCDurationEnabled = true
 
+		case Namespace + "_" + SubsystemDatapath + "_conntrack_gc_interval_seconds":
+			ConntrackGCInterval = prometheus.NewGauge(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemDatapath,
+				Name:      "conntrack_gc_interval_seconds",
+				Help: "The interval in seconds between garbage collector runs " +
+					"as chosen by the garbage collector pacing",
+			})
+
+			collectors = append(collectors, ConntrackGCInterval)
+			c.ConntrackGCIntervalEnabled = true
+
+		case Namespace + "_" + SubsystemDatapath + "_conntrack_gc_evictions_total":
+			ConntrackGCEvictions = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemDatapath,
+				Name:      "conntrack_gc_evictions_total",
+				Help: "The total number of conntrack entries evicted by the " +
+					"garbage collector labeled by datapath family",
+			}, []string{LabelDatapathFamily, LabelProtocol})
+
+			collectors = append(collectors, ConntrackGCEvictions)
+			c.ConntrackGCEvictionsEnabled = true
+
 		case Namespace + "_" + SubsystemDatapath + "_conntrack_dump_resets_total":
 			ConntrackDumpResets = prometheus.NewCounterVec(prometheus.CounterOpts{
 				Namespace: Namespace,
@@ -933,6 +1179,30 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, ConntrackDumpResets)
 			c.ConntrackDumpResetsEnabled = true
 
+		case Namespace + "_" + SubsystemDatapath + "_compilation_cache_total":
+			DatapathCompilationCache = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemDatapath,
+				Name:      "compilation_cache_total",
+				Help: "Number of endpoint datapath compilation requests labeled " +
+					"by whether they were served from the compile cache",
+			}, []string{"result"})
+
+			collectors = append(collectors, DatapathCompilationCache)
+			c.DatapathCompilationCacheEnabled = true
+
+		case Namespace + "_" + SubsystemDatapath + "_reconciliation_repairs_total":
+			DatapathReconciliationRepairs = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemDatapath,
+				Name:      "reconciliation_repairs_total",
+				Help: "Number of BPF map entries diverging from the desired state " +
+					"repaired by the periodic map reconciliation, labeled by map name",
+			}, []string{LabelMapName})
+
+			collectors = append(collectors, DatapathReconciliationRepairs)
+			c.DatapathReconciliationRepairsEnabled = true
+
 		case Namespace + "_" + SubsystemDatapath + "_signals_handled_total":
 			SignalsHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
 				Namespace: Namespace,
@@ -955,6 +1225,16 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, ServicesCount)
 			c.ServicesCountEnabled = true
 
+		case Namespace + "_services_topology_fallbacks_total":
+			ServicesTopologyFallback = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "services_topology_fallbacks_total",
+				Help:      "Number of times the topology aware backend selection of a service fell back to the full backend set",
+			})
+
+			collectors = append(collectors, ServicesTopologyFallback)
+			c.ServicesTopologyFallbackEnabled = true
+
 		case Namespace + "_errors_warnings_total":
 			ErrorsWarnings = prometheus.NewCounterVec(prometheus.CounterOpts{
 				Namespace: Namespace,
@@ -1048,6 +1328,17 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, KubernetesCNPStatusCompletion)
 			c.KubernetesCNPStatusCompletionEnabled = true
 
+		case Namespace + "_" + SubsystemK8s + "_cep_writes_avoided_total":
+			KubernetesCEPWritesAvoided = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemK8s,
+				Name:      "cep_writes_avoided_total",
+				Help:      "Number of CiliumEndpoint status updates skipped because the semantic content was unchanged",
+			})
+
+			collectors = append(collectors, KubernetesCEPWritesAvoided)
+			c.KubernetesCEPWritesAvoidedEnabled = true
+
 		case Namespace + "_ipam_events_total":
 			IpamEvent = prometheus.NewCounterVec(prometheus.CounterOpts{
 				Namespace: Namespace,
@@ -1058,6 +1349,26 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, IpamEvent)
 			c.IpamEventEnabled = true
 
+		case Namespace + "_ipam_orphaned_ips":
+			IpamOrphanedIPs = prometheus.NewGauge(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Name:      "ipam_orphaned_ips",
+				Help:      "Number of allocated IPs with no owning endpoint found by the IPAM garbage collection",
+			})
+
+			collectors = append(collectors, IpamOrphanedIPs)
+			c.IpamOrphanedIPsEnabled = true
+
+		case Namespace + "_ipam_reclaimed_ips_total":
+			IpamReclaimedIPs = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "ipam_reclaimed_ips_total",
+				Help:      "Total number of orphaned IPs released by the IPAM garbage collection",
+			})
+
+			collectors = append(collectors, IpamReclaimedIPs)
+			c.IpamReclaimedIPsEnabled = true
+
 		case Namespace + "_" + SubsystemKVStore + "_operations_duration_seconds":
 			KVStoreOperationsDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 				Namespace: Namespace,
@@ -1092,6 +1403,48 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, KVStoreQuorumErrors)
 			c.KVStoreQuorumErrorsEnabled = true
 
+		case Namespace + "_" + SubsystemKVStore + "_etcd_leases":
+			KVStoreEtcdLeases = prometheus.NewGauge(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemKVStore,
+				Name:      "etcd_leases",
+				Help:      "Number of etcd leases currently used to attach the keys owned by this agent",
+			})
+
+			collectors = append(collectors, KVStoreEtcdLeases)
+			c.KVStoreEtcdLeasesEnabled = true
+
+		case Namespace + "_" + SubsystemKVStore + "_etcd_lease_expirations_total":
+			KVStoreEtcdLeaseExpirations = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemKVStore,
+				Name:      "etcd_lease_expirations_total",
+				Help:      "Number of times an etcd lease used to attach kvstore keys expired because its keepalives failed",
+			})
+
+			collectors = append(collectors, KVStoreEtcdLeaseExpirations)
+			c.KVStoreEtcdLeaseExpirationsEnabled = true
+
+		case Namespace + "_egress_gateway_failovers_total":
+			EgressGatewayFailovers = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "egress_gateway_failovers_total",
+				Help:      "Number of times egress gateway map entries were reprogrammed to a different gateway following a gateway health state change",
+			})
+
+			collectors = append(collectors, EgressGatewayFailovers)
+			c.EgressGatewayFailoversEnabled = true
+
+		case Namespace + "_wireguard_peers_pending_key_rotation":
+			WireguardPeersPendingKeyRotation = prometheus.NewGauge(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Name:      "wireguard_peers_pending_key_rotation",
+				Help:      "Number of Wireguard peers which have not re-established a handshake since the last local key rotation",
+			})
+
+			collectors = append(collectors, WireguardPeersPendingKeyRotation)
+			c.WireguardPeersPendingKeyRotationEnabled = true
+
 		case Namespace + "_fqdn_gc_deletions_total":
 			FQDNGarbageCollectorCleanedTotal = prometheus.NewCounter(prometheus.CounterOpts{
 				Namespace: Namespace,
@@ -1102,6 +1455,48 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, FQDNGarbageCollectorCleanedTotal)
 			c.FQDNGarbageCollectorCleanedTotalEnabled = true
 
+		case Namespace + "_fqdn_ratelimit_rejections_total":
+			FQDNRateLimitRejectionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "fqdn_ratelimit_rejections_total",
+				Help:      "Number of DNS queries rejected by the DNS proxy rate limiter",
+			}, []string{LabelEndpoint})
+
+			collectors = append(collectors, FQDNRateLimitRejectionsTotal)
+			c.FQDNRateLimitRejectionsTotalEnabled = true
+
+		case Namespace + "_fqdn_proxy_restarts_total":
+			FQDNProxyRestartsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "fqdn_proxy_restarts_total",
+				Help:      "Number of times the DNS proxy supervisor replaced a wedged DNS proxy instance",
+			})
+
+			collectors = append(collectors, FQDNProxyRestartsTotal)
+			c.FQDNProxyRestartsTotalEnabled = true
+
+		case Namespace + "_fqdn_proxy_takeover_duration_seconds":
+			fqdnProxyTakeoverDuration := prometheus.NewHistogram(prometheus.HistogramOpts{
+				Namespace: Namespace,
+				Name:      "fqdn_proxy_takeover_duration_seconds",
+				Help:      "Duration of DNS proxy takeovers performed by the supervisor",
+			})
+			FQDNProxyTakeoverDuration = fqdnProxyTakeoverDuration
+
+			collectors = append(collectors, fqdnProxyTakeoverDuration)
+			c.FQDNProxyTakeoverDurationEnabled = true
+
+		case Namespace + "_fqdn_proxy_response_delay_seconds":
+			fqdnProxyResponseDelay := prometheus.NewHistogram(prometheus.HistogramOpts{
+				Namespace: Namespace,
+				Name:      "fqdn_proxy_response_delay_seconds",
+				Help:      "Duration DNS responses were delayed while waiting for datapath updates of FQDN IP information",
+			})
+			FQDNProxyResponseDelay = fqdnProxyResponseDelay
+
+			collectors = append(collectors, fqdnProxyResponseDelay)
+			c.FQDNProxyResponseDelayEnabled = true
+
 		case Namespace + "_" + SubsystemBPF + "_syscall_duration_seconds":
 			BPFSyscallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 				Namespace: Namespace,
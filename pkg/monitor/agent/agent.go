@@ -194,7 +194,7 @@ func (a *Agent) RegisterNewListener(newListener listener.MonitorListener) {
 
 	version := newListener.Version()
 	switch newListener.Version() {
-	case listener.Version1_2:
+	case listener.Version1_2, listener.Version1_3:
 		a.listeners[newListener] = struct{}{}
 
 	default:
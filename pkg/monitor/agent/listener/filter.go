@@ -0,0 +1,189 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package listener
+
+import (
+	"github.com/cilium/cilium/pkg/byteorder"
+	"github.com/cilium/cilium/pkg/monitor/api"
+	"github.com/cilium/cilium/pkg/monitor/payload"
+)
+
+// Verdict values understood by Filter.Verdicts.
+const (
+	// FilterVerdictForwarded matches trace events and allowed policy
+	// verdicts.
+	FilterVerdictForwarded = "forwarded"
+
+	// FilterVerdictDropped matches drop events and denied policy verdicts.
+	FilterVerdictDropped = "dropped"
+)
+
+// Filter restricts the monitor events delivered to a listener. Each empty
+// field matches all events, a zero Filter therefore lets every event pass.
+// Filters are negotiated at connect time by monitor API version 1.3 clients
+// so that events which no consumer is interested in are never serialized.
+type Filter struct {
+	// Types matches on the message type (api.MessageType*).
+	Types []int32
+
+	// FromSource matches on the endpoint ID which emitted the event.
+	FromSource []uint16
+
+	// ToDst matches on the destination endpoint ID of the event.
+	ToDst []uint16
+
+	// Related matches on either the emitting or the destination endpoint
+	// ID of the event.
+	Related []uint16
+
+	// Verdicts matches on the event verdict, see FilterVerdict*. Events
+	// which carry no verdict (debug, captures, agent events) only match
+	// an empty Verdicts filter.
+	Verdicts []string
+}
+
+// The offsets below mirror the fixed event headers laid out in bpf/lib and
+// decoded in pkg/monitor. Only the fields needed for filtering are read from
+// the raw sample, to keep the per-event cost on the agent side low.
+const (
+	// sourceOffset is the offset of the __u16 source field of the common
+	// notification header.
+	sourceOffset = 2
+
+	// dropDstOffset is the offset of the __u32 dst_id field of struct
+	// drop_notify.
+	dropDstOffset = 24
+
+	// traceDstOffset is the offset of the __u16 dst_id field of struct
+	// trace_notify.
+	traceDstOffset = 24
+
+	// policyRemoteOffset is the offset of the __u32 remote_label field of
+	// struct policy_verdict_notify.
+	policyRemoteOffset = 16
+
+	// policyVerdictOffset is the offset of the __s32 verdict field of
+	// struct policy_verdict_notify.
+	policyVerdictOffset = 20
+)
+
+// MatchesPayload returns true if the given payload passes the filter and
+// should be delivered to the listener. Lost-sample records always pass so
+// that consumers can account for gaps in the event stream.
+func (f *Filter) MatchesPayload(pl *payload.Payload) bool {
+	if pl.Type != payload.EventSample || len(pl.Data) == 0 {
+		return true
+	}
+
+	typ := pl.Data[0]
+	if !f.matchesType(typ) {
+		return false
+	}
+
+	src, dst := flowEndpoints(typ, pl.Data)
+	if len(f.FromSource) > 0 && !containsID(f.FromSource, src) {
+		return false
+	}
+	if len(f.ToDst) > 0 && !containsID(f.ToDst, dst) {
+		return false
+	}
+	if len(f.Related) > 0 && !containsID(f.Related, src) && !containsID(f.Related, dst) {
+		return false
+	}
+
+	return f.matchesVerdict(typ, pl.Data)
+}
+
+func (f *Filter) matchesType(typ uint8) bool {
+	if len(f.Types) == 0 {
+		return true
+	}
+	for _, t := range f.Types {
+		if t == int32(typ) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *Filter) matchesVerdict(typ uint8, data []byte) bool {
+	if len(f.Verdicts) == 0 {
+		return true
+	}
+
+	var verdict string
+	switch typ {
+	case api.MessageTypeDrop:
+		verdict = FilterVerdictDropped
+	case api.MessageTypeTrace:
+		verdict = FilterVerdictForwarded
+	case api.MessageTypePolicyVerdict:
+		if len(data) < policyVerdictOffset+4 {
+			return false
+		}
+		if int32(byteorder.Native.Uint32(data[policyVerdictOffset:])) < 0 {
+			verdict = FilterVerdictDropped
+		} else {
+			verdict = FilterVerdictForwarded
+		}
+	default:
+		return false
+	}
+
+	for _, v := range f.Verdicts {
+		if v == verdict {
+			return true
+		}
+	}
+	return false
+}
+
+// flowEndpoints extracts the emitting and destination endpoint IDs of a raw
+// datapath event. IDs which the event does not carry are returned as zero.
+func flowEndpoints(typ uint8, data []byte) (src, dst uint16) {
+	switch typ {
+	case api.MessageTypeDrop, api.MessageTypeDebug, api.MessageTypeCapture,
+		api.MessageTypeTrace, api.MessageTypePolicyVerdict:
+		if len(data) >= sourceOffset+2 {
+			src = byteorder.Native.Uint16(data[sourceOffset:])
+		}
+	}
+
+	switch typ {
+	case api.MessageTypeDrop:
+		if len(data) >= dropDstOffset+4 {
+			dst = uint16(byteorder.Native.Uint32(data[dropDstOffset:]))
+		}
+	case api.MessageTypeTrace:
+		if len(data) >= traceDstOffset+2 {
+			dst = byteorder.Native.Uint16(data[traceDstOffset:])
+		}
+	case api.MessageTypePolicyVerdict:
+		if len(data) >= policyRemoteOffset+4 {
+			dst = uint16(byteorder.Native.Uint32(data[policyRemoteOffset:]))
+		}
+	}
+
+	return src, dst
+}
+
+func containsID(ids []uint16, id uint16) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
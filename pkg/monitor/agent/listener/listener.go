@@ -24,12 +24,15 @@ import (
 )
 
 // Version is the version of a node-monitor listener client. There are
-// two API versions:
+// three API versions:
 // - 1.0 which encodes the gob type information with each payload sent, and
 //   adds a meta object before it.
 // - 1.2 which maintains a gob session per listener, thus only encoding the
 //   type information on the first payload sent. It does NOT prepend the a meta
 //   object.
+// - 1.3 which is identical to 1.2 on the payload stream, but expects the
+//   client to send a gob-encoded Filter immediately after connecting. Only
+//   events matching the filter are serialized and sent.
 type Version string
 
 const (
@@ -38,6 +41,9 @@ const (
 
 	// Version1_2 is the API 1.0 version of the protocol (see above).
 	Version1_2 = Version("1.2")
+
+	// Version1_3 is the API 1.3 version of the protocol (see above).
+	Version1_3 = Version("1.3")
 )
 
 // MonitorListener is a generic consumer of monitor events. Implementers are
@@ -0,0 +1,95 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package agent
+
+import (
+	"net"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/byteorder"
+	"github.com/cilium/cilium/pkg/monitor/agent/listener"
+	"github.com/cilium/cilium/pkg/monitor/api"
+	"github.com/cilium/cilium/pkg/monitor/payload"
+)
+
+func (m *ListenerSuite) TestListenerv1_3(c *C) {
+	closed := make(chan bool)
+	server, client := net.Pipe()
+	l := newListenerv1_3(client, 10, listener.Filter{}, func(listener listener.MonitorListener) {
+		closed <- true
+	})
+	// Verify the listener version.
+	c.Assert(l.Version(), Equals, listener.Version1_3)
+	// Calling Close() multiple times shouldn't cause panic.
+	l.Close()
+	l.Close()
+	// Make sure the cleanup function gets called.
+	<-closed
+	server.Close()
+}
+
+// dropSample builds a raw drop notification with the given source endpoint
+// and destination endpoint ID.
+func dropSample(src uint16, dst uint32) *payload.Payload {
+	data := make([]byte, 32)
+	data[0] = byte(api.MessageTypeDrop)
+	byteorder.Native.PutUint16(data[2:], src)
+	byteorder.Native.PutUint32(data[24:], dst)
+	return &payload.Payload{Data: data, Type: payload.EventSample}
+}
+
+// policyVerdictSample builds a raw policy verdict notification with the
+// given verdict.
+func policyVerdictSample(verdict int32) *payload.Payload {
+	data := make([]byte, 32)
+	data[0] = byte(api.MessageTypePolicyVerdict)
+	byteorder.Native.PutUint32(data[20:], uint32(verdict))
+	return &payload.Payload{Data: data, Type: payload.EventSample}
+}
+
+func (m *ListenerSuite) TestFilterMatchesPayload(c *C) {
+	empty := listener.Filter{}
+	c.Assert(empty.MatchesPayload(dropSample(1, 2)), Equals, true)
+
+	// Lost records always pass, even with a filter in place.
+	byType := listener.Filter{Types: []int32{api.MessageTypeTrace}}
+	c.Assert(byType.MatchesPayload(&payload.Payload{Type: payload.RecordLost}), Equals, true)
+	c.Assert(byType.MatchesPayload(dropSample(1, 2)), Equals, false)
+
+	byEndpoint := listener.Filter{FromSource: []uint16{1}}
+	c.Assert(byEndpoint.MatchesPayload(dropSample(1, 2)), Equals, true)
+	c.Assert(byEndpoint.MatchesPayload(dropSample(3, 2)), Equals, false)
+
+	byDst := listener.Filter{ToDst: []uint16{2}}
+	c.Assert(byDst.MatchesPayload(dropSample(1, 2)), Equals, true)
+	c.Assert(byDst.MatchesPayload(dropSample(1, 3)), Equals, false)
+
+	related := listener.Filter{Related: []uint16{2}}
+	c.Assert(related.MatchesPayload(dropSample(2, 7)), Equals, true)
+	c.Assert(related.MatchesPayload(dropSample(7, 2)), Equals, true)
+	c.Assert(related.MatchesPayload(dropSample(7, 7)), Equals, false)
+
+	dropped := listener.Filter{Verdicts: []string{listener.FilterVerdictDropped}}
+	c.Assert(dropped.MatchesPayload(dropSample(1, 2)), Equals, true)
+	c.Assert(dropped.MatchesPayload(policyVerdictSample(-151)), Equals, true)
+	c.Assert(dropped.MatchesPayload(policyVerdictSample(0)), Equals, false)
+
+	forwarded := listener.Filter{Verdicts: []string{listener.FilterVerdictForwarded}}
+	c.Assert(forwarded.MatchesPayload(policyVerdictSample(0)), Equals, true)
+	c.Assert(forwarded.MatchesPayload(dropSample(1, 2)), Equals, false)
+}
@@ -16,14 +16,17 @@ package agent
 
 import (
 	"context"
+	"encoding/gob"
 	"fmt"
 	"net"
 	"os"
+	"time"
 
 	"github.com/cilium/cilium/pkg/api"
 	"github.com/cilium/cilium/pkg/defaults"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/monitor/agent/listener"
 	"github.com/cilium/cilium/pkg/option"
 )
 
@@ -31,6 +34,10 @@ var (
 	log = logging.DefaultLogger.WithField(logfields.LogSubsys, "monitor-agent")
 )
 
+// filterTimeout bounds how long the 1.3 connection handler waits for the
+// client to send its filter before giving up on the connection.
+const filterTimeout = 10 * time.Second
+
 // buildServer opens a listener socket at path. It exits with logging on all
 // errors.
 func buildServer(path string) (*net.UnixListener, error) {
@@ -61,18 +68,18 @@ type server struct {
 	monitor  *Agent
 }
 
-// ServeMonitorAPI serves the Cilium 1.2 monitor API on a unix domain socket.
-// This method starts the server in the background. The server is stopped when
-// monitor.Context() is cancelled. Each incoming connection registers a new
-// listener on monitor.
+// ServeMonitorAPI serves the Cilium 1.2 and 1.3 monitor APIs, each on its own
+// unix domain socket. This method starts the servers in the background. The
+// servers are stopped when monitor.Context() is cancelled. Each incoming
+// connection registers a new listener on monitor.
 func ServeMonitorAPI(monitor *Agent) error {
-	listener, err := buildServer(defaults.MonitorSockPath1_2)
+	listener1_2, err := buildServer(defaults.MonitorSockPath1_2)
 	if err != nil {
 		return err
 	}
 
 	s := &server{
-		listener: listener,
+		listener: listener1_2,
 		monitor:  monitor,
 	}
 
@@ -80,6 +87,20 @@ func ServeMonitorAPI(monitor *Agent) error {
 
 	go s.connectionHandler1_2(monitor.Context())
 
+	listener1_3, err := buildServer(defaults.MonitorSockPath1_3)
+	if err != nil {
+		return err
+	}
+
+	s1_3 := &server{
+		listener: listener1_3,
+		monitor:  monitor,
+	}
+
+	log.Infof("Serving cilium node monitor v1.3 API at unix://%s", defaults.MonitorSockPath1_3)
+
+	go s1_3.connectionHandler1_3(monitor.Context())
+
 	return nil
 }
 
@@ -108,3 +129,47 @@ func (s *server) connectionHandler1_2(ctx context.Context) {
 		s.monitor.RegisterNewListener(newListener)
 	}
 }
+
+// connectionHandler1_3 handles all the incoming connections and sets up the
+// listener objects. It will block until ctx is cancelled.
+func (s *server) connectionHandler1_3(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		s.listener.Close()
+	}()
+
+	for !isCtxDone(ctx) {
+		conn, err := s.listener.Accept()
+		switch {
+		case isCtxDone(ctx):
+			if conn != nil {
+				conn.Close()
+			}
+			return
+		case err != nil:
+			log.WithError(err).Warn("Error accepting connection")
+			continue
+		}
+
+		// The filter is read in a separate goroutine to keep a slow or
+		// stuck client from blocking the accept loop.
+		go s.setupListener1_3(conn)
+	}
+}
+
+// setupListener1_3 reads the filter sent by a 1.3 client and registers the
+// connection as a new listener.
+func (s *server) setupListener1_3(conn net.Conn) {
+	var filter listener.Filter
+
+	conn.SetReadDeadline(time.Now().Add(filterTimeout))
+	if err := gob.NewDecoder(conn).Decode(&filter); err != nil {
+		log.WithError(err).Warn("Closing 1.3 monitor client which did not send a filter")
+		conn.Close()
+		return
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	newListener := newListenerv1_3(conn, option.Config.MonitorQueueSize, filter, s.monitor.RemoveListener)
+	s.monitor.RegisterNewListener(newListener)
+}
@@ -90,6 +90,26 @@ var errors = map[uint8]string{
 	181: "Policy denied by denylist",
 }
 
+// Extended drop sub-reasons carried in drop notifications of version 1 and
+// newer to refine the policy drop reasons. These values are shared with
+// bpf/lib/common.h.
+const (
+	// DropExtNone indicates that no extended sub-reason is available.
+	DropExtNone uint8 = iota
+	// DropExtMissingAllow indicates that no allow rule matched the flow.
+	DropExtMissingAllow
+	// DropExtDenyRule indicates that the flow matched an explicit deny rule.
+	DropExtDenyRule
+	// DropExtAuthRequired indicates that the flow requires authentication.
+	DropExtAuthRequired
+)
+
+var subErrors = map[uint8]string{
+	DropExtMissingAllow: "no allow rule matched",
+	DropExtDenyRule:     "matched deny rule",
+	DropExtAuthRequired: "authentication required",
+}
+
 // DropReason prints the drop reason in a human readable string
 func DropReason(reason uint8) string {
 	if err, ok := errors[reason]; ok {
@@ -97,3 +117,30 @@ func DropReason(reason uint8) string {
 	}
 	return fmt.Sprintf("%d", reason)
 }
+
+// DropSubReason prints the extended drop sub-reason in a human readable
+// string, or an empty string if no sub-reason is available.
+func DropSubReason(subReason uint8) string {
+	return subErrors[subReason]
+}
+
+// DropReasonExt prints the drop reason, refined with the extended
+// sub-reason if available, in a human readable string
+func DropReasonExt(reason, subReason uint8) string {
+	if sub, ok := subErrors[subReason]; ok {
+		return DropReason(reason) + " (" + sub + ")"
+	}
+	return DropReason(reason)
+}
+
+// PolicyTrafficDirection prints the direction of a policy lookup in a human
+// readable string
+func PolicyTrafficDirection(dir uint8) string {
+	switch dir {
+	case PolicyIngress:
+		return "ingress"
+	case PolicyEgress:
+		return "egress"
+	}
+	return fmt.Sprintf("%d", dir)
+}
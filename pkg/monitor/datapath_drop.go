@@ -16,34 +16,140 @@ package monitor
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"os"
+	"unsafe"
 
+	"github.com/cilium/cilium/pkg/byteorder"
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/monitor/api"
 )
 
 const (
-	// DropNotifyLen is the amount of packet data provided in a drop notification
-	DropNotifyLen = 32
+	// dropNotifyV0Len is the amount of packet data provided in a drop notification v0.
+	dropNotifyV0Len = 32
+	// dropNotifyV1Len is the amount of packet data provided in a drop notification v1.
+	dropNotifyV1Len = 40
+	// dropNotifyV2Len is the amount of packet data provided in a drop notification v2.
+	dropNotifyV2Len = 52
+
+	// DropNotifyLen is the amount of packet data provided in a drop
+	// notification v0, retained for backwards compatibility.
+	DropNotifyLen = dropNotifyV0Len
 )
 
-// DropNotify is the message format of a drop notification in the BPF ring buffer
-type DropNotify struct {
-	Type     uint8
-	SubType  uint8
-	Source   uint16
-	Hash     uint32
-	OrigLen  uint32
-	CapLen   uint32
-	SrcLabel identity.NumericIdentity
-	DstLabel identity.NumericIdentity
-	DstID    uint32
-	Unused   uint32
+const (
+	// DropNotifyVersion0 is the drop notification format sent by older
+	// datapath versions, without extended drop information.
+	DropNotifyVersion0 = iota
+	// DropNotifyVersion1 carries the extended sub-reason and, for policy
+	// drops, the policy map key that was looked up.
+	DropNotifyVersion1
+	// DropNotifyVersion2 additionally carries the forwarding state of the
+	// packet at drop time: TTL/hop limit, skb mark and ifindex.
+	DropNotifyVersion2
+)
+
+// DropNotifyV0 is the common message format for versions 0 and 1.
+type DropNotifyV0 struct {
+	Type      uint8
+	SubType   uint8
+	Source    uint16
+	Hash      uint32
+	OrigLen   uint32
+	CapLen    uint32
+	SrcLabel  identity.NumericIdentity
+	DstLabel  identity.NumericIdentity
+	DstID     uint32
+	Version   uint16
+	SubReason uint8
+	Pad       uint8
 	// data
 }
 
+// DropNotifyV1 is the version 1 message format.
+type DropNotifyV1 struct {
+	DropNotifyV0
+	// PolicyID is the remote identity of the policy map key that was
+	// looked up for policy drops.
+	PolicyID    uint32
+	PolicyDport uint16
+	PolicyProto uint8
+	// PolicyFlags carries the direction of the policy lookup in its
+	// lowest two bits.
+	PolicyFlags uint8
+	// data
+}
+
+// DropNotifyV2 is the version 2 message format.
+type DropNotifyV2 struct {
+	DropNotifyV1
+	// Mark is the skb mark of the packet at drop time.
+	Mark uint32
+	// Ifindex is the interface the packet was received on.
+	Ifindex uint32
+	// TTL is the TTL or hop limit of the dropped packet, zero if the
+	// packet carried no IP header.
+	TTL  uint8
+	Pad2 uint8
+	Pad3 uint16
+	// data
+}
+
+// DropNotify is the message format of a drop notification in the BPF ring buffer
+type DropNotify DropNotifyV2
+
+var (
+	dropNotifyLength = map[uint16]uint{
+		DropNotifyVersion0: dropNotifyV0Len,
+		DropNotifyVersion1: dropNotifyV1Len,
+		DropNotifyVersion2: dropNotifyV2Len,
+	}
+)
+
+// DecodeDropNotify will decode 'data' into the provided DropNotify structure
+func DecodeDropNotify(data []byte, dn *DropNotify) error {
+	if len(data) < dropNotifyV0Len {
+		return fmt.Errorf("Unknown drop event")
+	}
+
+	var version uint16
+	offset := unsafe.Offsetof(dn.Version)
+	length := unsafe.Sizeof(dn.Version)
+	reader := bytes.NewReader(data[offset : offset+length])
+	if err := binary.Read(reader, byteorder.Native, &version); err != nil {
+		return err
+	}
+
+	switch version {
+	case DropNotifyVersion0:
+		return binary.Read(bytes.NewReader(data), byteorder.Native, &dn.DropNotifyV0)
+	case DropNotifyVersion1:
+		return binary.Read(bytes.NewReader(data), byteorder.Native, &dn.DropNotifyV1)
+	case DropNotifyVersion2:
+		return binary.Read(bytes.NewReader(data), byteorder.Native, dn)
+	default:
+		return fmt.Errorf("Unrecognized drop event (version %d)", version)
+	}
+}
+
+// DataOffset returns the offset from the beginning of DropNotify where the
+// drop notify data begins.
+//
+// Returns zero for invalid or unknown DropNotify messages.
+func (n *DropNotify) DataOffset() uint {
+	return dropNotifyLength[n.Version]
+}
+
+// PolicyDir returns the direction of the policy lookup for policy drops, or
+// zero if the notification does not carry a policy key.
+func (n *DropNotify) PolicyDir() uint8 {
+	return n.PolicyFlags & 0x3
+}
+
 // dumpIdentity dumps the source and destination identities in numeric or
 // human-readable format.
 func (n *DropNotify) dumpIdentity(buf *bufio.Writer, numeric DisplayFormat) {
@@ -54,13 +160,24 @@ func (n *DropNotify) dumpIdentity(buf *bufio.Writer, numeric DisplayFormat) {
 	}
 }
 
+// dumpPolicyKey dumps the policy map key that was looked up for policy
+// drops, if carried by the notification.
+func (n *DropNotify) dumpPolicyKey(buf *bufio.Writer) {
+	dir := n.PolicyDir()
+	if dir == 0 {
+		return
+	}
+	fmt.Fprintf(buf, ", policy key [%s identity=%d dport=%d proto=%d]",
+		api.PolicyTrafficDirection(dir), n.PolicyID, n.PolicyDport, n.PolicyProto)
+}
+
 // DumpInfo prints a summary of the drop messages.
 func (n *DropNotify) DumpInfo(data []byte, numeric DisplayFormat) {
 	buf := bufio.NewWriter(os.Stdout)
 	fmt.Fprintf(buf, "xx drop (%s) flow %#x to endpoint %d, ",
-		api.DropReason(n.SubType), n.Hash, n.DstID)
+		api.DropReasonExt(n.SubType, n.SubReason), n.Hash, n.DstID)
 	n.dumpIdentity(buf, numeric)
-	fmt.Fprintf(buf, ": %s\n", GetConnectionSummary(data[DropNotifyLen:]))
+	fmt.Fprintf(buf, ": %s\n", GetConnectionSummary(data[n.DataOffset():]))
 	buf.Flush()
 }
 
@@ -68,20 +185,26 @@ func (n *DropNotify) DumpInfo(data []byte, numeric DisplayFormat) {
 func (n *DropNotify) DumpVerbose(dissect bool, data []byte, prefix string, numeric DisplayFormat) {
 	buf := bufio.NewWriter(os.Stdout)
 	fmt.Fprintf(buf, "%s MARK %#x FROM %d DROP: %d bytes, reason %s",
-		prefix, n.Hash, n.Source, n.OrigLen, api.DropReason(n.SubType))
+		prefix, n.Hash, n.Source, n.OrigLen, api.DropReasonExt(n.SubType, n.SubReason))
 
 	if n.SrcLabel != 0 || n.DstLabel != 0 {
 		n.dumpIdentity(buf, numeric)
 	}
 
+	n.dumpPolicyKey(buf)
+
+	if n.Version >= DropNotifyVersion2 {
+		fmt.Fprintf(buf, ", ttl %d, skb mark %#x, ifindex %d", n.TTL, n.Mark, n.Ifindex)
+	}
+
 	if n.DstID != 0 {
 		fmt.Fprintf(buf, ", to endpoint %d\n", n.DstID)
 	} else {
 		fmt.Fprintf(buf, "\n")
 	}
 
-	if n.CapLen > 0 && len(data) > DropNotifyLen {
-		Dissect(dissect, data[DropNotifyLen:])
+	if n.CapLen > 0 && len(data) > int(n.DataOffset()) {
+		Dissect(dissect, data[n.DataOffset():])
 	}
 	buf.Flush()
 }
@@ -90,8 +213,8 @@ func (n *DropNotify) getJSON(data []byte, cpuPrefix string) (string, error) {
 
 	v := DropNotifyToVerbose(n)
 	v.CPUPrefix = cpuPrefix
-	if n.CapLen > 0 && len(data) > DropNotifyLen {
-		v.Summary = GetDissectSummary(data[DropNotifyLen:])
+	if n.CapLen > 0 && len(data) > int(n.DataOffset()) {
+		v.Summary = GetDissectSummary(data[n.DataOffset():])
 	}
 
 	ret, err := json.Marshal(v)
@@ -112,6 +235,7 @@ type DropNotifyVerbose struct {
 	Type      string `json:"type,omitempty"`
 	Mark      string `json:"mark,omitempty"`
 	Reason    string `json:"reason,omitempty"`
+	SubReason string `json:"subReason,omitempty"`
 
 	Source   uint16                   `json:"source"`
 	Bytes    uint32                   `json:"bytes"`
@@ -119,19 +243,41 @@ type DropNotifyVerbose struct {
 	DstLabel identity.NumericIdentity `json:"dstLabel"`
 	DstID    uint32                   `json:"dstID"`
 
+	PolicyID    uint32 `json:"policyID,omitempty"`
+	PolicyDport uint16 `json:"policyDport,omitempty"`
+	PolicyProto uint8  `json:"policyProto,omitempty"`
+	PolicyDir   string `json:"policyDir,omitempty"`
+
+	SkbMark string `json:"skbMark,omitempty"`
+	Ifindex uint32 `json:"ifindex,omitempty"`
+	TTL     uint8  `json:"ttl,omitempty"`
+
 	Summary *DissectSummary `json:"summary,omitempty"`
 }
 
 //DropNotifyToVerbose creates verbose notification from DropNotify
 func DropNotifyToVerbose(n *DropNotify) DropNotifyVerbose {
-	return DropNotifyVerbose{
-		Type:     "drop",
-		Mark:     fmt.Sprintf("%#x", n.Hash),
-		Reason:   api.DropReason(n.SubType),
-		Source:   n.Source,
-		Bytes:    n.OrigLen,
-		SrcLabel: n.SrcLabel,
-		DstLabel: n.DstLabel,
-		DstID:    n.DstID,
+	v := DropNotifyVerbose{
+		Type:      "drop",
+		Mark:      fmt.Sprintf("%#x", n.Hash),
+		Reason:    api.DropReason(n.SubType),
+		SubReason: api.DropSubReason(n.SubReason),
+		Source:    n.Source,
+		Bytes:     n.OrigLen,
+		SrcLabel:  n.SrcLabel,
+		DstLabel:  n.DstLabel,
+		DstID:     n.DstID,
+	}
+	if dir := n.PolicyDir(); dir != 0 {
+		v.PolicyID = n.PolicyID
+		v.PolicyDport = n.PolicyDport
+		v.PolicyProto = n.PolicyProto
+		v.PolicyDir = api.PolicyTrafficDirection(dir)
+	}
+	if n.Version >= DropNotifyVersion2 {
+		v.SkbMark = fmt.Sprintf("%#x", n.Mark)
+		v.Ifindex = n.Ifindex
+		v.TTL = n.TTL
 	}
+	return v
 }
@@ -93,7 +93,7 @@ func (m *MonitorFormatter) match(messageType int, src uint16, dst uint16) bool {
 func (m *MonitorFormatter) dropEvents(prefix string, data []byte) {
 	dn := monitor.DropNotify{}
 
-	if err := binary.Read(bytes.NewReader(data), byteorder.Native, &dn); err != nil {
+	if err := monitor.DecodeDropNotify(data, &dn); err != nil {
 		fmt.Printf("Error while parsing drop notification message: %s\n", err)
 	}
 	if m.match(monitorAPI.MessageTypeDrop, dn.Source, uint16(dn.DstID)) {
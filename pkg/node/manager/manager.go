@@ -26,6 +26,7 @@ import (
 	"github.com/cilium/cilium/pkg/inctimer"
 	"github.com/cilium/cilium/pkg/ipcache"
 	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/node/addressing"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
@@ -41,6 +42,14 @@ var (
 	randGen                    = rand.NewSafeRand(time.Now().UnixNano())
 )
 
+// heldDeletion is a node deletion event held back by the node deletion
+// grace period. The timer processes the deletion once the grace period
+// expires unless the deletion is cancelled before.
+type heldDeletion struct {
+	node  nodeTypes.Node
+	timer *time.Timer
+}
+
 type nodeEntry struct {
 	// mutex serves two purposes:
 	// 1. Serialize any direct access to the node field in this entry.
@@ -66,6 +75,7 @@ type Configuration interface {
 	RemoteNodeIdentitiesEnabled() bool
 	NodeEncryptionEnabled() bool
 	EncryptionEnabled() bool
+	NodeDeletionGracePeriodDuration() time.Duration
 }
 
 // Notifier is the interface the wraps Subscribe and Unsubscribe. An
@@ -107,6 +117,11 @@ type Manager struct {
 	// nodes is the list of nodes. Access must be protected via mutex.
 	nodes map[nodeTypes.Identity]*nodeEntry
 
+	// heldDeletions is the set of node deletions currently held back by
+	// the node deletion grace period, indexed by node identity. Access
+	// must be protected via mutex.
+	heldDeletions map[nodeTypes.Identity]*heldDeletion
+
 	// nodeHandlersMu protects the nodeHandlers map against concurrent access.
 	nodeHandlersMu lock.RWMutex
 	// nodeHandlers has a slice containing all node handlers subscribed to node
@@ -132,6 +147,10 @@ type Manager struct {
 	// number of datapath node validation calls
 	metricDatapathValidations prometheus.Counter
 
+	// metricHeldDeletions is the prometheus metric to track the number of
+	// node deletions currently held by the deletion grace period
+	metricHeldDeletions prometheus.Gauge
+
 	// conf is the configuration of the caller passed in via NewManager.
 	// This field is immutable after NewManager()
 	conf Configuration
@@ -175,12 +194,13 @@ func (m *Manager) Iter(f func(nh datapath.NodeHandler)) {
 // NewManager returns a new node manager
 func NewManager(name string, dp datapath.NodeHandler, ipcache IPCache, c Configuration) (*Manager, error) {
 	m := &Manager{
-		name:         name,
-		nodes:        map[nodeTypes.Identity]*nodeEntry{},
-		conf:         c,
-		ipcache:      ipcache,
-		nodeHandlers: map[datapath.NodeHandler]struct{}{},
-		closeChan:    make(chan struct{}),
+		name:          name,
+		nodes:         map[nodeTypes.Identity]*nodeEntry{},
+		heldDeletions: map[nodeTypes.Identity]*heldDeletion{},
+		conf:          c,
+		ipcache:       ipcache,
+		nodeHandlers:  map[datapath.NodeHandler]struct{}{},
+		closeChan:     make(chan struct{}),
 	}
 	m.Subscribe(dp)
 
@@ -205,7 +225,14 @@ func NewManager(name string, dp datapath.NodeHandler, ipcache IPCache, c Configu
 		Help:      "Number of validation calls to implement the datapath implementation of a node",
 	})
 
-	err := metrics.RegisterList([]prometheus.Collector{m.metricDatapathValidations, m.metricEventsReceived, m.metricNumNodes})
+	m.metricHeldDeletions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "nodes",
+		Name:      name + "_held_deletions",
+		Help:      "Number of node deletions currently held by the node deletion grace period",
+	})
+
+	err := metrics.RegisterList([]prometheus.Collector{m.metricDatapathValidations, m.metricEventsReceived, m.metricNumNodes, m.metricHeldDeletions})
 	if err != nil {
 		return nil, err
 	}
@@ -225,6 +252,14 @@ func (m *Manager) Close() {
 	metrics.Unregister(m.metricNumNodes)
 	metrics.Unregister(m.metricEventsReceived)
 	metrics.Unregister(m.metricDatapathValidations)
+	metrics.Unregister(m.metricHeldDeletions)
+
+	// cancel any deletions still held, they are implied by the node
+	// removal below
+	for nodeIdentity, held := range m.heldDeletions {
+		held.timer.Stop()
+		delete(m.heldDeletions, nodeIdentity)
+	}
 
 	// delete all nodes to clean up the datapath for each node
 	for _, n := range m.nodes {
@@ -338,6 +373,10 @@ func (m *Manager) legacyNodeIpBehavior() bool {
 func (m *Manager) NodeUpdated(n nodeTypes.Node) {
 	log.Debugf("Received node update event from %s: %#v", n.Source, n)
 	nodeIdentity := n.Identity()
+
+	// A node that reappears cancels any deletion held for it.
+	m.cancelHeldDeletion(nodeIdentity)
+
 	dpUpdate := true
 	nodeIP := n.GetNodeIP(false)
 
@@ -495,6 +534,13 @@ func (m *Manager) deleteIPCache(oldSource source.Source, oldIPs []net.IP, newIPs
 // from the manager if the node is still owned by the source of which the event
 // origins from. If the node was removed, NodeDelete() is invoked of the
 // datapath interface.
+//
+// If a node deletion grace period is configured, deletions reported by the
+// Kubernetes control plane are held for the grace period and cancelled if the
+// node reappears in the meantime. This protects the datapath state of all
+// other nodes against transient kube-apiserver hiccups which briefly remove
+// Node objects. Deletions corroborated by the kvstore, including clustermesh,
+// as well as local deletions are processed immediately.
 func (m *Manager) NodeDeleted(n nodeTypes.Node) {
 	m.metricEventsReceived.WithLabelValues("delete", string(n.Source)).Inc()
 
@@ -502,6 +548,81 @@ func (m *Manager) NodeDeleted(n nodeTypes.Node) {
 
 	nodeIdentity := n.Identity()
 
+	gracePeriod := m.conf.NodeDeletionGracePeriodDuration()
+	if gracePeriod > 0 && !n.IsLocal() && n.Source != source.Local && n.Source != source.KVStore {
+		m.holdDeletion(nodeIdentity, n, gracePeriod)
+		return
+	}
+
+	// The deletion is processed immediately, any deletion held for the same
+	// node is implied by it.
+	m.cancelHeldDeletion(nodeIdentity)
+	m.nodeDeleted(n)
+}
+
+// holdDeletion schedules the deletion of the given node to be processed after
+// the grace period unless it is cancelled before, replacing any deletion
+// already held for the node.
+func (m *Manager) holdDeletion(nodeIdentity nodeTypes.Identity, n nodeTypes.Node, gracePeriod time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if held, ok := m.heldDeletions[nodeIdentity]; ok {
+		held.node = n
+		return
+	}
+
+	log.WithField(logfields.Node, n.Name).
+		Debugf("Holding node deletion from %s for %s", n.Source, gracePeriod)
+
+	m.heldDeletions[nodeIdentity] = &heldDeletion{
+		node: n,
+		timer: time.AfterFunc(gracePeriod, func() {
+			m.processHeldDeletion(nodeIdentity)
+		}),
+	}
+	m.metricHeldDeletions.Inc()
+}
+
+// cancelHeldDeletion cancels any deletion held for the given node identity.
+func (m *Manager) cancelHeldDeletion(nodeIdentity nodeTypes.Identity) {
+	m.mutex.Lock()
+	held, ok := m.heldDeletions[nodeIdentity]
+	if ok {
+		held.timer.Stop()
+		delete(m.heldDeletions, nodeIdentity)
+	}
+	m.mutex.Unlock()
+
+	if ok {
+		m.metricHeldDeletions.Dec()
+		log.WithField(logfields.Node, held.node.Name).
+			Debug("Cancelled held node deletion")
+	}
+}
+
+// processHeldDeletion processes a held deletion once its grace period has
+// expired, unless it has been cancelled in the meantime.
+func (m *Manager) processHeldDeletion(nodeIdentity nodeTypes.Identity) {
+	m.mutex.Lock()
+	held, ok := m.heldDeletions[nodeIdentity]
+	if ok {
+		delete(m.heldDeletions, nodeIdentity)
+	}
+	m.mutex.Unlock()
+
+	if !ok {
+		return
+	}
+
+	m.metricHeldDeletions.Dec()
+	m.nodeDeleted(held.node)
+}
+
+// nodeDeleted processes a node deletion event. See NodeDeleted for details.
+func (m *Manager) nodeDeleted(n nodeTypes.Node) {
+	nodeIdentity := n.Identity()
+
 	m.mutex.Lock()
 	entry, oldNodeExists := m.nodes[nodeIdentity]
 	if !oldNodeExists {
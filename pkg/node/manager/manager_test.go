@@ -45,9 +45,10 @@ type managerTestSuite struct{}
 var _ = check.Suite(&managerTestSuite{})
 
 type configMock struct {
-	RemoteNodeIdentity bool
-	NodeEncryption     bool
-	Encryption         bool
+	RemoteNodeIdentity      bool
+	NodeEncryption          bool
+	Encryption              bool
+	NodeDeletionGracePeriod time.Duration
 }
 
 func (c *configMock) RemoteNodeIdentitiesEnabled() bool {
@@ -62,6 +63,10 @@ func (c *configMock) EncryptionEnabled() bool {
 	return c.Encryption
 }
 
+func (c *configMock) NodeDeletionGracePeriodDuration() time.Duration {
+	return c.NodeDeletionGracePeriod
+}
+
 type nodeEvent struct {
 	event string
 	ip    net.IP
@@ -220,6 +225,86 @@ func (s *managerTestSuite) TestNodeLifecycle(c *check.C) {
 	}
 }
 
+func (s *managerTestSuite) TestNodeDeletionGracePeriod(c *check.C) {
+	dp := newSignalNodeHandler()
+	dp.EnableNodeAddEvent = true
+	dp.EnableNodeUpdateEvent = true
+	dp.EnableNodeDeleteEvent = true
+	mngr, err := NewManager("test", dp, newIPcacheMock(), &configMock{
+		NodeDeletionGracePeriod: 100 * time.Millisecond,
+	})
+	c.Assert(err, check.IsNil)
+	defer mngr.Close()
+
+	n1 := nodeTypes.Node{Name: "node1", Cluster: "c1", Source: source.Kubernetes}
+	mngr.NodeUpdated(n1)
+	select {
+	case nodeEvent := <-dp.NodeAddEvent:
+		c.Assert(nodeEvent, checker.DeepEquals, n1)
+	case <-time.After(3 * time.Second):
+		c.Errorf("timeout while waiting for NodeAdd() event for node1")
+	}
+
+	// A deletion from Kubernetes is held for the grace period and cancelled
+	// when the node reappears.
+	mngr.NodeDeleted(n1)
+	select {
+	case nodeEvent := <-dp.NodeDeleteEvent:
+		c.Errorf("Unexpected NodeDelete() event %#v while deletion is held", nodeEvent)
+	case <-time.After(50 * time.Millisecond):
+	}
+	_, ok := mngr.GetNodes()[n1.Identity()]
+	c.Assert(ok, check.Equals, true)
+
+	mngr.NodeUpdated(n1)
+	select {
+	case nodeEvent := <-dp.NodeUpdateEvent:
+		c.Assert(nodeEvent, checker.DeepEquals, n1)
+	case <-time.After(3 * time.Second):
+		c.Errorf("timeout while waiting for NodeUpdate() event for node1")
+	}
+
+	// The cancelled deletion must not be processed after the grace period.
+	select {
+	case nodeEvent := <-dp.NodeDeleteEvent:
+		c.Errorf("Unexpected NodeDelete() event %#v after cancelled deletion", nodeEvent)
+	case <-time.After(200 * time.Millisecond):
+	}
+	_, ok = mngr.GetNodes()[n1.Identity()]
+	c.Assert(ok, check.Equals, true)
+
+	// Without a reappearance, the held deletion is processed once the grace
+	// period expires.
+	mngr.NodeDeleted(n1)
+	select {
+	case nodeEvent := <-dp.NodeDeleteEvent:
+		c.Assert(nodeEvent, checker.DeepEquals, n1)
+	case <-time.After(3 * time.Second):
+		c.Errorf("timeout while waiting for NodeDelete() event for node1")
+	}
+	_, ok = mngr.GetNodes()[n1.Identity()]
+	c.Assert(ok, check.Equals, false)
+
+	// Deletions corroborated by the kvstore are processed immediately and
+	// cancel a held Kubernetes deletion.
+	n2 := nodeTypes.Node{Name: "node2", Cluster: "c1", Source: source.KVStore}
+	mngr.NodeUpdated(n2)
+	select {
+	case nodeEvent := <-dp.NodeAddEvent:
+		c.Assert(nodeEvent, checker.DeepEquals, n2)
+	case <-time.After(3 * time.Second):
+		c.Errorf("timeout while waiting for NodeAdd() event for node2")
+	}
+
+	mngr.NodeDeleted(n2)
+	select {
+	case nodeEvent := <-dp.NodeDeleteEvent:
+		c.Assert(nodeEvent, checker.DeepEquals, n2)
+	case <-time.After(3 * time.Second):
+		c.Errorf("timeout while waiting for NodeDelete() event for node2")
+	}
+}
+
 func (s *managerTestSuite) TestMultipleSources(c *check.C) {
 	dp := newSignalNodeHandler()
 	dp.EnableNodeAddEvent = true
@@ -85,6 +85,11 @@ const (
 	// ARPPingRefreshPeriod is the ARP entries refresher period
 	ARPPingRefreshPeriod = "arping-refresh-period"
 
+	// NodeDeletionGracePeriod is the time node deletions from non-kvstore
+	// sources are held before being processed, to protect against transient
+	// control-plane hiccups
+	NodeDeletionGracePeriod = "node-deletion-grace-period"
+
 	// BPFRoot is the Path to BPF filesystem
 	BPFRoot = "bpf-root"
 
@@ -109,6 +114,11 @@ const (
 	// ConntrackGCInterval is the name of the ConntrackGCInterval option
 	ConntrackGCInterval = "conntrack-gc-interval"
 
+	// DatapathMapReconciliationInterval is the interval of the periodic
+	// reconciliation of BPF maps against the agent's desired state. 0
+	// disables the reconciliation.
+	DatapathMapReconciliationInterval = "datapath-map-reconciliation-interval"
+
 	// DebugArg is the argument enables debugging mode
 	DebugArg = "debug"
 
@@ -212,6 +222,10 @@ const (
 	// AllocatorListTimeout is the timeout to list initial allocator state.
 	AllocatorListTimeoutName = "allocator-list-timeout"
 
+	// ShutdownDrainTimeoutName is the timeout to drain in-flight proxied
+	// requests and flush buffered Hubble flows on shutdown.
+	ShutdownDrainTimeoutName = "shutdown-drain-timeout"
+
 	// KeepConfig when restoring state, keeps containers' configuration in place
 	KeepConfig = "keep-config"
 
@@ -230,6 +244,9 @@ const (
 	// EnableHostFirewall enables network policies for the host
 	EnableHostFirewall = "enable-host-firewall"
 
+	// HostFirewallAuditMode enables audit (non-drop) mode for host policies
+	HostFirewallAuditMode = "host-firewall-audit-mode"
+
 	// EnableHostPort enables HostPort forwarding implemented by Cilium in BPF
 	EnableHostPort = "enable-host-port"
 
@@ -242,6 +259,27 @@ const (
 	// EnableSVCSourceRangeCheck enables check of service source range checks
 	EnableSVCSourceRangeCheck = "enable-svc-source-range-check"
 
+	// LBBackendDrainGracePeriod is the maximum time terminating service
+	// backends are kept in the datapath for connection draining
+	LBBackendDrainGracePeriod = "lb-backend-drain-grace-period"
+
+	// EnableLBBackendHealthCheck enables active health probing of service
+	// backends from the node
+	EnableLBBackendHealthCheck = "enable-lb-backend-health-check"
+
+	// LBBackendHealthCheckInterval is the interval between two active
+	// health probes of a service backend
+	LBBackendHealthCheckInterval = "lb-backend-health-check-interval"
+
+	// EnableServiceTopology enables topology aware routing of service
+	// backends based on the EndpointSlice hints
+	EnableServiceTopology = "enable-service-topology"
+
+	// ServiceTopologyMinBackendsRatio is the minimum ratio of local zone
+	// backends to total backends below which topology aware routing falls
+	// back to the full backend set
+	ServiceTopologyMinBackendsRatio = "service-topology-min-backends-ratio"
+
 	// NodePortMode indicates in which mode NodePort implementation should run
 	// ("snat", "dsr" or "hybrid")
 	NodePortMode = "node-port-mode"
@@ -304,6 +342,11 @@ const (
 	// conflicting marks.
 	EnableIdentityMark = "enable-identity-mark"
 
+	// EnableIdentityMigration enables the lazy recomputation of security
+	// identities for restored endpoints after the identity relevant labels
+	// configuration has changed.
+	EnableIdentityMigration = "enable-identity-migration"
+
 	// EnableBandwidthManager enables EDT-based pacing
 	EnableBandwidthManager = "enable-bandwidth-manager"
 
@@ -346,6 +389,10 @@ const (
 	// EnableEgressGateway enables egress-gateway
 	EnableEgressGateway = "enable-egress-gateway"
 
+	// EgressGatewayHealthCheckInterval is the interval between egress
+	// gateway liveness probes
+	EgressGatewayHealthCheckInterval = "egress-gateway-health-check-interval"
+
 	// IPMasqAgentConfigPath is the configuration file path
 	IPMasqAgentConfigPath = "ip-masq-agent-config-path"
 
@@ -417,6 +464,11 @@ const (
 	// for each FQDN name in an endpoint's FQDN cache
 	ToFQDNsMaxIPsPerHost = "tofqdns-endpoint-max-ip-per-hostname"
 
+	// ToFQDNsEndpointMaxQueriesPerSecond is the maximum number of DNS queries
+	// per second that the DNS proxy accepts from a single endpoint. 0
+	// disables rate limiting.
+	ToFQDNsEndpointMaxQueriesPerSecond = "tofqdns-endpoint-max-queries-per-second"
+
 	// ToFQDNsMaxDeferredConnectionDeletes defines the maximum number of IPs to
 	// retain for expired DNS lookups with still-active connections"
 	ToFQDNsMaxDeferredConnectionDeletes = "tofqdns-max-deferred-connection-deletes"
@@ -670,12 +722,21 @@ const (
 	// IdentityChangeGracePeriod option
 	IdentityChangeGracePeriod = "identity-change-grace-period"
 
+	// IdentityAuditInterval is the name of the IdentityAuditInterval option
+	IdentityAuditInterval = "identity-audit-interval"
+
+	// IdentityAuditRepair is the name of the IdentityAuditRepair option
+	IdentityAuditRepair = "identity-audit-repair"
+
 	// EnableHealthChecking is the name of the EnableHealthChecking option
 	EnableHealthChecking = "enable-health-checking"
 
 	// EnableEndpointHealthChecking is the name of the EnableEndpointHealthChecking option
 	EnableEndpointHealthChecking = "enable-endpoint-health-checking"
 
+	// HealthProbes is the name of the HealthProbes option
+	HealthProbes = "health-probes"
+
 	// EnableHealthCheckNodePort is the name of the EnableHealthCheckNodePort option
 	EnableHealthCheckNodePort = "enable-health-check-nodeport"
 
@@ -686,6 +747,10 @@ const (
 	// EndpointQueueSize is the size of the EventQueue per-endpoint.
 	EndpointQueueSize = "endpoint-queue-size"
 
+	// EndpointRegenParallelism is the maximum number of endpoints which are
+	// regenerated in parallel from the bulk regeneration queue.
+	EndpointRegenParallelism = "endpoint-regen-parallelism"
+
 	// EndpointGCInterval interval to attempt garbage collection of
 	// endpoints that are no longer alive and healthy.
 	EndpointGCInterval = "endpoint-gc-interval"
@@ -742,6 +807,12 @@ const (
 	// IPAM is the IPAM method to use
 	IPAM = "ipam"
 
+	// IPAMOrphanGCInterval is the name of the IPAMOrphanGCInterval option
+	IPAMOrphanGCInterval = "ipam-orphan-gc-interval"
+
+	// IPAMOrphanGCReportOnly is the name of the IPAMOrphanGCReportOnly option
+	IPAMOrphanGCReportOnly = "ipam-orphan-gc-report-only"
+
 	// XDPModeNative for loading progs with XDPModeLinkDriver
 	XDPModeNative = "native"
 
@@ -853,6 +924,19 @@ const (
 	// HubbleMetrics specifies enabled metrics and their configuration options.
 	HubbleMetrics = "hubble-metrics"
 
+	// HubbleFlowAggregationThreshold specifies the number of events a
+	// connection may produce before its per-packet trace events are
+	// aggregated into periodic summary flows. 0 disables aggregation.
+	HubbleFlowAggregationThreshold = "hubble-flow-aggregation-threshold"
+
+	// HubbleFlowAggregationInterval specifies the interval in which a
+	// summary flow is emitted for an aggregated connection.
+	HubbleFlowAggregationInterval = "hubble-flow-aggregation-interval"
+
+	// HubbleFlowAggregationVerdicts specifies the flow verdicts subject to
+	// aggregation. Dropped flows are never aggregated.
+	HubbleFlowAggregationVerdicts = "hubble-flow-aggregation-verdicts"
+
 	// HubbleExportFilePath specifies the filepath to write Hubble events to.
 	// e.g. "/var/run/cilium/hubble/events.log"
 	HubbleExportFilePath = "hubble-export-file-path"
@@ -867,6 +951,10 @@ const (
 	// HubbleExportFileCompress specifies whether rotated files are compressed.
 	HubbleExportFileCompress = "hubble-export-file-compress"
 
+	// HubbleExportConfigPath specifies the filepath of the dynamic exporter
+	// configuration, which is watched and reloaded at runtime.
+	HubbleExportConfigPath = "hubble-export-config-path"
+
 	// EnableHubbleRecorderAPI specifies if the Hubble Recorder API should be served
 	EnableHubbleRecorderAPI = "enable-hubble-recorder-api"
 
@@ -940,6 +1028,10 @@ const (
 	// for user-defined custom eBPF programs.
 	EnableCustomCallsName = "enable-custom-calls"
 
+	// EnablePolicyMapProvenanceName is the name of the option to retain
+	// the rule labels each policy map entry was derived from.
+	EnablePolicyMapProvenanceName = "enable-policy-map-provenance"
+
 	// BGPAnnounceLBIP announces service IPs of type LoadBalancer via BGP
 	BGPAnnounceLBIP = "bgp-announce-lb-ip"
 
@@ -988,6 +1080,15 @@ const (
 	// HTTPRequestTimeout specifies the time in seconds after which forwarded requests time out
 	HTTPRequestTimeout = "http-request-timeout"
 
+	// ProxyAccessLogSampleRate specifies that only one out of every N allowed
+	// L7 requests is emitted as an access log record. Denied requests are
+	// always logged.
+	ProxyAccessLogSampleRate = "proxy-accesslog-sample-rate"
+
+	// ProxyAccessLogRedactFields is the list of header and query parameter
+	// names whose values are redacted in L7 access log records
+	ProxyAccessLogRedactFields = "proxy-accesslog-redact-fields"
+
 	// HTTPIdleTimeout spcifies the time in seconds if http stream being idle after which the
 	// request times out
 	HTTPIdleTimeout = "http-idle-timeout"
@@ -1006,6 +1107,20 @@ const (
 	// is considered timed out
 	ProxyConnectTimeout = "proxy-connect-timeout"
 
+	// ProxyRedirectFallback selects how policy map entries of an L7
+	// redirect behave while its proxy listener is not confirmed listening
+	ProxyRedirectFallback = "proxy-redirect-fallback"
+
+	// ProxyRedirectFallbackDeny leaves the policy map entries of an
+	// unavailable redirect uninstalled, failing closed until the proxy is
+	// up
+	ProxyRedirectFallbackDeny = "deny"
+
+	// ProxyRedirectFallbackAllow installs the policy map entries of an
+	// unavailable redirect as plain L4 allows, bypassing the proxy until
+	// it is up
+	ProxyRedirectFallbackAllow = "allow"
+
 	// ReadCNIConfiguration reads the CNI configuration file and extracts
 	// Cilium relevant information. This can be used to pass per node
 	// configuration to Cilium.
@@ -1320,6 +1435,15 @@ type DaemonConfig struct {
 	// than the HTTPIdleTimeout
 	HTTPRequestTimeout int
 
+	// ProxyAccessLogSampleRate causes only one out of every N allowed L7
+	// requests to be emitted as an access log record. Denied requests are
+	// always logged. 0 or 1 logs every request.
+	ProxyAccessLogSampleRate int
+
+	// ProxyAccessLogRedactFields is the list of header and query parameter
+	// names whose values are redacted in L7 access log records
+	ProxyAccessLogRedactFields []string
+
 	// HTTPIdleTimeout is the time in seconds of a HTTP stream having no traffic after
 	// which Envoy responds with an error code. This needs to be shorter than the
 	// HTTPRequestTimeout
@@ -1343,6 +1467,12 @@ type DaemonConfig struct {
 	// ProxyPrometheusPort specifies the port to serve Envoy metrics on.
 	ProxyPrometheusPort int
 
+	// ProxyRedirectFallback selects how policy map entries of an L7
+	// redirect behave while its proxy listener is not confirmed
+	// listening, either ProxyRedirectFallbackDeny or
+	// ProxyRedirectFallbackAllow
+	ProxyRedirectFallback string
+
 	// EnvoyLogPath specifies where to store the Envoy proxy logs when Envoy
 	// runs in the same container as Cilium.
 	EnvoyLogPath string
@@ -1428,6 +1558,7 @@ type DaemonConfig struct {
 	K8sClientQPSLimit             float64
 	K8sSyncTimeout                time.Duration
 	AllocatorListTimeout          time.Duration
+	ShutdownDrainTimeout          time.Duration
 	K8sWatcherEndpointSelector    string
 	KVStore                       string
 	KVStoreOpt                    map[string]string
@@ -1441,13 +1572,18 @@ type DaemonConfig struct {
 
 	// Masquerade specifies whether or not to masquerade packets from endpoints
 	// leaving the host.
-	EnableIPv4Masquerade   bool
-	EnableIPv6Masquerade   bool
-	EnableBPFMasquerade    bool
-	EnableBPFClockProbe    bool
-	EnableIPMasqAgent      bool
-	EnableEgressGateway    bool
-	IPMasqAgentConfigPath  string
+	EnableIPv4Masquerade  bool
+	EnableIPv6Masquerade  bool
+	EnableBPFMasquerade   bool
+	EnableBPFClockProbe   bool
+	EnableIPMasqAgent     bool
+	EnableEgressGateway   bool
+	IPMasqAgentConfigPath string
+
+	// EgressGatewayHealthCheckInterval is the interval between egress
+	// gateway liveness probes. A value of 0 disables health checking.
+	EgressGatewayHealthCheckInterval time.Duration
+
 	InstallIptRules        bool
 	MonitorAggregation     string
 	PreAllocateMaps        bool
@@ -1476,6 +1612,12 @@ type DaemonConfig struct {
 	// for each FQDN name in an endpoint's FQDN cache
 	ToFQDNsMaxIPsPerHost int
 
+	// ToFQDNsEndpointMaxQueriesPerSecond is the maximum number of DNS
+	// queries per second that the DNS proxy accepts from a single endpoint.
+	// 0 disables rate limiting. DNS rules may override this default per
+	// destination port.
+	ToFQDNsEndpointMaxQueriesPerSecond int
+
 	// ToFQDNsMaxIPsPerHost defines the maximum number of IPs to retain for
 	// expired DNS lookups with still-active connections
 	ToFQDNsMaxDeferredConnectionDeletes int
@@ -1527,6 +1669,10 @@ type DaemonConfig struct {
 	// health endpoints
 	EnableEndpointHealthChecking bool
 
+	// HealthProbes is the subset of connectivity health probe types to
+	// run. An empty list enables all probe types.
+	HealthProbes []string
+
 	// EnableHealthCheckNodePort enables health checking of NodePort by
 	// cilium
 	EnableHealthCheckNodePort bool
@@ -1556,6 +1702,15 @@ type DaemonConfig struct {
 	// to whitelist the new upcoming identity of the endpoint.
 	IdentityChangeGracePeriod time.Duration
 
+	// IdentityAuditInterval is the interval in which allocated identities
+	// are cross-checked against their known users to detect reference
+	// count leaks. An interval of 0 disables the audit.
+	IdentityAuditInterval time.Duration
+
+	// IdentityAuditRepair instructs the identity audit to release
+	// identities for which no user could be found.
+	IdentityAuditRepair bool
+
 	// PolicyQueueSize is the size of the queues for the policy repository.
 	// A larger queue means that more events related to policy can be buffered.
 	PolicyQueueSize int
@@ -1566,6 +1721,11 @@ type DaemonConfig struct {
 	// events, specifically those which cause many regenerations.
 	EndpointQueueSize int
 
+	// EndpointRegenParallelism is the maximum number of endpoints which are
+	// regenerated in parallel from the bulk regeneration queue. A value of 0
+	// does not bound the parallelism.
+	EndpointRegenParallelism int
+
 	// EndpointGCInterval is interval to attempt garbage collection of
 	// endpoints that are no longer alive and healthy.
 	EndpointGCInterval time.Duration
@@ -1581,6 +1741,11 @@ type DaemonConfig struct {
 	// interval
 	ConntrackGCInterval time.Duration
 
+	// DatapathMapReconciliationInterval is the interval of the periodic
+	// reconciliation of BPF maps against the agent's desired state. 0
+	// disables the reconciliation.
+	DatapathMapReconciliationInterval time.Duration
+
 	// K8sEventHandover enables use of the kvstore to optimize Kubernetes
 	// event handling by listening for k8s events in the operator and
 	// mirroring it into the kvstore for reduced overhead in large
@@ -1633,6 +1798,30 @@ type DaemonConfig struct {
 	// EnableSVCSourceRangeCheck enables check of loadBalancerSourceRanges
 	EnableSVCSourceRangeCheck bool
 
+	// LBBackendDrainGracePeriod is the maximum time terminating service
+	// backends are kept in the datapath for connection draining before
+	// being removed. A zero value keeps them until they are removed from
+	// Kubernetes.
+	LBBackendDrainGracePeriod time.Duration
+
+	// EnableLBBackendHealthCheck enables active health probing of service
+	// backends from the node. Backends failing the probes are quarantined
+	// in the datapath until the probes succeed again.
+	EnableLBBackendHealthCheck bool
+
+	// LBBackendHealthCheckInterval is the interval between two active
+	// health probes of a service backend.
+	LBBackendHealthCheckInterval time.Duration
+
+	// EnableServiceTopology enables topology aware routing of service
+	// backends based on the EndpointSlice hints.
+	EnableServiceTopology bool
+
+	// ServiceTopologyMinBackendsRatio is the minimum ratio of local zone
+	// backends to total backends below which topology aware routing falls
+	// back to the full backend set.
+	ServiceTopologyMinBackendsRatio float64
+
 	// EnableHealthDatapath enables IPIP health probes data path
 	EnableHealthDatapath bool
 
@@ -1711,6 +1900,11 @@ type DaemonConfig struct {
 	// EnableHostFirewall enables network policies for the host
 	EnableHostFirewall bool
 
+	// HostFirewallAuditMode enables audit (non-drop) mode for the host
+	// endpoint: packets which would be denied by host policies are allowed
+	// but recorded and aggregated by the host firewall audit collector.
+	HostFirewallAuditMode bool
+
 	// EnableLocalRedirectPolicy enables redirect policies to redirect traffic within nodes
 	EnableLocalRedirectPolicy bool
 
@@ -1735,6 +1929,11 @@ type DaemonConfig struct {
 	// conflicting marks.
 	EnableIdentityMark bool
 
+	// EnableIdentityMigration enables the lazy recomputation of security
+	// identities for restored endpoints after the identity relevant labels
+	// configuration has changed.
+	EnableIdentityMigration bool
+
 	// KernelHz is the HZ rate the kernel is operating in
 	KernelHz int
 
@@ -1751,6 +1950,16 @@ type DaemonConfig struct {
 	// IPAM is the IPAM method to use
 	IPAM string
 
+	// IPAMOrphanGCInterval is the interval in which the IPAM allocations
+	// are cross-checked against existing endpoints to reclaim IPs left
+	// behind by crashed CNI DEL calls. An interval of 0 disables the
+	// garbage collection.
+	IPAMOrphanGCInterval time.Duration
+
+	// IPAMOrphanGCReportOnly instructs the IPAM orphan garbage collection
+	// to only report orphaned IPs instead of releasing them.
+	IPAMOrphanGCReportOnly bool
+
 	// AutoCreateCiliumNodeResource enables automatic creation of a
 	// CiliumNode resource for the local node
 	AutoCreateCiliumNodeResource bool
@@ -1835,6 +2044,19 @@ type DaemonConfig struct {
 	// HubbleMetrics specifies enabled metrics and their configuration options.
 	HubbleMetrics []string
 
+	// HubbleFlowAggregationThreshold specifies the number of events a
+	// connection may produce before its per-packet trace events are
+	// aggregated into periodic summary flows. 0 disables aggregation.
+	HubbleFlowAggregationThreshold int
+
+	// HubbleFlowAggregationInterval specifies the interval in which a
+	// summary flow is emitted for an aggregated connection.
+	HubbleFlowAggregationInterval time.Duration
+
+	// HubbleFlowAggregationVerdicts specifies the flow verdicts subject to
+	// aggregation. Dropped flows are never aggregated.
+	HubbleFlowAggregationVerdicts []string
+
 	// HubbleExportFilePath specifies the filepath to write Hubble events to.
 	// e.g. "/var/run/cilium/hubble/events.log"
 	HubbleExportFilePath string
@@ -1849,6 +2071,10 @@ type DaemonConfig struct {
 	// HubbleExportFileCompress specifies whether rotated files are compressed.
 	HubbleExportFileCompress bool
 
+	// HubbleExportConfigPath specifies the filepath of the dynamic exporter
+	// configuration, which is watched and reloaded at runtime.
+	HubbleExportConfigPath string
+
 	// EnableHubbleRecorderAPI specifies if the Hubble Recorder API should be served
 	EnableHubbleRecorderAPI bool
 
@@ -1936,6 +2162,12 @@ type DaemonConfig struct {
 	// metrics.
 	EnableCustomCalls bool
 
+	// EnablePolicyMapProvenance retains the labels of the rules each
+	// policy map entry was derived from, so that a policy map key can be
+	// mapped back to the policies that created its entry. Opt-in, as the
+	// labels can dominate the memory footprint of large policies.
+	EnablePolicyMapProvenance bool
+
 	// BGPAnnounceLBIP announces service IPs of type LoadBalancer via BGP.
 	BGPAnnounceLBIP bool
 
@@ -1949,6 +2181,11 @@ type DaemonConfig struct {
 
 	// ARPPingRefreshPeriod is the ARP entries refresher period.
 	ARPPingRefreshPeriod time.Duration
+
+	// NodeDeletionGracePeriod is the time node deletions from non-kvstore
+	// sources are held before being processed. Held deletions are cancelled
+	// if the node reappears. 0 disables the grace period.
+	NodeDeletionGracePeriod time.Duration
 }
 
 var (
@@ -1991,6 +2228,7 @@ var (
 		K8sEnableK8sEndpointSlice:    defaults.K8sEnableEndpointSlice,
 		k8sEnableAPIDiscovery:        defaults.K8sEnableAPIDiscovery,
 		AllocatorListTimeout:         defaults.AllocatorListTimeout,
+		ShutdownDrainTimeout:         defaults.ShutdownDrainTimeout,
 
 		k8sEnableLeasesFallbackDiscovery: defaults.K8sEnableLeasesFallbackDiscovery,
 		APIRateLimit:                     make(map[string]string),
@@ -2069,6 +2307,12 @@ func (c *DaemonConfig) NodeEncryptionEnabled() bool {
 	return c.EncryptNode
 }
 
+// NodeDeletionGracePeriodDuration returns the time node deletions from
+// non-kvstore sources are held before being processed
+func (c *DaemonConfig) NodeDeletionGracePeriodDuration() time.Duration {
+	return c.NodeDeletionGracePeriod
+}
+
 // EncryptionEnabled returns true if encryption is enabled
 func (c *DaemonConfig) EncryptionEnabled() bool {
 	return c.EnableIPSec
@@ -2347,6 +2591,7 @@ func (c *DaemonConfig) Populate() {
 	c.AllowLocalhost = viper.GetString(AllowLocalhost)
 	c.AnnotateK8sNode = viper.GetBool(AnnotateK8sNode)
 	c.ARPPingRefreshPeriod = viper.GetDuration(ARPPingRefreshPeriod)
+	c.NodeDeletionGracePeriod = viper.GetDuration(NodeDeletionGracePeriod)
 	c.AutoCreateCiliumNodeResource = viper.GetBool(AutoCreateCiliumNodeResource)
 	c.BPFRoot = viper.GetString(BPFRoot)
 	c.CertDirectory = viper.GetString(CertsDirectory)
@@ -2380,6 +2625,7 @@ func (c *DaemonConfig) Populate() {
 	c.EnableEndpointRoutes = viper.GetBool(EnableEndpointRoutes)
 	c.EnableHealthChecking = viper.GetBool(EnableHealthChecking)
 	c.EnableEndpointHealthChecking = viper.GetBool(EnableEndpointHealthChecking)
+	c.HealthProbes = viper.GetStringSlice(HealthProbes)
 	c.EnableHealthCheckNodePort = viper.GetBool(EnableHealthCheckNodePort)
 	c.EnableLocalNodeRoute = viper.GetBool(EnableLocalNodeRoute)
 	c.EnablePolicy = strings.ToLower(viper.GetString(EnablePolicy))
@@ -2388,6 +2634,11 @@ func (c *DaemonConfig) Populate() {
 	c.EnableTracing = viper.GetBool(EnableTracing)
 	c.EnableNodePort = viper.GetBool(EnableNodePort)
 	c.EnableSVCSourceRangeCheck = viper.GetBool(EnableSVCSourceRangeCheck)
+	c.LBBackendDrainGracePeriod = viper.GetDuration(LBBackendDrainGracePeriod)
+	c.EnableLBBackendHealthCheck = viper.GetBool(EnableLBBackendHealthCheck)
+	c.LBBackendHealthCheckInterval = viper.GetDuration(LBBackendHealthCheckInterval)
+	c.EnableServiceTopology = viper.GetBool(EnableServiceTopology)
+	c.ServiceTopologyMinBackendsRatio = viper.GetFloat64(ServiceTopologyMinBackendsRatio)
 	c.EnableHostPort = viper.GetBool(EnableHostPort)
 	c.EnableHostLegacyRouting = viper.GetBool(EnableHostLegacyRouting)
 	c.MaglevTableSize = viper.GetInt(MaglevTableSize)
@@ -2399,6 +2650,7 @@ func (c *DaemonConfig) Populate() {
 	c.EnableBandwidthManager = viper.GetBool(EnableBandwidthManager)
 	c.EnableRecorder = viper.GetBool(EnableRecorder)
 	c.EnableHostFirewall = viper.GetBool(EnableHostFirewall)
+	c.HostFirewallAuditMode = viper.GetBool(HostFirewallAuditMode)
 	c.EnableLocalRedirectPolicy = viper.GetBool(EnableLocalRedirectPolicy)
 	c.EncryptInterface = viper.GetStringSlice(EncryptInterface)
 	c.EncryptNode = viper.GetBool(EncryptNode)
@@ -2409,10 +2661,16 @@ func (c *DaemonConfig) Populate() {
 	c.HTTPIdleTimeout = viper.GetInt(HTTPIdleTimeout)
 	c.HTTPMaxGRPCTimeout = viper.GetInt(HTTPMaxGRPCTimeout)
 	c.HTTPRequestTimeout = viper.GetInt(HTTPRequestTimeout)
+	c.ProxyAccessLogSampleRate = viper.GetInt(ProxyAccessLogSampleRate)
+	c.ProxyAccessLogRedactFields = viper.GetStringSlice(ProxyAccessLogRedactFields)
 	c.HTTPRetryCount = viper.GetInt(HTTPRetryCount)
 	c.HTTPRetryTimeout = viper.GetInt(HTTPRetryTimeout)
 	c.IdentityChangeGracePeriod = viper.GetDuration(IdentityChangeGracePeriod)
+	c.IdentityAuditInterval = viper.GetDuration(IdentityAuditInterval)
+	c.IdentityAuditRepair = viper.GetBool(IdentityAuditRepair)
 	c.IPAM = viper.GetString(IPAM)
+	c.IPAMOrphanGCInterval = viper.GetDuration(IPAMOrphanGCInterval)
+	c.IPAMOrphanGCReportOnly = viper.GetBool(IPAMOrphanGCReportOnly)
 	c.IPv4Range = viper.GetString(IPv4Range)
 	c.IPv4NodeAddr = viper.GetString(IPv4NodeAddr)
 	c.IPv4ServiceRange = viper.GetString(IPv4ServiceRange)
@@ -2453,6 +2711,7 @@ func (c *DaemonConfig) Populate() {
 	c.EnableBPFClockProbe = viper.GetBool(EnableBPFClockProbe)
 	c.EnableIPMasqAgent = viper.GetBool(EnableIPMasqAgent)
 	c.EnableEgressGateway = viper.GetBool(EnableEgressGateway)
+	c.EgressGatewayHealthCheckInterval = viper.GetDuration(EgressGatewayHealthCheckInterval)
 	c.IPMasqAgentConfigPath = viper.GetString(IPMasqAgentConfigPath)
 	c.InstallIptRules = viper.GetBool(InstallIptRules)
 	c.IPTablesLockTimeout = viper.GetDuration(IPTablesLockTimeout)
@@ -2472,6 +2731,7 @@ func (c *DaemonConfig) Populate() {
 	c.PrometheusServeAddr = viper.GetString(PrometheusServeAddr)
 	c.ProxyConnectTimeout = viper.GetInt(ProxyConnectTimeout)
 	c.ProxyPrometheusPort = viper.GetInt(ProxyPrometheusPort)
+	c.ProxyRedirectFallback = viper.GetString(ProxyRedirectFallback)
 	c.ReadCNIConfiguration = viper.GetString(ReadCNIConfiguration)
 	c.RestoreState = viper.GetBool(Restore)
 	c.RunDir = viper.GetString(StateDir)
@@ -2479,6 +2739,7 @@ func (c *DaemonConfig) Populate() {
 	c.UseSingleClusterRoute = viper.GetBool(SingleClusterRouteName)
 	c.SocketPath = viper.GetString(SocketPath)
 	c.SockopsEnable = viper.GetBool(SockopsEnableName)
+	c.ShutdownDrainTimeout = viper.GetDuration(ShutdownDrainTimeoutName)
 	c.TracePayloadlen = viper.GetInt(TracePayloadlen)
 	c.Tunnel = viper.GetString(TunnelName)
 	c.Version = viper.GetString(Version)
@@ -2502,6 +2763,7 @@ func (c *DaemonConfig) Populate() {
 	c.EnableBPFBypassFIBLookup = viper.GetBool(EnableBPFBypassFIBLookup)
 	c.InstallNoConntrackIptRules = viper.GetBool(InstallNoConntrackIptRules)
 	c.EnableCustomCalls = viper.GetBool(EnableCustomCallsName)
+	c.EnablePolicyMapProvenance = viper.GetBool(EnablePolicyMapProvenanceName)
 	c.BGPAnnounceLBIP = viper.GetBool(BGPAnnounceLBIP)
 	c.BGPConfigPath = viper.GetString(BGPConfigPath)
 	c.ExternalClusterIP = viper.GetBool(ExternalClusterIPName)
@@ -2541,10 +2803,12 @@ func (c *DaemonConfig) Populate() {
 
 	c.ClockSource = ClockSourceKtime
 	c.EnableIdentityMark = viper.GetBool(EnableIdentityMark)
+	c.EnableIdentityMigration = viper.GetBool(EnableIdentityMigration)
 
 	// toFQDNs options
 	c.DNSMaxIPsPerRestoredRule = viper.GetInt(DNSMaxIPsPerRestoredRule)
 	c.ToFQDNsMaxIPsPerHost = viper.GetInt(ToFQDNsMaxIPsPerHost)
+	c.ToFQDNsEndpointMaxQueriesPerSecond = viper.GetInt(ToFQDNsEndpointMaxQueriesPerSecond)
 	if maxZombies := viper.GetInt(ToFQDNsMaxDeferredConnectionDeletes); maxZombies >= 0 {
 		c.ToFQDNsMaxDeferredConnectionDeletes = viper.GetInt(ToFQDNsMaxDeferredConnectionDeletes)
 	} else {
@@ -2612,6 +2876,7 @@ func (c *DaemonConfig) Populate() {
 	}
 
 	c.ConntrackGCInterval = viper.GetDuration(ConntrackGCInterval)
+	c.DatapathMapReconciliationInterval = viper.GetDuration(DatapathMapReconciliationInterval)
 
 	if m := viper.GetStringMapString(KVStoreOpt); len(m) != 0 {
 		c.KVStoreOpt = m
@@ -2707,10 +2972,14 @@ func (c *DaemonConfig) Populate() {
 	}
 	c.HubbleMetricsServer = viper.GetString(HubbleMetricsServer)
 	c.HubbleMetrics = viper.GetStringSlice(HubbleMetrics)
+	c.HubbleFlowAggregationThreshold = viper.GetInt(HubbleFlowAggregationThreshold)
+	c.HubbleFlowAggregationInterval = viper.GetDuration(HubbleFlowAggregationInterval)
+	c.HubbleFlowAggregationVerdicts = viper.GetStringSlice(HubbleFlowAggregationVerdicts)
 	c.HubbleExportFilePath = viper.GetString(HubbleExportFilePath)
 	c.HubbleExportFileMaxSizeMB = viper.GetInt(HubbleExportFileMaxSizeMB)
 	c.HubbleExportFileMaxBackups = viper.GetInt(HubbleExportFileMaxBackups)
 	c.HubbleExportFileCompress = viper.GetBool(HubbleExportFileCompress)
+	c.HubbleExportConfigPath = viper.GetString(HubbleExportConfigPath)
 	c.EnableHubbleRecorderAPI = viper.GetBool(EnableHubbleRecorderAPI)
 	c.HubbleRecorderStoragePath = viper.GetString(HubbleRecorderStoragePath)
 	c.HubbleRecorderSinkQueueSize = viper.GetInt(HubbleRecorderSinkQueueSize)
@@ -2725,6 +2994,7 @@ func (c *DaemonConfig) Populate() {
 	c.MaxControllerInterval = viper.GetInt(MaxCtrlIntervalName)
 	c.PolicyQueueSize = sanitizeIntParam(PolicyQueueSize, defaults.PolicyQueueSize)
 	c.EndpointQueueSize = sanitizeIntParam(EndpointQueueSize, defaults.EndpointQueueSize)
+	c.EndpointRegenParallelism = viper.GetInt(EndpointRegenParallelism)
 	c.EndpointGCInterval = viper.GetDuration(EndpointGCInterval)
 	c.SelectiveRegeneration = viper.GetBool(SelectiveRegeneration)
 	c.DisableCNPStatusUpdates = viper.GetBool(DisableCNPStatusUpdates)
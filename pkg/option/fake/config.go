@@ -14,6 +14,10 @@
 
 package fake
 
+import (
+	"time"
+)
+
 type Config struct{}
 
 // LocalClusterName returns the name of the cluster Cilium is deployed in
@@ -42,3 +46,9 @@ func (f *Config) EncryptionEnabled() bool {
 func (f *Config) NodeEncryptionEnabled() bool {
 	return true
 }
+
+// NodeDeletionGracePeriodDuration returns the duration to hold node deletions
+// for. The fake configuration disables the grace period.
+func (f *Config) NodeDeletionGracePeriodDuration() time.Duration {
+	return 0
+}
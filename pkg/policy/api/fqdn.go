@@ -111,7 +111,51 @@ func (s *FQDNSelector) ToRegex() (*regexp.Regexp, error) {
 }
 
 // PortRuleDNS is a list of allowed DNS lookups.
-type PortRuleDNS FQDNSelector
+type PortRuleDNS struct {
+	// MatchName matches literal DNS names. A trailing "." is automatically added
+	// when missing.
+	//
+	// +kubebuilder:validation:Pattern=`^([-a-zA-Z0-9_]+[.]?)+$`
+	MatchName string `json:"matchName,omitempty"`
+
+	// MatchPattern allows using wildcards to match DNS names. All wildcards are
+	// case insensitive. The wildcards are:
+	// - "*" matches 0 or more DNS valid characters, and may occur anywhere in
+	// the pattern. As a special case a "*" as the leftmost character, without a
+	// following "." matches all subdomains as well as the name to the right.
+	// A trailing "." is automatically added when missing.
+	//
+	// Examples:
+	// `*.cilium.io` matches subomains of cilium at that level
+	//   www.cilium.io and blog.cilium.io match, cilium.io and google.com do not
+	// `*cilium.io` matches cilium.io and all subdomains 1 level below
+	//   www.cilium.io, blog.cilium.io and cilium.io match, google.com does not
+	// sub*.cilium.io matches subdomains of cilium where the subdomain component
+	// begins with "sub"
+	//   sub.cilium.io and subdomain.cilium.io match, www.cilium.io,
+	//   blog.cilium.io, cilium.io and google.com do not
+	//
+	// +kubebuilder:validation:Pattern=`^([-a-zA-Z0-9_*]+[.]?)+$`
+	MatchPattern string `json:"matchPattern,omitempty"`
+
+	// QueriesPerSecond limits the number of DNS queries per second that the
+	// DNS proxy accepts from the source endpoint, overriding the proxy-wide
+	// default configured with --tofqdns-endpoint-max-queries-per-second.
+	// When multiple DNS rules with a limit apply to an endpoint, the largest
+	// limit is used. 0 means that the proxy-wide default applies.
+	//
+	// +kubebuilder:validation:Minimum=0
+	QueriesPerSecond uint32 `json:"queriesPerSecond,omitempty"`
+
+	// Passthrough, when set on any DNS rule that applies to an endpoint and
+	// destination port, causes queries that match none of the allowed names
+	// to be forwarded to the DNS server instead of being refused. The
+	// responses to such queries are not recorded, so their IPs are not
+	// granted any toFQDNs-based identity. Passthrough only applies to
+	// queries that were already allowed to reach the DNS server by the
+	// L3/L4 policy; it does not widen the set of reachable servers.
+	Passthrough bool `json:"passthrough,omitempty"`
+}
 
 // Sanitize checks that the matchName in the portRule can be compiled as a
 // regex. It does not check that a DNS name is a valid DNS name.
@@ -17,6 +17,16 @@ package api
 import (
 	"fmt"
 	"regexp"
+	"time"
+)
+
+const (
+	// maxHTTPTimeout bounds the timeout values configurable in HTTP rules.
+	maxHTTPTimeout = time.Hour
+
+	// maxHTTPConcurrentRequests bounds the MaxConcurrentRequests value
+	// configurable in HTTP rules.
+	maxHTTPConcurrentRequests = 65536
 )
 
 // MismatchAction specifies what to do when there is no header match
@@ -49,7 +59,8 @@ type HeaderMatch struct {
 	Name string `json:"name"`
 
 	// Secret refers to a secret that contains the value to be matched against.
-	// The secret must only contain one entry. If the referred secret does not
+	// The secret must only contain one entry, unless the secret reference
+	// names the key of the entry to use. If the referred secret does not
 	// exist, and there is no "Value" specified, the match will fail.
 	//
 	// +kubebuilder:validation:Optional
@@ -112,6 +123,34 @@ type PortRuleHTTP struct {
 	//
 	// +kubebuilder:validation:Optional
 	HeaderMatches []*HeaderMatch `json:"headerMatches,omitempty"`
+
+	// RequestTimeout is the maximum duration the proxy waits for the
+	// upstream to fully respond to a request, specified as a duration
+	// string, e.g. "10s". Bounded to one hour. If omitted or zero, the
+	// agent-wide default applies. The timeout applies to the proxy
+	// listener shared by all rules of the same port and direction, rules
+	// sharing a listener must therefore agree on the value.
+	//
+	// +kubebuilder:validation:Optional
+	RequestTimeout string `json:"requestTimeout,omitempty"`
+
+	// IdleTimeout is the maximum duration a request stream may be idle,
+	// i.e. without upstream or downstream activity, before it is reset,
+	// specified as a duration string, e.g. "60s". Bounded to one hour. If
+	// omitted or zero, the agent-wide default applies. Like
+	// RequestTimeout, rules sharing a listener must agree on the value.
+	//
+	// +kubebuilder:validation:Optional
+	IdleTimeout string `json:"idleTimeout,omitempty"`
+
+	// MaxConcurrentRequests bounds the number of concurrent requests the
+	// proxy accepts on each downstream connection of the shared listener,
+	// protecting the proxy from a hung upstream tying up all of its
+	// capacity. If omitted or zero, concurrency is not bounded. Like
+	// RequestTimeout, rules sharing a listener must agree on the value.
+	//
+	// +kubebuilder:validation:Optional
+	MaxConcurrentRequests uint32 `json:"maxConcurrentRequests,omitempty"`
 }
 
 // Sanitize sanitizes HTTP rules. It ensures that the path and method fields
@@ -151,5 +190,85 @@ func (h *PortRuleHTTP) Sanitize() error {
 		}
 	}
 
+	if _, err := sanitizeHTTPTimeout(h.RequestTimeout); err != nil {
+		return fmt.Errorf("invalid requestTimeout: %s", err)
+	}
+	if _, err := sanitizeHTTPTimeout(h.IdleTimeout); err != nil {
+		return fmt.Errorf("invalid idleTimeout: %s", err)
+	}
+	if h.MaxConcurrentRequests > maxHTTPConcurrentRequests {
+		return fmt.Errorf("maxConcurrentRequests exceeds the maximum of %d", maxHTTPConcurrentRequests)
+	}
+
+	return nil
+}
+
+// sanitizeHTTPTimeout parses a timeout value of an HTTP rule. The empty
+// string parses to zero, i.e. the timeout is not set.
+func sanitizeHTTPTimeout(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("timeout must not be negative")
+	}
+	if d > maxHTTPTimeout {
+		return 0, fmt.Errorf("timeout exceeds the maximum of %s", maxHTTPTimeout)
+	}
+	return d, nil
+}
+
+// CheckHTTPListenerSettings validates that the given HTTP rules, which share
+// a proxy listener, do not specify conflicting values for the listener-level
+// settings RequestTimeout, IdleTimeout and MaxConcurrentRequests. Unset
+// values do not conflict.
+func CheckHTTPListenerSettings(rules []PortRuleHTTP) error {
+	var requestTimeout, idleTimeout string
+	var maxConcurrentRequests uint32
+	for i := range rules {
+		h := &rules[i]
+		if h.RequestTimeout != "" {
+			if requestTimeout != "" && requestTimeout != h.RequestTimeout {
+				return fmt.Errorf("cannot merge conflicting requestTimeout values (%s/%s) on the same listener", requestTimeout, h.RequestTimeout)
+			}
+			requestTimeout = h.RequestTimeout
+		}
+		if h.IdleTimeout != "" {
+			if idleTimeout != "" && idleTimeout != h.IdleTimeout {
+				return fmt.Errorf("cannot merge conflicting idleTimeout values (%s/%s) on the same listener", idleTimeout, h.IdleTimeout)
+			}
+			idleTimeout = h.IdleTimeout
+		}
+		if h.MaxConcurrentRequests != 0 {
+			if maxConcurrentRequests != 0 && maxConcurrentRequests != h.MaxConcurrentRequests {
+				return fmt.Errorf("cannot merge conflicting maxConcurrentRequests values (%d/%d) on the same listener", maxConcurrentRequests, h.MaxConcurrentRequests)
+			}
+			maxConcurrentRequests = h.MaxConcurrentRequests
+		}
+	}
 	return nil
 }
+
+// HTTPListenerSettings resolves the listener-level settings from the given
+// HTTP rules sharing a proxy listener. CheckHTTPListenerSettings is assumed
+// to have validated the rules, the first set value of each setting wins. The
+// timeouts are zero when unset, in which case the agent-wide defaults apply.
+func HTTPListenerSettings(rules []PortRuleHTTP) (requestTimeout, idleTimeout time.Duration, maxConcurrentRequests uint32) {
+	for i := range rules {
+		h := &rules[i]
+		if requestTimeout == 0 && h.RequestTimeout != "" {
+			requestTimeout, _ = sanitizeHTTPTimeout(h.RequestTimeout)
+		}
+		if idleTimeout == 0 && h.IdleTimeout != "" {
+			idleTimeout, _ = sanitizeHTTPTimeout(h.IdleTimeout)
+		}
+		if maxConcurrentRequests == 0 {
+			maxConcurrentRequests = h.MaxConcurrentRequests
+		}
+	}
+	return requestTimeout, idleTimeout, maxConcurrentRequests
+}
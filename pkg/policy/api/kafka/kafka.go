@@ -63,6 +63,26 @@ type PortRule struct {
 	// +kubebuilder:validation:Optional
 	APIVersion string `json:"apiVersion,omitempty"`
 
+	// APIVersionMin is the minimum api version of the Kafka message
+	// allowed, inclusive. If set, it has to be a string representing a
+	// positive integer. May be combined with APIVersionMax to form a
+	// range, but is incompatible with APIVersion.
+	//
+	// If omitted or empty, no lower bound is applied.
+	//
+	// +kubebuilder:validation:Optional
+	APIVersionMin string `json:"apiVersionMin,omitempty"`
+
+	// APIVersionMax is the maximum api version of the Kafka message
+	// allowed, inclusive. If set, it has to be a string representing a
+	// positive integer. May be combined with APIVersionMin to form a
+	// range, but is incompatible with APIVersion.
+	//
+	// If omitted or empty, no upper bound is applied.
+	//
+	// +kubebuilder:validation:Optional
+	APIVersionMax string `json:"apiVersionMax,omitempty"`
+
 	// ClientID is the client identifier as provided in the request.
 	//
 	// From Kafka protocol documentation:
@@ -92,6 +112,10 @@ type PortRule struct {
 	// version the length was changed from 255 to 249. For compatibility
 	// reasons we are using 255.
 	//
+	// The topic may contain '*' wildcards matching any sequence of
+	// characters (including none), e.g. "logs-*" allows all topics with
+	// the "logs-" prefix.
+	//
 	// If omitted or empty, all topics are allowed.
 	//
 	// +kubebuilder:validation:MaxLength=255
@@ -239,6 +263,43 @@ const (
 // in kafka topic name.
 var TopicValidChar = regexp.MustCompile(`^[a-zA-Z0-9\\._\\-]+$`)
 
+// TopicPatternValidChar is a one-time regex generation of all allowed
+// characters in a kafka topic pattern, i.e. topic characters plus the '*'
+// wildcard.
+var TopicPatternValidChar = regexp.MustCompile(`^[a-zA-Z0-9\\._\\\-\*]+$`)
+
+// CompileTopicPattern compiles a topic containing '*' wildcards into a
+// regular expression matching the full topic name. The pattern is expected
+// to have been validated against TopicPatternValidChar.
+func CompileTopicPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^` + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, `.*`) + `$`)
+}
+
+// The NPDS API carries a single int32 api version field per rule, where -1
+// allows any version and values up to math.MaxInt16 allow one exact version.
+// A version range is encoded into the same field by storing the minimum
+// version in the low 16 bits and the maximum version in the high 16 bits.
+// Encoded ranges are only produced when the maximum is non-zero, so they are
+// always distinguishable from the exact and wildcard encodings.
+
+// EncodeAPIVersionRange encodes the inclusive api version range [min, max]
+// for transport in the NPDS api version field.
+func EncodeAPIVersionRange(min, max int16) int32 {
+	return int32(max)<<16 | int32(min)
+}
+
+// IsAPIVersionRange returns true if the given NPDS api version value carries
+// an encoded version range.
+func IsAPIVersionRange(v int32) bool {
+	return v > math.MaxInt16
+}
+
+// DecodeAPIVersionRange decodes an api version range encoded with
+// EncodeAPIVersionRange.
+func DecodeAPIVersionRange(v int32) (min, max int16) {
+	return int16(v & 0xffff), int16(v >> 16)
+}
+
 // Sanitize sanitizes Kafka rules
 // TODO we need to add support to check
 // wildcard and prefix/suffix later on.
@@ -263,25 +324,71 @@ func (kr *PortRule) Sanitize() error {
 	}
 
 	if len(kr.APIVersion) > 0 {
+		if len(kr.APIVersionMin) > 0 || len(kr.APIVersionMax) > 0 {
+			return fmt.Errorf("cannot set both APIVersion %q and APIVersionMin/APIVersionMax together", kr.APIVersion)
+		}
 		n, err := strconv.ParseInt(kr.APIVersion, 10, 16)
 		if err != nil || n < 0 || n > math.MaxInt16 {
 			return fmt.Errorf("invalid Kafka APIVersion %q", kr.APIVersion)
 		}
 	}
 
+	min, max := int64(0), int64(math.MaxInt16)
+	if len(kr.APIVersionMin) > 0 {
+		n, err := strconv.ParseInt(kr.APIVersionMin, 10, 16)
+		if err != nil || n < 0 || n > math.MaxInt16 {
+			return fmt.Errorf("invalid Kafka APIVersionMin %q", kr.APIVersionMin)
+		}
+		min = n
+	}
+	if len(kr.APIVersionMax) > 0 {
+		n, err := strconv.ParseInt(kr.APIVersionMax, 10, 16)
+		if err != nil || n < 0 || n > math.MaxInt16 {
+			return fmt.Errorf("invalid Kafka APIVersionMax %q", kr.APIVersionMax)
+		}
+		max = n
+	}
+	if min > max {
+		return fmt.Errorf("kafka APIVersionMin %q is greater than APIVersionMax %q", kr.APIVersionMin, kr.APIVersionMax)
+	}
+
 	if len(kr.Topic) > 0 {
 		if len(kr.Topic) > MaxTopicLen {
 			return fmt.Errorf("kafka topic exceeds maximum len of %d", MaxTopicLen)
 		}
-		if TopicValidChar.MatchString(kr.Topic) == false {
+		if strings.ContainsRune(kr.Topic, '*') {
+			if TopicPatternValidChar.MatchString(kr.Topic) == false {
+				return fmt.Errorf("invalid Kafka Topic pattern %q", kr.Topic)
+			}
+		} else if TopicValidChar.MatchString(kr.Topic) == false {
 			return fmt.Errorf("invalid Kafka Topic name %q", kr.Topic)
 		}
 	}
 	return nil
 }
 
-// GetAPIVersion() returns the numeric API version for the PortRule
+// GetAPIVersion() returns the numeric API version for the PortRule. A
+// version range set via APIVersionMin/APIVersionMax is returned in the
+// encoding produced by EncodeAPIVersionRange.
 func (kr *PortRule) GetAPIVersion() int32 {
+	if kr.APIVersionMin != "" || kr.APIVersionMax != "" {
+		min, max := int64(0), int64(math.MaxInt16)
+		if kr.APIVersionMin != "" {
+			n, err := strconv.ParseInt(kr.APIVersionMin, 10, 16)
+			if err != nil || n < 0 || n > math.MaxInt16 {
+				panic(fmt.Sprintf("Unsanitized Kafka PortRule: %v", kr))
+			}
+			min = n
+		}
+		if kr.APIVersionMax != "" {
+			n, err := strconv.ParseInt(kr.APIVersionMax, 10, 16)
+			if err != nil || n < 0 || n > math.MaxInt16 || n < min {
+				panic(fmt.Sprintf("Unsanitized Kafka PortRule: %v", kr))
+			}
+			max = n
+		}
+		return EncodeAPIVersionRange(int16(min), int16(max))
+	}
 	if kr.APIVersion != "" {
 		n, err := strconv.ParseInt(kr.APIVersion, 10, 16)
 		if err != nil || n < 0 || n > math.MaxInt16 {
@@ -34,6 +34,12 @@ func (in *PortRule) DeepEqual(other *PortRule) bool {
 	if in.APIVersion != other.APIVersion {
 		return false
 	}
+	if in.APIVersionMin != other.APIVersionMin {
+		return false
+	}
+	if in.APIVersionMax != other.APIVersionMax {
+		return false
+	}
 	if in.ClientID != other.ClientID {
 		return false
 	}
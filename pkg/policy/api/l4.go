@@ -81,6 +81,12 @@ type Secret struct {
 	//
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
+
+	// Key is the key in the secret to match against. If unspecified, the
+	// secret must contain exactly one item.
+	//
+	// +kubebuilder:validation:Optional
+	Key string `json:"key,omitempty"`
 }
 
 // TLSContext provides TLS configuration via reference to either k8s secrets
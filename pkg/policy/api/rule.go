@@ -79,6 +79,25 @@ type Rule struct {
 	// +kubebuilder:validation:Optional
 	EgressDeny []EgressDenyRule `json:"egressDeny,omitempty"`
 
+	// EnableDefaultDeny determines whether this policy configures the
+	// subject endpoint(s) to have a default deny mode. If enabled,
+	// this causes all traffic not explicitly allowed by a network policy
+	// to be dropped.
+	//
+	// If not specified, the default is true for each traffic direction
+	// that has rules, and false otherwise. For example, if a policy
+	// only has Ingress or IngressDeny rules, then the default for
+	// ingress is true and egress is false.
+	//
+	// If multiple policies apply to an endpoint, that endpoint's default deny
+	// is enabled if any policy requests it.
+	//
+	// This is useful for creating broad-based network policies that will not
+	// cause endpoints to enter default-deny mode.
+	//
+	// +kubebuilder:validation:Optional
+	EnableDefaultDeny DefaultDenyConfig `json:"enableDefaultDeny,omitempty"`
+
 	// Labels is a list of optional strings which can be used to
 	// re-identify the rule or to store metadata. It is possible to lookup
 	// or delete strings based on labels. Labels are not required to be
@@ -95,26 +114,44 @@ type Rule struct {
 	Description string `json:"description,omitempty"`
 }
 
+// DefaultDenyConfig expresses a policy's desired default mode for the subject
+// endpoints.
+type DefaultDenyConfig struct {
+	// Whether or not the endpoint should have a default-deny rule applied
+	// to ingress traffic.
+	//
+	// +kubebuilder:validation:Optional
+	Ingress *bool `json:"ingress,omitempty"`
+
+	// Whether or not the endpoint should have a default-deny rule applied
+	// to egress traffic.
+	//
+	// +kubebuilder:validation:Optional
+	Egress *bool `json:"egress,omitempty"`
+}
+
 // MarshalJSON returns the JSON encoding of Rule r. We need to overwrite it to
 // enforce omitempty on the EndpointSelector nested structures.
 func (r *Rule) MarshalJSON() ([]byte, error) {
 	type common struct {
-		Ingress     []IngressRule     `json:"ingress,omitempty"`
-		IngressDeny []IngressDenyRule `json:"ingressDeny,omitempty"`
-		Egress      []EgressRule      `json:"egress,omitempty"`
-		EgressDeny  []EgressDenyRule  `json:"egressDeny,omitempty"`
-		Labels      labels.LabelArray `json:"labels,omitempty"`
-		Description string            `json:"description,omitempty"`
+		Ingress           []IngressRule     `json:"ingress,omitempty"`
+		IngressDeny       []IngressDenyRule `json:"ingressDeny,omitempty"`
+		Egress            []EgressRule      `json:"egress,omitempty"`
+		EgressDeny        []EgressDenyRule  `json:"egressDeny,omitempty"`
+		EnableDefaultDeny DefaultDenyConfig `json:"enableDefaultDeny,omitempty"`
+		Labels            labels.LabelArray `json:"labels,omitempty"`
+		Description       string            `json:"description,omitempty"`
 	}
 
 	var a interface{}
 	ruleCommon := common{
-		Ingress:     r.Ingress,
-		IngressDeny: r.IngressDeny,
-		Egress:      r.Egress,
-		EgressDeny:  r.EgressDeny,
-		Labels:      r.Labels,
-		Description: r.Description,
+		Ingress:           r.Ingress,
+		IngressDeny:       r.IngressDeny,
+		Egress:            r.Egress,
+		EgressDeny:        r.EgressDeny,
+		EnableDefaultDeny: r.EnableDefaultDeny,
+		Labels:            r.Labels,
+		Description:       r.Description,
 	}
 
 	// Only one of endpointSelector or nodeSelector is permitted.
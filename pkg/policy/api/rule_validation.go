@@ -293,6 +293,11 @@ func (pr *L7Rules) sanitize(ports []PortProtocol) error {
 				return err
 			}
 		}
+		// The rules share a proxy listener, the listener-level settings
+		// must not conflict.
+		if err := CheckHTTPListenerSettings(pr.HTTP); err != nil {
+			return err
+		}
 	}
 
 	if pr.Kafka != nil {
@@ -18,6 +18,7 @@ package api
 
 import (
 	"fmt"
+	"time"
 
 	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
 	"github.com/cilium/cilium/pkg/labels"
@@ -319,6 +320,53 @@ func (s *PolicyAPITestSuite) TestHTTPRuleRegexes(c *C) {
 	c.Assert(err, Not(IsNil))
 }
 
+// This test validates the bounds of the listener-level HTTP rule settings and
+// that rules sharing a listener may not specify conflicting values for them.
+func (s *PolicyAPITestSuite) TestHTTPListenerSettings(c *C) {
+	validRule := PortRuleHTTP{Method: "GET", RequestTimeout: "10s", IdleTimeout: "1m", MaxConcurrentRequests: 100}
+	c.Assert(validRule.Sanitize(), IsNil)
+
+	invalidTimeoutRule := PortRuleHTTP{RequestTimeout: "bogus"}
+	c.Assert(invalidTimeoutRule.Sanitize(), Not(IsNil))
+
+	negativeTimeoutRule := PortRuleHTTP{IdleTimeout: "-10s"}
+	c.Assert(negativeTimeoutRule.Sanitize(), Not(IsNil))
+
+	excessiveTimeoutRule := PortRuleHTTP{RequestTimeout: "25h"}
+	c.Assert(excessiveTimeoutRule.Sanitize(), Not(IsNil))
+
+	excessiveConcurrencyRule := PortRuleHTTP{MaxConcurrentRequests: 1 << 20}
+	c.Assert(excessiveConcurrencyRule.Sanitize(), Not(IsNil))
+
+	// Unset values do not conflict with set ones.
+	err := CheckHTTPListenerSettings([]PortRuleHTTP{
+		{Method: "GET", RequestTimeout: "10s"},
+		{Method: "POST"},
+		{Method: "PUT", RequestTimeout: "10s", MaxConcurrentRequests: 100},
+	})
+	c.Assert(err, IsNil)
+
+	err = CheckHTTPListenerSettings([]PortRuleHTTP{
+		{Method: "GET", RequestTimeout: "10s"},
+		{Method: "POST", RequestTimeout: "20s"},
+	})
+	c.Assert(err, Not(IsNil))
+
+	err = CheckHTTPListenerSettings([]PortRuleHTTP{
+		{Method: "GET", MaxConcurrentRequests: 100},
+		{Method: "POST", MaxConcurrentRequests: 200},
+	})
+	c.Assert(err, Not(IsNil))
+
+	requestTimeout, idleTimeout, maxConcurrentRequests := HTTPListenerSettings([]PortRuleHTTP{
+		{Method: "GET", IdleTimeout: "1m"},
+		{Method: "POST", RequestTimeout: "10s", MaxConcurrentRequests: 100},
+	})
+	c.Assert(requestTimeout, Equals, 10*time.Second)
+	c.Assert(idleTimeout, Equals, time.Minute)
+	c.Assert(maxConcurrentRequests, Equals, uint32(100))
+}
+
 // Test the validation of CIDR rule prefix definitions
 func (s *PolicyAPITestSuite) TestCIDRsanitize(c *C) {
 	// IPv4
@@ -35,6 +35,9 @@ func (h *PortRuleHTTP) Equal(o PortRuleHTTP) bool {
 	if h.Path != o.Path ||
 		h.Method != o.Method ||
 		h.Host != o.Host ||
+		h.RequestTimeout != o.RequestTimeout ||
+		h.IdleTimeout != o.IdleTimeout ||
+		h.MaxConcurrentRequests != o.MaxConcurrentRequests ||
 		len(h.Headers) != len(o.Headers) ||
 		len(h.HeaderMatches) != len(o.HeaderMatches) {
 		return false
@@ -120,6 +120,32 @@ func (in CIDRSlice) DeepCopy() CIDRSlice {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultDenyConfig) DeepCopyInto(out *DefaultDenyConfig) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultDenyConfig.
+func (in *DefaultDenyConfig) DeepCopy() *DefaultDenyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultDenyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressCommonRule) DeepCopyInto(out *EgressCommonRule) {
 	*out = *in
@@ -773,6 +799,7 @@ func (in *Rule) DeepCopyInto(out *Rule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.EnableDefaultDeny.DeepCopyInto(&out.EnableDefaultDeny)
 	out.Labels = in.Labels.DeepCopy()
 	return
 }
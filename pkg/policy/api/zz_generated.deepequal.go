@@ -161,6 +161,32 @@ func (in *CIDRSlice) DeepEqual(other *CIDRSlice) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *DefaultDenyConfig) DeepEqual(other *DefaultDenyConfig) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Ingress == nil) != (other.Ingress == nil) {
+		return false
+	} else if in.Ingress != nil {
+		if *in.Ingress != *other.Ingress {
+			return false
+		}
+	}
+
+	if (in.Egress == nil) != (other.Egress == nil) {
+		return false
+	} else if in.Egress != nil {
+		if *in.Egress != *other.Egress {
+			return false
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *EgressCommonRule) DeepEqual(other *EgressCommonRule) bool {
@@ -1063,6 +1089,10 @@ func (in *Rule) deepEqual(other *Rule) bool {
 		}
 	}
 
+	if !in.EnableDefaultDeny.DeepEqual(&other.EnableDefaultDeny) {
+		return false
+	}
+
 	if ((in.Labels != nil) && (other.Labels != nil)) || ((in.Labels == nil) != (other.Labels == nil)) {
 		in, other := &in.Labels, &other.Labels
 		if other == nil {
@@ -1120,6 +1150,9 @@ func (in *Secret) DeepEqual(other *Secret) bool {
 	if in.Name != other.Name {
 		return false
 	}
+	if in.Key != other.Key {
+		return false
+	}
 
 	return true
 }
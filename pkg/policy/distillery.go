@@ -29,8 +29,11 @@ import (
 // to compute datapath-level policy configuration.
 type SelectorPolicy interface {
 	// Consume returns the policy in terms of connectivity to peer
-	// Identities.
-	Consume(owner PolicyOwner) *EndpointPolicy
+	// Identities. A non-nil *NamedPortsError is returned alongside a
+	// partial policy when named ports could not be resolved for the
+	// owner; the caller may apply the partial policy and consume again
+	// once the missing ports become resolvable.
+	Consume(owner PolicyOwner) (*EndpointPolicy, error)
 }
 
 // PolicyCache represents a cache of resolved policies for identities.
@@ -61,6 +64,21 @@ func (cache *PolicyCache) GetSelectorCache() *SelectorCache {
 	return cache.repo.GetSelectorCache()
 }
 
+// forEachPolicy calls 'f' for each cached selector policy and the identity it
+// was computed for.
+func (cache *PolicyCache) forEachPolicy(f func(*identityPkg.Identity, *selectorPolicy)) {
+	cache.Lock()
+	cips := make([]*cachedSelectorPolicy, 0, len(cache.policies))
+	for _, cip := range cache.policies {
+		cips = append(cips, cip)
+	}
+	cache.Unlock()
+
+	for _, cip := range cips {
+		f(cip.identity, cip.getPolicy())
+	}
+}
+
 // lookupOrCreate adds the specified Identity to the policy cache, with a reference
 // from the specified Endpoint, then returns the threadsafe copy of the policy.
 func (cache *PolicyCache) lookupOrCreate(identity *identityPkg.Identity, create bool) SelectorPolicy {
@@ -89,7 +107,13 @@ func (cache *PolicyCache) delete(identity *identityPkg.Identity) bool {
 	cip, ok := cache.policies[identity.ID]
 	if ok {
 		delete(cache.policies, identity.ID)
-		cip.getPolicy().Detach()
+		// Lock the 'cip' to wait out any in-flight policy update, then
+		// clear the policy so that a consumer racing with the removal
+		// of the identity fails gracefully instead of using a policy
+		// that has already been detached from the selector cache.
+		cip.Lock()
+		cip.setPolicy(nil)
+		cip.Unlock()
 	}
 	return ok
 }
@@ -121,8 +145,16 @@ func (cache *PolicyCache) updateSelectorPolicy(identity *identityPkg.Identity) (
 	cip.Lock()
 	defer cip.Unlock()
 
+	// The policy is cleared when the identity is removed from the cache.
+	// An update racing with such removal fails; the endpoint will compute
+	// a fresh policy for its new identity instead.
+	currentPolicy := cip.getPolicy()
+	if currentPolicy == nil {
+		return false, fmt.Errorf("SelectorPolicy for ID %d was removed", identity.ID)
+	}
+
 	// Don't resolve policy if it was already done for this or later revision.
-	if cip.getPolicy().Revision >= cache.repo.GetRevision() {
+	if currentPolicy.Revision >= cache.repo.GetRevision() {
 		return false, nil
 	}
 
@@ -205,10 +237,17 @@ func (cip *cachedSelectorPolicy) setPolicy(policy *selectorPolicy) {
 //
 // This denotes that a particular endpoint is 'consuming' the policy from the
 // selector policy cache.
-func (cip *cachedSelectorPolicy) Consume(owner PolicyOwner) *EndpointPolicy {
+func (cip *cachedSelectorPolicy) Consume(owner PolicyOwner) (*EndpointPolicy, error) {
 	// TODO: This currently computes the EndpointPolicy from SelectorPolicy
 	// on-demand, however in future the cip is intended to cache the
 	// EndpointPolicy for this Identity and emit datapath deltas instead.
+	selPolicy := cip.getPolicy()
+	if selPolicy == nil {
+		// The identity was removed after this SelectorPolicy was looked
+		// up. The endpoint is about to be regenerated with its new
+		// identity, so there is no policy to consume here.
+		return nil, fmt.Errorf("policy for identity %d was removed", cip.identity.ID)
+	}
 	isHost := cip.identity.ID == identityPkg.ReservedIdentityHost
-	return cip.getPolicy().DistillPolicy(owner, isHost)
+	return selPolicy.DistillPolicy(owner, isHost)
 }
@@ -84,6 +84,31 @@ func (s *DistilleryTestSuite) TestCacheManagement(c *C) {
 	c.Assert(policy3, NotNil)
 }
 
+func (s *DistilleryTestSuite) TestCacheDeleteInvalidatesConsumers(c *C) {
+	repo := NewPolicyRepository(nil, nil)
+	cache := repo.policyCache
+
+	identity := ep1.GetSecurityIdentity()
+	selPolicy := cache.insert(identity)
+	_, err := cache.updateSelectorPolicy(identity)
+	c.Assert(err, IsNil)
+
+	// A consumer may look up the SelectorPolicy before the identity is
+	// removed. Consuming it afterwards must fail gracefully instead of
+	// dereferencing a policy that has been detached.
+	cache.LocalEndpointIdentityRemoved(identity)
+	epPolicy, err := selPolicy.Consume(DummyOwner{})
+	c.Assert(epPolicy, IsNil)
+	c.Assert(err, NotNil)
+
+	// Updating the policy through the retained reference fails as well.
+	cip := selPolicy.(*cachedSelectorPolicy)
+	c.Assert(cip.getPolicy(), IsNil)
+
+	// The removal is idempotent.
+	c.Assert(cache.delete(identity), Equals, false)
+}
+
 func (s *DistilleryTestSuite) TestCachePopulation(c *C) {
 	repo := NewPolicyRepository(nil, nil)
 	repo.revision = 42
@@ -0,0 +1,249 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/cilium/cilium/pkg/checker"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/identity/cache"
+	"github.com/cilium/cilium/pkg/policy/api"
+	"github.com/cilium/cilium/pkg/policy/api/kafka"
+)
+
+//
+// Fuzz targets covering the full policy pipeline: rule parsing, import into
+// a Repository, resolution against a fixed identity set and distillation
+// into MapState. The inputs are schema-bounded: each byte quadruplet is
+// decoded into a rule drawn from a small vocabulary of selectors, ports and
+// L7 rules, so that the fuzzer explores rule *combinations* (where the merge
+// logic historically panicked) rather than JSON syntax.
+//
+
+var (
+	fuzzPeerSelectors = []api.EndpointSelector{
+		selectFoo_,
+		selectBar_,
+		api.WildcardEndpointSelector,
+	}
+
+	fuzzPorts = []api.PortProtocol{
+		{Port: "80", Protocol: api.ProtoTCP},
+		{Port: "80", Protocol: api.ProtoUDP},
+		{Port: "8080", Protocol: api.ProtoTCP},
+		{Port: "port-80", Protocol: api.ProtoTCP},
+		{Port: "0", Protocol: api.ProtoAny},
+	}
+
+	fuzzL7Rules = []*api.L7Rules{
+		nil,
+		allowHTTPRoot,
+		{
+			Kafka: []kafka.PortRule{
+				{APIKey: "produce", Topic: "foo"},
+			},
+		},
+	}
+)
+
+// fuzzGenerateRules decodes the fuzzer-provided byte stream into a bounded
+// set of api.Rules. Rules that fail Sanitize() are dropped, mirroring the
+// validation performed on the import path.
+func fuzzGenerateRules(data []byte) api.Rules {
+	const bytesPerRule = 4
+	rules := make(api.Rules, 0, len(data)/bytesPerRule)
+	for len(data) >= bytesPerRule && len(rules) < 16 {
+		var (
+			flags = data[0]
+			peer  = fuzzPeerSelectors[int(data[1])%len(fuzzPeerSelectors)]
+			port  = fuzzPorts[int(data[2])%len(fuzzPorts)]
+			l7    = fuzzL7Rules[int(data[3])%len(fuzzL7Rules)]
+		)
+		data = data[bytesPerRule:]
+
+		var (
+			withL3 = flags&0x1 != 0
+			withL4 = flags&0x2 != 0
+			deny   = flags&0x4 != 0
+			egress = flags&0x8 != 0
+		)
+
+		rule := api.NewRule().WithEndpointSelector(selectFoo_)
+		switch {
+		case deny && !egress:
+			ingress := api.IngressDenyRule{}
+			if withL3 {
+				ingress.FromEndpoints = []api.EndpointSelector{peer}
+			}
+			if withL4 {
+				ingress.ToPorts = []api.PortDenyRule{{Ports: []api.PortProtocol{port}}}
+			}
+			rule = rule.WithIngressDenyRules([]api.IngressDenyRule{ingress})
+		case deny && egress:
+			eg := api.EgressDenyRule{}
+			if withL3 {
+				eg.ToEndpoints = []api.EndpointSelector{peer}
+			}
+			if withL4 {
+				eg.ToPorts = []api.PortDenyRule{{Ports: []api.PortProtocol{port}}}
+			}
+			rule = rule.WithEgressDenyRules([]api.EgressDenyRule{eg})
+		case !deny && egress:
+			eg := api.EgressRule{}
+			if withL3 {
+				eg.ToEndpoints = []api.EndpointSelector{peer}
+			}
+			if withL4 {
+				eg.ToPorts = []api.PortRule{{Ports: []api.PortProtocol{port}, Rules: l7}}
+			}
+			rule = rule.WithEgressRules([]api.EgressRule{eg})
+		default:
+			ingress := api.IngressRule{}
+			if withL3 {
+				ingress.FromEndpoints = []api.EndpointSelector{peer}
+			}
+			if withL4 {
+				ingress.ToPorts = []api.PortRule{{Ports: []api.PortProtocol{port}, Rules: l7}}
+			}
+			rule = rule.WithIngressRules([]api.IngressRule{ingress})
+		}
+
+		if err := rule.Sanitize(); err != nil {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// fuzzDistill imports the given rules into a fresh Repository backed by a
+// fresh SelectorCache, resolves the policy for the "foo" identity and
+// distills it into a MapState. It fails the test if the selector cache
+// retains any selector registrations after the policy has been detached.
+func fuzzDistill(t *testing.T, rules api.Rules) MapState {
+	identityCache := cache.IdentityCache{
+		identity.NumericIdentity(identityFoo): labelsFoo,
+		identity.NumericIdentity(identityBar): labelsBar,
+	}
+	selectorCache := testNewSelectorCache(identityCache)
+	repo := NewPolicyRepository(nil, nil)
+	repo.selectorCache = selectorCache
+
+	_, _ = repo.AddList(rules)
+
+	fooIdentity := identity.NewIdentityFromLabelArray(identity.NumericIdentity(identityFoo), labelsFoo)
+	selPolicy, err := repo.resolvePolicyLocked(fooIdentity)
+	if err != nil {
+		return nil
+	}
+	epPolicy, _ := selPolicy.DistillPolicy(DummyOwner{}, false)
+	mapState := epPolicy.PolicyMapState
+	selPolicy.Detach()
+
+	// Debug invariant: once the only policy referencing the selectors has
+	// been detached, no selector registrations may leak in the cache.
+	selectorCache.mutex.RLock()
+	leaked := len(selectorCache.selectors)
+	selectorCache.mutex.RUnlock()
+	if leaked != 0 {
+		t.Fatalf("selector cache leaked %d selectors after Detach: %+v",
+			leaked, selectorCache.selectors)
+	}
+
+	mapState.clearCachedSelectors()
+	return mapState
+}
+
+// FuzzEndpointPolicyDiff distills two policies from arbitrary rule
+// combinations and asserts that applying the Diff() of the two onto the
+// first state is equivalent to a full rewrite with the second.
+func FuzzEndpointPolicyDiff(f *testing.F) {
+	f.Add([]byte{0x1, 0, 0, 0}, []byte{0x3, 0, 0, 0, 0x7, 0, 0, 0})
+	f.Add([]byte{0x3, 0, 0, 0, 0x2, 2, 0, 0}, []byte{0x3, 0, 0, 0})
+	f.Add([]byte{0xb, 1, 2, 1}, []byte{0xf, 1, 2, 0, 0x9, 2, 0, 0})
+
+	f.Fuzz(func(t *testing.T, realizedData, desiredData []byte) {
+		realized := fuzzDistill(t, fuzzGenerateRules(realizedData))
+		desired := fuzzDistill(t, fuzzGenerateRules(desiredData))
+		if realized == nil || desired == nil {
+			return
+		}
+
+		policy := &EndpointPolicy{PolicyMapState: desired}
+		adds, deletes := policy.Diff(realized)
+
+		// Apply the deltas on a copy of the realized state.
+		applied := make(MapState, len(realized))
+		for key, entry := range realized {
+			applied[key] = entry
+		}
+		for _, change := range adds {
+			applied[change.Key] = change.Value
+		}
+		for _, change := range deletes {
+			delete(applied, change.Key)
+		}
+
+		// Diff-apply must be equivalent to a full rewrite as far as the
+		// datapath is concerned.
+		if len(applied) != len(desired) {
+			t.Fatalf("diff-apply produced %d entries, full rewrite %d", len(applied), len(desired))
+		}
+		for key, entry := range desired {
+			appliedEntry, ok := applied[key]
+			if !ok || !appliedEntry.DatapathEqual(&entry) {
+				t.Fatalf("diff-apply diverges from full rewrite for key %s: %+v != %+v",
+					key.String(), appliedEntry, entry)
+			}
+		}
+	})
+}
+
+// FuzzResolvePolicy pushes arbitrary (schema-bounded) rule combinations
+// through import, resolution and distillation, asserting that the pipeline
+// does not panic, does not leak selector registrations, and produces the
+// same MapState for two identical runs.
+func FuzzResolvePolicy(f *testing.F) {
+	// Seed corpus with regression cases from past issues in the merge
+	// logic:
+	// - L3-only allow merged with L3/L4 allow on the same selector
+	//   (wildcard L4 covering entry, GH-10090).
+	// - Overlapping allow and deny on the same port, both directions
+	//   (deny precedence, GH-12830).
+	// - Named port merged with numeric port for the same protocol.
+	// - Wildcard L3 with an L7 redirect merged with L3/L4 without L7
+	//   (redirect precedence, GHSA-8fq6, CVE-2020-1765-style merges).
+	f.Add([]byte{0x1, 0, 0, 0, 0x3, 0, 0, 0})
+	f.Add([]byte{0x3, 0, 0, 0, 0x7, 0, 0, 0, 0x2, 2, 0, 0, 0x6, 2, 0, 0})
+	f.Add([]byte{0x3, 0, 3, 0, 0x3, 0, 0, 0})
+	f.Add([]byte{0x2, 2, 0, 1, 0x3, 0, 0, 0})
+	f.Add([]byte{0xb, 1, 2, 1, 0xf, 1, 2, 0, 0x9, 2, 0, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		rules := fuzzGenerateRules(data)
+		if len(rules) == 0 {
+			return
+		}
+		first := fuzzDistill(t, rules)
+		second := fuzzDistill(t, rules)
+		if equal, err := checker.DeepEqual(first, second); !equal {
+			t.Errorf("two identical runs disagree on the distilled policy:\n%s\nRules:\n%s",
+				err, rules.String())
+		}
+	})
+}
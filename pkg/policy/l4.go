@@ -1033,6 +1033,77 @@ func (l4 *L4Policy) Attach(ctx PolicyContext) {
 	l4.Egress.Attach(ctx, l4)
 }
 
+// httpRulesReferenceSecret returns true if any of the HTTP rules matches a
+// header value against one of the given secrets, named by
+// '<namespace>/<name>'. 'ns' is used as the namespace of secret references
+// that do not specify one.
+func httpRulesReferenceSecret(rules []api.PortRuleHTTP, secrets map[string]struct{}, ns string) bool {
+	for i := range rules {
+		for _, hdr := range rules[i].HeaderMatches {
+			if hdr.Secret == nil {
+				continue
+			}
+			secretNs := ns
+			if hdr.Secret.Namespace != "" {
+				secretNs = hdr.Secret.Namespace
+			}
+			if _, ok := secrets[secretNs+"/"+hdr.Secret.Name]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// refreshEnvoyHTTPRules recomputes the cached Envoy representation of the L7
+// rules matching header values against one of the given secrets, resolving
+// the secret references again. Returns true if any rules were recomputed.
+//
+// The filters are otherwise read-only after attach; the recomputed rules are
+// swapped in with a single pointer write so that concurrent readers observe
+// either the old or the new resolved values.
+func (l4 *L4Filter) refreshEnvoyHTTPRules(ctx PolicyContext, secrets map[string]struct{}, ns string) bool {
+	refreshed := false
+	for _, l7policy := range l4.L7RulesPerSelector {
+		if l7policy == nil || !httpRulesReferenceSecret(l7policy.HTTP, secrets, ns) {
+			continue
+		}
+		l7policy.EnvoyHTTPRules, l7policy.CanShortCircuit = ctx.GetEnvoyHTTPRules(&l7policy.L7Rules)
+		refreshed = true
+	}
+	return refreshed
+}
+
+// refreshEnvoyHTTPRules recomputes the cached Envoy representation of all L7
+// rules matching header values against one of the given secrets. Returns true
+// if any rules were recomputed, in which case the policy must be published to
+// the proxies of the endpoints using it.
+func (l4 *L4Policy) refreshEnvoyHTTPRules(ctx PolicyContext, secrets map[string]struct{}, ns string) bool {
+	refreshed := false
+	for _, filter := range l4.Ingress {
+		if filter.refreshEnvoyHTTPRules(ctx, secrets, ns) {
+			refreshed = true
+		}
+	}
+	for _, filter := range l4.Egress {
+		if filter.refreshEnvoyHTTPRules(ctx, secrets, ns) {
+			refreshed = true
+		}
+	}
+	return refreshed
+}
+
+// endpointIDs returns the IDs of the endpoints using this policy.
+func (l4 *L4Policy) endpointIDs() []uint64 {
+	l4.mutex.RLock()
+	defer l4.mutex.RUnlock()
+	ids := make([]uint64, 0, len(l4.users))
+	for epPolicy := range l4.users {
+		ids = append(ids, epPolicy.PolicyOwner.GetID())
+	}
+	return ids
+}
+
 // IngressCoversContext checks if the receiver's ingress L4Policy contains
 // all `dPorts` and `labels`.
 //
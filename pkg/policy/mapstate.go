@@ -16,7 +16,9 @@ package policy
 
 import (
 	"fmt"
+	"sort"
 
+	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/lock"
@@ -55,6 +57,35 @@ const (
 // MapState is a state of a policy map.
 type MapState map[Key]MapStateEntry
 
+// MapStateReader provides read-only access to a (realized) policy map state,
+// such as a MapState or a dump of the BPF policy map.
+type MapStateReader interface {
+	// Get returns the entry stored for the given key, if any.
+	Get(key Key) (MapStateEntry, bool)
+
+	// ForEach iterates over all entries, calling f for each key/entry
+	// pair. Iteration stops if f returns false.
+	ForEach(f func(key Key, entry MapStateEntry) bool)
+}
+
+// Get returns the entry stored for the given key, if any.
+// Implements MapStateReader.
+func (ms MapState) Get(key Key) (MapStateEntry, bool) {
+	entry, ok := ms[key]
+	return entry, ok
+}
+
+// ForEach iterates over all entries, calling f for each key/entry pair.
+// Iteration stops if f returns false.
+// Implements MapStateReader.
+func (ms MapState) ForEach(f func(key Key, entry MapStateEntry) bool) {
+	for key, entry := range ms {
+		if !f(key, entry) {
+			return
+		}
+	}
+}
+
 // Key is the userspace representation of a policy key in BPF. It is
 // intentionally duplicated from pkg/maps/policymap to avoid pulling in the
 // BPF dependency to this package.
@@ -97,7 +128,9 @@ type MapStateEntry struct {
 	// IsDeny is true when the policy should be denied.
 	IsDeny bool
 
-	// DerivedFromRules tracks the policy rules this entry derives from
+	// DerivedFromRules tracks the labels of the policy rules this entry
+	// derives from. Only retained when EnablePolicyMapProvenance is set,
+	// and bounded to at most maxEntryDerivedFromRules rule label sets.
 	DerivedFromRules labels.LabelArrayList
 
 	// Selectors collects the selectors in the policy that require this key to be present.
@@ -122,12 +155,96 @@ func NewMapStateEntry(cs CachedSelector, derivedFrom labels.LabelArrayList, redi
 
 	return MapStateEntry{
 		ProxyPort:        proxyPort,
-		DerivedFromRules: derivedFrom,
+		DerivedFromRules: entryDerivedFromRules(derivedFrom),
 		IsDeny:           deny,
 		selectors:        map[CachedSelector]struct{}{cs: {}},
 	}
 }
 
+// maxEntryDerivedFromRules bounds the number of rule label sets retained per
+// policy map entry, so that a single entry derived from a large number of
+// rules can not hold on to an unbounded amount of memory.
+const maxEntryDerivedFromRules = 4
+
+// entryDerivedFromRules returns the rule labels to be retained in a policy
+// map entry. Retention is opt-in as the rule labels can dominate the memory
+// footprint of a large policy.
+func entryDerivedFromRules(derivedFrom labels.LabelArrayList) labels.LabelArrayList {
+	if !option.Config.EnablePolicyMapProvenance {
+		return nil
+	}
+	if len(derivedFrom) > maxEntryDerivedFromRules {
+		derivedFrom = derivedFrom[:maxEntryDerivedFromRules:maxEntryDerivedFromRules]
+	}
+	return derivedFrom
+}
+
+// GetProvenanceModel returns the API model of the rule label provenance of
+// each entry in the map state. Returns nil unless provenance tracking is
+// enabled. The entries are sorted to keep the output stable.
+func (ms MapState) GetProvenanceModel() []*models.PolicyMapEntryProvenance {
+	if !option.Config.EnablePolicyMapProvenance || len(ms) == 0 {
+		return nil
+	}
+
+	res := make([]*models.PolicyMapEntryProvenance, 0, len(ms))
+	for key, entry := range ms {
+		res = append(res, &models.PolicyMapEntryProvenance{
+			Identity:         int64(key.Identity),
+			DestPort:         int64(key.DestPort),
+			Protocol:         int64(key.Nexthdr),
+			TrafficDirection: int64(key.TrafficDirection),
+			DerivedFromRules: entry.DerivedFromRules.GetModel(),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		a, b := res[i], res[j]
+		switch {
+		case a.TrafficDirection != b.TrafficDirection:
+			return a.TrafficDirection < b.TrafficDirection
+		case a.Identity != b.Identity:
+			return a.Identity < b.Identity
+		case a.DestPort != b.DestPort:
+			return a.DestPort < b.DestPort
+		}
+		return a.Protocol < b.Protocol
+	})
+	return res
+}
+
+// TopSelectors returns the n selectors requiring the most entries in the map
+// state, formatted as "<selector> (<count> entries)". It is used to point at
+// the likely culprits when an endpoint's policy map runs full.
+func (ms MapState) TopSelectors(n int) []string {
+	counts := make(map[CachedSelector]int)
+	for _, entry := range ms {
+		for cs := range entry.selectors {
+			if cs != nil {
+				counts[cs]++
+			}
+		}
+	}
+	selectors := make([]CachedSelector, 0, len(counts))
+	for cs := range counts {
+		selectors = append(selectors, cs)
+	}
+	sort.Slice(selectors, func(i, j int) bool {
+		a, b := selectors[i], selectors[j]
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		return a.String() < b.String()
+	})
+	if len(selectors) > n {
+		selectors = selectors[:n]
+	}
+	res := make([]string, len(selectors))
+	for i, cs := range selectors {
+		res[i] = fmt.Sprintf("%s (%d entries)", cs, counts[cs])
+	}
+	return res
+}
+
 // MergeSelectors adds selectors from entry 'b' to 'e'. 'b' is not modified.
 func (e *MapStateEntry) MergeSelectors(b *MapStateEntry) {
 	for cs, v := range b.selectors {
@@ -18,6 +18,8 @@ package policy
 
 import (
 	"github.com/cilium/cilium/pkg/checker"
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy/trafficdirection"
 
 	"gopkg.in/check.v1"
@@ -50,6 +52,55 @@ func (ds *PolicyTestSuite) TestPolicyKeyTrafficDirection(c *check.C) {
 	c.Assert(k.IsEgress(), check.Equals, true)
 }
 
+func (ds *PolicyTestSuite) TestMapStateEntryProvenance(c *check.C) {
+	derivedFrom := labels.LabelArrayList{
+		labels.ParseLabelArray("k8s:rule=1"),
+		labels.ParseLabelArray("k8s:rule=2"),
+		labels.ParseLabelArray("k8s:rule=3"),
+		labels.ParseLabelArray("k8s:rule=4"),
+		labels.ParseLabelArray("k8s:rule=5"),
+	}
+
+	// Provenance is not retained by default.
+	entry := NewMapStateEntry(nil, derivedFrom, false, false)
+	c.Assert(entry.DerivedFromRules, check.IsNil)
+	c.Assert(MapState{Key{}: entry}.GetProvenanceModel(), check.IsNil)
+
+	option.Config.EnablePolicyMapProvenance = true
+	defer func() { option.Config.EnablePolicyMapProvenance = false }()
+
+	// When enabled, retention is bounded.
+	entry = NewMapStateEntry(nil, derivedFrom, false, false)
+	c.Assert(entry.DerivedFromRules, check.HasLen, maxEntryDerivedFromRules)
+
+	ms := MapState{
+		Key{Identity: 42, DestPort: 80, Nexthdr: 6, TrafficDirection: trafficdirection.Egress.Uint8()}: entry,
+		Key{Identity: 42, TrafficDirection: trafficdirection.Ingress.Uint8()}:                          NewMapStateEntry(nil, derivedFrom[:1], false, false),
+	}
+	model := ms.GetProvenanceModel()
+	c.Assert(model, check.HasLen, 2)
+	// Sorted ingress first.
+	c.Assert(model[0].TrafficDirection, check.Equals, int64(trafficdirection.Ingress.Uint8()))
+	c.Assert(model[0].DerivedFromRules, checker.DeepEquals, derivedFrom[:1].GetModel())
+	c.Assert(model[1].Identity, check.Equals, int64(42))
+	c.Assert(model[1].DestPort, check.Equals, int64(80))
+	c.Assert(model[1].DerivedFromRules, check.HasLen, maxEntryDerivedFromRules)
+}
+
+func (ds *PolicyTestSuite) TestMapState_TopSelectors(c *check.C) {
+	csFoo := newTestCachedSelector("Foo", false)
+	csBar := newTestCachedSelector("Bar", false)
+
+	ms := MapState{}
+	for i := uint32(1); i <= 3; i++ {
+		ms[Key{Identity: i}] = NewMapStateEntry(csFoo, nil, false, false)
+	}
+	ms[Key{Identity: 4}] = NewMapStateEntry(csBar, nil, false, false)
+
+	c.Assert(ms.TopSelectors(5), checker.DeepEquals, []string{"Foo (3 entries)", "Bar (1 entries)"})
+	c.Assert(ms.TopSelectors(1), checker.DeepEquals, []string{"Foo (3 entries)"})
+}
+
 func (ds *PolicyTestSuite) TestMapState_DenyPreferredInsert(c *check.C) {
 	type args struct {
 		key   Key
@@ -152,6 +152,43 @@ func (p *Repository) SetEnvoyRulesFunc(f func(CertificateManager, *api.L7Rules,
 	p.getEnvoyHTTPRules = f
 }
 
+// RefreshHeaderMatchSecrets recomputes the Envoy HTTP rules of all cached
+// selector policies matching header values against one of the given rotated
+// secrets, named by '<namespace>/<name>', resolving the secret references
+// again. It returns the IDs of the endpoints whose network policy carries
+// refreshed values and must be published to the proxy again. The endpoints
+// themselves do not need to be regenerated.
+func (p *Repository) RefreshHeaderMatchSecrets(secrets []string) []uint64 {
+	secretSet := make(map[string]struct{}, len(secrets))
+	for _, name := range secrets {
+		secretSet[name] = struct{}{}
+	}
+
+	epIDs := make(map[uint64]struct{})
+	p.Mutex.RLock()
+	p.policyCache.forEachPolicy(func(secID *identity.Identity, selPolicy *selectorPolicy) {
+		if selPolicy == nil || selPolicy.L4Policy == nil {
+			return
+		}
+		policyCtx := policyContext{
+			repo: p,
+			ns:   secID.LabelArray.Get(labels.LabelSourceK8sKeyPrefix + k8sConst.PodNamespaceLabel),
+		}
+		if selPolicy.L4Policy.refreshEnvoyHTTPRules(&policyCtx, secretSet, policyCtx.ns) {
+			for _, id := range selPolicy.L4Policy.endpointIDs() {
+				epIDs[id] = struct{}{}
+			}
+		}
+	})
+	p.Mutex.RUnlock()
+
+	ids := make([]uint64, 0, len(epIDs))
+	for id := range epIDs {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 func (p *Repository) GetEnvoyHTTPRules(l7Rules *api.L7Rules, ns string) (*cilium.HttpNetworkPolicyRules, bool) {
 	if p.getEnvoyHTTPRules == nil {
 		return nil, true
@@ -386,6 +423,56 @@ func (p *Repository) AddListLocked(rules api.Rules) (ruleSlice, uint64) {
 	return newList, p.GetRevision()
 }
 
+// ReplaceByLabelsLocked atomically replaces all rules in the policy
+// repository carrying one of the given label sets with the given rules, with
+// the repository already locked. The complete new rule set is validated
+// before the repository is touched, so that a validation failure leaves the
+// repository unchanged; the returned error reports which rule failed. The
+// repository revision is bumped exactly once. Returns the added rules, the
+// deleted rules and the revision after the replacement.
+func (p *Repository) ReplaceByLabelsLocked(rules api.Rules, searchLabels []labels.LabelArray) (ruleSlice, ruleSlice, uint64, error) {
+	// Validate the full new rule set up front. Nothing can fail past this
+	// point, which makes the replacement below atomic without needing an
+	// explicit rollback.
+	for i, r := range rules {
+		if err := r.Sanitize(); err != nil {
+			return nil, nil, p.GetRevision(), fmt.Errorf("rule %d/%d with labels %s failed validation: %s", i+1, len(rules), r.Labels, err)
+		}
+	}
+
+	newList := make(ruleSlice, len(rules))
+	for i := range rules {
+		newList[i] = &rule{
+			Rule:     *rules[i],
+			metadata: newRuleMetadata(),
+		}
+	}
+
+	newRules := make(ruleSlice, 0, len(p.rules)+len(newList))
+	deletedRules := ruleSlice{}
+	for _, r := range p.rules {
+		deleted := false
+		for _, lbls := range searchLabels {
+			if r.Labels.Contains(lbls) {
+				deleted = true
+				break
+			}
+		}
+		if deleted {
+			deletedRules = append(deletedRules, r)
+		} else {
+			newRules = append(newRules, r)
+		}
+	}
+
+	p.rules = append(newRules, newList...)
+	p.BumpRevision()
+	metrics.Policy.Add(float64(len(newList)))
+	metrics.Policy.Sub(float64(len(deletedRules)))
+
+	return newList, deletedRules, p.GetRevision(), nil
+}
+
 // removeIdentityFromRuleCaches removes the identity from the selector cache
 // in each rule in the repository.
 //
@@ -568,11 +655,25 @@ func (p *Repository) getMatchingRules(securityIdentity *identity.Identity) (
 			// Don't need to update whether ingressMatch is true if it already
 			// has been determined to be true - allows us to not have to check
 			// lenth of slice.
+			//
+			// An explicit EnableDefaultDeny configuration on the rule
+			// overrides the default of enabling enforcement for the
+			// directions in which the rule has sections. This allows a
+			// rule to establish (or avoid establishing) default-deny
+			// posture without contributing any allows.
 			if !ingressMatch {
-				ingressMatch = len(r.Ingress) > 0 || len(r.IngressDeny) > 0
+				if r.EnableDefaultDeny.Ingress != nil {
+					ingressMatch = *r.EnableDefaultDeny.Ingress
+				} else {
+					ingressMatch = len(r.Ingress) > 0 || len(r.IngressDeny) > 0
+				}
 			}
 			if !egressMatch {
-				egressMatch = len(r.Egress) > 0 || len(r.EgressDeny) > 0
+				if r.EnableDefaultDeny.Egress != nil {
+					egressMatch = *r.EnableDefaultDeny.Egress
+				} else {
+					egressMatch = len(r.Egress) > 0 || len(r.EgressDeny) > 0
+				}
 			}
 			matchingRules = append(matchingRules, r)
 		}
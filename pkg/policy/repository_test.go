@@ -249,6 +249,87 @@ func (ds *PolicyTestSuite) TestComputePolicyEnforcementAndRules(c *C) {
 
 }
 
+func (ds *PolicyTestSuite) TestComputePolicyEnforcementEnableDefaultDeny(c *C) {
+
+	// Cache policy enforcement value from when test was ran to avoid pollution
+	// across tests.
+	oldPolicyEnable := GetPolicyEnabled()
+	defer SetPolicyEnabled(oldPolicyEnable)
+
+	SetPolicyEnabled(option.DefaultEnforcement)
+
+	repo := NewPolicyRepository(nil, nil)
+	repo.selectorCache = testSelectorCache
+
+	fooSelectLabel := labels.ParseSelectLabel("foo")
+	fooNumericIdentity := 9001
+	fooIdentity := identity.NewIdentity(identity.NumericIdentity(fooNumericIdentity), lbls)
+	postureRuleLabel := labels.NewLabel(k8sConst.PolicyLabelName, "defaultDenyPosture", labels.LabelSourceAny)
+	allowAllRuleLabel := labels.NewLabel(k8sConst.PolicyLabelName, "allowAllNoDefaultDeny", labels.LabelSourceAny)
+
+	enabled := true
+	disabled := false
+
+	// A rule without any ingress or egress sections which explicitly
+	// requests default deny establishes enforcement on its own.
+	postureRule := api.Rule{
+		EndpointSelector: api.NewESFromLabels(fooSelectLabel),
+		EnableDefaultDeny: api.DefaultDenyConfig{
+			Ingress: &enabled,
+			Egress:  &enabled,
+		},
+		Labels: labels.LabelArray{
+			postureRuleLabel,
+		},
+	}
+
+	// A rule carrying ingress allows which opts out of default deny does
+	// not establish enforcement despite having an ingress section.
+	allowAllRule := api.Rule{
+		EndpointSelector: api.NewESFromLabels(fooSelectLabel),
+		Ingress: []api.IngressRule{
+			{
+				IngressCommonRule: api.IngressCommonRule{
+					FromEndpoints: []api.EndpointSelector{
+						api.NewESFromLabels(fooSelectLabel),
+					},
+				},
+			},
+		},
+		EnableDefaultDeny: api.DefaultDenyConfig{
+			Ingress: &disabled,
+		},
+		Labels: labels.LabelArray{
+			allowAllRuleLabel,
+		},
+	}
+
+	_, _, err := repo.Add(postureRule, []Endpoint{})
+	c.Assert(err, IsNil, Commentf("unable to add rule to policy repository"))
+	ing, egr, matchingRules := repo.computePolicyEnforcementAndRules(fooIdentity)
+	c.Assert(ing, Equals, true, Commentf("ingress policy enforcement should apply since the rule requests default deny"))
+	c.Assert(egr, Equals, true, Commentf("egress policy enforcement should apply since the rule requests default deny"))
+	c.Assert(matchingRules[0].Rule, checker.DeepEquals, postureRule, Commentf("returned matching rules did not match"))
+
+	_, _, numDeleted := repo.DeleteByLabelsLocked(labels.LabelArray{postureRuleLabel})
+	c.Assert(numDeleted, Equals, 1)
+
+	_, _, err = repo.Add(allowAllRule, []Endpoint{})
+	c.Assert(err, IsNil, Commentf("unable to add rule to policy repository"))
+	ing, egr, matchingRules = repo.computePolicyEnforcementAndRules(fooIdentity)
+	c.Assert(ing, Equals, false, Commentf("ingress policy enforcement should not apply since the rule opts out of default deny"))
+	c.Assert(egr, Equals, false, Commentf("egress policy enforcement should not apply since no egress rules select"))
+	c.Assert(matchingRules[0].Rule, checker.DeepEquals, allowAllRule, Commentf("returned matching rules did not match"))
+
+	// With both rules in place, the posture rule wins: default deny
+	// requested by any matching rule enables enforcement.
+	_, _, err = repo.Add(postureRule, []Endpoint{})
+	c.Assert(err, IsNil, Commentf("unable to add rule to policy repository"))
+	ing, egr, _ = repo.computePolicyEnforcementAndRules(fooIdentity)
+	c.Assert(ing, Equals, true, Commentf("ingress policy enforcement should apply since a matching rule requests default deny"))
+	c.Assert(egr, Equals, true, Commentf("egress policy enforcement should apply since a matching rule requests default deny"))
+}
+
 func (ds *PolicyTestSuite) TestAddSearchDelete(c *C) {
 	repo := NewPolicyRepository(nil, nil)
 	repo.selectorCache = testSelectorCache
@@ -335,6 +416,66 @@ func (ds *PolicyTestSuite) TestAddSearchDelete(c *C) {
 	repo.Mutex.RUnlock()
 }
 
+func (ds *PolicyTestSuite) TestReplaceByLabels(c *C) {
+	repo := NewPolicyRepository(nil, nil)
+	repo.selectorCache = testSelectorCache
+
+	lbls1 := labels.LabelArray{
+		labels.ParseLabel("tag1"),
+	}
+	rule1 := api.Rule{
+		EndpointSelector: api.NewESFromLabels(labels.ParseSelectLabel("foo")),
+		Labels:           lbls1,
+	}
+	rule2 := api.Rule{
+		EndpointSelector: api.NewESFromLabels(labels.ParseSelectLabel("bar")),
+		Labels:           lbls1,
+	}
+	lbls2 := labels.LabelArray{labels.ParseLabel("tag2")}
+	rule3 := api.Rule{
+		EndpointSelector: api.NewESFromLabels(labels.ParseSelectLabel("baz")),
+		Labels:           lbls2,
+	}
+
+	_, _, err := repo.Add(rule1, []Endpoint{})
+	c.Assert(err, IsNil)
+	_, _, err = repo.Add(rule3, []Endpoint{})
+	c.Assert(err, IsNil)
+	revBefore := repo.GetRevision()
+
+	// Replacing the tag1 rules succeeds under a single revision bump and
+	// leaves the tag2 rule in place.
+	repo.Mutex.Lock()
+	added, deleted, rev, err := repo.ReplaceByLabelsLocked(api.Rules{&rule2}, []labels.LabelArray{lbls1})
+	repo.Mutex.Unlock()
+	c.Assert(err, IsNil)
+	c.Assert(rev, Equals, revBefore+1)
+	c.Assert(len(added), Equals, 1)
+	c.Assert(len(deleted), Equals, 1)
+	c.Assert(deleted[0].Rule, checker.DeepEquals, rule1)
+
+	repo.Mutex.RLock()
+	c.Assert(repo.SearchRLocked(lbls1), checker.DeepEquals, api.Rules{&rule2})
+	c.Assert(repo.SearchRLocked(lbls2), checker.DeepEquals, api.Rules{&rule3})
+	repo.Mutex.RUnlock()
+
+	// An invalid rule in the new set leaves the repository unchanged: the
+	// old rules remain, the revision is not bumped and the error reports
+	// the offending rule.
+	invalid := api.Rule{Labels: lbls1}
+	repo.Mutex.Lock()
+	_, _, rev, err = repo.ReplaceByLabelsLocked(api.Rules{&rule1, &invalid}, []labels.LabelArray{lbls1})
+	repo.Mutex.Unlock()
+	c.Assert(err, Not(IsNil))
+	c.Assert(err, ErrorMatches, "rule 2/2 .*")
+	c.Assert(rev, Equals, revBefore+1)
+
+	repo.Mutex.RLock()
+	c.Assert(repo.SearchRLocked(lbls1), checker.DeepEquals, api.Rules{&rule2})
+	c.Assert(repo.SearchRLocked(lbls2), checker.DeepEquals, api.Rules{&rule3})
+	repo.Mutex.RUnlock()
+}
+
 func BenchmarkParseLabel(b *testing.B) {
 	repo := NewPolicyRepository(nil, nil)
 	repo.selectorCache = testSelectorCache
@@ -15,7 +15,12 @@
 package policy
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/policy/trafficdirection"
 )
 
@@ -116,13 +121,37 @@ func (p *selectorPolicy) Detach() {
 	}
 }
 
+// NamedPortsError is returned from Consume() when one or more named ports in
+// the policy could not be mapped to port numbers for the PolicyOwner. The
+// EndpointPolicy returned alongside this error is partial: map entries for
+// the listed named ports are missing and need to be filled in by consuming
+// the policy again once the owner's pods expose the ports.
+type NamedPortsError struct {
+	// UnresolvedPorts maps each named port that failed to resolve to the
+	// labels of the rules it was derived from.
+	UnresolvedPorts map[string]labels.LabelArrayList
+}
+
+// Error returns a stable, human-readable list of the unresolvable named
+// ports.
+func (e *NamedPortsError) Error() string {
+	names := make([]string, 0, len(e.UnresolvedPorts))
+	for name := range e.UnresolvedPorts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("named ports can not be resolved: %s", strings.Join(names, ", "))
+}
+
 // DistillPolicy filters down the specified selectorPolicy (which acts
 // upon selectors) into a set of concrete map entries based on the
 // SelectorCache. These can subsequently be plumbed into the datapath.
 //
 // Must be performed while holding the Repository lock.
 // PolicyOwner (aka Endpoint) is also locked during this call.
-func (p *selectorPolicy) DistillPolicy(policyOwner PolicyOwner, isHost bool) *EndpointPolicy {
+// Returns a non-nil *NamedPortsError along with the (partial) EndpointPolicy
+// if any named ports in the policy could not be resolved for the owner.
+func (p *selectorPolicy) DistillPolicy(policyOwner PolicyOwner, isHost bool) (*EndpointPolicy, error) {
 	calculatedPolicy := &EndpointPolicy{
 		selectorPolicy: p,
 		PolicyMapState: make(MapState),
@@ -149,12 +178,15 @@ func (p *selectorPolicy) DistillPolicy(policyOwner PolicyOwner, isHost bool) *En
 	// Must come after the 'insertUser()' above to guarantee
 	// PolicyMapChanges will contain all changes that are applied
 	// after the computation of PolicyMapState has started.
-	calculatedPolicy.computeDesiredL4PolicyMapEntries()
+	unresolved := calculatedPolicy.computeDesiredL4PolicyMapEntries()
 	if !isHost {
 		calculatedPolicy.PolicyMapState.DetermineAllowLocalhostIngress()
 	}
 
-	return calculatedPolicy
+	if len(unresolved) > 0 {
+		return calculatedPolicy, &NamedPortsError{UnresolvedPorts: unresolved}
+	}
+	return calculatedPolicy, nil
 }
 
 // Detach removes EndpointPolicy references from selectorPolicy
@@ -166,17 +198,31 @@ func (p *EndpointPolicy) Detach() {
 
 // computeDesiredL4PolicyMapEntries transforms the EndpointPolicy.L4Policy into
 // the datapath-friendly format inside EndpointPolicy.PolicyMapState.
-func (p *EndpointPolicy) computeDesiredL4PolicyMapEntries() {
+// Returns the named ports that could not be resolved for the PolicyOwner,
+// keyed by port name, or nil if all named ports resolved.
+func (p *EndpointPolicy) computeDesiredL4PolicyMapEntries() map[string]labels.LabelArrayList {
 
 	if p.L4Policy == nil {
-		return
+		return nil
+	}
+	unresolved := make(map[string]labels.LabelArrayList)
+	p.computeDirectionL4PolicyMapEntries(p.PolicyMapState, p.L4Policy.Ingress, trafficdirection.Ingress, unresolved)
+	p.computeDirectionL4PolicyMapEntries(p.PolicyMapState, p.L4Policy.Egress, trafficdirection.Egress, unresolved)
+	if len(unresolved) == 0 {
+		return nil
 	}
-	p.computeDirectionL4PolicyMapEntries(p.PolicyMapState, p.L4Policy.Ingress, trafficdirection.Ingress)
-	p.computeDirectionL4PolicyMapEntries(p.PolicyMapState, p.L4Policy.Egress, trafficdirection.Egress)
+	return unresolved
 }
 
-func (p *EndpointPolicy) computeDirectionL4PolicyMapEntries(policyMapState MapState, l4PolicyMap L4PolicyMap, direction trafficdirection.TrafficDirection) {
+func (p *EndpointPolicy) computeDirectionL4PolicyMapEntries(policyMapState MapState, l4PolicyMap L4PolicyMap, direction trafficdirection.TrafficDirection, unresolved map[string]labels.LabelArrayList) {
 	for _, filter := range l4PolicyMap {
+		// Track named ports the owner can not resolve so that the
+		// failure can be surfaced to the caller instead of silently
+		// leaving the policy partial.
+		if filter.Port == 0 && filter.PortName != "" &&
+			p.PolicyOwner.GetNamedPortLocked(filter.Ingress, filter.PortName, uint8(filter.U8Proto)) == 0 {
+			unresolved[filter.PortName] = append(unresolved[filter.PortName], filter.DerivedFromRules...)
+		}
 		lookupDone := false
 		proxyport := uint16(0)
 		keysFromFilter := filter.ToMapState(p.PolicyOwner, direction)
@@ -204,6 +250,33 @@ func (p *EndpointPolicy) computeDirectionL4PolicyMapEntries(policyMapState MapSt
 	}
 }
 
+// Diff computes the minimal set of policy map mutations needed to move the
+// given realized state to the desired PolicyMapState of this policy. Deny
+// precedence and wildcard covering entries have already been resolved into
+// the desired state when it was distilled, so applying the returned adds
+// followed by the returned deletes is equivalent to a full rewrite of the
+// map, without touching entries that are already correct.
+//
+// The entries in 'adds' carry the desired values, including any dummy proxy
+// ports for redirects that have not been allocated yet; the caller is
+// expected to fix those up before writing, just as it would on a full
+// rewrite.
+// PolicyOwner (aka Endpoint) is locked during this call.
+func (p *EndpointPolicy) Diff(realized MapStateReader) (adds, deletes []MapChange) {
+	for key, entry := range p.PolicyMapState {
+		if oldEntry, ok := realized.Get(key); !ok || !oldEntry.DatapathEqual(&entry) {
+			adds = append(adds, MapChange{Add: true, Key: key, Value: entry})
+		}
+	}
+	realized.ForEach(func(key Key, entry MapStateEntry) bool {
+		if _, ok := p.PolicyMapState[key]; !ok {
+			deletes = append(deletes, MapChange{Add: false, Key: key, Value: entry})
+		}
+		return true
+	})
+	return adds, deletes
+}
+
 // ConsumeMapChanges transfers the changes from MapChanges to the caller,
 // locking the selector cache to make sure concurrent identity updates
 // have completed.
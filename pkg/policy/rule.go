@@ -186,6 +186,13 @@ func mergePortProto(ctx *SearchContext, existingFilter, filterToMerge *L4Filter,
 						l7Rules.HTTP = append(l7Rules.HTTP, newRule)
 					}
 				}
+
+				// Merged rules share a proxy listener, reject
+				// conflicting listener-level settings.
+				if err := api.CheckHTTPListenerSettings(l7Rules.HTTP); err != nil {
+					ctx.PolicyTrace("   Merge conflict: %s\n", err)
+					return err
+				}
 			case len(newL7Rules.Kafka) > 0:
 				if len(l7Rules.HTTP) > 0 || len(l7Rules.DNS) > 0 || l7Rules.L7Proto != "" {
 					ctx.PolicyTrace("   Merge conflict: mismatching L7 rule types.\n")
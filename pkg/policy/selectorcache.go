@@ -30,6 +30,7 @@ import (
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/policy/api"
 
 	"github.com/sirupsen/logrus"
@@ -197,6 +198,11 @@ type userNotification struct {
 	added    []identity.NumericIdentity
 	deleted  []identity.NumericIdentity
 	wg       *sync.WaitGroup
+
+	// mergedWgs holds the wait groups of notifications that were folded
+	// into this one when batching queued notifications for the same user
+	// and selector. Only set while the notification is being delivered.
+	mergedWgs []*sync.WaitGroup
 }
 
 // SelectorCache caches identities, identity selectors, and the
@@ -259,11 +265,86 @@ func (sc *SelectorCache) handleUserNotifications() {
 		sc.userNotes = nil
 		sc.userMutex.Unlock()
 
-		for _, n := range notifications {
+		for _, n := range coalesceUserNotifications(notifications) {
 			n.user.IdentitySelectionUpdated(n.selector, n.added, n.deleted)
+			metrics.PolicySelectorNotificationBatchSize.Observe(float64(len(n.added) + len(n.deleted)))
 			n.wg.Done()
+			for _, wg := range n.mergedWgs {
+				wg.Done()
+			}
+		}
+	}
+}
+
+// coalesceUserNotifications merges queued notifications destined for the same
+// user and selector into a single notification carrying the full set of
+// changed identities. Identity churn bursts, such as thousands of CIDR
+// identities allocated for one toFQDNs response, are this way delivered as
+// one batched selection update per user rather than one callback and one
+// incremental policy map update per identity. An identity that was both
+// added and deleted while queued cancels out and is not delivered at all.
+func coalesceUserNotifications(notifications []userNotification) []userNotification {
+	type userSelectorPair struct {
+		user     CachedSelectionUser
+		selector CachedSelector
+	}
+	merged := make([]userNotification, 0, len(notifications))
+	index := make(map[userSelectorPair]int, len(notifications))
+	for _, n := range notifications {
+		key := userSelectorPair{user: n.user, selector: n.selector}
+		i, exists := index[key]
+		if !exists {
+			index[key] = len(merged)
+			merged = append(merged, n)
+			continue
+		}
+		m := &merged[i]
+		// Copy the selections on first merge so that the slices queued
+		// by the notifiers are never modified.
+		if m.mergedWgs == nil {
+			m.added = append([]identity.NumericIdentity(nil), m.added...)
+			m.deleted = append([]identity.NumericIdentity(nil), m.deleted...)
+		}
+		m.added, m.deleted = mergeNotifiedSelections(m.added, m.deleted, n.added, n.deleted)
+		m.mergedWgs = append(m.mergedWgs, n.wg)
+	}
+	return merged
+}
+
+// mergeNotifiedSelections folds the selection changes 'newAdded' and
+// 'newDeleted' into the pending changes 'added' and 'deleted', keeping only
+// the net effect so that no identity ends up in both resulting slices.
+func mergeNotifiedSelections(added, deleted, newAdded, newDeleted []identity.NumericIdentity) ([]identity.NumericIdentity, []identity.NumericIdentity) {
+	for _, id := range newAdded {
+		// A pending deletion followed by an addition cancels out.
+		if i := indexOfNumericIdentity(deleted, id); i >= 0 {
+			deleted = append(deleted[:i], deleted[i+1:]...)
+			continue
+		}
+		if indexOfNumericIdentity(added, id) < 0 {
+			added = append(added, id)
+		}
+	}
+	for _, id := range newDeleted {
+		// A pending addition followed by a deletion cancels out.
+		if i := indexOfNumericIdentity(added, id); i >= 0 {
+			added = append(added[:i], added[i+1:]...)
+			continue
+		}
+		if indexOfNumericIdentity(deleted, id) < 0 {
+			deleted = append(deleted, id)
+		}
+	}
+	return added, deleted
+}
+
+func indexOfNumericIdentity(ids []identity.NumericIdentity, id identity.NumericIdentity) int {
+	for i, existing := range ids {
+		if existing == id {
+			return i
 		}
 	}
+	return -1
 }
 
 func (sc *SelectorCache) queueUserNotification(user CachedSelectionUser, selector CachedSelector, added, deleted []identity.NumericIdentity, wg *sync.WaitGroup) {
@@ -12,6 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !privileged_tests
 // +build !privileged_tests
 
 package policy
@@ -338,6 +339,59 @@ func (ds *SelectorCacheTestSuite) TestMultipleIdentitySelectors(c *C) {
 	c.Assert(len(sc.selectors), Equals, 0)
 }
 
+func (ds *SelectorCacheTestSuite) TestIdentityMigrationCoexistence(c *C) {
+	sc := testNewSelectorCache(cache.IdentityCache{})
+
+	// The old identity was computed before a labels filter change, the new
+	// identity carries an additional label which is now considered identity
+	// relevant. Both share the app label.
+	wg := &sync.WaitGroup{}
+	sc.UpdateIdentities(cache.IdentityCache{
+		1111: labels.Labels{"app": labels.NewLabel("app", "test", labels.LabelSourceK8s)}.LabelArray(),
+	}, nil, wg)
+	wg.Wait()
+
+	testSelector := api.NewESFromLabels(labels.NewLabel("app", "test", labels.LabelSourceK8s))
+
+	user1 := newUser(c, "user1", sc)
+	cached := user1.AddIdentitySelector(testSelector)
+	selections := cached.GetSelections()
+	c.Assert(len(selections), Equals, 1)
+	c.Assert(selections[0], Equals, identity.NumericIdentity(1111))
+
+	// During the migration the new identity is allocated while the old one
+	// is still referenced by endpoints which have not been regenerated yet.
+	// Policies selecting the shared labels select both identities.
+	wg = &sync.WaitGroup{}
+	sc.UpdateIdentities(cache.IdentityCache{
+		2222: labels.Labels{
+			"app":  labels.NewLabel("app", "test", labels.LabelSourceK8s),
+			"team": labels.NewLabel("team", "blue", labels.LabelSourceK8s),
+		}.LabelArray(),
+	}, nil, wg)
+	wg.Wait()
+
+	selections = cached.GetSelections()
+	c.Assert(len(selections), Equals, 2)
+	c.Assert(cached.Selects(identity.NumericIdentity(1111)), Equals, true)
+	c.Assert(cached.Selects(identity.NumericIdentity(2222)), Equals, true)
+
+	// Once all endpoints have been regenerated with the new identity, the
+	// old identity is released and only the new one remains selected.
+	wg = &sync.WaitGroup{}
+	sc.UpdateIdentities(nil, cache.IdentityCache{
+		1111: labels.Labels{"app": labels.NewLabel("app", "test", labels.LabelSourceK8s)}.LabelArray(),
+	}, wg)
+	wg.Wait()
+
+	selections = cached.GetSelections()
+	c.Assert(len(selections), Equals, 1)
+	c.Assert(selections[0], Equals, identity.NumericIdentity(2222))
+
+	user1.RemoveSelector(cached)
+	c.Assert(len(sc.selectors), Equals, 0)
+}
+
 func (ds *SelectorCacheTestSuite) TestIdentityUpdates(c *C) {
 	sc := testNewSelectorCache(cache.IdentityCache{})
 
@@ -682,6 +736,45 @@ func (ds *SelectorCacheTestSuite) TestIdentityNotifier(c *C) {
 
 }
 
+func (ds *SelectorCacheTestSuite) TestNotificationCoalescing(c *C) {
+	user1 := newUser(c, "user1", nil)
+	user2 := newUser(c, "user2", nil)
+	sel1 := CachedSelector(nil)
+	wg := &sync.WaitGroup{}
+
+	note := func(user CachedSelectionUser, added, deleted []identity.NumericIdentity) userNotification {
+		wg.Add(1)
+		return userNotification{user: user, selector: sel1, added: added, deleted: deleted, wg: wg}
+	}
+
+	// Notifications for the same user and selector are merged into one,
+	// an add/delete pair for the same identity cancels out, and
+	// notifications for other users are left alone.
+	merged := coalesceUserNotifications([]userNotification{
+		note(user1, []identity.NumericIdentity{1000}, nil),
+		note(user1, []identity.NumericIdentity{1001, 1002}, nil),
+		note(user2, []identity.NumericIdentity{1000}, nil),
+		note(user1, nil, []identity.NumericIdentity{1001, 1003}),
+	})
+	c.Assert(len(merged), Equals, 2)
+	c.Assert(merged[0].user, Equals, CachedSelectionUser(user1))
+	c.Assert(merged[0].added, checker.DeepEquals, []identity.NumericIdentity{1000, 1002})
+	c.Assert(merged[0].deleted, checker.DeepEquals, []identity.NumericIdentity{1003})
+	c.Assert(len(merged[0].mergedWgs), Equals, 2)
+	c.Assert(merged[1].user, Equals, CachedSelectionUser(user2))
+	c.Assert(merged[1].added, checker.DeepEquals, []identity.NumericIdentity{1000})
+
+	// All wait groups are accounted for: one Done() per original
+	// notification must unblock the Wait().
+	for _, n := range merged {
+		n.wg.Done()
+		for _, mwg := range n.mergedWgs {
+			mwg.Done()
+		}
+	}
+	wg.Wait()
+}
+
 func testNewSelectorCache(ids cache.IdentityCache) *SelectorCache {
 	sc := NewSelectorCache(ids)
 	sc.SetLocalIdentityNotifier(testutils.NewDummyIdentityNotifier())
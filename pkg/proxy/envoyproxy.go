@@ -63,7 +63,7 @@ func createEnvoyRedirect(r *Redirect, stateDir string, xdsServer *envoy.XDSServe
 			mayUseOriginalSourceAddr = false
 		}
 		xdsServer.AddListener(redir.listenerName, l.parserType, l.proxyPort, l.ingress,
-			mayUseOriginalSourceAddr, wg)
+			mayUseOriginalSourceAddr, r.httpListenerSettings(), wg)
 
 		return redir, nil
 	}
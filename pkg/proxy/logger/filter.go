@@ -0,0 +1,149 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"github.com/cilium/cilium/pkg/proxy/accesslog"
+)
+
+// RedactedValue replaces the value of redacted headers and query parameters
+// in emitted access log records.
+const RedactedValue = "REDACTED"
+
+var (
+	// sampleRate causes only one out of every sampleRate allowed requests
+	// to be emitted as a log record. Denied and errored requests are always
+	// emitted. A rate of 0 or 1 disables sampling.
+	sampleRate uint64
+
+	// sampleCounter counts the records subject to sampling, so that every
+	// sampleRate'th one is emitted.
+	sampleCounter uint64
+
+	// redactedFields is the set of lower-cased header names and query
+	// parameter names whose values are redacted before a record is emitted.
+	redactedFields map[string]struct{}
+)
+
+// SetSampleRate sets the access log sampling rate. Only one out of every
+// rate allowed requests is logged; denied and errored requests are always
+// logged. A rate of 0 or 1 logs every request.
+func SetSampleRate(rate int) {
+	logMutex.Lock()
+	defer logMutex.Unlock()
+
+	if rate < 0 {
+		rate = 0
+	}
+	atomic.StoreUint64(&sampleRate, uint64(rate))
+}
+
+// SetRedactedFields sets the list of header names and query parameter names
+// whose values are redacted in emitted access log records. Matching is
+// case-insensitive.
+func SetRedactedFields(names []string) {
+	fields := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		fields[strings.ToLower(name)] = struct{}{}
+	}
+
+	logMutex.Lock()
+	defer logMutex.Unlock()
+
+	if len(fields) == 0 {
+		redactedFields = nil
+	} else {
+		redactedFields = fields
+	}
+}
+
+// sampledOut returns true if the record must be dropped due to sampling.
+// Only successful verdicts are sampled; denied and errored requests are
+// always emitted so that policy drops remain visible. Proxy statistics are
+// updated before the record reaches the logger, so sampling does not affect
+// metrics.
+func (lr *LogRecord) sampledOut() bool {
+	rate := atomic.LoadUint64(&sampleRate)
+	if rate <= 1 {
+		return false
+	}
+
+	if lr.Verdict != accesslog.VerdictForwarded {
+		return false
+	}
+
+	return atomic.AddUint64(&sampleCounter, 1)%rate != 0
+}
+
+// redact replaces the values of redacted headers and query parameters in the
+// record. It must be called with logMutex held, before the record is passed
+// to the notifier.
+func (lr *LogRecord) redact() {
+	if redactedFields == nil || lr.HTTP == nil {
+		return
+	}
+
+	redactHeaders(lr.HTTP.Headers)
+	redactHeaders(lr.HTTP.MissingHeaders)
+	redactHeaders(lr.HTTP.RejectedHeaders)
+
+	if lr.HTTP.URL != nil {
+		lr.HTTP.URL = redactURL(lr.HTTP.URL)
+	}
+}
+
+func redactHeaders(headers http.Header) {
+	for name, values := range headers {
+		if _, ok := redactedFields[strings.ToLower(name)]; !ok {
+			continue
+		}
+		redacted := make([]string, len(values))
+		for i := range redacted {
+			redacted[i] = RedactedValue
+		}
+		headers[name] = redacted
+	}
+}
+
+// redactURL returns a copy of u with the values of redacted query parameters
+// replaced. The original URL is left untouched as it may be shared with the
+// parser that produced the record.
+func redactURL(u *url.URL) *url.URL {
+	query := u.Query()
+	modified := false
+	for name, values := range query {
+		if _, ok := redactedFields[strings.ToLower(name)]; !ok {
+			continue
+		}
+		redacted := make([]string, len(values))
+		for i := range redacted {
+			redacted[i] = RedactedValue
+		}
+		query[name] = redacted
+		modified = true
+	}
+	if !modified {
+		return u
+	}
+
+	redacted := *u
+	redacted.RawQuery = query.Encode()
+	return &redacted
+}
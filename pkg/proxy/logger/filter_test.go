@@ -0,0 +1,125 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package logger
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/cilium/cilium/pkg/proxy/accesslog"
+
+	. "gopkg.in/check.v1"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type LoggerSuite struct{}
+
+var _ = Suite(&LoggerSuite{})
+
+func httpRecord(verdict accesslog.FlowVerdict, rawurl string, headers http.Header) *LogRecord {
+	u, _ := url.Parse(rawurl)
+	return &LogRecord{
+		LogRecord: accesslog.LogRecord{
+			Type:    accesslog.TypeRequest,
+			Verdict: verdict,
+			HTTP: &accesslog.LogRecordHTTP{
+				URL:     u,
+				Headers: headers,
+			},
+		},
+	}
+}
+
+func (s *LoggerSuite) TestSampling(c *C) {
+	defer SetSampleRate(0)
+
+	SetSampleRate(0)
+	lr := httpRecord(accesslog.VerdictForwarded, "http://foo/bar", nil)
+	for i := 0; i < 10; i++ {
+		c.Assert(lr.sampledOut(), Equals, false)
+	}
+
+	SetSampleRate(4)
+	emitted := 0
+	for i := 0; i < 100; i++ {
+		if !lr.sampledOut() {
+			emitted++
+		}
+	}
+	c.Assert(emitted, Equals, 25)
+
+	// Denied and errored requests are never sampled out.
+	denied := httpRecord(accesslog.VerdictDenied, "http://foo/bar", nil)
+	errored := httpRecord(accesslog.VerdictError, "http://foo/bar", nil)
+	for i := 0; i < 10; i++ {
+		c.Assert(denied.sampledOut(), Equals, false)
+		c.Assert(errored.sampledOut(), Equals, false)
+	}
+}
+
+func (s *LoggerSuite) TestRedactHeaders(c *C) {
+	defer SetRedactedFields(nil)
+
+	SetRedactedFields([]string{"Authorization", "x-api-key"})
+
+	lr := httpRecord(accesslog.VerdictForwarded, "http://foo/bar", http.Header{
+		"Authorization": {"Bearer secret"},
+		"X-Api-Key":     {"key1", "key2"},
+		"Content-Type":  {"application/json"},
+	})
+	lr.redact()
+
+	c.Assert(lr.HTTP.Headers["Authorization"], DeepEquals, []string{RedactedValue})
+	c.Assert(lr.HTTP.Headers["X-Api-Key"], DeepEquals, []string{RedactedValue, RedactedValue})
+	c.Assert(lr.HTTP.Headers["Content-Type"], DeepEquals, []string{"application/json"})
+}
+
+func (s *LoggerSuite) TestRedactQueryParameters(c *C) {
+	defer SetRedactedFields(nil)
+
+	SetRedactedFields([]string{"token"})
+
+	lr := httpRecord(accesslog.VerdictForwarded, "http://foo/bar?token=secret&q=ok", nil)
+	lr.redact()
+
+	q := lr.HTTP.URL.Query()
+	c.Assert(q.Get("token"), Equals, RedactedValue)
+	c.Assert(q.Get("q"), Equals, "ok")
+
+	// Records without matching parameters are left untouched.
+	lr = httpRecord(accesslog.VerdictForwarded, "http://foo/bar?q=ok", nil)
+	orig := lr.HTTP.URL
+	lr.redact()
+	c.Assert(lr.HTTP.URL, Equals, orig)
+}
+
+func (s *LoggerSuite) TestRedactDisabled(c *C) {
+	SetRedactedFields(nil)
+
+	lr := httpRecord(accesslog.VerdictForwarded, "http://foo/bar?token=secret", http.Header{
+		"Authorization": {"Bearer secret"},
+	})
+	lr.redact()
+
+	c.Assert(lr.HTTP.Headers["Authorization"], DeepEquals, []string{"Bearer secret"})
+	c.Assert(lr.HTTP.URL.Query().Get("token"), Equals, "secret")
+}
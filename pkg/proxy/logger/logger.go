@@ -318,11 +318,17 @@ func (lr *LogRecord) getLogFields() *logrus.Entry {
 
 // Log logs a record to the logfile and flushes the buffer
 func (lr *LogRecord) Log() {
-	flowdebug.Log(lr.getLogFields(), "Logging flow record")
+	if lr.sampledOut() {
+		return
+	}
 
 	logMutex.Lock()
 	defer logMutex.Unlock()
 
+	lr.redact()
+
+	flowdebug.Log(lr.getLogFields(), "Logging flow record")
+
 	lr.Metadata = metadata
 
 	if notifier != nil {
@@ -15,6 +15,7 @@
 package proxy
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/node"
+	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy"
 	"github.com/cilium/cilium/pkg/proxy/logger"
 	"github.com/cilium/cilium/pkg/rand"
@@ -107,6 +109,10 @@ type Proxy struct {
 	// Datapath updater for installing and removing proxy rules for a single
 	// proxy port
 	datapathUpdater DatapathUpdater
+
+	// draining is true once Drain has been called. No new redirects are
+	// accepted once the proxy is draining.
+	draining bool
 }
 
 // StartProxySupport starts the servers to support L7 proxies: xDS GRPC server
@@ -125,6 +131,9 @@ func StartProxySupport(minPort uint16, maxPort uint16, stateDir string,
 		logger.SetMetadata(accessLogMetadata)
 	}
 
+	logger.SetSampleRate(option.Config.ProxyAccessLogSampleRate)
+	logger.SetRedactedFields(option.Config.ProxyAccessLogRedactFields)
+
 	envoy.StartAccessLogServer(stateDir, xdsServer, DefaultEndpointInfoRegistry)
 
 	return &Proxy{
@@ -296,6 +305,7 @@ func (p *Proxy) releaseProxyPort(name string) error {
 		delete(allocatedPorts, pp.proxyPort)
 		// Force new port allocation the next time this ProxyPort is used.
 		pp.configured = false
+		p.storeProxyPortsLocked()
 		// Leave the datapath rules behind on the hope that they get reused later.
 		// This becomes possible when we are able to keep the proxy listeners
 		// configured also when there are no redirects.
@@ -363,6 +373,7 @@ func (p *Proxy) SetProxyPort(name string, port uint16) error {
 	pp.proxyPort = port
 	pp.isStatic = true // prevents release of the proxy port
 	pp.reservePort()   // marks 'port' as reserved, 'pp' as configured
+	p.storeProxyPortsLocked()
 	return nil
 }
 
@@ -407,6 +418,10 @@ func (p *Proxy) CreateOrUpdateRedirect(l4 policy.ProxyPolicy, id string, localEn
 
 	scopedLog := log.WithField(fieldProxyRedirectID, id)
 
+	if p.draining {
+		return 0, fmt.Errorf("proxy is draining for shutdown"), nil, nil
+	}
+
 	var revertStack revert.RevertStack
 	revertFunc = revertStack.Revert
 
@@ -493,6 +508,7 @@ func (p *Proxy) CreateOrUpdateRedirect(l4 policy.ProxyPolicy, id string, localEn
 			// must mark the proxyPort configured while we still hold the lock to prevent racing between
 			// two parallel runs
 			pp.reservePort()
+			p.storeProxyPortsLocked()
 
 			revertStack.Push(func() error {
 				// Proxy port refcount has not been incremented yet, so it must not be decremented
@@ -544,6 +560,38 @@ func (p *Proxy) RemoveRedirect(id string, wg *completion.WaitGroup) (error, reve
 	return p.removeRedirect(id, wg)
 }
 
+// Drain prepares the proxy for agent shutdown. No new redirects are
+// accepted, the listeners of all existing redirects are closed so that no
+// new connections are proxied, and in-flight requests are given until ctx
+// expires to complete. Drain never blocks beyond the lifetime of ctx, even
+// if a proxy connection hangs.
+func (p *Proxy) Drain(ctx context.Context) error {
+	wg := completion.NewWaitGroup(ctx)
+	var finalizeList revert.FinalizeList
+
+	p.mutex.Lock()
+	p.draining = true
+	ids := make([]string, 0, len(p.redirects))
+	for id := range p.redirects {
+		ids = append(ids, id)
+	}
+	for _, id := range ids {
+		err, finalizeFunc, _ := p.removeRedirect(id, wg)
+		if err != nil {
+			log.WithField(fieldProxyRedirectID, id).WithError(err).
+				Warning("Unable to remove proxy redirect on drain")
+			continue
+		}
+		finalizeList.Append(finalizeFunc)
+	}
+	p.updateRedirectMetrics()
+	p.mutex.Unlock()
+
+	err := wg.Wait()
+	finalizeList.Finalize()
+	return err
+}
+
 // removeRedirect removes an existing redirect. p.mutex must be held
 // p.mutex must NOT be held when the returned revert function is called!
 // proxyPortsMutex must NOT be held when the returned finalize function is called!
@@ -16,8 +16,10 @@ package proxy
 
 import (
 	"github.com/cilium/cilium/pkg/completion"
+	"github.com/cilium/cilium/pkg/envoy"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/policy"
+	"github.com/cilium/cilium/pkg/policy/api"
 	"github.com/cilium/cilium/pkg/proxy/logger"
 	"github.com/cilium/cilium/pkg/revert"
 )
@@ -63,6 +65,27 @@ func newRedirect(localEndpoint logger.EndpointUpdater, listener *ProxyPort, dstP
 	}
 }
 
+// httpListenerSettings resolves the per-listener HTTP settings from the
+// redirect's policy rules. Returns nil when no rule sets any of them, in
+// which case the agent-wide defaults apply. Redirect.mutex must be held.
+func (r *Redirect) httpListenerSettings() *envoy.ListenerHTTPSettings {
+	var rules []api.PortRuleHTTP
+	for _, l7 := range r.rules {
+		if l7 != nil {
+			rules = append(rules, l7.L7Rules.HTTP...)
+		}
+	}
+	requestTimeout, idleTimeout, maxConcurrentRequests := api.HTTPListenerSettings(rules)
+	if requestTimeout == 0 && idleTimeout == 0 && maxConcurrentRequests == 0 {
+		return nil
+	}
+	return &envoy.ListenerHTTPSettings{
+		RequestTimeout:        requestTimeout,
+		IdleTimeout:           idleTimeout,
+		MaxConcurrentRequests: maxConcurrentRequests,
+	}
+}
+
 // updateRules updates the rules of the redirect, Redirect.mutex must be held
 // 'implementation' is not initialized when this is called the first time.
 // TODO: Replace this with RedirectImplementation UpdateRules method!
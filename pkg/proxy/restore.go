@@ -0,0 +1,98 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// proxyPortsStateFile is the file in the state directory where the proxy
+// port allocations are persisted across agent restarts.
+const proxyPortsStateFile = "proxy_ports_state.json"
+
+// storeProxyPortsLocked persists the current proxy port allocations (name to
+// port number) to the state directory so that they can be restored after an
+// agent restart. Failures are logged but otherwise non-fatal as the worst
+// case outcome is a new port allocation after a restart.
+// Must be called with proxyPortsMutex held!
+func (p *Proxy) storeProxyPortsLocked() {
+	ports := make(map[string]uint16, len(proxyPorts))
+	for i := range proxyPorts {
+		if proxyPorts[i].configured && proxyPorts[i].proxyPort != 0 {
+			ports[proxyPorts[i].name] = proxyPorts[i].proxyPort
+		}
+	}
+
+	data, err := json.Marshal(ports)
+	if err != nil {
+		log.WithError(err).Warning("Unable to encode proxy ports state")
+		return
+	}
+
+	path := filepath.Join(p.stateDir, proxyPortsStateFile)
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		log.WithError(err).Warning("Unable to store proxy ports state")
+		return
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		log.WithError(err).Warning("Unable to store proxy ports state")
+	}
+}
+
+// RestoreProxyPorts restores the proxy port allocations of the previous agent
+// run from the state directory so that listener ports remain stable across
+// restarts and in-flight redirect state keeps pointing at the right ports.
+// Restored ports are proposals only: the datapath rules are installed once
+// the respective proxy is configured, and a port which has been taken by
+// another process in the meantime is dropped with a warning and re-allocated.
+// Must be called before any redirects are created.
+func (p *Proxy) RestoreProxyPorts() {
+	path := filepath.Join(p.stateDir, proxyPortsStateFile)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithError(err).Warning("Unable to read proxy ports state")
+		}
+		return
+	}
+
+	ports := map[string]uint16{}
+	if err := json.Unmarshal(data, &ports); err != nil {
+		log.WithError(err).Warning("Unable to parse proxy ports state, proxy ports will be re-allocated")
+		return
+	}
+
+	// Get a snapshot of the TCP and UDP ports already open locally.
+	openLocalPorts := readOpenLocalPorts(append(procNetTCPFiles, procNetUDPFiles...))
+
+	proxyPortsMutex.Lock()
+	defer proxyPortsMutex.Unlock()
+	for name, port := range ports {
+		pp := findProxyPort(name)
+		if pp == nil {
+			// Listener no longer exists in this version
+			continue
+		}
+		if !isPortAvailable(openLocalPorts, port) {
+			log.Warningf("Restored proxy port %d for %s is already in use, re-allocating", port, name)
+			continue
+		}
+		pp.proxyPort = port
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !privileged_tests
+
+package proxy
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) { TestingT(t) }
+
+type ProxySuite struct{}
+
+var _ = Suite(&ProxySuite{})
+
+// resetProxyPort restores the global state mutated by the test for the given
+// proxy port.
+func resetProxyPort(name string) {
+	proxyPortsMutex.Lock()
+	defer proxyPortsMutex.Unlock()
+	pp := findProxyPort(name)
+	delete(allocatedPorts, pp.proxyPort)
+	pp.proxyPort = 0
+	pp.configured = false
+	pp.isStatic = false
+}
+
+func (s *ProxySuite) TestRestoreProxyPorts(c *C) {
+	stateDir, err := ioutil.TempDir("", "proxy_restore_test")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(stateDir)
+	defer resetProxyPort("cilium-dns-egress")
+
+	p := &Proxy{stateDir: stateDir, rangeMin: 10000, rangeMax: 20000}
+
+	// Configure a proxy port and persist the allocation
+	proxyPortsMutex.Lock()
+	pp := findProxyPort("cilium-dns-egress")
+	c.Assert(pp, Not(IsNil))
+	pp.proxyPort = 10001
+	pp.reservePort()
+	p.storeProxyPortsLocked()
+
+	// Simulate an agent restart by dropping the in-memory allocation
+	delete(allocatedPorts, pp.proxyPort)
+	pp.proxyPort = 0
+	pp.configured = false
+	proxyPortsMutex.Unlock()
+
+	p.RestoreProxyPorts()
+
+	proxyPortsMutex.Lock()
+	c.Assert(pp.proxyPort, Equals, uint16(10001))
+	// The restored port is only a proposal, allocation happens when the
+	// proxy is configured
+	c.Assert(pp.configured, Equals, false)
+	proxyPortsMutex.Unlock()
+}
+
+func (s *ProxySuite) TestRestoreProxyPortsStolen(c *C) {
+	stateDir, err := ioutil.TempDir("", "proxy_restore_test")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(stateDir)
+	defer resetProxyPort("cilium-dns-egress")
+
+	p := &Proxy{stateDir: stateDir, rangeMin: 10000, rangeMax: 20000}
+
+	// Occupy a local port as another process would between restarts
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	defer listener.Close()
+	stolenPort := uint16(listener.Addr().(*net.TCPAddr).Port)
+
+	// Write a state file claiming the now-stolen port
+	data, err := json.Marshal(map[string]uint16{"cilium-dns-egress": stolenPort})
+	c.Assert(err, IsNil)
+	err = ioutil.WriteFile(filepath.Join(stateDir, proxyPortsStateFile), data, 0644)
+	c.Assert(err, IsNil)
+
+	p.RestoreProxyPorts()
+
+	// The stolen port must not be restored, a new port is allocated when
+	// the proxy is configured
+	proxyPortsMutex.Lock()
+	pp := findProxyPort("cilium-dns-egress")
+	c.Assert(pp.proxyPort, Not(Equals), stolenPort)
+	c.Assert(pp.proxyPort, Equals, uint16(0))
+	proxyPortsMutex.Unlock()
+}
@@ -92,9 +92,89 @@ type outcome string
 const (
 	outcomeParallelMaxWait outcome = "fail-parallel-wait"
 	outcomeLimitMaxWait    outcome = "fail-limit-wait"
+	outcomeClientMaxWait   outcome = "fail-client-wait"
+	outcomePriorityMaxWait outcome = "fail-priority-wait"
 	outcomeReqCancelled    outcome = "request-cancelled"
 )
 
+// Priority is the priority class of an API call group within an
+// APILimiterSet. While requests of critical groups are waiting to be
+// admitted, admission of background group requests is suspended.
+type Priority int
+
+const (
+	// PriorityNormal is neither held back by nor holds back other groups.
+	// It is the default.
+	PriorityNormal Priority = iota
+
+	// PriorityCritical suspends admission of background groups while
+	// requests of this group are waiting to be admitted.
+	PriorityCritical
+
+	// PriorityBackground is only admitted while no critical requests are
+	// waiting.
+	PriorityBackground
+)
+
+// priorityGate couples the limiters of an APILimiterSet: as long as requests
+// of critical API groups are waiting to be admitted, admission of background
+// group requests is suspended.
+type priorityGate struct {
+	mutex lock.Mutex
+
+	// criticalWaiting is the number of requests of critical groups
+	// currently waiting to be admitted
+	criticalWaiting int
+
+	// idleCh is closed while criticalWaiting is zero and replaced with an
+	// open channel while critical requests are waiting
+	idleCh chan struct{}
+}
+
+func newPriorityGate() *priorityGate {
+	ch := make(chan struct{})
+	close(ch)
+	return &priorityGate{idleCh: ch}
+}
+
+func (g *priorityGate) enterCritical() {
+	g.mutex.Lock()
+	if g.criticalWaiting == 0 {
+		g.idleCh = make(chan struct{})
+	}
+	g.criticalWaiting++
+	g.mutex.Unlock()
+}
+
+func (g *priorityGate) exitCritical() {
+	g.mutex.Lock()
+	g.criticalWaiting--
+	if g.criticalWaiting == 0 {
+		close(g.idleCh)
+	}
+	g.mutex.Unlock()
+}
+
+// waitIdle blocks until no critical requests are waiting to be admitted or
+// the context is cancelled.
+func (g *priorityGate) waitIdle(ctx context.Context) error {
+	for {
+		g.mutex.Lock()
+		if g.criticalWaiting == 0 {
+			g.mutex.Unlock()
+			return nil
+		}
+		ch := g.idleCh
+		g.mutex.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 // APILimiter is an extension to x/time/rate.Limiter specifically for Cilium
 // API calls. It allows to automatically adjust the rate, burst and maximum
 // parallel API calls to stay as close as possible to an estimated processing
@@ -158,6 +238,17 @@ type APILimiter struct {
 	// waitSemaphoreResolution and each API request will acquire
 	// waitSemaphoreResolution/params.MaxParallel tokens.
 	parallelWaitSemaphore *semaphore.Weighted
+
+	// clientSemaphores is the set of semaphores used to implement
+	// params.MaxParallelPerClient, keyed by the client identifier passed
+	// into WaitForClient()
+	clientSemaphores map[string]*semaphore.Weighted
+
+	// priorityGate links the limiter with the other limiters of an
+	// APILimiterSet to implement params.Priority. It is nil for
+	// standalone limiters. This field is immutable after
+	// NewAPILimiterSet().
+	priorityGate *priorityGate
 }
 
 // APILimiterParameters is the configuration of an APILimiter. The structure
@@ -227,6 +318,17 @@ type APILimiterParameters struct {
 	// MaxAdjustmentFactor is the maximum adjustment factor when AutoAdjust
 	// is enabled. Base values will not adjust more than by this factor.
 	MaxAdjustmentFactor float64
+
+	// MaxParallelPerClient is the parallel requests allowed for a single
+	// client as identified by the caller of WaitForClient(). A value of 0
+	// disables per-client fairness. Requests above the per-client limit
+	// queue on the client's own slot without consuming the capacity of
+	// other clients.
+	MaxParallelPerClient int
+
+	// Priority is the priority class of the API group when the limiter is
+	// part of an APILimiterSet. It has no effect on standalone limiters.
+	Priority Priority
 }
 
 // MergeUserConfig merges the provided user configuration into the existing
@@ -266,6 +368,7 @@ func NewAPILimiter(name string, p APILimiterParameters, metrics MetricsObserver)
 		params:                p,
 		parallelRequests:      p.ParallelRequests,
 		parallelWaitSemaphore: semaphore.NewWeighted(waitSemaphoreResolution),
+		clientSemaphores:      map[string]*semaphore.Weighted{},
 		metrics:               metrics,
 	}
 
@@ -373,6 +476,23 @@ func (p *APILimiterParameters) mergeUserConfigKeyValue(key, value string) error
 			return err
 		}
 		p.SkipInitial = skipInitial
+	case "max-parallel-per-client":
+		maxParallelPerClient, err := parsePositiveInt(value)
+		if err != nil {
+			return err
+		}
+		p.MaxParallelPerClient = maxParallelPerClient
+	case "priority":
+		switch strings.ToLower(value) {
+		case "normal":
+			p.Priority = PriorityNormal
+		case "critical":
+			p.Priority = PriorityCritical
+		case "background":
+			p.Priority = PriorityBackground
+		default:
+			return fmt.Errorf("unknown priority %q, must be normal, critical or background", value)
+		}
 	default:
 		return fmt.Errorf("unknown rate limiting option %q", key)
 	}
@@ -443,6 +563,20 @@ func (l *APILimiter) adjustedParallelRequests() int {
 	return int(l.adjustmentLimit(newParallelRequests, float64(l.params.ParallelRequests)))
 }
 
+// clientSemaphore returns the semaphore accounting the parallel requests of
+// the given client, creating it on first use.
+func (l *APILimiter) clientSemaphore(client string) *semaphore.Weighted {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	clientSemaphore, ok := l.clientSemaphores[client]
+	if !ok {
+		clientSemaphore = semaphore.NewWeighted(waitSemaphoreResolution)
+		l.clientSemaphores[client] = clientSemaphore
+	}
+	return clientSemaphore
+}
+
 func (l *APILimiter) requestFinished(r *limitedRequest, err error) {
 	if r.finished {
 		return
@@ -479,6 +613,10 @@ func (l *APILimiter) requestFinished(r *limitedRequest, err error) {
 		l.parallelWaitSemaphore.Release(r.waitSemaphoreWeight)
 	}
 
+	if r.clientSemaphoreWeight != 0 {
+		r.clientSemaphore.Release(r.clientSemaphoreWeight)
+	}
+
 	l.mutex.Lock()
 
 	if !r.startTime.IsZero() {
@@ -559,14 +697,16 @@ type LimitedRequest interface {
 }
 
 type limitedRequest struct {
-	limiter             *APILimiter
-	startTime           time.Time
-	scheduleTime        time.Time
-	waitDuration        time.Duration
-	waitSemaphoreWeight int64
-	uuid                string
-	finished            bool
-	outcome             outcome
+	limiter               *APILimiter
+	startTime             time.Time
+	scheduleTime          time.Time
+	waitDuration          time.Duration
+	waitSemaphoreWeight   int64
+	clientSemaphore       *semaphore.Weighted
+	clientSemaphoreWeight int64
+	uuid                  string
+	finished              bool
+	outcome               outcome
 }
 
 // WaitDuration returns the duration the request had to wait
@@ -589,7 +729,15 @@ func (l *limitedRequest) Error(err error) {
 // LimitedRequest is returned on which Done() must be called when the API call
 // has completed or Error() if an error occurred.
 func (l *APILimiter) Wait(ctx context.Context) (LimitedRequest, error) {
-	req, err := l.wait(ctx)
+	return l.WaitForClient(ctx, "")
+}
+
+// WaitForClient is identical to Wait() but additionally accounts the request
+// to the given client, enforcing params.MaxParallelPerClient so that a single
+// client cannot starve the requests of other clients. An empty client
+// identifier disables the per-client accounting for this request.
+func (l *APILimiter) WaitForClient(ctx context.Context, client string) (LimitedRequest, error) {
+	req, err := l.wait(ctx, client)
 	if err != nil {
 		l.requestFinished(req, err)
 		return nil, err
@@ -602,10 +750,11 @@ func (l *APILimiter) Wait(ctx context.Context) (LimitedRequest, error) {
 // Documentation/configuration/api-rate-limiting.rst. If any changes related to
 // errors or log messages are made to this function, please update the
 // aforementioned page as well.
-func (l *APILimiter) wait(ctx context.Context) (req *limitedRequest, err error) {
+func (l *APILimiter) wait(ctx context.Context, client string) (req *limitedRequest, err error) {
 	var (
 		limitWaitDuration time.Duration
 		r                 *rate.Reservation
+		waitCtx           context.Context
 	)
 
 	req = &limitedRequest{
@@ -659,17 +808,50 @@ func (l *APILimiter) wait(ctx context.Context) (req *limitedRequest, err error)
 		scopedLog.Debug("Processing API request with rate limiter")
 	}
 
+	waitCtx = ctx
+	if l.params.MaxWaitDuration > 0 {
+		ctx2, cancel := context.WithTimeout(ctx, l.params.MaxWaitDuration)
+		defer cancel()
+		waitCtx = ctx2
+	}
+
+	if l.priorityGate != nil && l.params.Priority == PriorityCritical {
+		l.priorityGate.enterCritical()
+		defer l.priorityGate.exitCritical()
+	}
+
 	if skip {
 		goto skipRateLimiter
 	}
 
-	if parallelRequests > 0 {
-		waitCtx := ctx
-		if l.params.MaxWaitDuration > 0 {
-			ctx2, cancel := context.WithTimeout(ctx, l.params.MaxWaitDuration)
-			defer cancel()
-			waitCtx = ctx2
+	if l.priorityGate != nil && l.params.Priority == PriorityBackground {
+		if err2 := l.priorityGate.waitIdle(waitCtx); err2 != nil {
+			if l.params.Log {
+				scopedLog.WithError(err2).Warning("Not processing API request. Wait duration for critical API requests exceeds maximum")
+			}
+			req.outcome = outcomePriorityMaxWait
+			err = fmt.Errorf("timed out while waiting for critical API requests to be served: %w", err2)
+			return
+		}
+	}
+
+	if l.params.MaxParallelPerClient > 0 && client != "" {
+		clientSemaphore := l.clientSemaphore(client)
+		w := int64(waitSemaphoreResolution / l.params.MaxParallelPerClient)
+		err2 := clientSemaphore.Acquire(waitCtx, w)
+		if err2 != nil {
+			if l.params.Log {
+				scopedLog.WithError(err2).Warning("Not processing API request. Wait duration for maximum parallel requests of the client exceeds maximum")
+			}
+			req.outcome = outcomeClientMaxWait
+			err = fmt.Errorf("timed out while waiting for other requests of client %q to be served: %w", client, err2)
+			return
 		}
+		req.clientSemaphore = clientSemaphore
+		req.clientSemaphoreWeight = w
+	}
+
+	if parallelRequests > 0 {
 		w := int64(waitSemaphoreResolution / parallelRequests)
 		err2 := l.parallelWaitSemaphore.Acquire(waitCtx, w)
 		if err2 != nil {
@@ -859,6 +1041,13 @@ func NewAPILimiterSet(config map[string]string, defaults map[string]APILimiterPa
 		}
 	}
 
+	// Link all limiters of the set together so that the configured
+	// priorities take effect across API groups.
+	gate := newPriorityGate()
+	for _, l := range limiters {
+		l.priorityGate = gate
+	}
+
 	return &APILimiterSet{
 		limiters: limiters,
 		metrics:  metrics,
@@ -888,6 +1077,18 @@ func (s *APILimiterSet) Wait(ctx context.Context, name string) (LimitedRequest,
 	return l.Wait(ctx)
 }
 
+// WaitForClient invokes WaitForClient() on the APILimiter with the given
+// name. If the limiter does not exist, a dummy limiter is used which will not
+// impose any restrictions.
+func (s *APILimiterSet) WaitForClient(ctx context.Context, name, client string) (LimitedRequest, error) {
+	l, ok := s.limiters[name]
+	if !ok {
+		return dummyRequest{}, nil
+	}
+
+	return l.WaitForClient(ctx, client)
+}
+
 // parsePositiveInt parses value as an int. It returns an error if value cannot
 // be parsed or is negative.
 func parsePositiveInt(value string) (int, error) {
@@ -408,6 +408,21 @@ func (b *ControllerSuite) TestNewAPILimiterFromConfig(c *check.C) {
 	c.Assert(l.params.MaxWaitDuration, check.Equals, 2*time.Second)
 	c.Assert(l.params.MinWaitDuration, check.Equals, 100*time.Millisecond)
 	c.Assert(l.params.MaxAdjustmentFactor, check.Equals, 50.0)
+
+	l, err = NewAPILimiterFromConfig("foo", "max-parallel-per-client:2,priority:background", nil)
+	c.Assert(err, check.IsNil)
+	c.Assert(l, check.Not(check.IsNil))
+	c.Assert(l.params.MaxParallelPerClient, check.Equals, 2)
+	c.Assert(l.params.Priority, check.Equals, PriorityBackground)
+
+	l, err = NewAPILimiterFromConfig("foo", "priority:critical", nil)
+	c.Assert(err, check.IsNil)
+	c.Assert(l, check.Not(check.IsNil))
+	c.Assert(l.params.Priority, check.Equals, PriorityCritical)
+
+	l, err = NewAPILimiterFromConfig("foo", "priority:foo", nil)
+	c.Assert(err, check.Not(check.IsNil))
+	c.Assert(l, check.IsNil)
 }
 
 func (b *ControllerSuite) TestNewAPILimiterSet(c *check.C) {
@@ -808,3 +823,98 @@ func (b *ControllerSuite) TestReservationCancel(c *check.C) {
 		req2.Done()
 	}
 }
+
+func (b *ControllerSuite) TestMaxParallelPerClient(c *check.C) {
+	a := NewAPILimiter("foo", APILimiterParameters{
+		ParallelRequests:     4,
+		MaxParallelPerClient: 1,
+		MaxWaitDuration:      100 * time.Millisecond,
+		Log:                  true,
+	}, nil)
+
+	// Occupy the only per-client slot of client "greedy"
+	req, err := a.WaitForClient(context.Background(), "greedy")
+	c.Assert(err, check.IsNil)
+	c.Assert(req, check.Not(check.IsNil))
+
+	// Additional requests of the same client queue on the client's own
+	// slot and time out...
+	req2, err := a.WaitForClient(context.Background(), "greedy")
+	c.Assert(err, check.ErrorMatches, ".*requests of client \"greedy\".*")
+	c.Assert(req2, check.IsNil)
+
+	// ...while other clients are still admitted immediately
+	req3, err := a.WaitForClient(context.Background(), "other")
+	c.Assert(err, check.IsNil)
+	c.Assert(req3, check.Not(check.IsNil))
+	req3.Done()
+
+	// Once the greedy client finished its request, it is served again
+	req.Done()
+	req4, err := a.WaitForClient(context.Background(), "greedy")
+	c.Assert(err, check.IsNil)
+	c.Assert(req4, check.Not(check.IsNil))
+	req4.Done()
+}
+
+func (b *ControllerSuite) TestPriorityBackgroundYields(c *check.C) {
+	// Validate that background API groups do not inflate the latency of
+	// critical API groups: while a critical request is waiting to be
+	// admitted, background requests are held back.
+	s, err := NewAPILimiterSet(nil, map[string]APILimiterParameters{
+		"create": {
+			ParallelRequests:    1,
+			MaxParallelRequests: 1,
+			MaxWaitDuration:     5 * time.Second,
+			Priority:            PriorityCritical,
+		},
+		"list": {
+			ParallelRequests: 2,
+			MaxWaitDuration:  100 * time.Millisecond,
+			Priority:         PriorityBackground,
+		},
+	}, nil)
+	c.Assert(err, check.IsNil)
+
+	// While no critical request is waiting, background requests are
+	// served as usual
+	req, err := s.Wait(context.Background(), "list")
+	c.Assert(err, check.IsNil)
+	req.Done()
+
+	// Occupy the only parallel request slot of the critical group...
+	req, err = s.Wait(context.Background(), "create")
+	c.Assert(err, check.IsNil)
+
+	// ...and queue a second critical request behind it
+	var numCompleted int32
+	go func() {
+		req2, err := s.Wait(context.Background(), "create")
+		c.Assert(err, check.IsNil)
+		req2.Done()
+		atomic.AddInt32(&numCompleted, 1)
+	}()
+
+	gate := s.Limiter("create").priorityGate
+	c.Assert(testutils.WaitUntil(func() bool {
+		gate.mutex.Lock()
+		defer gate.mutex.Unlock()
+		return gate.criticalWaiting > 0
+	}, time.Second), check.IsNil)
+
+	// Background requests must yield while the critical request waits
+	req3, err := s.Wait(context.Background(), "list")
+	c.Assert(err, check.ErrorMatches, ".*waiting for critical API requests.*")
+	c.Assert(req3, check.IsNil)
+
+	// Completing the first critical request unblocks the queued one and,
+	// subsequently, background requests again
+	req.Done()
+	c.Assert(testutils.WaitUntil(func() bool {
+		return atomic.LoadInt32(&numCompleted) == 1
+	}, 5*time.Second), check.IsNil)
+
+	req4, err := s.Wait(context.Background(), "list")
+	c.Assert(err, check.IsNil)
+	req4.Done()
+}
@@ -51,15 +51,21 @@ type RecorderTuple struct {
 	DstPrefix cidr.CIDR
 	DstPort   uint16
 	Proto     u8proto.U8proto
+	// SrcSecID and DstSecID, if non-zero, restrict the capture to
+	// traffic from resp. to the given security identity.
+	SrcSecID uint32
+	DstSecID uint32
 }
 
 // +k8s:deepcopy-gen=true
 type RecorderMask struct {
-	srcMask net.IPMask
-	srcPort uint16
-	dstMask net.IPMask
-	dstPort uint16
-	proto   u8proto.U8proto
+	srcMask  net.IPMask
+	srcPort  uint16
+	dstMask  net.IPMask
+	dstPort  uint16
+	proto    u8proto.U8proto
+	srcSecID uint32
+	dstSecID uint32
 }
 
 // +k8s:deepcopy-gen=true
@@ -143,6 +149,12 @@ func convertTupleToMask(t RecorderTuple) RecorderMask {
 	if t.Proto != 0 {
 		m.proto = 0xff
 	}
+	if t.SrcSecID != 0 {
+		m.srcSecID = 0xffffffff
+	}
+	if t.DstSecID != 0 {
+		m.dstSecID = 0xffffffff
+	}
 	copy(m.srcMask, t.SrcPrefix.Mask)
 	copy(m.dstMask, t.DstPrefix.Mask)
 	return m
@@ -162,19 +174,27 @@ func countMaskOnes(m RecorderMask) int {
 	if m.proto == 0xff {
 		ones += 8
 	}
+	if m.srcSecID == 0xffffffff {
+		ones += 32
+	}
+	if m.dstSecID == 0xffffffff {
+		ones += 32
+	}
 	return ones
 }
 
 func hashMask(x *RecorderMask) string {
-	return fmt.Sprintf("%s/%s/%x/%x/%x",
+	return fmt.Sprintf("%s/%s/%x/%x/%x/%x/%x",
 		x.srcMask.String(), x.dstMask.String(),
-		int(x.srcPort), int(x.dstPort), int(x.proto))
+		int(x.srcPort), int(x.dstPort), int(x.proto),
+		int(x.srcSecID), int(x.dstSecID))
 }
 
 func hashTuple(x *RecorderTuple) string {
-	return fmt.Sprintf("%s/%s/%x/%x/%x",
+	return fmt.Sprintf("%s/%s/%x/%x/%x/%x/%x",
 		x.SrcPrefix.String(), x.DstPrefix.String(),
-		int(x.SrcPort), int(x.DstPort), int(x.Proto))
+		int(x.SrcPort), int(x.DstPort), int(x.Proto),
+		int(x.SrcSecID), int(x.DstSecID))
 }
 
 func (t *RecorderTuple) isIPv4() bool {
@@ -204,6 +224,8 @@ func (m *RecorderMask) genMacroSpec() string {
 	spec += fmt.Sprintf(".dport=%#x,", m.dstPort)
 	spec += fmt.Sprintf(".sport=%#x,", m.srcPort)
 	spec += fmt.Sprintf(".nexthdr=%#x,", uint8(m.proto))
+	spec += fmt.Sprintf(".sec_id_src=%#x,", m.srcSecID)
+	spec += fmt.Sprintf(".sec_id_dst=%#x,", m.dstSecID)
 	spec += "},"
 	return spec
 }
@@ -278,6 +300,8 @@ func recorderTupleToMapTuple4(ri *RecInfo, t *RecorderTuple) (*recorder.CaptureW
 	}
 	k.DestPort = byteorder.HostToNetwork16(t.DstPort)
 	k.SrcPort = byteorder.HostToNetwork16(t.SrcPort)
+	k.SrcSecID = t.SrcSecID
+	k.DstSecID = t.DstSecID
 	copy(k.DestAddr[:], t.DstPrefix.IP.To4()[:])
 	copy(k.SrcAddr[:], t.SrcPrefix.IP.To4()[:])
 	v := &recorder.CaptureRule4{
@@ -298,6 +322,8 @@ func recorderTupleToMapTuple6(ri *RecInfo, t *RecorderTuple) (*recorder.CaptureW
 	}
 	k.DestPort = byteorder.HostToNetwork16(t.DstPort)
 	k.SrcPort = byteorder.HostToNetwork16(t.SrcPort)
+	k.SrcSecID = t.SrcSecID
+	k.DstSecID = t.DstSecID
 	copy(k.DestAddr[:], t.DstPrefix.IP.To16()[:])
 	copy(k.SrcAddr[:], t.SrcPrefix.IP.To16()[:])
 	v := &recorder.CaptureRule6{
@@ -553,6 +579,16 @@ func ModelToRecorder(mo *models.RecorderSpec) (*RecInfo, error) {
 			return nil, err
 		}
 		f.SrcPort = uint16(port)
+		if mf.SrcIdentity < 0 || mf.SrcIdentity > 0xffffffff {
+			return nil, fmt.Errorf("Recorder source identity %d out of range",
+				mf.SrcIdentity)
+		}
+		f.SrcSecID = uint32(mf.SrcIdentity)
+		if mf.DstIdentity < 0 || mf.DstIdentity > 0xffffffff {
+			return nil, fmt.Errorf("Recorder destination identity %d out of range",
+				mf.DstIdentity)
+		}
+		f.DstSecID = uint32(mf.DstIdentity)
 		switch mf.Protocol {
 		case models.RecorderFilterProtocolTCP:
 			f.Proto = u8proto.TCP
@@ -582,6 +618,8 @@ func RecorderToModel(ri *RecInfo) (*models.RecorderSpec, error) {
 		mf.SrcPrefix = rf.SrcPrefix.String()
 		mf.DstPort = fmt.Sprintf("%d", int(rf.DstPort))
 		mf.SrcPort = fmt.Sprintf("%d", int(rf.SrcPort))
+		mf.SrcIdentity = int64(rf.SrcSecID)
+		mf.DstIdentity = int64(rf.DstSecID)
 		switch rf.Proto {
 		case u8proto.TCP:
 			mf.Protocol = models.RecorderFilterProtocolTCP
@@ -608,5 +646,7 @@ func RecorderMaskToModel(rm *RecMask) *models.RecorderMaskSpec {
 	mo.DstPortMask = fmt.Sprintf("%x", int(rm.mask.dstPort))
 	mo.SrcPortMask = fmt.Sprintf("%x", int(rm.mask.srcPort))
 	mo.ProtocolMask = fmt.Sprintf("%x", int(rm.mask.proto))
+	mo.SrcIdentityMask = fmt.Sprintf("%x", int(rm.mask.srcSecID))
+	mo.DstIdentityMask = fmt.Sprintf("%x", int(rm.mask.dstSecID))
 	return mo
 }
@@ -0,0 +1,227 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	lb "github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/option"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// healthProbeFailureThreshold is the number of consecutive failed
+	// probes after which a backend is quarantined.
+	healthProbeFailureThreshold = 3
+
+	// healthProbeTimeout bounds a single backend probe.
+	healthProbeTimeout = 2 * time.Second
+
+	// healthProbeParallelism is the maximum number of backend probes
+	// which may be in flight at the same time.
+	healthProbeParallelism = 16
+)
+
+// probeTarget identifies a single backend of a service to be probed.
+type probeTarget struct {
+	svcID lb.ID
+	hash  string
+	addr  lb.L3n4Addr
+	path  string
+}
+
+// probeResult is the outcome of a single backend probe.
+type probeResult struct {
+	target  probeTarget
+	healthy bool
+}
+
+// StartBackendHealthChecking starts the periodic active backend health
+// checker. It probes the backends of all services from the node and
+// quarantines backends failing healthProbeFailureThreshold consecutive
+// probes until they pass a probe again. Quarantined backends are kept in
+// the BPF backend map, but are not selectable for new connections.
+func (s *Service) StartBackendHealthChecking() {
+	interval := option.Config.LBBackendHealthCheckInterval
+
+	log.WithField(logfields.Interval, interval).
+		Info("Starting active health checking of service backends")
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.probeBackends()
+		}
+	}()
+}
+
+// probeBackends probes all probeable backends once and re-syncs the
+// services whose quarantine state changed as a result.
+func (s *Service) probeBackends() {
+	targets := s.collectProbeTargets()
+	if len(targets) == 0 {
+		return
+	}
+
+	workers := healthProbeParallelism
+	if len(targets) < workers {
+		workers = len(targets)
+	}
+
+	jobs := make(chan int, len(targets))
+	results := make([]probeResult, len(targets))
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				results[j] = probeResult{
+					target:  targets[j],
+					healthy: probeBackend(targets[j]),
+				}
+			}
+		}()
+	}
+	for j := range targets {
+		jobs <- j
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, id := range s.applyProbeResults(results) {
+		s.resyncService(id)
+	}
+}
+
+// collectProbeTargets returns a probe target for each backend of each
+// service which can be probed from the node.
+func (s *Service) collectProbeTargets() []probeTarget {
+	s.RLock()
+	defer s.RUnlock()
+
+	var targets []probeTarget
+	for id, svc := range s.svcByID {
+		for _, b := range svc.backends {
+			// Terminating backends are about to go away, probing them
+			// would only cause the quarantine state to flap.
+			if b.Terminating {
+				continue
+			}
+			// UDP backends cannot be probed with a TCP connect or an
+			// HTTP request.
+			if b.Protocol == lb.UDP {
+				continue
+			}
+			targets = append(targets, probeTarget{
+				svcID: id,
+				hash:  b.L3n4Addr.Hash(),
+				addr:  *b.L3n4Addr.DeepCopy(),
+				path:  svc.healthProbePath,
+			})
+		}
+	}
+	return targets
+}
+
+// probeBackend checks whether a single backend is healthy. If a probe path
+// is set for the service, an HTTP GET to it must return a status code below
+// 400. Otherwise, a plain TCP connect to the backend must succeed.
+func probeBackend(target probeTarget) bool {
+	hostPort := net.JoinHostPort(target.addr.IP.String(),
+		strconv.Itoa(int(target.addr.Port)))
+
+	if target.path == "" {
+		conn, err := net.DialTimeout("tcp", hostPort, healthProbeTimeout)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}
+
+	client := &http.Client{Timeout: healthProbeTimeout}
+	resp, err := client.Get("http://" + hostPort + target.path)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode < http.StatusBadRequest
+}
+
+// applyProbeResults updates the per-backend probe counters and the
+// quarantine state, and returns the IDs of the services whose set of
+// selectable backends changed.
+func (s *Service) applyProbeResults(results []probeResult) []lb.ID {
+	s.Lock()
+	defer s.Unlock()
+
+	changed := map[lb.ID]struct{}{}
+	for _, r := range results {
+		svc, found := s.svcByID[r.target.svcID]
+		if !found {
+			continue
+		}
+
+		hash := r.target.hash
+		if r.healthy {
+			delete(svc.probeFailures, hash)
+			if _, quarantined := svc.quarantinedBackends[hash]; quarantined {
+				delete(svc.quarantinedBackends, hash)
+				changed[r.target.svcID] = struct{}{}
+				log.WithFields(logrus.Fields{
+					logfields.ServiceID: r.target.svcID,
+					logfields.L3n4Addr:  r.target.addr,
+				}).Info("Backend passed health probe again, removing quarantine")
+			}
+			continue
+		}
+
+		if _, quarantined := svc.quarantinedBackends[hash]; quarantined {
+			continue
+		}
+		if svc.probeFailures == nil {
+			svc.probeFailures = map[string]int{}
+		}
+		svc.probeFailures[hash]++
+		if svc.probeFailures[hash] < healthProbeFailureThreshold {
+			continue
+		}
+		delete(svc.probeFailures, hash)
+		if svc.quarantinedBackends == nil {
+			svc.quarantinedBackends = map[string]struct{}{}
+		}
+		svc.quarantinedBackends[hash] = struct{}{}
+		changed[r.target.svcID] = struct{}{}
+		log.WithFields(logrus.Fields{
+			logfields.ServiceID: r.target.svcID,
+			logfields.L3n4Addr:  r.target.addr,
+		}).Warn("Quarantining backend after failed health probes")
+	}
+
+	ids := make([]lb.ID, 0, len(changed))
+	for id := range changed {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,169 @@
+// Copyright 2021 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+
+	lb "github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+
+	"github.com/sirupsen/logrus"
+)
+
+// lbmapInSync reports whether the dumped BPF state of the service matches its
+// in-memory desired state. Only properties visible in a service map dump are
+// compared.
+func (svc *svcInfo) lbmapInSync(dumped *lb.SVC) bool {
+	if dumped.Type != svc.svcType {
+		return false
+	}
+
+	// Terminating and quarantined backends do not occupy backend slots,
+	// see upsertServiceIntoLBMaps().
+	active := map[lb.BackendID]struct{}{}
+	for _, b := range svc.backends {
+		if b.Terminating || b.Quarantined {
+			continue
+		}
+		active[b.ID] = struct{}{}
+	}
+
+	if len(dumped.Backends) != len(active) {
+		return false
+	}
+	for _, b := range dumped.Backends {
+		if _, found := active[b.ID]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+// ReconcileLBMaps diffs the service and backend BPF maps against the
+// in-memory service state and repairs any divergence: missing or diverging
+// entries are re-written and entries unknown to the agent are removed. The
+// service lock is held for the duration of the reconciliation so that
+// concurrent service updates cannot race the repairs.
+//
+// At most maxRepairs discrepancies are repaired in one invocation to bound
+// the map churn of a single run; the remainder is picked up by subsequent
+// invocations. Returns the number of repaired discrepancies.
+func (s *Service) ReconcileLBMaps(maxRepairs int) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	repaired := 0
+
+	// Restore desired backends missing from the backend map first so that
+	// service repairs below can reference them, and drop backend entries
+	// the agent has no knowledge of.
+	dumpedBackends, err := s.lbmap.DumpBackendMaps()
+	if err != nil {
+		return repaired, fmt.Errorf("unable to dump backend maps: %s", err)
+	}
+	dumpedBackendIDs := map[lb.BackendID]struct{}{}
+	for _, b := range dumpedBackends {
+		dumpedBackendIDs[b.ID] = struct{}{}
+	}
+	desiredBackendIDs := map[lb.BackendID]struct{}{}
+	for _, b := range s.backendByHash {
+		desiredBackendIDs[b.ID] = struct{}{}
+		if _, found := dumpedBackendIDs[b.ID]; found {
+			continue
+		}
+		if repaired >= maxRepairs {
+			return repaired, nil
+		}
+		log.WithField(logfields.BackendID, b.ID).
+			Warning("Reconciliation restoring backend missing from BPF map")
+		if err := s.lbmap.AddBackend(uint16(b.ID), b.L3n4Addr.IP,
+			b.L3n4Addr.L4Addr.Port, b.L3n4Addr.IsIPv6()); err != nil {
+			return repaired, err
+		}
+		repaired++
+	}
+	for _, b := range dumpedBackends {
+		if _, found := desiredBackendIDs[b.ID]; found {
+			continue
+		}
+		if repaired >= maxRepairs {
+			return repaired, nil
+		}
+		log.WithField(logfields.BackendID, b.ID).
+			Warning("Reconciliation removing unknown backend from BPF map")
+		if err := s.lbmap.DeleteBackendByID(uint16(b.ID), b.L3n4Addr.IsIPv6()); err != nil {
+			return repaired, err
+		}
+		repaired++
+	}
+
+	dumpedSVCs, errs := s.lbmap.DumpServiceMaps()
+	for _, err := range errs {
+		log.WithError(err).Warning("Error occurred while dumping service maps")
+	}
+	dumpedByHash := map[string]*lb.SVC{}
+	for _, svc := range dumpedSVCs {
+		dumpedByHash[svc.Frontend.Hash()] = svc
+	}
+
+	// Re-upsert services whose map state is missing or diverging.
+	for hash, svc := range s.svcByHash {
+		dumped, found := dumpedByHash[hash]
+		if found && svc.lbmapInSync(dumped) {
+			continue
+		}
+		if repaired >= maxRepairs {
+			return repaired, nil
+		}
+		prevBackendCount := 0
+		if found {
+			prevBackendCount = len(dumped.Backends)
+		}
+		scopedLog := log.WithFields(logrus.Fields{
+			logfields.ServiceID: svc.frontend.ID,
+			logfields.ServiceIP: svc.frontend.L3n4Addr.String(),
+		})
+		scopedLog.Warning("Reconciliation re-upserting service diverging from BPF map state")
+		onlyLocalBackends, _ := svc.requireNodeLocalBackends(svc.frontend)
+		if err := s.upsertServiceIntoLBMaps(svc, onlyLocalBackends, prevBackendCount,
+			nil, nil, svc.sessionAffinity, svc.loadBalancerSourceRanges,
+			nil, scopedLog); err != nil {
+			return repaired, err
+		}
+		repaired++
+	}
+
+	// Remove service entries the agent has no knowledge of.
+	for hash, dumped := range dumpedByHash {
+		if _, found := s.svcByHash[hash]; found {
+			continue
+		}
+		if repaired >= maxRepairs {
+			return repaired, nil
+		}
+		log.WithFields(logrus.Fields{
+			logfields.ServiceID: dumped.Frontend.ID,
+			logfields.ServiceIP: dumped.Frontend.L3n4Addr.String(),
+		}).Warning("Reconciliation removing unknown service from BPF map")
+		maglev := (&svcInfo{frontend: dumped.Frontend, svcType: dumped.Type}).useMaglev()
+		if err := s.lbmap.DeleteService(dumped.Frontend, len(dumped.Backends), maglev); err != nil {
+			return repaired, err
+		}
+		repaired++
+	}
+
+	return repaired, nil
+}
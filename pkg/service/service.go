@@ -17,6 +17,8 @@ package service
 import (
 	"fmt"
 	"net"
+	"runtime"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -53,6 +55,7 @@ type LBMap interface {
 	DeleteBackendByID(uint16, bool) error
 	AddAffinityMatch(uint16, uint16) error
 	DeleteAffinityMatch(uint16, uint16) error
+	PurgeAffinity(uint16) error
 	UpdateSourceRanges(uint16, []*cidr.CIDR, []*cidr.CIDR, bool) error
 	DumpServiceMaps() ([]*lb.SVC, []error)
 	DumpBackendMaps() ([]*lb.Backend, error)
@@ -84,8 +87,24 @@ type svcInfo struct {
 	svcHealthCheckNodePort    uint16
 	svcName                   string
 	svcNamespace              string
+	healthProbePath           string
 	loadBalancerSourceRanges  []*cidr.CIDR
 
+	// terminatingSince records when a backend (keyed by its L3n4Addr hash)
+	// entered the terminating state, to enforce the drain grace period.
+	terminatingSince map[string]time.Time
+	// drainTimer re-syncs the service once the drain grace period of its
+	// oldest terminating backend has passed.
+	drainTimer *time.Timer
+
+	// probeFailures counts consecutive failed health probes per backend
+	// (keyed by its L3n4Addr hash).
+	probeFailures map[string]int
+	// quarantinedBackends holds the backends (keyed by their L3n4Addr hash)
+	// which failed enough consecutive health probes to be excluded from
+	// backend selection.
+	quarantinedBackends map[string]struct{}
+
 	restoredFromDatapath bool
 }
 
@@ -122,6 +141,108 @@ func (svc *svcInfo) requireNodeLocalBackends(frontend lb.L3n4AddrID) (bool, bool
 	}
 }
 
+// activeBackendCount returns the number of backends which are selectable for
+// new connections, i.e. excluding terminating and quarantined ones. This
+// matches the number of backend slots written to the service BPF map.
+func (svc *svcInfo) activeBackendCount() int {
+	count := 0
+	for _, b := range svc.backends {
+		if !b.Terminating && !b.Quarantined {
+			count++
+		}
+	}
+	return count
+}
+
+// markQuarantinedBackends sets the Quarantined flag on the backends which
+// failed enough consecutive health probes, and forgets the probe state of
+// backends which are gone from the service.
+func (svc *svcInfo) markQuarantinedBackends(backends []lb.Backend) {
+	present := map[string]struct{}{}
+	for i := range backends {
+		hash := backends[i].L3n4Addr.Hash()
+		present[hash] = struct{}{}
+		_, quarantined := svc.quarantinedBackends[hash]
+		backends[i].Quarantined = quarantined
+	}
+
+	for hash := range svc.quarantinedBackends {
+		if _, found := present[hash]; !found {
+			delete(svc.quarantinedBackends, hash)
+		}
+	}
+	for hash := range svc.probeFailures {
+		if _, found := present[hash]; !found {
+			delete(svc.probeFailures, hash)
+		}
+	}
+}
+
+// filterTerminatingBackends records when each backend entered the terminating
+// state and drops the ones whose drain grace period has passed. With a zero
+// grace period terminating backends are kept until they are removed from
+// Kubernetes.
+func (svc *svcInfo) filterTerminatingBackends(backends []lb.Backend) []lb.Backend {
+	gracePeriod := option.Config.LBBackendDrainGracePeriod
+	now := time.Now()
+
+	terminating := map[string]struct{}{}
+	filtered := backends[:0]
+	for _, b := range backends {
+		if !b.Terminating {
+			filtered = append(filtered, b)
+			continue
+		}
+
+		hash := b.L3n4Addr.Hash()
+		terminating[hash] = struct{}{}
+		since, found := svc.terminatingSince[hash]
+		if !found {
+			if svc.terminatingSince == nil {
+				svc.terminatingSince = map[string]time.Time{}
+			}
+			svc.terminatingSince[hash] = now
+			since = now
+		}
+		if gracePeriod != 0 && now.Sub(since) >= gracePeriod {
+			continue
+		}
+		filtered = append(filtered, b)
+	}
+
+	// Forget backends which are gone or no longer terminating
+	for hash := range svc.terminatingSince {
+		if _, found := terminating[hash]; !found {
+			delete(svc.terminatingSince, hash)
+		}
+	}
+
+	return filtered
+}
+
+// nextDrainDeadline returns the time at which the drain grace period of the
+// oldest terminating backend expires.
+func (svc *svcInfo) nextDrainDeadline() (time.Time, bool) {
+	gracePeriod := option.Config.LBBackendDrainGracePeriod
+	if gracePeriod == 0 {
+		return time.Time{}, false
+	}
+
+	var deadline time.Time
+	now := time.Now()
+	for _, since := range svc.terminatingSince {
+		d := since.Add(gracePeriod)
+		if !d.After(now) {
+			// Already drained
+			continue
+		}
+		if deadline.IsZero() || d.Before(deadline) {
+			deadline = d
+		}
+	}
+	return deadline, !deadline.IsZero()
+}
+
 func (svc *svcInfo) useMaglev() bool {
 	return option.Config.NodePortAlg == option.NodePortAlgMaglev &&
 		((svc.svcType == lb.SVCTypeNodePort && !isWildcardAddr(svc.frontend)) ||
@@ -284,7 +405,7 @@ func (s *Service) UpsertService(params *lb.SVC) (bool, lb.ID, error) {
 	scopedLog.Debug("Acquired service ID")
 
 	onlyLocalBackends, filterBackends := svc.requireNodeLocalBackends(params.Frontend)
-	prevBackendCount := len(svc.backends)
+	prevBackendCount := svc.activeBackendCount()
 
 	backendsCopy := []lb.Backend{}
 	for _, b := range params.Backends {
@@ -297,6 +418,16 @@ func (s *Service) UpsertService(params *lb.SVC) (bool, lb.ID, error) {
 		backendsCopy = append(backendsCopy, *b.DeepCopy())
 	}
 
+	// Terminating backends remain in the BPF backend map so that established
+	// connections can drain, but are excluded from backend selection. Once
+	// the drain grace period has passed they are removed entirely.
+	backendsCopy = svc.filterTerminatingBackends(backendsCopy)
+
+	// Backends which failed their active health probes remain in the BPF
+	// backend map as well, but are excluded from backend selection until
+	// the probes succeed again.
+	svc.markQuarantinedBackends(backendsCopy)
+
 	// TODO (Aditi) When we filter backends for LocalRedirect service, there
 	// might be some backend pods with active connections. We may need to
 	// defer filtering the backends list (thereby defer redirecting traffic)
@@ -321,7 +452,8 @@ func (s *Service) UpsertService(params *lb.SVC) (bool, lb.ID, error) {
 	// only contain local backends (i.e. it has externalTrafficPolicy=Local)
 	if option.Config.EnableHealthCheckNodePort {
 		if onlyLocalBackends && filterBackends {
-			localBackendCount := len(backendsCopy)
+			// Terminating backends should not be reported as healthy
+			localBackendCount := svc.activeBackendCount()
 			s.healthServer.UpsertService(lb.ID(svc.frontend.ID), svc.svcNamespace, svc.svcName,
 				localBackendCount, svc.svcHealthCheckNodePort)
 		} else if svc.svcHealthCheckNodePort == 0 {
@@ -338,11 +470,68 @@ func (s *Service) UpsertService(params *lb.SVC) (bool, lb.ID, error) {
 		updateMetric.Inc()
 	}
 
+	s.scheduleTerminatingDrain(svc)
+
 	s.notifyMonitorServiceUpsert(svc.frontend, svc.backends,
 		svc.svcType, svc.svcTrafficPolicy, svc.svcName, svc.svcNamespace)
 	return new, lb.ID(svc.frontend.ID), nil
 }
 
+// scheduleTerminatingDrain arranges for the service to be re-synced once the
+// drain grace period of its oldest terminating backend has passed, so that
+// drained backends are removed from the datapath even if no further service
+// update arrives.
+func (s *Service) scheduleTerminatingDrain(svc *svcInfo) {
+	if svc.drainTimer != nil {
+		svc.drainTimer.Stop()
+		svc.drainTimer = nil
+	}
+
+	deadline, found := svc.nextDrainDeadline()
+	if !found {
+		return
+	}
+
+	id := svc.frontend.ID
+	svc.drainTimer = time.AfterFunc(time.Until(deadline), func() {
+		s.resyncService(id)
+	})
+}
+
+// resyncService re-upserts the given service with its cached backends so
+// that backend exclusions are re-evaluated, e.g. to remove terminating
+// backends whose drain grace period has passed or to apply a changed
+// quarantine state.
+func (s *Service) resyncService(id lb.ID) {
+	s.Lock()
+	svc, found := s.svcByID[id]
+	if !found {
+		s.Unlock()
+		return
+	}
+	params := &lb.SVC{
+		Frontend:                  *svc.frontend.DeepCopy(),
+		Type:                      svc.svcType,
+		TrafficPolicy:             svc.svcTrafficPolicy,
+		SessionAffinity:           svc.sessionAffinity,
+		SessionAffinityTimeoutSec: svc.sessionAffinityTimeoutSec,
+		HealthCheckNodePort:       svc.svcHealthCheckNodePort,
+		Name:                      svc.svcName,
+		Namespace:                 svc.svcNamespace,
+		HealthProbePath:           svc.healthProbePath,
+		LoadBalancerSourceRanges:  svc.loadBalancerSourceRanges,
+	}
+	for _, b := range svc.backends {
+		params.Backends = append(params.Backends, *b.DeepCopy())
+	}
+	s.Unlock()
+
+	if _, _, err := s.UpsertService(params); err != nil {
+		log.WithError(err).WithField(logfields.ServiceID, id).
+			Warn("Unable to re-sync backends of service")
+	}
+}
+
 // DeleteServiceByID removes a service identified by the given ID.
 func (s *Service) DeleteServiceByID(id lb.ServiceID) (bool, error) {
 	s.Lock()
@@ -355,6 +544,21 @@ func (s *Service) DeleteServiceByID(id lb.ServiceID) (bool, error) {
 	return false, nil
 }
 
+// PurgeServiceAffinity removes all session affinity entries of the given
+// service from the datapath, causing its clients to re-select a backend.
+// Affinity entries of other services are left intact. Returns true if the
+// service was found.
+func (s *Service) PurgeServiceAffinity(id lb.ServiceID) (bool, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if _, found := s.svcByID[lb.ID(id)]; !found {
+		return false, nil
+	}
+
+	return true, s.lbmap.PurgeAffinity(uint16(id))
+}
+
 // DeleteService removes the given service.
 func (s *Service) DeleteService(frontend lb.L3n4Addr) (bool, error) {
 	s.Lock()
@@ -566,6 +770,7 @@ func (s *Service) createSVCInfoIfNotExist(p *lb.SVC) (*svcInfo, bool, bool,
 
 			svcTrafficPolicy:         p.TrafficPolicy,
 			svcHealthCheckNodePort:   p.HealthCheckNodePort,
+			healthProbePath:          p.HealthProbePath,
 			loadBalancerSourceRanges: p.LoadBalancerSourceRanges,
 		}
 		s.svcByID[p.Frontend.ID] = svc
@@ -593,6 +798,7 @@ func (s *Service) createSVCInfoIfNotExist(p *lb.SVC) (*svcInfo, bool, bool,
 		svc.svcType = p.Type
 		svc.svcTrafficPolicy = p.TrafficPolicy
 		svc.svcHealthCheckNodePort = p.HealthCheckNodePort
+		svc.healthProbePath = p.HealthProbePath
 		svc.sessionAffinity = p.SessionAffinity
 		svc.sessionAffinityTimeoutSec = p.SessionAffinityTimeoutSec
 		svc.loadBalancerSourceRanges = p.LoadBalancerSourceRanges
@@ -671,6 +877,12 @@ func (s *Service) upsertServiceIntoLBMaps(svc *svcInfo, onlyLocalBackends bool,
 		} else if svc.sessionAffinity {
 			toAddAffinity = make([]lb.BackendID, 0, len(svc.backends))
 			for _, b := range svc.backends {
+				// No new affinity for terminating or quarantined backends.
+				// Existing matches are kept so that affine clients can
+				// drain with them.
+				if b.Terminating || b.Quarantined {
+					continue
+				}
 				toAddAffinity = append(toAddAffinity, b.ID)
 			}
 			if prevSessionAffinity {
@@ -713,9 +925,16 @@ func (s *Service) upsertServiceIntoLBMaps(svc *svcInfo, onlyLocalBackends bool,
 		}
 	}
 
-	// Upsert service entries into BPF maps
+	// Upsert service entries into BPF maps. Terminating and quarantined
+	// backends are not written to the backend slots (nor to the Maglev
+	// lookup table), so they are not selectable for new connections, but
+	// they remain in the backend map so that established connections can
+	// drain.
 	backends := make(map[string]uint16, len(svc.backends))
 	for _, b := range svc.backends {
+		if b.Terminating || b.Quarantined {
+			continue
+		}
 		backends[b.String()] = uint16(b.ID)
 	}
 
@@ -723,6 +942,7 @@ func (s *Service) upsertServiceIntoLBMaps(svc *svcInfo, onlyLocalBackends bool,
 		ID:                        uint16(svc.frontend.ID),
 		IP:                        svc.frontend.L3n4Addr.IP,
 		Port:                      svc.frontend.L3n4Addr.L4Addr.Port,
+		Protocol:                  svc.frontend.L3n4Addr.Protocol,
 		Backends:                  backends,
 		PrevBackendCount:          prevBackendCount,
 		IPv6:                      ipv6,
@@ -798,6 +1018,7 @@ func (s *Service) deleteOrphanBackends() error {
 
 func (s *Service) restoreServicesLocked() error {
 	failed, restored := 0, 0
+	var recomputeMaglev []*svcInfo
 
 	svcs, errors := s.lbmap.DumpServiceMaps()
 	for _, err := range errors {
@@ -846,13 +1067,7 @@ func (s *Service) restoreServicesLocked() error {
 		if option.Config.DatapathMode == datapathOpt.DatapathModeLBOnly &&
 			newSVC.useMaglev() && s.lbmap.IsMaglevLookupTableRecreated(ipv6) {
 
-			backends := make(map[string]uint16, len(newSVC.backends))
-			for _, b := range newSVC.backends {
-				backends[b.String()] = uint16(b.ID)
-			}
-			if err := s.lbmap.UpsertMaglevLookupTable(uint16(newSVC.frontend.ID), backends, ipv6); err != nil {
-				return err
-			}
+			recomputeMaglev = append(recomputeMaglev, newSVC)
 		}
 
 		s.svcByHash[newSVC.hash] = newSVC
@@ -860,6 +1075,10 @@ func (s *Service) restoreServicesLocked() error {
 		restored++
 	}
 
+	if err := s.recomputeMaglevTables(recomputeMaglev); err != nil {
+		return err
+	}
+
 	log.WithFields(logrus.Fields{
 		"restored": restored,
 		"failed":   failed,
@@ -868,6 +1087,55 @@ func (s *Service) restoreServicesLocked() error {
 	return nil
 }
 
+// recomputeMaglevTables recalculates the Maglev lookup tables of the given
+// services. The calculation is CPU-bound, so the services are processed by a
+// worker pool bounded to the number of available cores.
+func (s *Service) recomputeMaglevTables(svcs []*svcInfo) error {
+	if len(svcs) == 0 {
+		return nil
+	}
+
+	workers := runtime.NumCPU()
+	if workers > len(svcs) {
+		workers = len(svcs)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       lock.Mutex
+		firstErr error
+	)
+	jobs := make(chan *svcInfo)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for svc := range jobs {
+				backends := make(map[string]uint16, len(svc.backends))
+				for _, b := range svc.backends {
+					backends[b.String()] = uint16(b.ID)
+				}
+				err := s.lbmap.UpsertMaglevLookupTable(uint16(svc.frontend.ID),
+					backends, svc.frontend.IsIPv6())
+				if err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, svc := range svcs {
+		jobs <- svc
+	}
+	close(jobs)
+	wg.Wait()
+
+	return firstErr
+}
+
 func (s *Service) deleteServiceLocked(svc *svcInfo) error {
 	ipv6 := svc.frontend.L3n4Addr.IsIPv6()
 	obsoleteBackendIDs := s.deleteBackendsFromCacheLocked(svc)
@@ -879,7 +1147,12 @@ func (s *Service) deleteServiceLocked(svc *svcInfo) error {
 	})
 	scopedLog.Debug("Deleting service")
 
-	if err := s.lbmap.DeleteService(svc.frontend, len(svc.backends), svc.useMaglev()); err != nil {
+	if svc.drainTimer != nil {
+		svc.drainTimer.Stop()
+		svc.drainTimer = nil
+	}
+
+	if err := s.lbmap.DeleteService(svc.frontend, svc.activeBackendCount(), svc.useMaglev()); err != nil {
 		return err
 	}
 
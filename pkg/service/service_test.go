@@ -18,6 +18,7 @@ package service
 
 import (
 	"net"
+	"time"
 
 	"github.com/cilium/cilium/pkg/checker"
 	"github.com/cilium/cilium/pkg/cidr"
@@ -104,6 +105,34 @@ func (m *ManagerTestSuite) TestUpsertAndDeleteServiceWithoutIPv6(c *C) {
 	m.testUpsertAndDeleteService(c)
 }
 
+func (m *ManagerTestSuite) TestUpsertAndDeleteServiceSCTP(c *C) {
+	frontend := *lb.NewL3n4AddrID(lb.SCTP, net.ParseIP("1.1.1.3"), 2905, lb.ScopeExternal, 0)
+	backends := []lb.Backend{
+		*lb.NewBackend(0, lb.SCTP, net.ParseIP("10.0.0.4"), 2905),
+	}
+
+	p := &lb.SVC{
+		Frontend:      frontend,
+		Backends:      backends,
+		Type:          lb.SVCTypeNodePort,
+		TrafficPolicy: lb.SVCTrafficPolicyCluster,
+		Name:          "svc-sctp",
+		Namespace:     "ns1",
+	}
+	created, id, err := m.svc.UpsertService(p)
+	c.Assert(err, IsNil)
+	c.Assert(created, Equals, true)
+	// The frontend protocol must be handed through to the datapath so that
+	// SCTP services get their own key space in the service maps.
+	c.Assert(m.lbmap.ServiceByID[uint16(id)].Frontend.Protocol, Equals, lb.SCTP)
+	c.Assert(len(m.lbmap.ServiceByID[uint16(id)].Backends), Equals, 1)
+
+	found, err := m.svc.DeleteService(frontend.L3n4Addr)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, true)
+	c.Assert(len(m.lbmap.ServiceByID), Equals, 0)
+}
+
 func (m *ManagerTestSuite) testUpsertAndDeleteService(c *C) {
 	// Should create a new service with two backends and session affinity
 	p := &lb.SVC{
@@ -407,6 +436,163 @@ func (m *ManagerTestSuite) TestSyncWithK8sFinished(c *C) {
 	c.Assert(len(m.lbmap.AffinityMatch), Equals, 0)
 }
 
+func (m *ManagerTestSuite) TestTerminatingBackendDrain(c *C) {
+	m.testTerminatingBackendDrain(c)
+}
+
+func (m *ManagerTestSuite) TestTerminatingBackendDrainMaglev(c *C) {
+	option.Config.NodePortAlg = option.NodePortAlgMaglev
+	m.testTerminatingBackendDrain(c)
+}
+
+func (m *ManagerTestSuite) testTerminatingBackendDrain(c *C) {
+	prevGracePeriod := option.Config.LBBackendDrainGracePeriod
+	defer func() {
+		option.Config.LBBackendDrainGracePeriod = prevGracePeriod
+	}()
+	option.Config.LBBackendDrainGracePeriod = 0
+
+	p := &lb.SVC{
+		Frontend:      frontend1,
+		Backends:      backends1,
+		Type:          lb.SVCTypeNodePort,
+		TrafficPolicy: lb.SVCTrafficPolicyCluster,
+		Name:          "svc1",
+		Namespace:     "ns1",
+	}
+	_, id1, err := m.svc.UpsertService(p)
+	c.Assert(err, IsNil)
+	c.Assert(len(m.lbmap.ServiceByID[uint16(id1)].Backends), Equals, len(backends1))
+	if option.Config.NodePortAlg == option.NodePortAlgMaglev {
+		c.Assert(m.lbmap.DummyMaglevTable[uint16(id1)], Equals, len(backends1))
+	}
+
+	// A terminating backend is removed from the selectable backends (and
+	// the Maglev lookup table), but is kept in the backend map so that
+	// established connections can drain.
+	terminating := make([]lb.Backend, len(backends1))
+	copy(terminating, backends1)
+	terminating[0].Terminating = true
+	p.Backends = terminating
+	_, _, err = m.svc.UpsertService(p)
+	c.Assert(err, IsNil)
+	c.Assert(len(m.lbmap.ServiceByID[uint16(id1)].Backends), Equals, len(backends1)-1)
+	if option.Config.NodePortAlg == option.NodePortAlgMaglev {
+		c.Assert(m.lbmap.DummyMaglevTable[uint16(id1)], Equals, len(backends1)-1)
+	}
+	c.Assert(len(m.lbmap.BackendByID), Equals, len(backends1))
+
+	// Once the grace period has passed, the terminating backend is removed
+	// entirely on the next sync.
+	option.Config.LBBackendDrainGracePeriod = time.Nanosecond
+	time.Sleep(time.Millisecond)
+	_, _, err = m.svc.UpsertService(p)
+	c.Assert(err, IsNil)
+	c.Assert(len(m.lbmap.ServiceByID[uint16(id1)].Backends), Equals, len(backends1)-1)
+	c.Assert(len(m.lbmap.BackendByID), Equals, len(backends1)-1)
+}
+
+func (m *ManagerTestSuite) TestBackendQuarantine(c *C) {
+	p := &lb.SVC{
+		Frontend:      frontend1,
+		Backends:      backends1,
+		Type:          lb.SVCTypeNodePort,
+		TrafficPolicy: lb.SVCTrafficPolicyCluster,
+		Name:          "svc1",
+		Namespace:     "ns1",
+	}
+	_, id1, err := m.svc.UpsertService(p)
+	c.Assert(err, IsNil)
+	c.Assert(len(m.lbmap.ServiceByID[uint16(id1)].Backends), Equals, len(backends1))
+
+	target := probeTarget{
+		svcID: id1,
+		hash:  backends1[0].L3n4Addr.Hash(),
+		addr:  backends1[0].L3n4Addr,
+	}
+
+	// Failed probes below the threshold do not change the backend
+	// selection.
+	for i := 0; i < healthProbeFailureThreshold-1; i++ {
+		changed := m.svc.applyProbeResults([]probeResult{{target: target}})
+		c.Assert(len(changed), Equals, 0)
+	}
+	c.Assert(len(m.lbmap.ServiceByID[uint16(id1)].Backends), Equals, len(backends1))
+
+	// The failed probe reaching the threshold quarantines the backend: it
+	// is removed from the selectable backends, but kept in the backend map
+	// so that established connections can drain.
+	changed := m.svc.applyProbeResults([]probeResult{{target: target}})
+	c.Assert(changed, checker.DeepEquals, []lb.ID{id1})
+	for _, id := range changed {
+		m.svc.resyncService(id)
+	}
+	c.Assert(len(m.lbmap.ServiceByID[uint16(id1)].Backends), Equals, len(backends1)-1)
+	c.Assert(len(m.lbmap.BackendByID), Equals, len(backends1))
+
+	// The quarantine state is exposed via the API.
+	svc, ok := m.svc.GetDeepCopyServiceByID(lb.ServiceID(id1))
+	c.Assert(ok, Equals, true)
+	quarantined := 0
+	for _, b := range svc.Backends {
+		if b.Quarantined {
+			quarantined++
+		}
+	}
+	c.Assert(quarantined, Equals, 1)
+
+	// A single successful probe lifts the quarantine again.
+	changed = m.svc.applyProbeResults([]probeResult{{target: target, healthy: true}})
+	c.Assert(changed, checker.DeepEquals, []lb.ID{id1})
+	for _, id := range changed {
+		m.svc.resyncService(id)
+	}
+	c.Assert(len(m.lbmap.ServiceByID[uint16(id1)].Backends), Equals, len(backends1))
+}
+
+func (m *ManagerTestSuite) TestPurgeServiceAffinity(c *C) {
+	p1 := &lb.SVC{
+		Frontend:                  frontend1,
+		Backends:                  backends1,
+		Type:                      lb.SVCTypeClusterIP,
+		TrafficPolicy:             lb.SVCTrafficPolicyCluster,
+		SessionAffinity:           true,
+		SessionAffinityTimeoutSec: 100,
+		Name:                      "svc1",
+		Namespace:                 "ns1",
+	}
+	_, id1, err := m.svc.UpsertService(p1)
+	c.Assert(err, IsNil)
+	p2 := &lb.SVC{
+		Frontend:                  frontend2,
+		Backends:                  backends2,
+		Type:                      lb.SVCTypeClusterIP,
+		TrafficPolicy:             lb.SVCTrafficPolicyCluster,
+		SessionAffinity:           true,
+		SessionAffinityTimeoutSec: 100,
+		Name:                      "svc2",
+		Namespace:                 "ns1",
+	}
+	_, id2, err := m.svc.UpsertService(p2)
+	c.Assert(err, IsNil)
+
+	// Only the affinity of the requested service should be purged
+	found, err := m.svc.PurgeServiceAffinity(lb.ServiceID(id1))
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, true)
+	c.Assert(m.lbmap.PurgedAffinity, checker.DeepEquals, []uint16{uint16(id1)})
+
+	// Affinity matches of both services must be left intact
+	c.Assert(len(m.lbmap.AffinityMatch[uint16(id1)]), Equals, len(backends1))
+	c.Assert(len(m.lbmap.AffinityMatch[uint16(id2)]), Equals, len(backends2))
+
+	// Purging a non-existing service should not touch the maps
+	found, err = m.svc.PurgeServiceAffinity(lb.ServiceID(id2 + 100))
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, false)
+	c.Assert(m.lbmap.PurgedAffinity, checker.DeepEquals, []uint16{uint16(id1)})
+}
+
 func (m *ManagerTestSuite) TestHealthCheckNodePort(c *C) {
 	// Create two frontends, one for LoadBalaner and one for ClusterIP.
 	// This is used to emulate how we get K8s services from the K8s watcher,
@@ -720,3 +906,59 @@ func (m *ManagerTestSuite) TestLocalRedirectServiceOverride(c *C) {
 	c.Assert(err, NotNil)
 	c.Assert(created, Equals, false)
 }
+
+func (m *ManagerTestSuite) TestReconcileLBMaps(c *C) {
+	p := &lb.SVC{
+		Frontend:      frontend1,
+		Backends:      backends1,
+		Type:          lb.SVCTypeClusterIP,
+		TrafficPolicy: lb.SVCTrafficPolicyCluster,
+		Name:          "svc1",
+		Namespace:     "ns1",
+	}
+	created, id1, err := m.svc.UpsertService(p)
+	c.Assert(err, IsNil)
+	c.Assert(created, Equals, true)
+
+	// Nothing to repair while the maps match the desired state.
+	repaired, err := m.svc.ReconcileLBMaps(128)
+	c.Assert(err, IsNil)
+	c.Assert(repaired, Equals, 0)
+
+	// Inject drift: remove one backend of the service from the maps, and
+	// add a service and a backend the agent has no knowledge of.
+	lostBackendID := m.lbmap.ServiceByID[uint16(id1)].Backends[0].ID
+	delete(m.lbmap.BackendByID, uint16(lostBackendID))
+	m.lbmap.ServiceByID[uint16(id1)].Backends = m.lbmap.ServiceByID[uint16(id1)].Backends[1:]
+	m.lbmap.ServiceByID[99] = &lb.SVC{
+		Frontend: *lb.NewL3n4AddrID(lb.TCP, net.ParseIP("2.2.2.2"), 80, lb.ScopeExternal, 99),
+	}
+	m.lbmap.BackendByID[77] = lb.NewBackend(77, lb.TCP, net.ParseIP("10.0.0.77"), 8080)
+
+	// One repair each for the re-added backend, the removed unknown
+	// backend, the re-upserted service and the removed unknown service.
+	repaired, err = m.svc.ReconcileLBMaps(128)
+	c.Assert(err, IsNil)
+	c.Assert(repaired, Equals, 4)
+
+	c.Assert(len(m.lbmap.ServiceByID), Equals, 1)
+	c.Assert(len(m.lbmap.ServiceByID[uint16(id1)].Backends), Equals, 2)
+	c.Assert(len(m.lbmap.BackendByID), Equals, 2)
+
+	// A second run finds nothing left to repair.
+	repaired, err = m.svc.ReconcileLBMaps(128)
+	c.Assert(err, IsNil)
+	c.Assert(repaired, Equals, 0)
+
+	// The repair budget bounds the repairs of a single run; the remainder
+	// is left for the next run.
+	m.lbmap.BackendByID[78] = lb.NewBackend(78, lb.TCP, net.ParseIP("10.0.0.78"), 8080)
+	m.lbmap.BackendByID[79] = lb.NewBackend(79, lb.TCP, net.ParseIP("10.0.0.79"), 8080)
+	repaired, err = m.svc.ReconcileLBMaps(1)
+	c.Assert(err, IsNil)
+	c.Assert(repaired, Equals, 1)
+	repaired, err = m.svc.ReconcileLBMaps(1)
+	c.Assert(err, IsNil)
+	c.Assert(repaired, Equals, 1)
+	c.Assert(len(m.lbmap.BackendByID), Equals, 2)
+}
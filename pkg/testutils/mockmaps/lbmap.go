@@ -20,6 +20,7 @@ import (
 
 	"github.com/cilium/cilium/pkg/cidr"
 	lb "github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/maps/lbmap"
 )
 
@@ -29,6 +30,11 @@ type LBMockMap struct {
 	AffinityMatch    lbmap.BackendIDByServiceIDSet
 	SourceRanges     lbmap.SourceRangeSetByServiceID
 	DummyMaglevTable map[uint16]int // svcID => backends count
+	PurgedAffinity   []uint16       // svcIDs of PurgeAffinity calls
+
+	// maglevMu protects DummyMaglevTable, which the restoration worker pool
+	// may update concurrently.
+	maglevMu lock.Mutex
 }
 
 func NewLBMockMap() *LBMockMap {
@@ -53,7 +59,7 @@ func (m *LBMockMap) UpsertService(p *lbmap.UpsertServiceParams) error {
 
 	svc, found := m.ServiceByID[p.ID]
 	if !found {
-		frontend := lb.NewL3n4AddrID(lb.NONE, p.IP, p.Port, p.Scope, lb.ID(p.ID))
+		frontend := lb.NewL3n4AddrID(p.Protocol, p.IP, p.Port, p.Scope, lb.ID(p.ID))
 		svc = &lb.SVC{Frontend: *frontend}
 	} else {
 		if p.PrevBackendCount != len(svc.Backends) {
@@ -65,12 +71,20 @@ func (m *LBMockMap) UpsertService(p *lbmap.UpsertServiceParams) error {
 	svc.SessionAffinityTimeoutSec = p.SessionAffinityTimeoutSec
 	svc.Type = p.Type
 
+	if p.UseMaglev {
+		if err := m.UpsertMaglevLookupTable(p.ID, p.Backends, p.IPv6); err != nil {
+			return err
+		}
+	}
+
 	m.ServiceByID[p.ID] = svc
 
 	return nil
 }
 
 func (m *LBMockMap) UpsertMaglevLookupTable(svcID uint16, backends map[string]uint16, ipv6 bool) error {
+	m.maglevMu.Lock()
+	defer m.maglevMu.Unlock()
 	m.DummyMaglevTable[svcID] = len(backends)
 	return nil
 }
@@ -157,6 +171,14 @@ func (m *LBMockMap) DeleteAffinityMatch(revNATID uint16, backendID uint16) error
 	return nil
 }
 
+func (m *LBMockMap) PurgeAffinity(revNATID uint16) error {
+	if revNATID == 0 {
+		return fmt.Errorf("invalid svc ID 0")
+	}
+	m.PurgedAffinity = append(m.PurgedAffinity, revNATID)
+	return nil
+}
+
 func (m *LBMockMap) DumpAffinityMatches() (lbmap.BackendIDByServiceIDSet, error) {
 	return m.AffinityMatch, nil
 }
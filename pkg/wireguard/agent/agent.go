@@ -24,6 +24,7 @@ import (
 	"net"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/cidr"
@@ -33,6 +34,7 @@ import (
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/mtu"
 	"github.com/cilium/cilium/pkg/node"
 	"github.com/cilium/cilium/pkg/option"
@@ -49,6 +51,12 @@ import (
 
 const (
 	listenPort = 51871
+
+	// keyRotationGracePeriod is the duration for which the old public key
+	// of a peer stays accepted after the peer published a new one. This
+	// gives in-flight traffic and slow watchers time to converge to the
+	// new key without dropping the encrypted traffic of the whole node.
+	keyRotationGracePeriod = 2 * time.Minute
 )
 
 var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "wireguard")
@@ -72,9 +80,20 @@ type Agent struct {
 	ipCache          *ipcache.IPCache
 	listenPort       int
 	privKey          wgtypes.Key
+	privKeyPath      string
 	peerByNodeName   map[string]*peerConfig
 	nodeNameByNodeIP map[string]string
 	restoredPubKeys  map[wgtypes.Key]struct{}
+	// obsoleteKeys holds the old public keys of peers which rotated their
+	// key. They stay accepted for the rotation grace period and are
+	// removed afterwards.
+	obsoleteKeys map[wgtypes.Key]struct{}
+	// prevPubKey is set to the previous local public key after a local
+	// key rotation, until all peers re-established a handshake with the
+	// new key.
+	prevPubKey *wgtypes.Key
+	// keyRotationTime is the time of the last local key rotation
+	keyRotationTime time.Time
 }
 
 // NewAgent creates a new Wireguard Agent
@@ -95,10 +114,12 @@ func NewAgent(privKeyPath string) (*Agent, error) {
 		wgClient:         wgClient,
 		ipCache:          ipcache.IPIdentityCache,
 		privKey:          key,
+		privKeyPath:      privKeyPath,
 		listenPort:       listenPort,
 		peerByNodeName:   map[string]*peerConfig{},
 		nodeNameByNodeIP: map[string]string{},
 		restoredPubKeys:  map[wgtypes.Key]struct{}{},
+		obsoleteKeys:     map[wgtypes.Key]struct{}{},
 	}, nil
 }
 
@@ -235,10 +256,10 @@ func (a *Agent) UpdatePeer(nodeName, pubKeyHex string, nodeIPv4, nodeIPv6 net.IP
 		// Handle pubKey change
 		if prev.pubKey.String() != pubKeyHex {
 			log.WithField(logfields.NodeName, nodeName).Debug("Pubkey has changed")
-			// pubKeys differ, so delete old peer
-			if err := a.deletePeerByPubKey(prev.pubKey); err != nil {
-				return err
-			}
+			// The peer rotated its key. Keep the old key accepted for
+			// the rotation grace period so that traffic encrypted with
+			// it converges without disruption, then remove it.
+			a.scheduleObsoleteKeyRemoval(prev.pubKey)
 		}
 
 		// Reuse allowedIPs from existing peer config
@@ -342,6 +363,77 @@ func (a *Agent) DeletePeer(nodeName string) error {
 	return nil
 }
 
+// scheduleObsoleteKeyRemoval marks the given public key as obsolete and
+// schedules the removal of its peer entry once the rotation grace period has
+// passed. The caller must hold the agent lock.
+func (a *Agent) scheduleObsoleteKeyRemoval(pubKey wgtypes.Key) {
+	a.obsoleteKeys[pubKey] = struct{}{}
+
+	time.AfterFunc(keyRotationGracePeriod, func() {
+		a.Lock()
+		defer a.Unlock()
+
+		if _, ok := a.obsoleteKeys[pubKey]; !ok {
+			return
+		}
+		delete(a.obsoleteKeys, pubKey)
+
+		// The key may be in use again if the peer rolled its rotation
+		// back in the meantime.
+		for _, p := range a.peerByNodeName {
+			if p.pubKey == pubKey {
+				return
+			}
+		}
+
+		log.WithField(logfields.PubKey, pubKey).Debug("Removing obsolete peer key after rotation grace period")
+		if err := a.deletePeerByPubKey(pubKey); err != nil {
+			log.WithField(logfields.PubKey, pubKey).
+				WithError(err).Error("Failed to remove obsolete peer key")
+		}
+	})
+}
+
+// RotateKey generates a new private key for the local node, persists it, and
+// switches the Wireguard device over to it without recreating the interface.
+// It is up to the caller to publish the new public key via the node
+// annotation and the CiliumNode resource. Peers keep our old public key
+// accepted for the rotation grace period while they converge.
+func (a *Agent) RotateKey() error {
+	a.Lock()
+	defer a.Unlock()
+
+	key, err := wgtypes.GeneratePrivateKey()
+	if err != nil {
+		return fmt.Errorf("failed to generate wg private key: %w", err)
+	}
+
+	if err := os.WriteFile(a.privKeyPath, key[:], 0600); err != nil {
+		return fmt.Errorf("failed to save wg private key: %w", err)
+	}
+
+	cfg := wgtypes.Config{PrivateKey: &key}
+	if err := a.wgClient.ConfigureDevice(types.IfaceName, cfg); err != nil {
+		// Restore the persisted key so that a restart comes up with the
+		// key the device is actually using.
+		if err2 := os.WriteFile(a.privKeyPath, a.privKey[:], 0600); err2 != nil {
+			log.WithError(err2).Error("Failed to restore wg private key file after failed rotation")
+		}
+		return fmt.Errorf("failed to configure wg device with rotated key: %w", err)
+	}
+
+	oldPubKey := a.privKey.PublicKey()
+	a.privKey = key
+	a.prevPubKey = &oldPubKey
+	a.keyRotationTime = time.Now()
+
+	node.SetWireguardPubKey(key.PublicKey().String())
+
+	log.WithField(logfields.PubKey, key.PublicKey().String()).Info("Rotated Wireguard key")
+
+	return nil
+}
+
 func (a *Agent) deletePeerByPubKey(pubKey wgtypes.Key) error {
 	log.WithField(logfields.PubKey, pubKey).Debug("Removing peer")
 
@@ -469,6 +561,21 @@ func (a *Agent) OnIPIdentityCacheGC() {
 func (a *Agent) Status(withPeers bool) (*models.WireguardStatus, error) {
 	a.Lock()
 	dev, err := a.wgClient.Device(types.IfaceName)
+	if err == nil && a.prevPubKey != nil {
+		// Track how many peers have not re-established a handshake since
+		// the last local key rotation, i.e. may still encrypt to the old
+		// key.
+		stale := 0
+		for _, p := range dev.Peers {
+			if p.LastHandshakeTime.Before(a.keyRotationTime) {
+				stale++
+			}
+		}
+		if stale == 0 {
+			a.prevPubKey = nil
+		}
+		metrics.WireguardPeersPendingKeyRotation.Set(float64(stale))
+	}
 	a.Unlock()
 
 	if err != nil {
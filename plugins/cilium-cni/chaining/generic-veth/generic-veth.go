@@ -33,6 +33,16 @@ import (
 
 type GenericVethChainer struct{}
 
+// containerVeth describes a veth interface found inside the container's
+// network namespace.
+type containerVeth struct {
+	name      string
+	mac       string
+	peerIndex int
+	ipv4      string
+	ipv6      string
+}
+
 func (f *GenericVethChainer) ImplementsAdd() bool {
 	return true
 }
@@ -63,6 +73,7 @@ func (f *GenericVethChainer) Add(ctx context.Context, pluginCtx chainingapi.Plug
 		vethHostIdx, peerIndex                              int
 		peer                                                netlink.Link
 		netNs                                               ns.NetNS
+		secondaryVeths                                      []containerVeth
 	)
 
 	netNs, err = ns.GetNS(pluginCtx.Args.Netns)
@@ -78,6 +89,7 @@ func (f *GenericVethChainer) Add(ctx context.Context, pluginCtx chainingapi.Plug
 			return err
 		}
 
+		primaryFound := false
 		for _, link := range links {
 			pluginCtx.Logger.Debugf("Found interface in container %+v", link.Attrs())
 
@@ -85,16 +97,14 @@ func (f *GenericVethChainer) Add(ctx context.Context, pluginCtx chainingapi.Plug
 				continue
 			}
 
-			vethLXCMac = link.Attrs().HardwareAddr.String()
-
 			veth, ok := link.(*netlink.Veth)
 			if !ok {
-				return fmt.Errorf("link %s is not a veth interface", vethHostName)
+				return fmt.Errorf("link %s is not a veth interface", link.Attrs().Name)
 			}
 
-			peerIndex, err = netlink.VethPeerIndex(veth)
+			idx, err := netlink.VethPeerIndex(veth)
 			if err != nil {
-				return fmt.Errorf("unable to retrieve index of veth peer %s: %s", vethHostName, err)
+				return fmt.Errorf("unable to retrieve index of veth peer %s: %s", link.Attrs().Name, err)
 			}
 
 			addrs, err := netlink.AddrList(link, netlink.FAMILY_V4)
@@ -102,24 +112,49 @@ func (f *GenericVethChainer) Add(ctx context.Context, pluginCtx chainingapi.Plug
 				return fmt.Errorf("unable to list addresses for link %s: %s", link.Attrs().Name, err)
 			}
 
-			if len(addrs) < 1 {
-				return fmt.Errorf("no address configured inside container")
+			var ipv4, ipv6 string
+			if len(addrs) > 0 {
+				ipv4 = addrs[0].IPNet.IP.String()
 			}
 
-			vethIP = addrs[0].IPNet.IP.String()
-
 			addrsv6, err := netlink.AddrList(link, netlink.FAMILY_V6)
 			if err == nil && len(addrsv6) > 0 {
-				vethIPv6 = addrsv6[0].IPNet.IP.String()
+				ipv6 = addrsv6[0].IPNet.IP.String()
 			} else if err != nil {
 				pluginCtx.Logger.WithError(err).WithFields(logrus.Fields{
 					logfields.Interface: link.Attrs().Name}).Warn("No valid IPv6 address found")
 			}
 
-			return nil
+			// The first veth is the primary interface of the
+			// endpoint, any further veths are secondary interfaces
+			// attached by other plugins in the chain, e.g. Multus.
+			if !primaryFound {
+				if ipv4 == "" {
+					return fmt.Errorf("no address configured inside container")
+				}
+
+				vethLXCMac = link.Attrs().HardwareAddr.String()
+				peerIndex = idx
+				vethIP = ipv4
+				vethIPv6 = ipv6
+				primaryFound = true
+				continue
+			}
+
+			secondaryVeths = append(secondaryVeths, containerVeth{
+				name:      link.Attrs().Name,
+				mac:       link.Attrs().HardwareAddr.String(),
+				peerIndex: idx,
+				ipv4:      ipv4,
+				ipv6:      ipv6,
+			})
 		}
 
-		return fmt.Errorf("no link found inside container")
+		if !primaryFound {
+			return fmt.Errorf("no link found inside container")
+		}
+
+		return nil
 	}); err != nil {
 		return
 	}
@@ -149,21 +184,45 @@ func (f *GenericVethChainer) Add(ctx context.Context, pluginCtx chainingapi.Plug
 		return
 	}
 
+	var secondaryInterfaces []*models.EndpointSecondaryInterface
+	for _, secondary := range secondaryVeths {
+		var secondaryPeer netlink.Link
+		secondaryPeer, err = netlink.LinkByIndex(secondary.peerIndex)
+		if err != nil {
+			err = fmt.Errorf("unable to lookup veth peer %d of secondary interface %s: %s",
+				secondary.peerIndex, secondary.name, err)
+			return
+		}
+
+		secondaryInterfaces = append(secondaryInterfaces, &models.EndpointSecondaryInterface{
+			InterfaceName:          secondaryPeer.Attrs().Name,
+			InterfaceIndex:         int64(secondaryPeer.Attrs().Index),
+			ContainerInterfaceName: secondary.name,
+			Mac:                    secondary.mac,
+			HostMac:                secondaryPeer.Attrs().HardwareAddr.String(),
+			Addressing: []*models.AddressPair{{
+				IPV4: secondary.ipv4,
+				IPV6: secondary.ipv6,
+			}},
+		})
+	}
+
 	var disabled = false
 	ep := &models.EndpointChangeRequest{
 		Addressing: &models.AddressPair{
 			IPV4: vethIP,
 			IPV6: vethIPv6,
 		},
-		ContainerID:       pluginCtx.Args.ContainerID,
-		State:             models.EndpointStateWaitingForIdentity,
-		HostMac:           hostMac,
-		InterfaceIndex:    int64(vethHostIdx),
-		Mac:               vethLXCMac,
-		InterfaceName:     vethHostName,
-		K8sPodName:        string(pluginCtx.CniArgs.K8S_POD_NAME),
-		K8sNamespace:      string(pluginCtx.CniArgs.K8S_POD_NAMESPACE),
-		SyncBuildEndpoint: true,
+		ContainerID:         pluginCtx.Args.ContainerID,
+		State:               models.EndpointStateWaitingForIdentity,
+		HostMac:             hostMac,
+		InterfaceIndex:      int64(vethHostIdx),
+		Mac:                 vethLXCMac,
+		InterfaceName:       vethHostName,
+		K8sPodName:          string(pluginCtx.CniArgs.K8S_POD_NAME),
+		K8sNamespace:        string(pluginCtx.CniArgs.K8S_POD_NAMESPACE),
+		SecondaryInterfaces: secondaryInterfaces,
+		SyncBuildEndpoint:   true,
 		DatapathConfiguration: &models.EndpointDatapathConfiguration{
 			// aws-cni requires ARP passthrough between Linux and
 			// the pod